@@ -0,0 +1,357 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/pgavlin/text"
+)
+
+var (
+	_ io.Reader      = (*Buffer[string])(nil)
+	_ io.Writer      = (*Buffer[string])(nil)
+	_ io.ByteScanner = (*Buffer[string])(nil)
+	_ io.RuneScanner = (*Buffer[string])(nil)
+	_ io.ReaderFrom  = (*Buffer[string])(nil)
+	_ io.WriterTo    = (*Buffer[string])(nil)
+	_ Writer[string] = (*Buffer[string])(nil)
+)
+
+const bufN = 10000
+
+var bufTestString = func() string {
+	b := make([]byte, bufN)
+	for i := range b {
+		b[i] = 'a' + byte(i%26)
+	}
+	return string(b)
+}()
+
+// checkBuffer verifies that the contents of buf match the string s.
+func checkBuffer[S String](t *testing.T, testname string, buf *Buffer[S], s string) {
+	t.Helper()
+	if buf.Len() != len(buf.Bytes()) {
+		t.Errorf("%s: buf.Len() == %d, len(buf.Bytes()) == %d", testname, buf.Len(), len(buf.Bytes()))
+	}
+	if buf.Len() != len(s) {
+		t.Errorf("%s: buf.Len() == %d, len(s) == %d", testname, buf.Len(), len(s))
+	}
+	if got := string(buf.Text()); got != s {
+		t.Errorf("%s: buf.Text() == %q, s == %q", testname, got, s)
+	}
+	if got := buf.String(); got != s {
+		t.Errorf("%s: buf.String() == %q, s == %q", testname, got, s)
+	}
+}
+
+func TestNewBuffer(t *testing.T) {
+	checkBuffer(t, "NewBuffer", NewBuffer(bufTestString), bufTestString)
+	checkBuffer(t, "NewBuffer", NewBuffer([]byte(bufTestString)), bufTestString)
+}
+
+func testBufferBasicOperations[S String](t *testing.T) {
+	var buf Buffer[S]
+
+	for i := 0; i < 5; i++ {
+		checkBuffer(t, "TestBasicOperations (1)", &buf, "")
+
+		buf.Reset()
+		checkBuffer(t, "TestBasicOperations (2)", &buf, "")
+
+		buf.Truncate(0)
+		checkBuffer(t, "TestBasicOperations (3)", &buf, "")
+
+		n, err := buf.Write([]byte(bufTestString[0:1]))
+		if want := 1; err != nil || n != want {
+			t.Errorf("Write: got (%d, %v), want (%d, %v)", n, err, want, nil)
+		}
+		checkBuffer(t, "TestBasicOperations (4)", &buf, "a")
+
+		buf.WriteByte(bufTestString[1])
+		checkBuffer(t, "TestBasicOperations (5)", &buf, "ab")
+
+		n, err = buf.WriteText(S(bufTestString[2:26]))
+		if want := 24; err != nil || n != want {
+			t.Errorf("WriteText: got (%d, %v), want (%d, %v)", n, err, want, nil)
+		}
+		checkBuffer(t, "TestBasicOperations (6)", &buf, bufTestString[0:26])
+
+		buf.Truncate(26)
+		checkBuffer(t, "TestBasicOperations (7)", &buf, bufTestString[0:26])
+
+		buf.Truncate(20)
+		checkBuffer(t, "TestBasicOperations (8)", &buf, bufTestString[0:20])
+
+		p := make([]byte, 5)
+		for s := bufTestString[0:20]; len(s) > 0; s = s[5:] {
+			if n, err := buf.Read(p); n != 5 || err != nil {
+				t.Errorf("Read: got (%d, %v), want (5, nil)", n, err)
+			}
+			checkBuffer(t, "TestBasicOperations (9)", &buf, s[5:])
+		}
+
+		buf.WriteByte(bufTestString[1])
+		c, err := buf.ReadByte()
+		if want := bufTestString[1]; err != nil || c != want {
+			t.Errorf("ReadByte: got (%q, %v), want (%q, %v)", c, err, want, nil)
+		}
+		c, err = buf.ReadByte()
+		if err != io.EOF {
+			t.Errorf("ReadByte: got (%q, %v), want (%q, %v)", c, err, byte(0), io.EOF)
+		}
+	}
+}
+
+func TestBufferBasicOperations(t *testing.T) {
+	t.Run("string", testBufferBasicOperations[string])
+	t.Run("bytes", testBufferBasicOperations[[]byte])
+}
+
+func TestBufferLargeWrites(t *testing.T) {
+	var buf Buffer[string]
+	want := ""
+	for i := 0; i < 5; i++ {
+		buf.WriteString(bufTestString)
+		want += bufTestString
+		checkBuffer(t, "TestBufferLargeWrites (1)", &buf, want)
+	}
+	for len(want) > 0 {
+		n := len(want)
+		if n > 1000 {
+			n = 1000
+		}
+		if got := buf.Next(n); got != want[:n] {
+			t.Fatalf("Next(%d) = %q, want %q", n, got, want[:n])
+		}
+		want = want[n:]
+	}
+	checkBuffer(t, "TestBufferLargeWrites (2)", &buf, "")
+}
+
+func TestBufferReadFrom(t *testing.T) {
+	var buf Buffer[[]byte]
+	for i := 3; i < 30; i += 3 {
+		s := bufTestString[:len(bufTestString)/i]
+		var b Buffer[[]byte]
+		n, err := b.ReadFrom(strings.NewReader(s))
+		if err != nil || n != int64(len(s)) {
+			t.Errorf("ReadFrom: got (%d, %v), want (%d, nil)", n, err, len(s))
+		}
+		checkBuffer(t, "TestBufferReadFrom", &b, s)
+		buf.ReadFrom(&b)
+		checkBuffer(t, "TestBufferReadFrom (drained)", &b, "")
+	}
+}
+
+func TestBufferWriteTo(t *testing.T) {
+	buf := NewBuffer(bufTestString)
+	var b strings.Builder
+	n, err := buf.WriteTo(&b)
+	if err != nil || n != int64(len(bufTestString)) {
+		t.Errorf("WriteTo: got (%d, %v), want (%d, nil)", n, err, len(bufTestString))
+	}
+	if b.String() != bufTestString {
+		t.Errorf("WriteTo: wrote %q", b.String())
+	}
+	checkBuffer(t, "TestBufferWriteTo", buf, "")
+}
+
+func TestBufferRuneIO(t *testing.T) {
+	const NRune = 1000
+	// Built a test slice while we write the data
+	b := make([]byte, utf8.UTFMax*NRune)
+	var buf Buffer[[]byte]
+	n := 0
+	for r := rune(0); r < NRune; r++ {
+		size := utf8.EncodeRune(b[n:], r)
+		nbytes, err := buf.WriteRune(r)
+		if err != nil {
+			t.Fatalf("WriteRune(%U) error: %s", r, err)
+		}
+		if nbytes != size {
+			t.Fatalf("WriteRune(%U) expected %d, got %d", r, size, nbytes)
+		}
+		n += size
+	}
+	b = b[0:n]
+
+	// Check the resulting bytes
+	if string(buf.Bytes()) != string(b) {
+		t.Fatalf("incorrect result from WriteRune: %q not %q", buf.Bytes(), b)
+	}
+
+	p := make([]byte, utf8.UTFMax)
+	// Read it back with ReadRune
+	for r := rune(0); r < NRune; r++ {
+		size := utf8.EncodeRune(p, r)
+		nr, nbytes, err := buf.ReadRune()
+		if nr != r || nbytes != size || err != nil {
+			t.Fatalf("ReadRune(%U) got %U,%d not %U,%d (err=%s)", r, nr, nbytes, r, size, err)
+		}
+	}
+
+	// Check that UnreadRune works
+	buf.Reset()
+	buf.Write(b)
+	for r := rune(0); r < NRune; r++ {
+		r1, size, _ := buf.ReadRune()
+		if err := buf.UnreadRune(); err != nil {
+			t.Fatalf("UnreadRune(%U) got error %q", r, err)
+		}
+		r2, nbytes, err := buf.ReadRune()
+		if r1 != r2 || r1 != r || nbytes != size || err != nil {
+			t.Fatalf("ReadRune(%U) after UnreadRune got %U,%d not %U,%d (err=%s)", r, r2, nbytes, r, size, err)
+		}
+	}
+}
+
+func TestBufferUnreadErrors(t *testing.T) {
+	var buf Buffer[string]
+	buf.WriteString("abc")
+	if err := buf.UnreadRune(); err == nil {
+		t.Error("UnreadRune after WriteString: expected error")
+	}
+	if err := buf.UnreadByte(); err == nil {
+		t.Error("UnreadByte after WriteString: expected error")
+	}
+	buf.Next(1)
+	if err := buf.UnreadRune(); err == nil {
+		t.Error("UnreadRune after Next: expected error")
+	}
+	if err := buf.UnreadByte(); err != nil {
+		t.Errorf("UnreadByte after Next: unexpected error %v", err)
+	}
+	checkBuffer(t, "TestBufferUnreadErrors", &buf, "abc")
+}
+
+var bufferReadTextTests = []struct {
+	buffer   string
+	delim    byte
+	expected []string
+	err      error
+}{
+	{"", 0, []string{""}, io.EOF},
+	{"a\x00", 0, []string{"a\x00"}, nil},
+	{"abbbaaaba", 'b', []string{"ab", "b", "b", "aaab"}, nil},
+	{"hello\x01world", 1, []string{"hello\x01"}, nil},
+	{"foo\nbar", 0, []string{"foo\nbar"}, io.EOF},
+	{"alpha\nbeta\ngamma\n", '\n', []string{"alpha\n", "beta\n", "gamma\n"}, nil},
+	{"alpha\nbeta\ngamma", '\n', []string{"alpha\n", "beta\n", "gamma"}, io.EOF},
+}
+
+func TestBufferReadText(t *testing.T) {
+	for _, test := range bufferReadTextTests {
+		buf := NewBuffer([]byte(test.buffer))
+		var err error
+		for _, expected := range test.expected {
+			var s []byte
+			s, err = buf.ReadText(test.delim)
+			if string(s) != expected {
+				t.Errorf("expected %q, got %q", expected, s)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err != test.err {
+			t.Errorf("expected error %v, got %v", test.err, err)
+		}
+	}
+}
+
+func TestBufferReadTextCopies(t *testing.T) {
+	buf := NewBuffer([]byte("abc\ndef\n"))
+	line, _ := buf.ReadText('\n')
+	buf.Reset()
+	buf.WriteString("xyz\n")
+	if string(line) != "abc\n" {
+		t.Errorf("ReadText result aliases the buffer: got %q", line)
+	}
+}
+
+func TestBufferNext(t *testing.T) {
+	b := []byte{0, 1, 2, 3, 4}
+	tmp := make([]byte, 5)
+	for i := 0; i <= 5; i++ {
+		for j := i; j <= 5; j++ {
+			for k := 0; k <= 6; k++ {
+				// 0 <= i <= j <= 5; 0 <= k <= 6
+				// Check that if we start with a buffer
+				// of length j at offset i and ask for
+				// Next(k), we get the right bytes.
+				buf := NewBuffer(b[0:j])
+				n, _ := buf.Read(tmp[0:i])
+				if n != i {
+					t.Fatalf("Read %d returned %d", i, n)
+				}
+				bb := buf.Next(k)
+				want := k
+				if want > j-i {
+					want = j - i
+				}
+				if len(bb) != want {
+					t.Fatalf("in %d,%d: len(Next(%d)) == %d", i, j, k, len(bb))
+				}
+				for l, v := range bb {
+					if v != byte(l+i) {
+						t.Fatalf("in %d,%d: Next(%d)[%d] = %d, want %d", i, j, k, l, v, l+i)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBufferGrow(t *testing.T) {
+	x := []byte{'x'}
+	y := []byte{'y'}
+	tmp := make([]byte, 72)
+	for _, growLen := range []int{0, 100, 1000, 10000, 100000} {
+		for _, startLen := range []int{0, 100, 1000, 10000, 100000} {
+			xBytes := Repeat(x, startLen)
+
+			buf := NewBuffer(xBytes)
+			// If we read, this affects buf.off, which is good to test.
+			readBytes, _ := buf.Read(tmp)
+			yBytes := Repeat(y, growLen)
+			buf.Grow(growLen)
+			if buf.Available() < growLen {
+				t.Errorf("growth: got %d, want at least %d", buf.Available(), growLen)
+			}
+			buf.Write(yBytes)
+			// Check that buffer has correct data.
+			if !Equal(buf.Bytes()[0:startLen-readBytes], xBytes[readBytes:]) {
+				t.Errorf("bad initial data at %d %d", startLen, growLen)
+			}
+			if !Equal(buf.Bytes()[startLen-readBytes:startLen-readBytes+growLen], yBytes) {
+				t.Errorf("bad written data at %d %d", startLen, growLen)
+			}
+		}
+	}
+}
+
+func TestBufferGrowNegative(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Fatal("Grow(-1) should have panicked")
+		}
+	}()
+	var b Buffer[string]
+	b.Grow(-1)
+}
+
+func TestBufferTruncateOutOfRange(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Fatal("Truncate(10) should have panicked")
+		}
+	}()
+	b := NewBuffer("abc")
+	b.Truncate(10)
+}
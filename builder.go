@@ -74,12 +74,18 @@ func (b *Builder[S]) Reset() {
 	b.buf = nil
 }
 
+// growSlice copies b to a new, larger slice so that there are at least n
+// bytes of capacity beyond len(b).
+func growSlice(b []byte, n int) []byte {
+	buf := make([]byte, len(b), 2*cap(b)+n)
+	copy(buf, b)
+	return buf
+}
+
 // grow copies the buffer to a new, larger buffer so that there are at least n
 // bytes of capacity beyond len(b.buf).
 func (b *Builder[S]) grow(n int) {
-	buf := make([]byte, len(b.buf), 2*cap(b.buf)+n)
-	copy(buf, b.buf)
-	b.buf = buf
+	b.buf = growSlice(b.buf, n)
 }
 
 // Grow grows b's capacity, if necessary, to guarantee space for
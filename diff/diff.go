@@ -0,0 +1,281 @@
+// Package diff computes differences between texts and renders them as
+// unified diffs.
+//
+// Texts are split into tokens—lines, words or runes—and compared with
+// Myers' O(ND) algorithm, using its linear-space refinement, which finds an
+// edit script with the fewest insertions and deletions.
+package diff
+
+import (
+	"unicode"
+
+	"github.com/pgavlin/text"
+	"github.com/pgavlin/text/utf8"
+)
+
+// A Unit is the granularity at which texts are compared.
+type Unit int
+
+const (
+	Lines Unit = iota // newline-terminated lines
+	Words             // maximal runs of white space and of other runes
+	Runes             // single runes
+)
+
+// An Op is the kind of an Edit.
+type Op int
+
+const (
+	Equal  Op = iota // text common to both inputs
+	Delete           // text only in the old input
+	Insert           // text only in the new input
+)
+
+// String returns the name of the operation.
+func (op Op) String() string {
+	switch op {
+	case Equal:
+		return "Equal"
+	case Delete:
+		return "Delete"
+	case Insert:
+		return "Insert"
+	default:
+		return "Op(?)"
+	}
+}
+
+// An Edit is a run of tokens in an edit script.
+type Edit[S text.String] struct {
+	Op Op
+
+	// Text is the text of the edit. For Equal and Delete edits it is a slice
+	// of the old input; for Insert edits it is a slice of the new input.
+	Text S
+
+	// Old and New are the byte offsets at which the edit begins in the old and
+	// new inputs.
+	Old, New int
+}
+
+// Diff returns an edit script that transforms old into new, comparing the
+// texts at the given granularity. Concatenating the texts of the Equal and
+// Delete edits gives old; concatenating those of the Equal and Insert edits
+// gives new. Adjacent edits have different operations, and within each
+// changed region deletions precede insertions.
+func Diff[S text.String](old, new S, unit Unit) []Edit[S] {
+	a, b := split(old, unit), split(new, unit)
+	var edits []Edit[S]
+	oldOff, newOff := 0, 0
+	for _, op := range compare(a, b) {
+		var tok S
+		switch op.op {
+		case Equal, Delete:
+			tok = a[op.old]
+		case Insert:
+			tok = b[op.new]
+		}
+		if n := len(edits); n > 0 && edits[n-1].Op == op.op {
+			e := &edits[n-1]
+			if op.op == Insert {
+				e.Text = new[e.New : newOff+len(tok)]
+			} else {
+				e.Text = old[e.Old : oldOff+len(tok)]
+			}
+		} else {
+			edits = append(edits, Edit[S]{Op: op.op, Text: tok, Old: oldOff, New: newOff})
+		}
+		if op.op != Insert {
+			oldOff += len(tok)
+		}
+		if op.op != Delete {
+			newOff += len(tok)
+		}
+	}
+	return edits
+}
+
+// split splits s into tokens of the given unit. The tokens concatenate to s.
+func split[S text.String](s S, unit Unit) []S {
+	switch unit {
+	case Lines:
+		lines := text.SplitAfter(s, "\n")
+		if len(lines[len(lines)-1]) == 0 {
+			lines = lines[:len(lines)-1]
+		}
+		return lines
+	case Words:
+		var words []S
+		for i := 0; i < len(s); {
+			r, n := utf8.DecodeRune(s[i:])
+			space, j := unicode.IsSpace(r), i+n
+			for j < len(s) {
+				r, n := utf8.DecodeRune(s[j:])
+				if unicode.IsSpace(r) != space {
+					break
+				}
+				j += n
+			}
+			words, i = append(words, s[i:j]), j
+		}
+		return words
+	default:
+		var runes []S
+		for i := 0; i < len(s); {
+			_, n := utf8.DecodeRune(s[i:])
+			runes, i = append(runes, s[i:i+n]), i+n
+		}
+		return runes
+	}
+}
+
+// A tokenOp is a single-token edit. old and new are the indices of the token
+// in the old and new inputs; for insertions and deletions, the index into the
+// other input is the position of the edit.
+type tokenOp struct {
+	op       Op
+	old, new int
+}
+
+// compare returns a minimal single-token edit script that transforms a into b.
+func compare[S text.String](a, b []S) []tokenOp {
+	// Compare tokens by integer ids.
+	ids := make(map[string]int)
+	id := func(toks []S) []int {
+		s := make([]int, len(toks))
+		for i, tok := range toks {
+			k, ok := ids[string(tok)]
+			if !ok {
+				k = len(ids)
+				ids[string(tok)] = k
+			}
+			s[i] = k
+		}
+		return s
+	}
+
+	d := differ{a: id(a), b: id(b)}
+	d.deleted, d.inserted = make([]bool, len(a)), make([]bool, len(b))
+	d.compare(0, len(a), 0, len(b))
+
+	ops := make([]tokenOp, 0, max(len(a), len(b)))
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		switch {
+		case i < len(a) && d.deleted[i]:
+			ops = append(ops, tokenOp{Delete, i, j})
+			i++
+		case j < len(b) && d.inserted[j]:
+			ops = append(ops, tokenOp{Insert, i, j})
+			j++
+		default:
+			ops = append(ops, tokenOp{Equal, i, j})
+			i, j = i+1, j+1
+		}
+	}
+	return ops
+}
+
+// A differ marks the tokens of a that are deleted and the tokens of b that are
+// inserted by a minimal edit script.
+type differ struct {
+	a, b              []int
+	deleted, inserted []bool
+}
+
+// compare marks the edits that transform a[aLo:aHi] into b[bLo:bHi].
+func (d *differ) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] {
+		aLo, bLo = aLo+1, bLo+1
+	}
+	for aLo < aHi && bLo < bHi && d.a[aHi-1] == d.b[bHi-1] {
+		aHi, bHi = aHi-1, bHi-1
+	}
+
+	switch {
+	case aLo == aHi:
+		for j := bLo; j < bHi; j++ {
+			d.inserted[j] = true
+		}
+	case bLo == bHi:
+		for i := aLo; i < aHi; i++ {
+			d.deleted[i] = true
+		}
+	default:
+		x, y := d.middleSnake(aLo, aHi, bLo, bHi)
+		d.compare(aLo, x, bLo, y)
+		d.compare(x, aHi, y, bHi)
+	}
+}
+
+// middleSnake returns a point (x, y) through which a minimal edit script for
+// a[aLo:aHi] and b[bLo:bHi] passes, found by searching forward from the start
+// and backward from the end until the searches meet. The inputs must be
+// non-empty and must differ in their first and last tokens, which guarantees
+// that the point is neither the start nor the end.
+func (d *differ) middleSnake(aLo, aHi, bLo, bHi int) (x, y int) {
+	a, b := d.a[aLo:aHi], d.b[bLo:bHi]
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD + 1
+
+	// fwd[offset+k] is the furthest x reached on diagonal k = x-y searching
+	// forward; bwd[offset+k] is the furthest distance from the end reached on
+	// diagonal k searching backward.
+	fwd, bwd := make([]int, 2*offset+1), make([]int, 2*offset+1)
+	for i := range fwd {
+		fwd[i], bwd[i] = -1, -1
+	}
+	fwd[offset+1], bwd[offset+1] = 0, 0
+
+	delta := n - m
+	odd := delta%2 != 0
+	for D := 0; D <= maxD; D++ {
+		for k := -D; k <= D; k += 2 {
+			var x int
+			if k == -D || (k != D && fwd[offset+k-1] < fwd[offset+k+1]) {
+				x = fwd[offset+k+1]
+			} else {
+				x = fwd[offset+k-1] + 1
+			}
+			y := x - k
+			if x < 0 || y < 0 || x > n || y > m {
+				continue
+			}
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			fwd[offset+k] = x
+			if odd {
+				if rk := delta - k; rk >= -(D-1) && rk <= D-1 {
+					if rx := bwd[offset+rk]; rx >= 0 && x+rx >= n {
+						return aLo + x, bLo + y
+					}
+				}
+			}
+		}
+		for k := -D; k <= D; k += 2 {
+			var x int
+			if k == -D || (k != D && bwd[offset+k-1] < bwd[offset+k+1]) {
+				x = bwd[offset+k+1]
+			} else {
+				x = bwd[offset+k-1] + 1
+			}
+			y := x - k
+			if x < 0 || y < 0 || x > n || y > m {
+				continue
+			}
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x, y = x+1, y+1
+			}
+			bwd[offset+k] = x
+			if !odd {
+				if fk := delta - k; fk >= -D && fk <= D {
+					if fx := fwd[offset+fk]; fx >= 0 && fx+x >= n {
+						return aLo + n - x, bLo + m - y
+					}
+				}
+			}
+		}
+	}
+	panic("unreachable")
+}
@@ -0,0 +1,153 @@
+package diff_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	. "github.com/pgavlin/text/diff"
+)
+
+func format[S ~string | ~[]byte](edits []Edit[S]) string {
+	var b strings.Builder
+	for _, e := range edits {
+		switch e.Op {
+		case Equal:
+			b.WriteString(string(e.Text))
+		case Delete:
+			b.WriteString("[-" + string(e.Text) + "]")
+		case Insert:
+			b.WriteString("{+" + string(e.Text) + "}")
+		}
+	}
+	return b.String()
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		old, new string
+		unit     Unit
+		want     string
+	}{
+		{"", "", Lines, ""},
+		{"a\n", "a\n", Lines, "a\n"},
+		{"", "a\nb\n", Lines, "{+a\nb\n}"},
+		{"a\nb\n", "", Lines, "[-a\nb\n]"},
+		{"a\nb\nc\n", "a\nx\nc\n", Lines, "a\n[-b\n]{+x\n}c\n"},
+		{"a\nb", "a\nb\n", Lines, "a\n[-b]{+b\n}"},
+		{"a\nb\nc\nd\n", "b\nc\nd\ne\n", Lines, "[-a\n]b\nc\nd\n{+e\n}"},
+		{"the quick brown fox", "the slow brown  fox", Words, "the [-quick]{+slow} brown[- ]{+  }fox"},
+		{"héllo", "hallo", Runes, "h[-é]{+a}llo"},
+		{"abcabba", "cbabac", Runes, ""},
+	}
+	for _, tt := range tests {
+		edits := Diff(tt.old, tt.new, tt.unit)
+		if tt.want != "" || tt.old == tt.new {
+			if got := format(edits); got != tt.want {
+				t.Errorf("Diff(%q, %q, %d) = %q, want %q", tt.old, tt.new, tt.unit, got, tt.want)
+			}
+		}
+		checkEdits(t, tt.old, tt.new, edits)
+		if got := format(Diff([]byte(tt.old), []byte(tt.new), tt.unit)); got != format(edits) {
+			t.Errorf("Diff([]byte(%q), []byte(%q), %d) = %q, want %q", tt.old, tt.new, tt.unit, got, format(edits))
+		}
+	}
+}
+
+// checkEdits checks that edits transform old into new and that their offsets
+// are consistent.
+func checkEdits(t *testing.T, old, new string, edits []Edit[string]) {
+	t.Helper()
+	var a, b strings.Builder
+	for i, e := range edits {
+		if i > 0 && edits[i-1].Op == e.Op {
+			t.Errorf("Diff(%q, %q): adjacent edits with op %v", old, new, e.Op)
+		}
+		if i > 0 && edits[i-1].Op == Insert && e.Op == Delete {
+			t.Errorf("Diff(%q, %q): insertion precedes deletion", old, new)
+		}
+		if e.Old != a.Len() || e.New != b.Len() {
+			t.Errorf("Diff(%q, %q): edit %d at %d, %d, want %d, %d", old, new, i, e.Old, e.New, a.Len(), b.Len())
+		}
+		if len(e.Text) == 0 {
+			t.Errorf("Diff(%q, %q): empty edit", old, new)
+		}
+		if e.Op != Insert {
+			a.WriteString(e.Text)
+		}
+		if e.Op != Delete {
+			b.WriteString(e.Text)
+		}
+	}
+	if a.String() != old || b.String() != new {
+		t.Errorf("Diff(%q, %q): edits give %q, %q", old, new, a.String(), b.String())
+	}
+}
+
+// lcs returns the length of the longest common subsequence of a and b.
+func lcs(a, b string) int {
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				cur[j] = prev[j-1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j-1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func TestDiffMinimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randText := func() string {
+		b := make([]byte, rng.Intn(40))
+		for i := range b {
+			b[i] = "abc"[rng.Intn(3)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 2000; i++ {
+		old, new := randText(), randText()
+		edits := Diff(old, new, Runes)
+		checkEdits(t, old, new, edits)
+
+		changed := 0
+		for _, e := range edits {
+			if e.Op != Equal {
+				changed += len(e.Text)
+			}
+		}
+		if want := len(old) + len(new) - 2*lcs(old, new); changed != want {
+			t.Fatalf("Diff(%q, %q): %d changes, want %d", old, new, changed, want)
+		}
+	}
+}
+
+func TestOpString(t *testing.T) {
+	for op, want := range map[Op]string{Equal: "Equal", Delete: "Delete", Insert: "Insert", Op(7): "Op(?)"} {
+		if got := op.String(); got != want {
+			t.Errorf("Op(%d).String() = %q, want %q", int(op), got, want)
+		}
+	}
+}
+
+func BenchmarkDiff(b *testing.B) {
+	var old, new strings.Builder
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		line := strings.Repeat("x", rng.Intn(40)) + "\n"
+		if rng.Intn(20) != 0 {
+			old.WriteString(line)
+		}
+		if rng.Intn(20) != 0 {
+			new.WriteString(line)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(old.String(), new.String(), Lines)
+	}
+}
@@ -0,0 +1,133 @@
+package diff
+
+import "github.com/pgavlin/text"
+
+// A change replaces the lines base[start:end] of a merge base with lines.
+type change[S text.String] struct {
+	start, end int
+	lines      []S
+}
+
+// changes returns the changes that transform the lines of base into those of
+// other, in order.
+func changes[S text.String](base, other []S) []change[S] {
+	var cs []change[S]
+	ops := compare(base, other)
+	for i := 0; i < len(ops); {
+		if ops[i].op == Equal {
+			i++
+			continue
+		}
+		c := change[S]{start: ops[i].old, end: ops[i].old}
+		for ; i < len(ops) && ops[i].op != Equal; i++ {
+			if ops[i].op == Delete {
+				c.end++
+			} else {
+				c.lines = append(c.lines, other[ops[i].new])
+			}
+		}
+		cs = append(cs, c)
+	}
+	return cs
+}
+
+// Merge3 merges the changes that transform base into ours and base into
+// theirs, comparing the texts line by line. Changes to different parts of
+// base are combined, and identical changes made on both sides are included
+// once. Changes on both sides to overlapping or adjacent lines that differ
+// are conflicts, which are written with conflict markers:
+//
+//	<<<<<<< ours
+//	our lines
+//	=======
+//	their lines
+//	>>>>>>> theirs
+//
+// Merge3 returns the merged text and the number of conflicts.
+func Merge3[S text.String](base, ours, theirs S) (merged S, conflicts int) {
+	lines := split(base, Lines)
+	sides := [2][]change[S]{changes(lines, split(ours, Lines)), changes(lines, split(theirs, Lines))}
+
+	var b text.Builder[S]
+	b.Grow(max(len(ours), len(theirs)))
+	pos := 0
+	for len(sides[0]) > 0 || len(sides[1]) > 0 {
+		// Gather the changes on either side that overlap or touch the
+		// region, which begins with the earliest remaining change.
+		var region [2][]change[S]
+		start, end := len(lines)+1, -1
+		for s := range sides {
+			if len(sides[s]) > 0 && sides[s][0].start < start {
+				start, end = sides[s][0].start, sides[s][0].end
+			}
+		}
+		for grown := true; grown; {
+			grown = false
+			for s := range sides {
+				n := 0
+				for n < len(sides[s]) && sides[s][n].start <= end {
+					end = max(end, sides[s][n].end)
+					n++
+				}
+				if n > 0 {
+					region[s] = append(region[s], sides[s][:n]...)
+					sides[s], grown = sides[s][n:], true
+				}
+			}
+		}
+
+		for _, l := range lines[pos:start] {
+			b.WriteText(l)
+		}
+		pos = end
+
+		switch {
+		case len(region[1]) == 0:
+			writeRegion(&b, lines, start, end, region[0])
+		case len(region[0]) == 0:
+			writeRegion(&b, lines, start, end, region[1])
+		default:
+			var o, t text.Builder[S]
+			writeRegion(&o, lines, start, end, region[0])
+			writeRegion(&t, lines, start, end, region[1])
+			if o.String() == t.String() {
+				b.WriteText(o.Text())
+				break
+			}
+			conflicts++
+			writeConflict(&b, "<<<<<<< ours\n", o.Text())
+			writeConflict(&b, "=======\n", t.Text())
+			writeConflict(&b, ">>>>>>> theirs\n", text.Empty[S]())
+		}
+	}
+	for _, l := range lines[pos:] {
+		b.WriteText(l)
+	}
+	return b.Text(), conflicts
+}
+
+// writeRegion writes the lines base[start:end] as transformed by cs.
+func writeRegion[S text.String](b *text.Builder[S], base []S, start, end int, cs []change[S]) {
+	for _, c := range cs {
+		for _, l := range base[start:c.start] {
+			b.WriteText(l)
+		}
+		for _, l := range c.lines {
+			b.WriteText(l)
+		}
+		start = c.end
+	}
+	for _, l := range base[start:end] {
+		b.WriteText(l)
+	}
+}
+
+// writeConflict writes a conflict marker followed by s. If the text written
+// so far does not end in a newline, a newline is written before the marker.
+func writeConflict[S text.String](b *text.Builder[S], marker string, s S) {
+	if b.Len() > 0 && b.String()[b.Len()-1] != '\n' {
+		b.WriteString("\n")
+	}
+	b.WriteString(marker)
+	b.WriteText(s)
+}
@@ -0,0 +1,59 @@
+package diff_test
+
+import (
+	"testing"
+
+	. "github.com/pgavlin/text/diff"
+)
+
+func TestMerge3(t *testing.T) {
+	base := "a\nb\nc\nd\ne\n"
+	tests := []struct {
+		name         string
+		ours, theirs string
+		want         string
+		conflicts    int
+	}{
+		{"unchanged", base, base, base, 0},
+		{"ours only", "a\nB\nc\nd\ne\n", base, "a\nB\nc\nd\ne\n", 0},
+		{"theirs only", base, "a\nb\nc\nd\ne\nf\n", "a\nb\nc\nd\ne\nf\n", 0},
+		{"both disjoint", "A\nb\nc\nd\ne\n", "a\nb\nc\nd\nE\n", "A\nb\nc\nd\nE\n", 0},
+		{"both same", "a\nX\nc\nd\ne\n", "a\nX\nc\nd\ne\n", "a\nX\nc\nd\ne\n", 0},
+		{"deletions", "a\nc\nd\ne\n", "a\nb\nc\ne\n", "a\nc\ne\n", 0},
+		{
+			"conflict",
+			"a\nours\nc\nd\ne\n", "a\ntheirs\nc\nd\ne\n",
+			"a\n<<<<<<< ours\nours\n=======\ntheirs\n>>>>>>> theirs\nc\nd\ne\n", 1,
+		},
+		{
+			"adjacent conflict",
+			"a\nB\nc\nd\ne\n", "a\nb\nC\nd\ne\n",
+			"a\n<<<<<<< ours\nB\nc\n=======\nb\nC\n>>>>>>> theirs\nd\ne\n", 1,
+		},
+		{
+			"two conflicts",
+			"1\nb\nc\nd\n5\n", "one\nb\nc\nd\nfive\n",
+			"<<<<<<< ours\n1\n=======\none\n>>>>>>> theirs\nb\nc\nd\n<<<<<<< ours\n5\n=======\nfive\n>>>>>>> theirs\n", 2,
+		},
+		{
+			"insertions at the same place",
+			"a\nb\nc\nx\nd\ne\n", "a\nb\nc\ny\nd\ne\n",
+			"a\nb\nc\n<<<<<<< ours\nx\n=======\ny\n>>>>>>> theirs\nd\ne\n", 1,
+		},
+		{
+			"missing final newline",
+			"a\nb\nc\nd\nours", "a\nb\nc\nd\ntheirs",
+			"a\nb\nc\nd\n<<<<<<< ours\nours\n=======\ntheirs\n>>>>>>> theirs\n", 1,
+		},
+	}
+	for _, tt := range tests {
+		got, conflicts := Merge3(base, tt.ours, tt.theirs)
+		if got != tt.want || conflicts != tt.conflicts {
+			t.Errorf("%s: Merge3 =\n%s(%d conflicts)\nwant\n%s(%d conflicts)", tt.name, got, conflicts, tt.want, tt.conflicts)
+		}
+		gotb, conflicts := Merge3([]byte(base), []byte(tt.ours), []byte(tt.theirs))
+		if string(gotb) != tt.want || conflicts != tt.conflicts {
+			t.Errorf("%s: Merge3([]byte) =\n%s(%d conflicts)", tt.name, gotb, conflicts)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package diff
+
+import (
+	"fmt"
+
+	"github.com/pgavlin/text"
+)
+
+// A Line is a line of a Hunk. Its text includes the terminating newline, if
+// any.
+type Line[S text.String] struct {
+	Op   Op
+	Text S
+}
+
+// A Hunk is a run of changed lines surrounded by unchanged context lines.
+type Hunk[S text.String] struct {
+	// Old and New are the indices of the first line of the hunk in the old
+	// and new texts, starting at 0.
+	Old, New int
+
+	Lines []Line[S]
+}
+
+// counts returns the number of lines the hunk covers in the old and new texts.
+func (h *Hunk[S]) counts() (old, new int) {
+	for _, l := range h.Lines {
+		if l.Op != Insert {
+			old++
+		}
+		if l.Op != Delete {
+			new++
+		}
+	}
+	return old, new
+}
+
+// A Patch is a sequence of hunks that transforms one text into another. The
+// hunks are ordered and do not overlap.
+type Patch[S text.String] []Hunk[S]
+
+// MakePatch returns a patch that transforms the lines of old into the lines
+// of new, with context lines of unchanged text around each change. Changes
+// separated by at most 2*context unchanged lines share a hunk. If the texts
+// are equal, the patch is empty.
+func MakePatch[S text.String](old, new S, context int) Patch[S] {
+	a, b := split(old, Lines), split(new, Lines)
+	ops := compare(a, b)
+	context = max(context, 0)
+
+	var patch Patch[S]
+	for i := 0; i < len(ops); {
+		if ops[i].op == Equal {
+			i++
+			continue
+		}
+
+		start, end := max(i-context, 0), i
+		for {
+			for end < len(ops) && ops[end].op != Equal {
+				end++
+			}
+			next := end
+			for next < len(ops) && ops[next].op == Equal {
+				next++
+			}
+			if next == len(ops) || next-end > 2*context {
+				end = min(end+context, next)
+				break
+			}
+			end = next
+		}
+
+		h := Hunk[S]{Old: ops[start].old, New: ops[start].new, Lines: make([]Line[S], end-start)}
+		for k, op := range ops[start:end] {
+			h.Lines[k].Op = op.op
+			if op.op == Insert {
+				h.Lines[k].Text = b[op.new]
+			} else {
+				h.Lines[k].Text = a[op.old]
+			}
+		}
+		patch = append(patch, h)
+		i = end
+	}
+	return patch
+}
+
+// maxFuzz is the number of context lines at each end of a hunk that Apply may
+// ignore when the hunk does not otherwise match.
+const maxFuzz = 2
+
+// An ApplyError reports a hunk that Apply could not match in the original
+// text.
+type ApplyError struct {
+	// Hunk is the number of the hunk, starting at 1, and Line is the line of
+	// the original text at which the hunk was expected, starting at 1.
+	Hunk int
+	Line int
+}
+
+func (e *ApplyError) Error() string {
+	return fmt.Sprintf("diff.Apply: hunk %d does not apply at line %d", e.Hunk, e.Line)
+}
+
+// Apply applies patch to original and returns the result.
+//
+// Each hunk is matched against the lines of original nearest to where the
+// hunk expects them, adjusted by the distance that previous hunks moved, so
+// the patch still applies after lines are added or removed elsewhere in the
+// text. If a hunk's lines are not found, Apply tries again ignoring up to two
+// context lines at each end of the hunk. If a hunk still does not match,
+// Apply returns an *ApplyError.
+func Apply[S text.String](original S, patch Patch[S]) (S, error) {
+	lines := split(original, Lines)
+
+	var b text.Builder[S]
+	b.Grow(len(original))
+	pos, drift := 0, 0
+	for i := range patch {
+		h := &patch[i]
+		at, lead, trail, ok := match(lines, pos, h, h.Old+drift)
+		if !ok {
+			return text.Empty[S](), &ApplyError{Hunk: i + 1, Line: h.Old + drift + 1}
+		}
+
+		for _, l := range lines[pos:at] {
+			b.WriteText(l)
+		}
+		pos = at
+		for _, l := range h.Lines[lead : len(h.Lines)-trail] {
+			switch l.Op {
+			case Equal:
+				b.WriteText(lines[pos])
+				pos++
+			case Delete:
+				pos++
+			case Insert:
+				b.WriteText(l.Text)
+			}
+		}
+		drift = at - lead - h.Old
+	}
+	for _, l := range lines[pos:] {
+		b.WriteText(l)
+	}
+	return b.Text(), nil
+}
+
+// match finds the lines of h in lines at or after index from, searching
+// outward from index expected. If the lines are not found, match retries
+// with up to maxFuzz leading and trailing context lines of h ignored. match
+// returns the index of the first matched line and the numbers of leading and
+// trailing lines of h that were ignored.
+func match[S text.String](lines []S, from int, h *Hunk[S], expected int) (at, lead, trail int, ok bool) {
+	leadContext, trailContext := 0, 0
+	for leadContext < len(h.Lines) && h.Lines[leadContext].Op == Equal {
+		leadContext++
+	}
+	for trailContext < len(h.Lines)-leadContext && h.Lines[len(h.Lines)-1-trailContext].Op == Equal {
+		trailContext++
+	}
+
+	var old []S
+	for fuzz := 0; fuzz <= maxFuzz; fuzz++ {
+		lead, trail = min(fuzz, leadContext), min(fuzz, trailContext)
+		if fuzz > 0 && lead < fuzz && trail < fuzz {
+			break // there is no more context to ignore
+		}
+
+		old = old[:0]
+		for _, l := range h.Lines[lead : len(h.Lines)-trail] {
+			if l.Op != Insert {
+				old = append(old, l.Text)
+			}
+		}
+		if at, ok := search(lines, old, from, expected+lead); ok {
+			return at, lead, trail, true
+		}
+	}
+	return 0, 0, 0, false
+}
+
+// search returns the index of an occurrence of old in lines at or after
+// index from, choosing the occurrence closest to index expected.
+func search[S text.String](lines, old []S, from, expected int) (int, bool) {
+	last := len(lines) - len(old)
+	if last < from {
+		return 0, false
+	}
+	expected = min(max(expected, from), last)
+	for d := 0; expected-d >= from || expected+d <= last; d++ {
+		if p := expected + d; p <= last && linesEqual(lines[p:p+len(old)], old) {
+			return p, true
+		}
+		if p := expected - d; d > 0 && p >= from && linesEqual(lines[p:p+len(old)], old) {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+func linesEqual[S text.String](a, b []S) bool {
+	for i := range a {
+		if !text.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,157 @@
+package diff_test
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/pgavlin/text/diff"
+)
+
+func numbered(lines ...int) string {
+	var b strings.Builder
+	for _, n := range lines {
+		b.WriteString(strconv.Itoa(n) + "\n")
+	}
+	return b.String()
+}
+
+func seq(lo, hi int) []int {
+	var s []int
+	for i := lo; i < hi; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+func TestMakePatch(t *testing.T) {
+	p := MakePatch("a\nb\nc\nd\n", "a\nx\nc\nd\ne\n", 1)
+	want := Patch[string]{
+		{Old: 0, New: 0, Lines: []Line[string]{
+			{Equal, "a\n"}, {Delete, "b\n"}, {Insert, "x\n"}, {Equal, "c\n"}, {Equal, "d\n"}, {Insert, "e\n"},
+		}},
+	}
+	if len(p) != len(want) {
+		t.Fatalf("MakePatch: got %d hunks, want %d", len(p), len(want))
+	}
+	for i := range p {
+		if p[i].Old != want[i].Old || p[i].New != want[i].New || len(p[i].Lines) != len(want[i].Lines) {
+			t.Fatalf("MakePatch: hunk %d = %+v, want %+v", i, p[i], want[i])
+		}
+		for j := range p[i].Lines {
+			if p[i].Lines[j] != want[i].Lines[j] {
+				t.Errorf("MakePatch: hunk %d line %d = %+v, want %+v", i, j, p[i].Lines[j], want[i].Lines[j])
+			}
+		}
+	}
+	if p := MakePatch("a\n", "a\n", 3); len(p) != 0 {
+		t.Errorf("MakePatch of equal texts has %d hunks", len(p))
+	}
+}
+
+func TestApply(t *testing.T) {
+	old := numbered(seq(1, 21)...)
+	new := strings.Replace(strings.Replace(old, "5\n", "five\n", 1), "15\n", "fifteen\n", 1)
+	patch := MakePatch(old, new, 3)
+
+	tests := []struct {
+		name     string
+		original string
+		want     string
+	}{
+		{"exact", old, new},
+		{
+			"lines added before",
+			"a\nb\n" + old,
+			"a\nb\n" + new,
+		},
+		{
+			"lines removed between hunks",
+			strings.Replace(old, "10\n11\n", "", 1),
+			strings.Replace(new, "10\n11\n", "", 1),
+		},
+		{
+			"context changed",
+			strings.Replace(old, "3\n", "three\n", 1),
+			strings.Replace(new, "3\n", "three\n", 1),
+		},
+	}
+	for _, tt := range tests {
+		got, err := Apply(tt.original, patch)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: Apply = %q, %v; want %q", tt.name, got, err, tt.want)
+		}
+		gotb, err := Apply([]byte(tt.original), MakePatch([]byte(old), []byte(new), 3))
+		if err != nil || string(gotb) != tt.want {
+			t.Errorf("%s: Apply([]byte) = %q, %v; want %q", tt.name, gotb, err, tt.want)
+		}
+	}
+
+	if got, err := Apply("a\n", nil); got != "a\n" || err != nil {
+		t.Errorf("Apply with an empty patch = %q, %v", got, err)
+	}
+	if got, err := Apply("", MakePatch("", "a\nb", 3)); got != "a\nb" || err != nil {
+		t.Errorf("Apply to an empty text = %q, %v", got, err)
+	}
+}
+
+func TestApplyError(t *testing.T) {
+	old := numbered(seq(1, 21)...)
+	new := strings.Replace(strings.Replace(old, "5\n", "five\n", 1), "15\n", "fifteen\n", 1)
+	patch := MakePatch(old, new, 3)
+
+	_, err := Apply(strings.Replace(old, "15\n", "XV\n", 1), patch)
+	var ae *ApplyError
+	if !errors.As(err, &ae) {
+		t.Fatalf("Apply: got error %v, want *ApplyError", err)
+	}
+	if ae.Hunk != 2 || ae.Line != 12 {
+		t.Errorf("Apply: got hunk %d at line %d, want hunk 2 at line 12", ae.Hunk, ae.Line)
+	}
+	if got, want := err.Error(), "diff.Apply: hunk 2 does not apply at line 12"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	// Too much changed context cannot be fuzzed away.
+	broken := strings.Replace(strings.Replace(strings.Replace(old, "2\n", "b\n", 1), "3\n", "c\n", 1), "4\n", "d\n", 1)
+	if _, err := Apply(broken, patch); !errors.As(err, &ae) || ae.Hunk != 1 {
+		t.Errorf("Apply with changed context: got %v", err)
+	}
+}
+
+func TestApplyRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	edit := func(lines []string) []string {
+		out := append([]string(nil), lines...)
+		for n := rng.Intn(4); n > 0; n-- {
+			i := rng.Intn(len(out) + 1)
+			switch rng.Intn(3) {
+			case 0:
+				out = append(out[:i], append([]string{"new" + strconv.Itoa(rng.Intn(1000)) + "\n"}, out[i:]...)...)
+			case 1:
+				if i < len(out) {
+					out = append(out[:i], out[i+1:]...)
+				}
+			case 2:
+				if i < len(out) {
+					out[i] = "changed" + strconv.Itoa(rng.Intn(1000)) + "\n"
+				}
+			}
+		}
+		return out
+	}
+	for i := 0; i < 500; i++ {
+		var lines []string
+		for n := rng.Intn(30); n > 0; n-- {
+			lines = append(lines, "line"+strconv.Itoa(rng.Intn(1000))+"\n")
+		}
+		old := strings.Join(lines, "")
+		new := strings.Join(edit(lines), "")
+		context := rng.Intn(4)
+		if got, err := Apply(old, MakePatch(old, new, context)); err != nil || got != new {
+			t.Fatalf("Apply(%q, MakePatch(%q, %q, %d)) = %q, %v", old, old, new, context, got, err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package diff
+
+import (
+	"strconv"
+
+	"github.com/pgavlin/text"
+)
+
+// WriteUnified writes a unified diff of the lines of old and new to w, with
+// context lines of unchanged text around each change. The file header names
+// old and new oldName and newName. A line that lacks a terminating newline is
+// followed by a "\ No newline at end of file" marker. If the texts are equal,
+// WriteUnified writes nothing.
+//
+// WriteUnified returns the number of bytes written and any error encountered
+// while writing.
+func WriteUnified[S text.String](w text.Writer[S], oldName, newName string, old, new S, context int) (n int, err error) {
+	return WritePatch(w, oldName, newName, MakePatch(old, new, context))
+}
+
+// WritePatch writes patch to w in unified diff format. The file header names
+// the old and new texts oldName and newName. If the patch is empty,
+// WritePatch writes nothing.
+//
+// WritePatch returns the number of bytes written and any error encountered
+// while writing.
+func WritePatch[S text.String](w text.Writer[S], oldName, newName string, patch Patch[S]) (n int, err error) {
+	u := unified[S]{w: w}
+	for i := range patch {
+		if i == 0 {
+			u.writeString("--- " + oldName + "\n+++ " + newName + "\n")
+		}
+		u.writeHunk(&patch[i])
+	}
+	return u.n, u.err
+}
+
+// Unified returns a unified diff of the lines of old and new. See
+// WriteUnified for details.
+func Unified[S text.String](oldName, newName string, old, new S, context int) S {
+	var b text.Builder[S]
+	WriteUnified[S](&b, oldName, newName, old, new, context)
+	return b.Text()
+}
+
+// unified accumulates the byte count and first error of a sequence of writes.
+type unified[S text.String] struct {
+	w   text.Writer[S]
+	n   int
+	err error
+}
+
+func (u *unified[S]) write(s S) {
+	if u.err == nil && len(s) > 0 {
+		n, err := u.w.WriteText(s)
+		u.n += n
+		u.err = err
+	}
+}
+
+func (u *unified[S]) writeString(s string) {
+	u.write(S(s))
+}
+
+// writeHunk writes a hunk header followed by the lines of h.
+func (u *unified[S]) writeHunk(h *Hunk[S]) {
+	oldCount, newCount := h.counts()
+	u.writeString("@@ -" + hunkRange(h.Old, oldCount) + " +" + hunkRange(h.New, newCount) + " @@\n")
+
+	for _, l := range h.Lines {
+		switch l.Op {
+		case Equal:
+			u.writeString(" ")
+		case Delete:
+			u.writeString("-")
+		case Insert:
+			u.writeString("+")
+		}
+		u.write(l.Text)
+		if l.Text[len(l.Text)-1] != '\n' {
+			u.writeString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the range of count lines starting at the 0-based line
+// start. An empty range is named by the line before it, and a count of one is
+// omitted.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return strconv.Itoa(start) + ",0"
+	case 1:
+		return strconv.Itoa(start + 1)
+	default:
+		return strconv.Itoa(start+1) + "," + strconv.Itoa(count)
+	}
+}
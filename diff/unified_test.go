@@ -0,0 +1,84 @@
+package diff_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/pgavlin/text"
+	. "github.com/pgavlin/text/diff"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		old, new string
+		context  int
+		want     string
+	}{
+		{"a\nb\n", "a\nb\n", 3, ""},
+		{
+			"a\nb\nc\n", "a\nx\nc\n", 3,
+			"--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			"", "a\n", 3,
+			"--- old\n+++ new\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			"a\n", "", 3,
+			"--- old\n+++ new\n@@ -1 +0,0 @@\n-a\n",
+		},
+		{
+			"a\nb", "a\nb\n", 3,
+			"--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+		},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n", "1\nX\n3\n4\n5\n6\n7\nY\n9\n", 1,
+			"--- old\n+++ new\n@@ -1,3 +1,3 @@\n 1\n-2\n+X\n 3\n@@ -7,3 +7,3 @@\n 7\n-8\n+Y\n 9\n",
+		},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n", "1\nX\n3\n4\n5\n6\n7\nY\n9\n", 3,
+			"--- old\n+++ new\n@@ -1,9 +1,9 @@\n 1\n-2\n+X\n 3\n 4\n 5\n 6\n 7\n-8\n+Y\n 9\n",
+		},
+		{
+			"1\n2\n3\n4\n5\n", "1\n2\n4\n5\n", 0,
+			"--- old\n+++ new\n@@ -3 +2,0 @@\n-3\n",
+		},
+		{
+			"1\n2\n3\n", "1\n2\nx\n3\n", 0,
+			"--- old\n+++ new\n@@ -2,0 +3 @@\n+x\n",
+		},
+	}
+	for _, tt := range tests {
+		if got := Unified("old", "new", tt.old, tt.new, tt.context); got != tt.want {
+			t.Errorf("Unified(%q, %q, %d) =\n%s\nwant\n%s", tt.old, tt.new, tt.context, got, tt.want)
+		}
+		if got := Unified("old", "new", []byte(tt.old), []byte(tt.new), tt.context); string(got) != tt.want {
+			t.Errorf("Unified([]byte(%q), []byte(%q), %d) =\n%s\nwant\n%s", tt.old, tt.new, tt.context, got, tt.want)
+		}
+
+		var b bytes.Buffer
+		n, err := WriteUnified[string](text.AsWriter[string](&b), "old", "new", tt.old, tt.new, tt.context)
+		if n != len(tt.want) || err != nil || b.String() != tt.want {
+			t.Errorf("WriteUnified(%q, %q, %d): got %d, %v", tt.old, tt.new, tt.context, n, err)
+		}
+	}
+}
+
+type errWriter struct{ n int }
+
+func (w *errWriter) WriteText(s string) (int, error) {
+	if w.n <= 0 {
+		return 0, errors.New("boom")
+	}
+	w.n--
+	return len(s), nil
+}
+
+func TestWriteUnifiedError(t *testing.T) {
+	w := &errWriter{n: 2}
+	n, err := WriteUnified[string](w, "old", "new", "a\n", "b\n", 3)
+	if err == nil || n != len("--- old\n+++ new\n@@ -1 +1 @@\n") {
+		t.Errorf("WriteUnified: got %d, %v", n, err)
+	}
+}
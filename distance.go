@@ -0,0 +1,271 @@
+package text
+
+import (
+	"github.com/pgavlin/text/internal/bytealg"
+)
+
+// Levenshtein returns the Levenshtein distance between s and t: the minimum
+// number of single-rune insertions, deletions and substitutions that
+// transform s into t.
+func Levenshtein[S1, S2 String](s S1, t S2) int {
+	return levenshtein(runesOf(s), runesOf(t), -1, false)
+}
+
+// LevenshteinBytes is like Levenshtein, but compares s and t byte by byte.
+func LevenshteinBytes[S1, S2 String](s S1, t S2) int {
+	return levenshtein(bytealg.AsBytes(s), bytealg.AsBytes(t), -1, false)
+}
+
+// LevenshteinWithin reports whether the Levenshtein distance between s and t
+// is at most maxDist, and if so returns the distance. It stops as soon as the
+// distance is known to exceed maxDist, taking O(maxDist·max(len(s), len(t)))
+// time. If the distance exceeds maxDist, LevenshteinWithin returns maxDist+1
+// and false.
+func LevenshteinWithin[S1, S2 String](s S1, t S2, maxDist int) (int, bool) {
+	return within(levenshtein(runesOf(s), runesOf(t), maxDist, false), maxDist)
+}
+
+// LevenshteinBytesWithin is like LevenshteinWithin, but compares s and t
+// byte by byte.
+func LevenshteinBytesWithin[S1, S2 String](s S1, t S2, maxDist int) (int, bool) {
+	return within(levenshtein(bytealg.AsBytes(s), bytealg.AsBytes(t), maxDist, false), maxDist)
+}
+
+// DamerauLevenshtein returns the optimal string alignment distance between s
+// and t: the minimum number of single-rune insertions, deletions and
+// substitutions and transpositions of adjacent runes that transform s into t,
+// where no substring is edited more than once.
+func DamerauLevenshtein[S1, S2 String](s S1, t S2) int {
+	return levenshtein(runesOf(s), runesOf(t), -1, true)
+}
+
+// DamerauLevenshteinBytes is like DamerauLevenshtein, but compares s and t
+// byte by byte.
+func DamerauLevenshteinBytes[S1, S2 String](s S1, t S2) int {
+	return levenshtein(bytealg.AsBytes(s), bytealg.AsBytes(t), -1, true)
+}
+
+// DamerauLevenshteinWithin is like LevenshteinWithin, but computes the
+// distance of DamerauLevenshtein.
+func DamerauLevenshteinWithin[S1, S2 String](s S1, t S2, maxDist int) (int, bool) {
+	return within(levenshtein(runesOf(s), runesOf(t), maxDist, true), maxDist)
+}
+
+// DamerauLevenshteinBytesWithin is like DamerauLevenshteinWithin, but
+// compares s and t byte by byte.
+func DamerauLevenshteinBytesWithin[S1, S2 String](s S1, t S2, maxDist int) (int, bool) {
+	return within(levenshtein(bytealg.AsBytes(s), bytealg.AsBytes(t), maxDist, true), maxDist)
+}
+
+// Jaro returns the Jaro similarity of s and t, compared rune by rune. The
+// similarity ranges from 0, for texts with nothing in common, to 1, for
+// equal texts.
+func Jaro[S1, S2 String](s S1, t S2) float64 {
+	return jaro(runesOf(s), runesOf(t))
+}
+
+// JaroBytes is like Jaro, but compares s and t byte by byte.
+func JaroBytes[S1, S2 String](s S1, t S2) float64 {
+	return jaro(bytealg.AsBytes(s), bytealg.AsBytes(t))
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of s and t, compared rune
+// by rune. If the Jaro similarity exceeds 0.7, it is increased for texts that
+// share a prefix of up to four runes, using a scaling factor of 0.1;
+// otherwise JaroWinkler returns the Jaro similarity.
+func JaroWinkler[S1, S2 String](s S1, t S2) float64 {
+	return jaroWinkler(runesOf(s), runesOf(t))
+}
+
+// JaroWinklerBytes is like JaroWinkler, but compares s and t byte by byte.
+func JaroWinklerBytes[S1, S2 String](s S1, t S2) float64 {
+	return jaroWinkler(bytealg.AsBytes(s), bytealg.AsBytes(t))
+}
+
+// LongestCommonSubsequence returns the length in runes of the longest
+// sequence of runes that appears in both s and t in the same order, though
+// not necessarily contiguously.
+func LongestCommonSubsequence[S1, S2 String](s S1, t S2) int {
+	return lcs(runesOf(s), runesOf(t))
+}
+
+// LongestCommonSubsequenceBytes is like LongestCommonSubsequence, but
+// compares s and t byte by byte and returns a length in bytes.
+func LongestCommonSubsequenceBytes[S1, S2 String](s S1, t S2) int {
+	return lcs(bytealg.AsBytes(s), bytealg.AsBytes(t))
+}
+
+// runesOf returns the runes of s.
+func runesOf[S String](s S) []rune {
+	return []rune(bytealg.AsString(s))
+}
+
+func within(d, maxDist int) (int, bool) {
+	if d > maxDist {
+		return maxDist + 1, false
+	}
+	return d, true
+}
+
+// trimCommon removes the common prefix and suffix of a and b.
+func trimCommon[T byte | rune](a, b []T) ([]T, []T) {
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	return a, b
+}
+
+// levenshtein returns the Levenshtein distance between a and b, or their
+// optimal string alignment distance if transpose is set. If limit is not
+// negative and the distance exceeds it, levenshtein returns a value greater
+// than limit, computing only the cells of the table within limit of its
+// diagonal.
+func levenshtein[T byte | rune](a, b []T, limit int, transpose bool) int {
+	// A common prefix or suffix never needs editing. Leaving a transposed pair
+	// intact at the boundary cannot make the distance smaller, so trimming is
+	// also safe for the optimal string alignment distance.
+	a, b = trimCommon(a, b)
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	n, m := len(a), len(b)
+	if m == 0 {
+		return n
+	}
+	inf := n + 1
+	if limit >= 0 {
+		if n-m > limit {
+			return limit + 1
+		}
+		inf = limit + 1
+	}
+
+	// prev2, prev and cur are rows i-2, i-1 and i of the table; row i holds
+	// the distances between a[:i] and the prefixes of b.
+	rows := make([]int, 3*(m+1))
+	prev2, prev, cur := rows[:m+1], rows[m+1:2*(m+1)], rows[2*(m+1):]
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= n; i++ {
+		lo, hi := 1, m
+		if limit >= 0 {
+			lo, hi = max(lo, i-limit), min(hi, i+limit)
+		}
+		if lo == 1 {
+			cur[0] = i
+		} else {
+			cur[lo-1] = inf
+		}
+		if hi < m {
+			cur[hi+1] = inf
+		}
+
+		rowMin := cur[lo-1]
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d := min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d = min(d, prev2[j-2]+1)
+			}
+			cur[j] = d
+			rowMin = min(rowMin, d)
+		}
+		if limit >= 0 && rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[m]
+}
+
+// jaro returns the Jaro similarity of a and b.
+func jaro[T byte | rune](a, b []T) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	// Elements match if they are equal and no further apart than window.
+	window := max(max(len(a), len(b))/2-1, 0)
+	matchedA, matchedB := make([]bool, len(a)), make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		for j := max(i-window, 0); j < min(i+window+1, len(b)); j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count the matched elements that are out of order.
+	transpositions := 0
+	for i, j := 0, 0; i < len(a); i++ {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of a and b.
+func jaroWinkler[T byte | rune](a, b []T) float64 {
+	sim := jaro(a, b)
+	if sim <= 0.7 {
+		return sim
+	}
+	prefix := 0
+	for prefix < min(len(a), len(b), 4) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return sim + float64(prefix)*0.1*(1-sim)
+}
+
+// lcs returns the length of the longest common subsequence of a and b.
+func lcs[T byte | rune](a, b []T) int {
+	n := len(a)
+	a, b = trimCommon(a, b)
+	common := n - len(a)
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	// row[j] is the length of the longest common subsequence of the prefix of
+	// a processed so far and b[:j].
+	row := make([]int, len(b)+1)
+	for i := range a {
+		diag := 0
+		for j := range b {
+			next := row[j+1]
+			if a[i] == b[j] {
+				row[j+1] = diag + 1
+			} else {
+				row[j+1] = max(row[j+1], row[j])
+			}
+			diag = next
+		}
+	}
+	return common + row[len(b)]
+}
@@ -0,0 +1,183 @@
+package text_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+// naiveDistance computes the Levenshtein or optimal string alignment distance
+// between a and b with the full dynamic programming table.
+func naiveDistance[T byte | rune](a, b []T, transpose bool) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		s, t              string
+		runes, bytes, osa int
+	}{
+		{"", "", 0, 0, 0},
+		{"", "abc", 3, 3, 3},
+		{"kitten", "sitting", 3, 3, 3},
+		{"flaw", "lawn", 2, 2, 2},
+		{"ab", "ba", 2, 2, 1},
+		{"ca", "abc", 3, 3, 3},
+		{"héllo", "hello", 1, 2, 1},
+		{"日本語", "日本", 1, 3, 1},
+		{"abcdef", "abdcef", 2, 2, 1},
+	}
+	for _, tt := range tests {
+		if got := Levenshtein(tt.s, tt.t); got != tt.runes {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.s, tt.t, got, tt.runes)
+		}
+		if got := Levenshtein([]byte(tt.t), tt.s); got != tt.runes {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.t, tt.s, got, tt.runes)
+		}
+		if got := LevenshteinBytes(tt.s, []byte(tt.t)); got != tt.bytes {
+			t.Errorf("LevenshteinBytes(%q, %q) = %d, want %d", tt.s, tt.t, got, tt.bytes)
+		}
+		if got := DamerauLevenshtein(tt.s, tt.t); got != tt.osa {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d, want %d", tt.s, tt.t, got, tt.osa)
+		}
+		for maxDist := 0; maxDist <= 4; maxDist++ {
+			want, wantOK := tt.runes, tt.runes <= maxDist
+			if !wantOK {
+				want = maxDist + 1
+			}
+			if got, ok := LevenshteinWithin(tt.s, tt.t, maxDist); got != want || ok != wantOK {
+				t.Errorf("LevenshteinWithin(%q, %q, %d) = %d, %v; want %d, %v", tt.s, tt.t, maxDist, got, ok, want, wantOK)
+			}
+		}
+	}
+}
+
+func TestLevenshteinRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randBytes := func() []byte {
+		b := make([]byte, rng.Intn(12))
+		for i := range b {
+			b[i] = "abc"[rng.Intn(3)]
+		}
+		return b
+	}
+	for i := 0; i < 5000; i++ {
+		a, b := randBytes(), randBytes()
+		maxDist := rng.Intn(8)
+		for _, transpose := range []bool{false, true} {
+			want := naiveDistance(a, b, transpose)
+			var got, within int
+			var ok bool
+			if transpose {
+				got = DamerauLevenshteinBytes(a, b)
+				within, ok = DamerauLevenshteinBytesWithin(a, b, maxDist)
+			} else {
+				got = LevenshteinBytes(a, b)
+				within, ok = LevenshteinBytesWithin(a, b, maxDist)
+			}
+			if got != want {
+				t.Fatalf("distance(%q, %q, %v) = %d, want %d", a, b, transpose, got, want)
+			}
+			if ok != (want <= maxDist) || (ok && within != want) || (!ok && within != maxDist+1) {
+				t.Fatalf("distanceWithin(%q, %q, %d, %v) = %d, %v; want %d", a, b, maxDist, transpose, within, ok, want)
+			}
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		s, t          string
+		jaro, winkler float64
+	}{
+		{"", "", 1, 1},
+		{"abc", "", 0, 0},
+		{"abc", "xyz", 0, 0},
+		{"abc", "abc", 1, 1},
+		{"MARTHA", "MARHTA", 0.944444, 0.961111},
+		{"DWAYNE", "DUANE", 0.822222, 0.84},
+		{"DIXON", "DICKSONX", 0.766667, 0.813333},
+		{"CRATE", "TRACE", 0.733333, 0.733333},
+		{"ABCDEFGH", "ABWXYZUV", 0.5, 0.5}, // below the boost threshold
+	}
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+	for _, tt := range tests {
+		if got := Jaro(tt.s, tt.t); !near(got, tt.jaro) {
+			t.Errorf("Jaro(%q, %q) = %f, want %f", tt.s, tt.t, got, tt.jaro)
+		}
+		if got := JaroBytes(tt.t, []byte(tt.s)); !near(got, tt.jaro) {
+			t.Errorf("JaroBytes(%q, %q) = %f, want %f", tt.t, tt.s, got, tt.jaro)
+		}
+		if got := JaroWinkler(tt.s, tt.t); !near(got, tt.winkler) {
+			t.Errorf("JaroWinkler(%q, %q) = %f, want %f", tt.s, tt.t, got, tt.winkler)
+		}
+		if got := JaroWinklerBytes([]byte(tt.s), tt.t); !near(got, tt.winkler) {
+			t.Errorf("JaroWinklerBytes(%q, %q) = %f, want %f", tt.s, tt.t, got, tt.winkler)
+		}
+	}
+
+	// Runes and bytes differ for non-ASCII text.
+	if got := JaroWinkler("é", "è"); got != 0 {
+		t.Errorf("JaroWinkler(\"é\", \"è\") = %f, want 0", got)
+	}
+	if got := JaroWinklerBytes("é", "è"); got <= 0 {
+		t.Errorf("JaroWinklerBytes(\"é\", \"è\") = %f, want > 0", got)
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	tests := []struct {
+		s, t         string
+		runes, bytes int
+	}{
+		{"", "", 0, 0},
+		{"abc", "", 0, 0},
+		{"ABCBDAB", "BDCABA", 4, 4},
+		{"xabcx", "xacbx", 4, 4},
+		{"héllo", "hèllo", 4, 5},
+	}
+	for _, tt := range tests {
+		if got := LongestCommonSubsequence(tt.s, []byte(tt.t)); got != tt.runes {
+			t.Errorf("LongestCommonSubsequence(%q, %q) = %d, want %d", tt.s, tt.t, got, tt.runes)
+		}
+		if got := LongestCommonSubsequenceBytes(tt.t, tt.s); got != tt.bytes {
+			t.Errorf("LongestCommonSubsequenceBytes(%q, %q) = %d, want %d", tt.t, tt.s, got, tt.bytes)
+		}
+	}
+}
+
+func BenchmarkLevenshtein(b *testing.B) {
+	s, t := Repeat("kitten sitting ", 20), Repeat("sitting kitten ", 20)
+	b.Run("Full", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Levenshtein(s, t)
+		}
+	})
+	b.Run("Within", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			LevenshteinWithin(s, t, 3)
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package text
+
+// An EditBuffer is an editable text with undo history. The text is stored as
+// a Rope, and every edit produces a new version of the Rope in O(log n) time
+// that shares all but the edited leaves with the previous version. The undo
+// and redo histories are stacks of these versions, so undoing or redoing an
+// edit takes O(1) time.
+//
+// Snapshot returns the current version in O(1) time, and the snapshot may be
+// searched or read by other goroutines while edits to the EditBuffer
+// continue. The EditBuffer itself is not safe for concurrent use.
+//
+// If S is not a string type, an EditBuffer aliases the texts it is given,
+// which must not be modified afterwards.
+//
+// The zero value for EditBuffer is an empty buffer with no history.
+type EditBuffer[S String] struct {
+	text       Rope[S]
+	undo, redo []Rope[S] // previous and undone versions, most recent last
+}
+
+// NewEditBuffer returns a new EditBuffer holding s.
+func NewEditBuffer[S String](s S) *EditBuffer[S] {
+	return &EditBuffer[S]{text: NewRope(s)}
+}
+
+// Len returns the length of the text in bytes.
+func (b *EditBuffer[S]) Len() int {
+	return b.text.Len()
+}
+
+// Text returns the contents of the buffer as an S.
+func (b *EditBuffer[S]) Text() S {
+	return b.text.Text()
+}
+
+// String returns the contents of the buffer as a string.
+func (b *EditBuffer[S]) String() string {
+	return b.text.String()
+}
+
+// Snapshot returns the current version of the text. The returned Rope is
+// unaffected by later edits.
+//
+// Snapshot returns a Rope rather than an S, because an S holding the whole
+// text would have to be copied in O(n) time for every version. The Rope's
+// Index, Count, Slice, Chunks and NewReader methods read the snapshot without
+// copying it; its Text method copies it into a single S.
+func (b *EditBuffer[S]) Snapshot() Rope[S] {
+	return b.text
+}
+
+// Insert inserts s before the byte at index i.
+// Insert panics if i is out of range.
+func (b *EditBuffer[S]) Insert(i int, s S) {
+	if i < 0 || i > b.Len() {
+		panic("text.EditBuffer.Insert: index out of range")
+	}
+	b.Replace(i, i, s)
+}
+
+// Delete removes the bytes in [i, j).
+// Delete panics if the range is out of bounds.
+func (b *EditBuffer[S]) Delete(i, j int) {
+	if i < 0 || j < i || j > b.Len() {
+		panic("text.EditBuffer.Delete: range out of bounds")
+	}
+	b.Replace(i, j, Empty[S]())
+}
+
+// Replace replaces the bytes in [i, j) with s as a single undoable edit.
+// Replace panics if the range is out of bounds. An edit that changes nothing
+// is not recorded.
+func (b *EditBuffer[S]) Replace(i, j int, s S) {
+	if i < 0 || j < i || j > b.Len() {
+		panic("text.EditBuffer.Replace: range out of bounds")
+	}
+	if i == j && len(s) == 0 {
+		return
+	}
+	text := b.text.Delete(i, j)
+	if len(s) != 0 {
+		text = text.Insert(i, s)
+	}
+	b.undo = append(b.undo, b.text)
+	b.redo = nil
+	b.text = text
+}
+
+// CanUndo reports whether there is an edit to undo.
+func (b *EditBuffer[S]) CanUndo() bool {
+	return len(b.undo) > 0
+}
+
+// CanRedo reports whether there is an undone edit to redo.
+func (b *EditBuffer[S]) CanRedo() bool {
+	return len(b.redo) > 0
+}
+
+// Undo reverts the most recent edit that has not been undone. It reports
+// whether there was such an edit.
+func (b *EditBuffer[S]) Undo() bool {
+	if len(b.undo) == 0 {
+		return false
+	}
+	b.redo = append(b.redo, b.text)
+	b.text = b.undo[len(b.undo)-1]
+	b.undo[len(b.undo)-1] = Rope[S]{}
+	b.undo = b.undo[:len(b.undo)-1]
+	return true
+}
+
+// Redo reapplies the most recently undone edit. Any edit other than Undo or
+// Redo discards the undone edits. Redo reports whether there was an edit to
+// redo.
+func (b *EditBuffer[S]) Redo() bool {
+	if len(b.redo) == 0 {
+		return false
+	}
+	b.undo = append(b.undo, b.text)
+	b.text = b.redo[len(b.redo)-1]
+	b.redo[len(b.redo)-1] = Rope[S]{}
+	b.redo = b.redo[:len(b.redo)-1]
+	return true
+}
+
+// Index returns the index of the first instance of substr in the text, or -1
+// if substr is not present.
+func (b *EditBuffer[S]) Index(substr S) int {
+	return b.text.Index(substr)
+}
+
+// Count counts the number of non-overlapping instances of substr in the text.
+// If substr is empty, Count returns 1 + the number of Unicode code points in
+// the text.
+func (b *EditBuffer[S]) Count(substr S) int {
+	return b.text.Count(substr)
+}
@@ -0,0 +1,145 @@
+package text_test
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+func TestEditBuffer(t *testing.T) {
+	eb := NewEditBuffer("hello world")
+	check := func(what, want string) {
+		t.Helper()
+		if got := eb.Text(); got != want {
+			t.Errorf("%s: text = %q, want %q", what, got, want)
+		}
+		if eb.Len() != len(want) {
+			t.Errorf("%s: Len() = %d, want %d", what, eb.Len(), len(want))
+		}
+	}
+
+	if eb.CanUndo() || eb.Undo() {
+		t.Errorf("Undo on a new buffer succeeded")
+	}
+
+	eb.Insert(5, ",")
+	check("Insert", "hello, world")
+	eb.Delete(0, 1)
+	check("Delete", "ello, world")
+	eb.Replace(6, 11, "there")
+	check("Replace", "ello, there")
+	snap := eb.Snapshot()
+
+	eb.Insert(0, "")
+	eb.Delete(3, 3)
+	if !eb.Undo() {
+		t.Fatal("Undo failed")
+	}
+	check("Undo ignores empty edits", "ello, world")
+	eb.Undo()
+	check("Undo Delete", "hello, world")
+	eb.Redo()
+	check("Redo", "ello, world")
+	if got := snap.Text(); got != "ello, there" {
+		t.Errorf("snapshot changed: %q", got)
+	}
+
+	eb.Insert(0, "J")
+	check("Insert after Undo", "Jello, world")
+	if eb.CanRedo() || eb.Redo() {
+		t.Errorf("Redo after an edit succeeded")
+	}
+
+	for eb.Undo() {
+	}
+	check("Undo all", "hello world")
+	if eb.Index("o w") != 4 || eb.Count("o") != 2 {
+		t.Errorf("Index or Count mismatch on %q", eb.String())
+	}
+}
+
+func TestEditBufferZero(t *testing.T) {
+	var eb EditBuffer[[]byte]
+	eb.Insert(0, []byte("abc"))
+	eb.Replace(1, 2, []byte("XY"))
+	if got := string(eb.Text()); got != "aXYc" {
+		t.Errorf("text = %q, want \"aXYc\"", got)
+	}
+	eb.Undo()
+	eb.Undo()
+	if eb.Len() != 0 {
+		t.Errorf("Len() after undoing everything = %d", eb.Len())
+	}
+}
+
+func TestEditBufferPanics(t *testing.T) {
+	eb := NewEditBuffer("abc")
+	for name, f := range map[string]func(){
+		"Insert":  func() { eb.Insert(4, "x") },
+		"Delete":  func() { eb.Delete(-1, 1) },
+		"Replace": func() { eb.Replace(2, 1, "x") },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestEditBufferRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	want := []string{randRopeText(rng, 3000)}
+	eb := NewEditBuffer(want[0])
+	undone := []string{}
+
+	for i := 0; i < 1000; i++ {
+		cur := want[len(want)-1]
+		switch n := rng.Intn(10); {
+		case n < 2 && len(want) > 1:
+			eb.Undo()
+			undone, want = append(undone, cur), want[:len(want)-1]
+		case n < 3 && len(undone) > 0:
+			eb.Redo()
+			want, undone = append(want, undone[len(undone)-1]), undone[:len(undone)-1]
+		default:
+			i := rng.Intn(len(cur) + 1)
+			j := i + rng.Intn(min(len(cur)-i, 50)+1)
+			s := randRopeText(rng, rng.Intn(30)+1)
+			eb.Replace(i, j, s)
+			want, undone = append(want, cur[:i]+s+cur[j:]), undone[:0]
+		}
+		if got := eb.Text(); got != want[len(want)-1] {
+			t.Fatalf("step %d: text mismatch", i)
+		}
+	}
+}
+
+func TestEditBufferSnapshotConcurrent(t *testing.T) {
+	eb := NewEditBuffer(strings.Repeat("needle in a haystack\n", 1000))
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		snap := eb.Snapshot()
+		want := strings.Count(snap.Text(), "needle")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if got := snap.Count("needle"); got != want {
+					t.Errorf("snapshot Count = %d, want %d", got, want)
+					return
+				}
+			}
+		}()
+		for j := 0; j < 100; j++ {
+			eb.Insert(j*37%eb.Len(), "needle")
+		}
+	}
+	wg.Wait()
+}
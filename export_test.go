@@ -45,3 +45,25 @@ func DumpTables(pattern string) ([]int, []int) {
 	finder := makeStringFinder(pattern)
 	return finder.badCharSkip[:], finder.goodSuffixSkip
 }
+
+// Balanced reports whether the rope's tree is height-balanced and its cached
+// lengths and heights are consistent.
+func (r Rope[S]) Balanced() bool {
+	var check func(n *ropeNode[S]) bool
+	check = func(n *ropeNode[S]) bool {
+		if n == nil {
+			return true
+		}
+		if n.height == 0 {
+			return n.left == nil && n.right == nil && len(n.leaf) == n.length && n.length > 0
+		}
+		if n.left == nil || n.right == nil || !check(n.left) || !check(n.right) {
+			return false
+		}
+		d := n.left.height - n.right.height
+		return d >= -1 && d <= 1 &&
+			n.height == max(n.left.height, n.right.height)+1 &&
+			n.length == n.left.length+n.right.length
+	}
+	return check(r.root)
+}
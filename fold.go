@@ -0,0 +1,142 @@
+package text
+
+import (
+	"github.com/pgavlin/text/utf8"
+)
+
+// The functions in this file compare text under simple Unicode case-folding,
+// using the same rules as EqualFold. Because runes that fold to each other
+// may have encodings of different lengths, the length of a match in s is not
+// necessarily the length of the text being searched for.
+
+// decodeFoldRune returns the first rune in s and its width in bytes.
+func decodeFoldRune[S String](s S) (rune, int) {
+	if s[0] < utf8.RuneSelf {
+		return rune(s[0]), 1
+	}
+	return utf8.DecodeRune(s)
+}
+
+// prefixFold reports whether s begins with prefix under simple Unicode
+// case-folding. If it does, n is the length in bytes of the matching prefix
+// of s.
+func prefixFold[S1, S2 String](s S1, prefix S2) (n int, ok bool) {
+	for len(prefix) > 0 {
+		if n == len(s) {
+			return 0, false
+		}
+		sr, ssize := decodeFoldRune(s[n:])
+		pr, psize := decodeFoldRune(prefix)
+		if !equalFoldRune(sr, pr) {
+			return 0, false
+		}
+		n, prefix = n+ssize, prefix[psize:]
+	}
+	return n, true
+}
+
+// suffixFold reports whether s ends with suffix under simple Unicode
+// case-folding. If it does, n is the length in bytes of the matching suffix
+// of s.
+func suffixFold[S1, S2 String](s S1, suffix S2) (n int, ok bool) {
+	for len(suffix) > 0 {
+		if n == len(s) {
+			return 0, false
+		}
+		sr, ssize := utf8.DecodeLastRune(s[:len(s)-n])
+		tr, tsize := utf8.DecodeLastRune(suffix)
+		if !equalFoldRune(sr, tr) {
+			return 0, false
+		}
+		n, suffix = n+ssize, suffix[:len(suffix)-tsize]
+	}
+	return n, true
+}
+
+// indexFold returns the index i of the first instance of substr in s under
+// simple Unicode case-folding and the length n of that instance, or -1, 0 if
+// substr is not present in s.
+func indexFold[S1, S2 String](s S1, substr S2) (i, n int) {
+	if len(substr) == 0 {
+		return 0, 0
+	}
+	first, _ := decodeFoldRune(substr)
+	for i < len(s) {
+		r, size := decodeFoldRune(s[i:])
+		if equalFoldRune(r, first) {
+			if n, ok := prefixFold(s[i:], substr); ok {
+				return i, n
+			}
+		}
+		i += size
+	}
+	return -1, 0
+}
+
+// IndexFold returns the index of the first instance of substr in s under
+// simple Unicode case-folding, or -1 if substr is not present in s.
+func IndexFold[S1, S2 String](s S1, substr S2) int {
+	i, _ := indexFold(s, substr)
+	return i
+}
+
+// LastIndexFold returns the index of the last instance of substr in s under
+// simple Unicode case-folding, or -1 if substr is not present in s.
+func LastIndexFold[S1, S2 String](s S1, substr S2) int {
+	if len(substr) == 0 {
+		return len(s)
+	}
+	first, _ := decodeFoldRune(substr)
+	for i := len(s); i > 0; {
+		_, size := utf8.DecodeLastRune(s[:i])
+		i -= size
+		r, _ := decodeFoldRune(s[i:])
+		if equalFoldRune(r, first) {
+			if _, ok := prefixFold(s[i:], substr); ok {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// ContainsFold reports whether substr is within s under simple Unicode
+// case-folding.
+func ContainsFold[S1, S2 String](s S1, substr S2) bool {
+	return IndexFold(s, substr) >= 0
+}
+
+// HasPrefixFold reports whether s begins with prefix under simple Unicode
+// case-folding.
+func HasPrefixFold[S1, S2 String](s S1, prefix S2) bool {
+	_, ok := prefixFold(s, prefix)
+	return ok
+}
+
+// HasSuffixFold reports whether s ends with suffix under simple Unicode
+// case-folding.
+func HasSuffixFold[S1, S2 String](s S1, suffix S2) bool {
+	_, ok := suffixFold(s, suffix)
+	return ok
+}
+
+// TrimPrefixFold returns s without the provided leading prefix, compared
+// under simple Unicode case-folding. If s doesn't start with prefix, s is
+// returned unchanged.
+func TrimPrefixFold[S1, S2 String](s S1, prefix S2) S1 {
+	if n, ok := prefixFold(s, prefix); ok {
+		return s[n:]
+	}
+	return s
+}
+
+// CutFold slices s around the first instance of sep under simple Unicode
+// case-folding, returning the text before and after sep.
+// The found result reports whether sep appears in s.
+// If sep does not appear in s, CutFold returns s, "", false.
+func CutFold[S1, S2 String](s S1, sep S2) (before, after S1, found bool) {
+	if i, n := indexFold(s, sep); i >= 0 {
+		return s[:i], s[i+n:], true
+	}
+	return s, Empty[S1](), false
+}
@@ -0,0 +1,134 @@
+package text_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+var indexFoldTests = []struct {
+	s, substr string
+	first     int
+	last      int
+}{
+	{"", "", 0, 0},
+	{"", "a", -1, -1},
+	{"abc", "", 0, 3},
+	{"abc", "B", 1, 1},
+	{"ABCabc", "bC", 1, 4},
+	{"Content-Type", "content-type", 0, 0},
+	{"x-CONTENT-type: y", "content-TYPE", 2, 2},
+	{"abc", "abcd", -1, -1},
+	{"σΣς", "Σ", 0, 4},
+	{"Straße STRASSE", "strasse", 8, 8},
+	{"Straße STRASSE", "ß", 4, 4},
+	{"K", "k", 0, 0}, // Kelvin sign
+	{"kKk", "K", 0, 4},
+	{"Kelvin", "KELVIN", 0, 0},
+	{"☺☻☹", "☻", 3, 3},
+	{"a\xffb\xffc", "\xffC", 3, 3},
+}
+
+func TestIndexFold(t *testing.T) {
+	for _, tt := range indexFoldTests {
+		if got := IndexFold(tt.s, tt.substr); got != tt.first {
+			t.Errorf("IndexFold(%q, %q) = %d; want %d", tt.s, tt.substr, got, tt.first)
+		}
+		if got := IndexFold([]byte(tt.s), tt.substr); got != tt.first {
+			t.Errorf("IndexFold([]byte(%q), %q) = %d; want %d", tt.s, tt.substr, got, tt.first)
+		}
+		if got := LastIndexFold(tt.s, []byte(tt.substr)); got != tt.last {
+			t.Errorf("LastIndexFold(%q, %q) = %d; want %d", tt.s, tt.substr, got, tt.last)
+		}
+		if got := ContainsFold(tt.s, tt.substr); got != (tt.first >= 0) {
+			t.Errorf("ContainsFold(%q, %q) = %v", tt.s, tt.substr, got)
+		}
+	}
+}
+
+// TestIndexFoldASCII checks IndexFold and LastIndexFold against ToLower and
+// Index for ASCII input, where folding and lowering agree.
+func TestIndexFoldASCII(t *testing.T) {
+	const chars = "aAbB"
+	random := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = chars[rand.Intn(len(chars))]
+		}
+		return string(b)
+	}
+	for i := 0; i < 1000; i++ {
+		s, substr := random(rand.Intn(20)), random(rand.Intn(4))
+		ls, lsubstr := strings.ToLower(s), strings.ToLower(substr)
+		if got, want := IndexFold(s, substr), strings.Index(ls, lsubstr); got != want {
+			t.Fatalf("IndexFold(%q, %q) = %d; want %d", s, substr, got, want)
+		}
+		if got, want := LastIndexFold(s, substr), strings.LastIndex(ls, lsubstr); got != want {
+			t.Fatalf("LastIndexFold(%q, %q) = %d; want %d", s, substr, got, want)
+		}
+	}
+}
+
+var prefixFoldTests = []struct {
+	s, affix       string
+	prefix, suffix bool
+	trimmed        string
+}{
+	{"", "", true, true, ""},
+	{"Hello", "", true, true, "Hello"},
+	{"Hello", "hE", true, false, "llo"},
+	{"Hello", "LO", false, true, "Hello"},
+	{"Hello", "hellO", true, true, ""},
+	{"Hello", "hello!", false, false, "Hello"},
+	{"Key", "key", true, true, ""},
+	{"ΣΑΣ", "σας", true, true, ""},
+	{"ab☺", "☺", false, true, "ab☺"},
+}
+
+func TestPrefixSuffixFold(t *testing.T) {
+	for _, tt := range prefixFoldTests {
+		if got := HasPrefixFold(tt.s, tt.affix); got != tt.prefix {
+			t.Errorf("HasPrefixFold(%q, %q) = %v; want %v", tt.s, tt.affix, got, tt.prefix)
+		}
+		if got := HasSuffixFold([]byte(tt.s), tt.affix); got != tt.suffix {
+			t.Errorf("HasSuffixFold(%q, %q) = %v; want %v", tt.s, tt.affix, got, tt.suffix)
+		}
+		if got := TrimPrefixFold(tt.s, []byte(tt.affix)); got != tt.trimmed {
+			t.Errorf("TrimPrefixFold(%q, %q) = %q; want %q", tt.s, tt.affix, got, tt.trimmed)
+		}
+	}
+}
+
+var cutFoldTests = []struct {
+	s, sep        string
+	before, after string
+	found         bool
+}{
+	{"abc", "b", "a", "c", true},
+	{"abc", "B", "a", "c", true},
+	{"Content-Type: text/plain", ": ", "Content-Type", "text/plain", true},
+	{"KEY=KEY", "=key", "KEY", "", true},
+	{"aKb", "K", "a", "b", true},
+	{"abc", "x", "abc", "", false},
+	{"abc", "", "", "abc", true},
+}
+
+func TestCutFold(t *testing.T) {
+	for _, tt := range cutFoldTests {
+		if before, after, found := CutFold(tt.s, tt.sep); before != tt.before || after != tt.after || found != tt.found {
+			t.Errorf("CutFold(%q, %q) = %q, %q, %v, want %q, %q, %v", tt.s, tt.sep, before, after, found, tt.before, tt.after, tt.found)
+		}
+		if before, after, found := CutFold([]byte(tt.s), tt.sep); string(before) != tt.before || string(after) != tt.after || found != tt.found {
+			t.Errorf("CutFold([]byte(%q), %q) = %q, %q, %v, want %q, %q, %v", tt.s, tt.sep, before, after, found, tt.before, tt.after, tt.found)
+		}
+	}
+}
+
+func BenchmarkIndexFold(b *testing.B) {
+	s := strings.Repeat("x-forwarded-for: 127.0.0.1\r\n", 20) + "Content-Type: text/plain\r\n"
+	for i := 0; i < b.N; i++ {
+		IndexFold(s, "CONTENT-TYPE")
+	}
+}
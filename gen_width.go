@@ -0,0 +1,126 @@
+//go:build ignore
+
+// This program generates width_tables.go from the Unicode Character Database.
+//
+// It reads EastAsianWidth.txt and emoji/emoji-data.txt from the directory or
+// URL given by the -ucd flag.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucd    = flag.String("ucd", "https://www.unicode.org/Public/15.0.0/ucd", "directory or URL of the Unicode Character Database")
+	output = flag.String("output", "width_tables.go", "output file")
+)
+
+type runeRange struct {
+	lo, hi rune
+}
+
+func open(name string) io.ReadCloser {
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		resp, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("fetching %v: %v", name, resp.Status)
+		}
+		return resp.Body
+	}
+	f, err := os.Open(filepath.Join(*ucd, filepath.FromSlash(name)))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return f
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+// parse returns the ranges in the named file whose property value is one
+// of values.
+func parse(name string, values ...string) []runeRange {
+	r := open(name)
+	defer r.Close()
+
+	var ranges []runeRange
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		codes, value, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		for _, v := range values {
+			if value == v {
+				lo, hi, ok := strings.Cut(strings.TrimSpace(codes), "..")
+				if !ok {
+					hi = lo
+				}
+				ranges = append(ranges, runeRange{parseRune(lo), parseRune(hi)})
+				break
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return ranges
+}
+
+func main() {
+	flag.Parse()
+
+	ranges := append(parse("EastAsianWidth.txt", "W", "F"), parse("emoji/emoji-data.txt", "Emoji_Presentation")...)
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+
+	// Merge overlapping and adjacent ranges.
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.lo <= merged[n-1].hi+1 {
+			merged[n-1].hi = max(merged[n-1].hi, r.hi)
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by running \"go generate\" in github.com/pgavlin/text. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package text\n\n")
+	fmt.Fprintf(&buf, "// wideRanges holds the runes whose East_Asian_Width property is Wide or\n")
+	fmt.Fprintf(&buf, "// Fullwidth or that have the Emoji_Presentation property, in ascending order.\n")
+	fmt.Fprintf(&buf, "var wideRanges = [...]runeRange{\n")
+	for _, r := range merged {
+		fmt.Fprintf(&buf, "\t{0x%04X, 0x%04X},\n", r.lo, r.hi)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
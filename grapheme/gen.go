@@ -0,0 +1,153 @@
+//go:build ignore
+
+// This program generates tables.go from the Unicode Character Database.
+//
+// It reads auxiliary/GraphemeBreakProperty.txt and emoji/emoji-data.txt from
+// the directory or URL given by the -ucd flag.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucd     = flag.String("ucd", "https://www.unicode.org/Public/15.0.0/ucd", "directory or URL of the Unicode Character Database")
+	version = flag.String("version", "15.0.0", "version of the Unicode Character Database")
+	output  = flag.String("output", "tables.go", "output file")
+)
+
+// properties maps from property value names to the names of the
+// corresponding constants in package grapheme.
+var properties = map[string]string{
+	"CR":                    "propCR",
+	"LF":                    "propLF",
+	"Control":               "propControl",
+	"Extend":                "propExtend",
+	"ZWJ":                   "propZWJ",
+	"Regional_Indicator":    "propRegionalIndicator",
+	"Prepend":               "propPrepend",
+	"SpacingMark":           "propSpacingMark",
+	"L":                     "propL",
+	"V":                     "propV",
+	"T":                     "propT",
+	"LV":                    "propLV",
+	"LVT":                   "propLVT",
+	"Extended_Pictographic": "propExtendedPictographic",
+}
+
+type propertyRange struct {
+	lo, hi rune
+	prop   string
+}
+
+func open(name string) io.ReadCloser {
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		resp, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("fetching %v: %v", name, resp.Status)
+		}
+		return resp.Body
+	}
+	f, err := os.Open(filepath.Join(*ucd, filepath.FromSlash(name)))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return f
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+// parse returns the ranges in the named file whose property is one of
+// those in the properties map.
+func parse(name string) []propertyRange {
+	r := open(name)
+	defer r.Close()
+
+	var ranges []propertyRange
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		codes, prop, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+		c, ok := properties[strings.TrimSpace(prop)]
+		if !ok {
+			continue
+		}
+		lo, hi, ok := strings.Cut(strings.TrimSpace(codes), "..")
+		if !ok {
+			hi = lo
+		}
+		ranges = append(ranges, propertyRange{parseRune(lo), parseRune(hi), c})
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return ranges
+}
+
+func main() {
+	flag.Parse()
+
+	ranges := append(parse("auxiliary/GraphemeBreakProperty.txt"), parse("emoji/emoji-data.txt")...)
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+
+	// Merge adjacent ranges with the same property.
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if r.lo <= last.hi {
+				log.Fatalf("overlapping ranges %04X..%04X and %04X..%04X", last.lo, last.hi, r.lo, r.hi)
+			}
+			if r.lo == last.hi+1 && r.prop == last.prop {
+				last.hi = r.hi
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by running \"go generate\" in github.com/pgavlin/text/grapheme. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package grapheme\n\n")
+	fmt.Fprintf(&buf, "// UnicodeVersion is the Unicode edition from which the tables are derived.\n")
+	fmt.Fprintf(&buf, "const UnicodeVersion = %q\n\n", *version)
+	fmt.Fprintf(&buf, "// properties holds the runes whose Grapheme_Cluster_Break property is not\n")
+	fmt.Fprintf(&buf, "// Other or that are Extended_Pictographic, in ascending order.\n")
+	fmt.Fprintf(&buf, "var properties = [...]propertyRange{\n")
+	for _, r := range merged {
+		fmt.Fprintf(&buf, "\t{0x%04X, 0x%04X, %s},\n", r.lo, r.hi, r.prop)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
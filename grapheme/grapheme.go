@@ -0,0 +1,180 @@
+// Package grapheme implements segmentation of text into extended grapheme
+// clusters as described in Unicode Standard Annex #29.
+//
+// An extended grapheme cluster is what a user thinks of as a single
+// character: a base character with its combining marks, a Hangul syllable, an
+// emoji ZWJ sequence or a pair of regional indicators that forms a flag.
+package grapheme
+
+import (
+	"iter"
+
+	"github.com/pgavlin/text/utf8"
+)
+
+//go:generate go run gen.go
+
+// property is a rune's Grapheme_Cluster_Break property, or
+// propExtendedPictographic for runes that are Extended_Pictographic.
+type property uint8
+
+const (
+	propOther property = iota
+	propCR
+	propLF
+	propControl
+	propExtend
+	propZWJ
+	propRegionalIndicator
+	propPrepend
+	propSpacingMark
+	propL
+	propV
+	propT
+	propLV
+	propLVT
+	propExtendedPictographic
+)
+
+// propertyRange assigns a property to the runes lo through hi inclusive.
+type propertyRange struct {
+	lo, hi rune
+	prop   property
+}
+
+// lookup returns the property of r.
+func lookup(r rune) property {
+	if r >= 0x20 && r < 0x7F {
+		return propOther
+	}
+	lo, hi := 0, len(properties)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch {
+		case properties[m].hi < r:
+			lo = m + 1
+		case properties[m].lo > r:
+			hi = m
+		default:
+			return properties[m].prop
+		}
+	}
+	return propOther
+}
+
+// firstLen returns the length in bytes of the first extended grapheme
+// cluster in s.
+func firstLen[S ~string | ~[]byte](s S) int {
+	if len(s) == 0 {
+		return 0
+	}
+	// Fast path: printable ASCII and controls other than CR never join with
+	// a following ASCII byte.
+	if s[0] < utf8.RuneSelf && s[0] != '\r' && (len(s) == 1 || s[1] < utf8.RuneSelf) {
+		return 1
+	}
+
+	r, i := utf8.DecodeRune(s)
+	prev := lookup(r)
+	// emoji is true if the text since the last Extended_Pictographic rune
+	// matches Extended_Pictographic Extend*; zwjEmoji is true if it matches
+	// Extended_Pictographic Extend* ZWJ.
+	emoji, zwjEmoji := prev == propExtendedPictographic, false
+	// oddRI is true if the cluster ends with an odd number of regional
+	// indicators.
+	oddRI := prev == propRegionalIndicator
+	for i < len(s) {
+		r, size := utf8.DecodeRune(s[i:])
+		next := lookup(r)
+		if isBoundary(prev, next, zwjEmoji, oddRI) {
+			break
+		}
+		zwjEmoji = next == propZWJ && emoji
+		emoji = next == propExtendedPictographic || next == propExtend && emoji
+		oddRI = next == propRegionalIndicator && !oddRI
+		prev = next
+		i += size
+	}
+	return i
+}
+
+// isBoundary reports whether there is a grapheme cluster boundary between a
+// rune with property prev and a following rune with property next.
+func isBoundary(prev, next property, zwjEmoji, oddRI bool) bool {
+	switch {
+	case prev == propCR && next == propLF: // GB3
+		return false
+	case prev == propControl || prev == propCR || prev == propLF: // GB4
+		return true
+	case next == propControl || next == propCR || next == propLF: // GB5
+		return true
+	case prev == propL && (next == propL || next == propV || next == propLV || next == propLVT): // GB6
+		return false
+	case (prev == propLV || prev == propV) && (next == propV || next == propT): // GB7
+		return false
+	case (prev == propLVT || prev == propT) && next == propT: // GB8
+		return false
+	case next == propExtend || next == propZWJ: // GB9
+		return false
+	case next == propSpacingMark: // GB9a
+		return false
+	case prev == propPrepend: // GB9b
+		return false
+	case prev == propZWJ && next == propExtendedPictographic && zwjEmoji: // GB11
+		return false
+	case prev == propRegionalIndicator && next == propRegionalIndicator && oddRI: // GB12, GB13
+		return false
+	default: // GB999
+		return true
+	}
+}
+
+// FirstGrapheme splits s into its first extended grapheme cluster and the
+// remaining text. If s is empty, both results are empty. Each byte of
+// invalid UTF-8 is treated as if it were the replacement character U+FFFD.
+func FirstGrapheme[S ~string | ~[]byte](s S) (cluster, rest S) {
+	n := firstLen(s)
+	return s[:n], s[n:]
+}
+
+// Graphemes returns an iterator over the extended grapheme clusters in s.
+// The iterator yields the same clusters as repeated calls to FirstGrapheme.
+func Graphemes[S ~string | ~[]byte](s S) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		for len(s) > 0 {
+			n := firstLen(s)
+			if !yield(s[:n]) {
+				return
+			}
+			s = s[n:]
+		}
+	}
+}
+
+// GraphemeCount returns the number of extended grapheme clusters in s.
+func GraphemeCount[S ~string | ~[]byte](s S) int {
+	n := 0
+	for len(s) > 0 {
+		s = s[firstLen(s):]
+		n++
+	}
+	return n
+}
+
+// IndexGrapheme returns the byte index in s at which the nth extended
+// grapheme cluster, counting from zero, begins. If s holds exactly n
+// clusters, IndexGrapheme returns len(s); if it holds fewer, it returns -1.
+// s[:IndexGrapheme(s, n)] is thus the first n clusters of s.
+func IndexGrapheme[S ~string | ~[]byte](s S, n int) int {
+	if n < 0 {
+		return -1
+	}
+	i := 0
+	for ; n > 0 && i < len(s); n-- {
+		i += firstLen(s[i:])
+	}
+	if n > 0 {
+		return -1
+	}
+	return i
+}
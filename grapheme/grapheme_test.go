@@ -0,0 +1,90 @@
+package grapheme_test
+
+import (
+	"slices"
+	"testing"
+
+	. "github.com/pgavlin/text/grapheme"
+)
+
+var graphemeTests = []struct {
+	in  string
+	out []string
+}{
+	{"", nil},
+	{"abc", []string{"a", "b", "c"}},
+	{"a\r\nb", []string{"a", "\r\n", "b"}},
+	{"\n\r", []string{"\n", "\r"}},
+	{"a\u0301\u0323b", []string{"a\u0301\u0323", "b"}},
+	{"\u0301a", []string{"\u0301", "a"}},
+	{"\x00\u0308", []string{"\x00", "\u0308"}},
+	// Hangul syllables and jamo.
+	{"\u1100\u1161\u11a8\u1100", []string{"\u1100\u1161\u11a8", "\u1100"}},
+	{"\uac00\u11a8\uac01\u11a8", []string{"\uac00\u11a8", "\uac01\u11a8"}},
+	// SpacingMark and Prepend.
+	{"\u0915\u093f", []string{"\u0915\u093f"}},
+	{"\u0600\u0661", []string{"\u0600\u0661"}},
+	// Emoji modifiers and ZWJ sequences.
+	{"\U0001f44d\U0001f3fd!", []string{"\U0001f44d\U0001f3fd", "!"}},
+	{"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466", []string{"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466"}},
+	{"\U0001f3f3\ufe0f\u200d\U0001f308", []string{"\U0001f3f3\ufe0f\u200d\U0001f308"}},
+	{"a\u200d\U0001f308", []string{"a\u200d", "\U0001f308"}},
+	// Regional indicator pairs.
+	{"\U0001f1fa\U0001f1f8\U0001f1eb\U0001f1f7", []string{"\U0001f1fa\U0001f1f8", "\U0001f1eb\U0001f1f7"}},
+	{"\U0001f1fa\U0001f1f8\U0001f1eb", []string{"\U0001f1fa\U0001f1f8", "\U0001f1eb"}},
+	// Invalid UTF-8.
+	{"\xff\xfe", []string{"\xff", "\xfe"}},
+	{"\xff\u0301", []string{"\xff\u0301"}},
+}
+
+func TestGraphemes(t *testing.T) {
+	for _, tt := range graphemeTests {
+		if got := slices.Collect(Graphemes(tt.in)); !slices.Equal(got, tt.out) {
+			t.Errorf("Graphemes(%+q) = %+q; want %+q", tt.in, got, tt.out)
+		}
+		var got []string
+		for c := range Graphemes([]byte(tt.in)) {
+			got = append(got, string(c))
+		}
+		if !slices.Equal(got, tt.out) {
+			t.Errorf("Graphemes([]byte(%+q)) = %+q; want %+q", tt.in, got, tt.out)
+		}
+		if n := GraphemeCount(tt.in); n != len(tt.out) {
+			t.Errorf("GraphemeCount(%+q) = %d; want %d", tt.in, n, len(tt.out))
+		}
+		cluster, rest := FirstGrapheme(tt.in)
+		if len(tt.out) == 0 && (cluster != "" || rest != "") || len(tt.out) > 0 && (cluster != tt.out[0] || cluster+rest != tt.in) {
+			t.Errorf("FirstGrapheme(%+q) = %+q, %+q", tt.in, cluster, rest)
+		}
+	}
+}
+
+func TestIndexGrapheme(t *testing.T) {
+	s := "e\u0301\U0001f1fa\U0001f1f8x"
+	for _, tt := range []struct{ n, want int }{{-1, -1}, {0, 0}, {1, 3}, {2, 11}, {3, 12}, {4, -1}} {
+		if got := IndexGrapheme(s, tt.n); got != tt.want {
+			t.Errorf("IndexGrapheme(%+q, %d) = %d; want %d", s, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestGraphemesEarlyExit(t *testing.T) {
+	var got []string
+	for c := range Graphemes("a\u0301bc") {
+		got = append(got, c)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"a\u0301", "b"}; !slices.Equal(got, want) {
+		t.Errorf("got %+q; want %+q", got, want)
+	}
+}
+
+func BenchmarkGraphemeCount(b *testing.B) {
+	s := "The quick brown fox jumps over the lazy dog. Ame\u0301lie \U0001f469\u200d\U0001f469\u200d\U0001f467 \U0001f1fa\U0001f1f8"
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		GraphemeCount(s)
+	}
+}
@@ -0,0 +1,1460 @@
+// Code generated by running "go generate" in github.com/pgavlin/text/grapheme. DO NOT EDIT.
+
+package grapheme
+
+// UnicodeVersion is the Unicode edition from which the tables are derived.
+const UnicodeVersion = "15.0.0"
+
+// properties holds the runes whose Grapheme_Cluster_Break property is not
+// Other or that are Extended_Pictographic, in ascending order.
+var properties = [...]propertyRange{
+	{0x0000, 0x0009, propControl},
+	{0x000A, 0x000A, propLF},
+	{0x000B, 0x000C, propControl},
+	{0x000D, 0x000D, propCR},
+	{0x000E, 0x001F, propControl},
+	{0x007F, 0x009F, propControl},
+	{0x00A9, 0x00A9, propExtendedPictographic},
+	{0x00AD, 0x00AD, propControl},
+	{0x00AE, 0x00AE, propExtendedPictographic},
+	{0x0300, 0x036F, propExtend},
+	{0x0483, 0x0489, propExtend},
+	{0x0591, 0x05BD, propExtend},
+	{0x05BF, 0x05BF, propExtend},
+	{0x05C1, 0x05C2, propExtend},
+	{0x05C4, 0x05C5, propExtend},
+	{0x05C7, 0x05C7, propExtend},
+	{0x0600, 0x0605, propPrepend},
+	{0x0610, 0x061A, propExtend},
+	{0x061C, 0x061C, propControl},
+	{0x064B, 0x065F, propExtend},
+	{0x0670, 0x0670, propExtend},
+	{0x06D6, 0x06DC, propExtend},
+	{0x06DD, 0x06DD, propPrepend},
+	{0x06DF, 0x06E4, propExtend},
+	{0x06E7, 0x06E8, propExtend},
+	{0x06EA, 0x06ED, propExtend},
+	{0x070F, 0x070F, propPrepend},
+	{0x0711, 0x0711, propExtend},
+	{0x0730, 0x074A, propExtend},
+	{0x07A6, 0x07B0, propExtend},
+	{0x07EB, 0x07F3, propExtend},
+	{0x07FD, 0x07FD, propExtend},
+	{0x0816, 0x0819, propExtend},
+	{0x081B, 0x0823, propExtend},
+	{0x0825, 0x0827, propExtend},
+	{0x0829, 0x082D, propExtend},
+	{0x0859, 0x085B, propExtend},
+	{0x0890, 0x0891, propPrepend},
+	{0x0898, 0x089F, propExtend},
+	{0x08CA, 0x08E1, propExtend},
+	{0x08E2, 0x08E2, propPrepend},
+	{0x08E3, 0x0902, propExtend},
+	{0x0903, 0x0903, propSpacingMark},
+	{0x093A, 0x093A, propExtend},
+	{0x093B, 0x093B, propSpacingMark},
+	{0x093C, 0x093C, propExtend},
+	{0x093E, 0x0940, propSpacingMark},
+	{0x0941, 0x0948, propExtend},
+	{0x0949, 0x094C, propSpacingMark},
+	{0x094D, 0x094D, propExtend},
+	{0x094E, 0x094F, propSpacingMark},
+	{0x0951, 0x0957, propExtend},
+	{0x0962, 0x0963, propExtend},
+	{0x0981, 0x0981, propExtend},
+	{0x0982, 0x0983, propSpacingMark},
+	{0x09BC, 0x09BC, propExtend},
+	{0x09BE, 0x09BE, propExtend},
+	{0x09BF, 0x09C0, propSpacingMark},
+	{0x09C1, 0x09C4, propExtend},
+	{0x09C7, 0x09C8, propSpacingMark},
+	{0x09CB, 0x09CC, propSpacingMark},
+	{0x09CD, 0x09CD, propExtend},
+	{0x09D7, 0x09D7, propExtend},
+	{0x09E2, 0x09E3, propExtend},
+	{0x09FE, 0x09FE, propExtend},
+	{0x0A01, 0x0A02, propExtend},
+	{0x0A03, 0x0A03, propSpacingMark},
+	{0x0A3C, 0x0A3C, propExtend},
+	{0x0A3E, 0x0A40, propSpacingMark},
+	{0x0A41, 0x0A42, propExtend},
+	{0x0A47, 0x0A48, propExtend},
+	{0x0A4B, 0x0A4D, propExtend},
+	{0x0A51, 0x0A51, propExtend},
+	{0x0A70, 0x0A71, propExtend},
+	{0x0A75, 0x0A75, propExtend},
+	{0x0A81, 0x0A82, propExtend},
+	{0x0A83, 0x0A83, propSpacingMark},
+	{0x0ABC, 0x0ABC, propExtend},
+	{0x0ABE, 0x0AC0, propSpacingMark},
+	{0x0AC1, 0x0AC5, propExtend},
+	{0x0AC7, 0x0AC8, propExtend},
+	{0x0AC9, 0x0AC9, propSpacingMark},
+	{0x0ACB, 0x0ACC, propSpacingMark},
+	{0x0ACD, 0x0ACD, propExtend},
+	{0x0AE2, 0x0AE3, propExtend},
+	{0x0AFA, 0x0AFF, propExtend},
+	{0x0B01, 0x0B01, propExtend},
+	{0x0B02, 0x0B03, propSpacingMark},
+	{0x0B3C, 0x0B3C, propExtend},
+	{0x0B3E, 0x0B3F, propExtend},
+	{0x0B40, 0x0B40, propSpacingMark},
+	{0x0B41, 0x0B44, propExtend},
+	{0x0B47, 0x0B48, propSpacingMark},
+	{0x0B4B, 0x0B4C, propSpacingMark},
+	{0x0B4D, 0x0B4D, propExtend},
+	{0x0B55, 0x0B57, propExtend},
+	{0x0B62, 0x0B63, propExtend},
+	{0x0B82, 0x0B82, propExtend},
+	{0x0BBE, 0x0BBE, propExtend},
+	{0x0BBF, 0x0BBF, propSpacingMark},
+	{0x0BC0, 0x0BC0, propExtend},
+	{0x0BC1, 0x0BC2, propSpacingMark},
+	{0x0BC6, 0x0BC8, propSpacingMark},
+	{0x0BCA, 0x0BCC, propSpacingMark},
+	{0x0BCD, 0x0BCD, propExtend},
+	{0x0BD7, 0x0BD7, propExtend},
+	{0x0C00, 0x0C00, propExtend},
+	{0x0C01, 0x0C03, propSpacingMark},
+	{0x0C04, 0x0C04, propExtend},
+	{0x0C3C, 0x0C3C, propExtend},
+	{0x0C3E, 0x0C40, propExtend},
+	{0x0C41, 0x0C44, propSpacingMark},
+	{0x0C46, 0x0C48, propExtend},
+	{0x0C4A, 0x0C4D, propExtend},
+	{0x0C55, 0x0C56, propExtend},
+	{0x0C62, 0x0C63, propExtend},
+	{0x0C81, 0x0C81, propExtend},
+	{0x0C82, 0x0C83, propSpacingMark},
+	{0x0CBC, 0x0CBC, propExtend},
+	{0x0CBE, 0x0CBE, propSpacingMark},
+	{0x0CBF, 0x0CBF, propExtend},
+	{0x0CC0, 0x0CC1, propSpacingMark},
+	{0x0CC2, 0x0CC2, propExtend},
+	{0x0CC3, 0x0CC4, propSpacingMark},
+	{0x0CC6, 0x0CC6, propExtend},
+	{0x0CC7, 0x0CC8, propSpacingMark},
+	{0x0CCA, 0x0CCB, propSpacingMark},
+	{0x0CCC, 0x0CCD, propExtend},
+	{0x0CD5, 0x0CD6, propExtend},
+	{0x0CE2, 0x0CE3, propExtend},
+	{0x0CF3, 0x0CF3, propSpacingMark},
+	{0x0D00, 0x0D01, propExtend},
+	{0x0D02, 0x0D03, propSpacingMark},
+	{0x0D3B, 0x0D3C, propExtend},
+	{0x0D3E, 0x0D3E, propExtend},
+	{0x0D3F, 0x0D40, propSpacingMark},
+	{0x0D41, 0x0D44, propExtend},
+	{0x0D46, 0x0D48, propSpacingMark},
+	{0x0D4A, 0x0D4C, propSpacingMark},
+	{0x0D4D, 0x0D4D, propExtend},
+	{0x0D4E, 0x0D4E, propPrepend},
+	{0x0D57, 0x0D57, propExtend},
+	{0x0D62, 0x0D63, propExtend},
+	{0x0D81, 0x0D81, propExtend},
+	{0x0D82, 0x0D83, propSpacingMark},
+	{0x0DCA, 0x0DCA, propExtend},
+	{0x0DCF, 0x0DCF, propExtend},
+	{0x0DD0, 0x0DD1, propSpacingMark},
+	{0x0DD2, 0x0DD4, propExtend},
+	{0x0DD6, 0x0DD6, propExtend},
+	{0x0DD8, 0x0DDE, propSpacingMark},
+	{0x0DDF, 0x0DDF, propExtend},
+	{0x0DF2, 0x0DF3, propSpacingMark},
+	{0x0E31, 0x0E31, propExtend},
+	{0x0E33, 0x0E33, propSpacingMark},
+	{0x0E34, 0x0E3A, propExtend},
+	{0x0E47, 0x0E4E, propExtend},
+	{0x0EB1, 0x0EB1, propExtend},
+	{0x0EB3, 0x0EB3, propSpacingMark},
+	{0x0EB4, 0x0EBC, propExtend},
+	{0x0EC8, 0x0ECE, propExtend},
+	{0x0F18, 0x0F19, propExtend},
+	{0x0F35, 0x0F35, propExtend},
+	{0x0F37, 0x0F37, propExtend},
+	{0x0F39, 0x0F39, propExtend},
+	{0x0F3E, 0x0F3F, propSpacingMark},
+	{0x0F71, 0x0F7E, propExtend},
+	{0x0F7F, 0x0F7F, propSpacingMark},
+	{0x0F80, 0x0F84, propExtend},
+	{0x0F86, 0x0F87, propExtend},
+	{0x0F8D, 0x0F97, propExtend},
+	{0x0F99, 0x0FBC, propExtend},
+	{0x0FC6, 0x0FC6, propExtend},
+	{0x102D, 0x1030, propExtend},
+	{0x1031, 0x1031, propSpacingMark},
+	{0x1032, 0x1037, propExtend},
+	{0x1039, 0x103A, propExtend},
+	{0x103B, 0x103C, propSpacingMark},
+	{0x103D, 0x103E, propExtend},
+	{0x1056, 0x1057, propSpacingMark},
+	{0x1058, 0x1059, propExtend},
+	{0x105E, 0x1060, propExtend},
+	{0x1071, 0x1074, propExtend},
+	{0x1082, 0x1082, propExtend},
+	{0x1084, 0x1084, propSpacingMark},
+	{0x1085, 0x1086, propExtend},
+	{0x108D, 0x108D, propExtend},
+	{0x109D, 0x109D, propExtend},
+	{0x1100, 0x115F, propL},
+	{0x1160, 0x11A7, propV},
+	{0x11A8, 0x11FF, propT},
+	{0x135D, 0x135F, propExtend},
+	{0x1712, 0x1714, propExtend},
+	{0x1715, 0x1715, propSpacingMark},
+	{0x1732, 0x1733, propExtend},
+	{0x1734, 0x1734, propSpacingMark},
+	{0x1752, 0x1753, propExtend},
+	{0x1772, 0x1773, propExtend},
+	{0x17B4, 0x17B5, propExtend},
+	{0x17B6, 0x17B6, propSpacingMark},
+	{0x17B7, 0x17BD, propExtend},
+	{0x17BE, 0x17C5, propSpacingMark},
+	{0x17C6, 0x17C6, propExtend},
+	{0x17C7, 0x17C8, propSpacingMark},
+	{0x17C9, 0x17D3, propExtend},
+	{0x17DD, 0x17DD, propExtend},
+	{0x180B, 0x180D, propExtend},
+	{0x180E, 0x180E, propControl},
+	{0x180F, 0x180F, propExtend},
+	{0x1885, 0x1886, propExtend},
+	{0x18A9, 0x18A9, propExtend},
+	{0x1920, 0x1922, propExtend},
+	{0x1923, 0x1926, propSpacingMark},
+	{0x1927, 0x1928, propExtend},
+	{0x1929, 0x192B, propSpacingMark},
+	{0x1930, 0x1931, propSpacingMark},
+	{0x1932, 0x1932, propExtend},
+	{0x1933, 0x1938, propSpacingMark},
+	{0x1939, 0x193B, propExtend},
+	{0x1A17, 0x1A18, propExtend},
+	{0x1A19, 0x1A1A, propSpacingMark},
+	{0x1A1B, 0x1A1B, propExtend},
+	{0x1A55, 0x1A55, propSpacingMark},
+	{0x1A56, 0x1A56, propExtend},
+	{0x1A57, 0x1A57, propSpacingMark},
+	{0x1A58, 0x1A5E, propExtend},
+	{0x1A60, 0x1A60, propExtend},
+	{0x1A62, 0x1A62, propExtend},
+	{0x1A65, 0x1A6C, propExtend},
+	{0x1A6D, 0x1A72, propSpacingMark},
+	{0x1A73, 0x1A7C, propExtend},
+	{0x1A7F, 0x1A7F, propExtend},
+	{0x1AB0, 0x1ACE, propExtend},
+	{0x1B00, 0x1B03, propExtend},
+	{0x1B04, 0x1B04, propSpacingMark},
+	{0x1B34, 0x1B3A, propExtend},
+	{0x1B3B, 0x1B3B, propSpacingMark},
+	{0x1B3C, 0x1B3C, propExtend},
+	{0x1B3D, 0x1B41, propSpacingMark},
+	{0x1B42, 0x1B42, propExtend},
+	{0x1B43, 0x1B44, propSpacingMark},
+	{0x1B6B, 0x1B73, propExtend},
+	{0x1B80, 0x1B81, propExtend},
+	{0x1B82, 0x1B82, propSpacingMark},
+	{0x1BA1, 0x1BA1, propSpacingMark},
+	{0x1BA2, 0x1BA5, propExtend},
+	{0x1BA6, 0x1BA7, propSpacingMark},
+	{0x1BA8, 0x1BA9, propExtend},
+	{0x1BAA, 0x1BAA, propSpacingMark},
+	{0x1BAB, 0x1BAD, propExtend},
+	{0x1BE6, 0x1BE6, propExtend},
+	{0x1BE7, 0x1BE7, propSpacingMark},
+	{0x1BE8, 0x1BE9, propExtend},
+	{0x1BEA, 0x1BEC, propSpacingMark},
+	{0x1BED, 0x1BED, propExtend},
+	{0x1BEE, 0x1BEE, propSpacingMark},
+	{0x1BEF, 0x1BF1, propExtend},
+	{0x1BF2, 0x1BF3, propSpacingMark},
+	{0x1C24, 0x1C2B, propSpacingMark},
+	{0x1C2C, 0x1C33, propExtend},
+	{0x1C34, 0x1C35, propSpacingMark},
+	{0x1C36, 0x1C37, propExtend},
+	{0x1CD0, 0x1CD2, propExtend},
+	{0x1CD4, 0x1CE0, propExtend},
+	{0x1CE1, 0x1CE1, propSpacingMark},
+	{0x1CE2, 0x1CE8, propExtend},
+	{0x1CED, 0x1CED, propExtend},
+	{0x1CF4, 0x1CF4, propExtend},
+	{0x1CF7, 0x1CF7, propSpacingMark},
+	{0x1CF8, 0x1CF9, propExtend},
+	{0x1DC0, 0x1DFF, propExtend},
+	{0x200B, 0x200B, propControl},
+	{0x200C, 0x200C, propExtend},
+	{0x200D, 0x200D, propZWJ},
+	{0x200E, 0x200F, propControl},
+	{0x2028, 0x202E, propControl},
+	{0x203C, 0x203C, propExtendedPictographic},
+	{0x2049, 0x2049, propExtendedPictographic},
+	{0x2060, 0x206F, propControl},
+	{0x20D0, 0x20F0, propExtend},
+	{0x2122, 0x2122, propExtendedPictographic},
+	{0x2139, 0x2139, propExtendedPictographic},
+	{0x2194, 0x2199, propExtendedPictographic},
+	{0x21A9, 0x21AA, propExtendedPictographic},
+	{0x231A, 0x231B, propExtendedPictographic},
+	{0x2328, 0x2328, propExtendedPictographic},
+	{0x2388, 0x2388, propExtendedPictographic},
+	{0x23CF, 0x23CF, propExtendedPictographic},
+	{0x23E9, 0x23F3, propExtendedPictographic},
+	{0x23F8, 0x23FA, propExtendedPictographic},
+	{0x24C2, 0x24C2, propExtendedPictographic},
+	{0x25AA, 0x25AB, propExtendedPictographic},
+	{0x25B6, 0x25B6, propExtendedPictographic},
+	{0x25C0, 0x25C0, propExtendedPictographic},
+	{0x25FB, 0x25FE, propExtendedPictographic},
+	{0x2600, 0x2605, propExtendedPictographic},
+	{0x2607, 0x2612, propExtendedPictographic},
+	{0x2614, 0x2685, propExtendedPictographic},
+	{0x2690, 0x2705, propExtendedPictographic},
+	{0x2708, 0x2712, propExtendedPictographic},
+	{0x2714, 0x2714, propExtendedPictographic},
+	{0x2716, 0x2716, propExtendedPictographic},
+	{0x271D, 0x271D, propExtendedPictographic},
+	{0x2721, 0x2721, propExtendedPictographic},
+	{0x2728, 0x2728, propExtendedPictographic},
+	{0x2733, 0x2734, propExtendedPictographic},
+	{0x2744, 0x2744, propExtendedPictographic},
+	{0x2747, 0x2747, propExtendedPictographic},
+	{0x274C, 0x274C, propExtendedPictographic},
+	{0x274E, 0x274E, propExtendedPictographic},
+	{0x2753, 0x2755, propExtendedPictographic},
+	{0x2757, 0x2757, propExtendedPictographic},
+	{0x2763, 0x2767, propExtendedPictographic},
+	{0x2795, 0x2797, propExtendedPictographic},
+	{0x27A1, 0x27A1, propExtendedPictographic},
+	{0x27B0, 0x27B0, propExtendedPictographic},
+	{0x27BF, 0x27BF, propExtendedPictographic},
+	{0x2934, 0x2935, propExtendedPictographic},
+	{0x2B05, 0x2B07, propExtendedPictographic},
+	{0x2B1B, 0x2B1C, propExtendedPictographic},
+	{0x2B50, 0x2B50, propExtendedPictographic},
+	{0x2B55, 0x2B55, propExtendedPictographic},
+	{0x2CEF, 0x2CF1, propExtend},
+	{0x2D7F, 0x2D7F, propExtend},
+	{0x2DE0, 0x2DFF, propExtend},
+	{0x302A, 0x302F, propExtend},
+	{0x3030, 0x3030, propExtendedPictographic},
+	{0x303D, 0x303D, propExtendedPictographic},
+	{0x3099, 0x309A, propExtend},
+	{0x3297, 0x3297, propExtendedPictographic},
+	{0x3299, 0x3299, propExtendedPictographic},
+	{0xA66F, 0xA672, propExtend},
+	{0xA674, 0xA67D, propExtend},
+	{0xA69E, 0xA69F, propExtend},
+	{0xA6F0, 0xA6F1, propExtend},
+	{0xA802, 0xA802, propExtend},
+	{0xA806, 0xA806, propExtend},
+	{0xA80B, 0xA80B, propExtend},
+	{0xA823, 0xA824, propSpacingMark},
+	{0xA825, 0xA826, propExtend},
+	{0xA827, 0xA827, propSpacingMark},
+	{0xA82C, 0xA82C, propExtend},
+	{0xA880, 0xA881, propSpacingMark},
+	{0xA8B4, 0xA8C3, propSpacingMark},
+	{0xA8C4, 0xA8C5, propExtend},
+	{0xA8E0, 0xA8F1, propExtend},
+	{0xA8FF, 0xA8FF, propExtend},
+	{0xA926, 0xA92D, propExtend},
+	{0xA947, 0xA951, propExtend},
+	{0xA952, 0xA953, propSpacingMark},
+	{0xA960, 0xA97C, propL},
+	{0xA980, 0xA982, propExtend},
+	{0xA983, 0xA983, propSpacingMark},
+	{0xA9B3, 0xA9B3, propExtend},
+	{0xA9B4, 0xA9B5, propSpacingMark},
+	{0xA9B6, 0xA9B9, propExtend},
+	{0xA9BA, 0xA9BB, propSpacingMark},
+	{0xA9BC, 0xA9BD, propExtend},
+	{0xA9BE, 0xA9C0, propSpacingMark},
+	{0xA9E5, 0xA9E5, propExtend},
+	{0xAA29, 0xAA2E, propExtend},
+	{0xAA2F, 0xAA30, propSpacingMark},
+	{0xAA31, 0xAA32, propExtend},
+	{0xAA33, 0xAA34, propSpacingMark},
+	{0xAA35, 0xAA36, propExtend},
+	{0xAA43, 0xAA43, propExtend},
+	{0xAA4C, 0xAA4C, propExtend},
+	{0xAA4D, 0xAA4D, propSpacingMark},
+	{0xAA7C, 0xAA7C, propExtend},
+	{0xAAB0, 0xAAB0, propExtend},
+	{0xAAB2, 0xAAB4, propExtend},
+	{0xAAB7, 0xAAB8, propExtend},
+	{0xAABE, 0xAABF, propExtend},
+	{0xAAC1, 0xAAC1, propExtend},
+	{0xAAEB, 0xAAEB, propSpacingMark},
+	{0xAAEC, 0xAAED, propExtend},
+	{0xAAEE, 0xAAEF, propSpacingMark},
+	{0xAAF5, 0xAAF5, propSpacingMark},
+	{0xAAF6, 0xAAF6, propExtend},
+	{0xABE3, 0xABE4, propSpacingMark},
+	{0xABE5, 0xABE5, propExtend},
+	{0xABE6, 0xABE7, propSpacingMark},
+	{0xABE8, 0xABE8, propExtend},
+	{0xABE9, 0xABEA, propSpacingMark},
+	{0xABEC, 0xABEC, propSpacingMark},
+	{0xABED, 0xABED, propExtend},
+	{0xAC00, 0xAC00, propLV},
+	{0xAC01, 0xAC1B, propLVT},
+	{0xAC1C, 0xAC1C, propLV},
+	{0xAC1D, 0xAC37, propLVT},
+	{0xAC38, 0xAC38, propLV},
+	{0xAC39, 0xAC53, propLVT},
+	{0xAC54, 0xAC54, propLV},
+	{0xAC55, 0xAC6F, propLVT},
+	{0xAC70, 0xAC70, propLV},
+	{0xAC71, 0xAC8B, propLVT},
+	{0xAC8C, 0xAC8C, propLV},
+	{0xAC8D, 0xACA7, propLVT},
+	{0xACA8, 0xACA8, propLV},
+	{0xACA9, 0xACC3, propLVT},
+	{0xACC4, 0xACC4, propLV},
+	{0xACC5, 0xACDF, propLVT},
+	{0xACE0, 0xACE0, propLV},
+	{0xACE1, 0xACFB, propLVT},
+	{0xACFC, 0xACFC, propLV},
+	{0xACFD, 0xAD17, propLVT},
+	{0xAD18, 0xAD18, propLV},
+	{0xAD19, 0xAD33, propLVT},
+	{0xAD34, 0xAD34, propLV},
+	{0xAD35, 0xAD4F, propLVT},
+	{0xAD50, 0xAD50, propLV},
+	{0xAD51, 0xAD6B, propLVT},
+	{0xAD6C, 0xAD6C, propLV},
+	{0xAD6D, 0xAD87, propLVT},
+	{0xAD88, 0xAD88, propLV},
+	{0xAD89, 0xADA3, propLVT},
+	{0xADA4, 0xADA4, propLV},
+	{0xADA5, 0xADBF, propLVT},
+	{0xADC0, 0xADC0, propLV},
+	{0xADC1, 0xADDB, propLVT},
+	{0xADDC, 0xADDC, propLV},
+	{0xADDD, 0xADF7, propLVT},
+	{0xADF8, 0xADF8, propLV},
+	{0xADF9, 0xAE13, propLVT},
+	{0xAE14, 0xAE14, propLV},
+	{0xAE15, 0xAE2F, propLVT},
+	{0xAE30, 0xAE30, propLV},
+	{0xAE31, 0xAE4B, propLVT},
+	{0xAE4C, 0xAE4C, propLV},
+	{0xAE4D, 0xAE67, propLVT},
+	{0xAE68, 0xAE68, propLV},
+	{0xAE69, 0xAE83, propLVT},
+	{0xAE84, 0xAE84, propLV},
+	{0xAE85, 0xAE9F, propLVT},
+	{0xAEA0, 0xAEA0, propLV},
+	{0xAEA1, 0xAEBB, propLVT},
+	{0xAEBC, 0xAEBC, propLV},
+	{0xAEBD, 0xAED7, propLVT},
+	{0xAED8, 0xAED8, propLV},
+	{0xAED9, 0xAEF3, propLVT},
+	{0xAEF4, 0xAEF4, propLV},
+	{0xAEF5, 0xAF0F, propLVT},
+	{0xAF10, 0xAF10, propLV},
+	{0xAF11, 0xAF2B, propLVT},
+	{0xAF2C, 0xAF2C, propLV},
+	{0xAF2D, 0xAF47, propLVT},
+	{0xAF48, 0xAF48, propLV},
+	{0xAF49, 0xAF63, propLVT},
+	{0xAF64, 0xAF64, propLV},
+	{0xAF65, 0xAF7F, propLVT},
+	{0xAF80, 0xAF80, propLV},
+	{0xAF81, 0xAF9B, propLVT},
+	{0xAF9C, 0xAF9C, propLV},
+	{0xAF9D, 0xAFB7, propLVT},
+	{0xAFB8, 0xAFB8, propLV},
+	{0xAFB9, 0xAFD3, propLVT},
+	{0xAFD4, 0xAFD4, propLV},
+	{0xAFD5, 0xAFEF, propLVT},
+	{0xAFF0, 0xAFF0, propLV},
+	{0xAFF1, 0xB00B, propLVT},
+	{0xB00C, 0xB00C, propLV},
+	{0xB00D, 0xB027, propLVT},
+	{0xB028, 0xB028, propLV},
+	{0xB029, 0xB043, propLVT},
+	{0xB044, 0xB044, propLV},
+	{0xB045, 0xB05F, propLVT},
+	{0xB060, 0xB060, propLV},
+	{0xB061, 0xB07B, propLVT},
+	{0xB07C, 0xB07C, propLV},
+	{0xB07D, 0xB097, propLVT},
+	{0xB098, 0xB098, propLV},
+	{0xB099, 0xB0B3, propLVT},
+	{0xB0B4, 0xB0B4, propLV},
+	{0xB0B5, 0xB0CF, propLVT},
+	{0xB0D0, 0xB0D0, propLV},
+	{0xB0D1, 0xB0EB, propLVT},
+	{0xB0EC, 0xB0EC, propLV},
+	{0xB0ED, 0xB107, propLVT},
+	{0xB108, 0xB108, propLV},
+	{0xB109, 0xB123, propLVT},
+	{0xB124, 0xB124, propLV},
+	{0xB125, 0xB13F, propLVT},
+	{0xB140, 0xB140, propLV},
+	{0xB141, 0xB15B, propLVT},
+	{0xB15C, 0xB15C, propLV},
+	{0xB15D, 0xB177, propLVT},
+	{0xB178, 0xB178, propLV},
+	{0xB179, 0xB193, propLVT},
+	{0xB194, 0xB194, propLV},
+	{0xB195, 0xB1AF, propLVT},
+	{0xB1B0, 0xB1B0, propLV},
+	{0xB1B1, 0xB1CB, propLVT},
+	{0xB1CC, 0xB1CC, propLV},
+	{0xB1CD, 0xB1E7, propLVT},
+	{0xB1E8, 0xB1E8, propLV},
+	{0xB1E9, 0xB203, propLVT},
+	{0xB204, 0xB204, propLV},
+	{0xB205, 0xB21F, propLVT},
+	{0xB220, 0xB220, propLV},
+	{0xB221, 0xB23B, propLVT},
+	{0xB23C, 0xB23C, propLV},
+	{0xB23D, 0xB257, propLVT},
+	{0xB258, 0xB258, propLV},
+	{0xB259, 0xB273, propLVT},
+	{0xB274, 0xB274, propLV},
+	{0xB275, 0xB28F, propLVT},
+	{0xB290, 0xB290, propLV},
+	{0xB291, 0xB2AB, propLVT},
+	{0xB2AC, 0xB2AC, propLV},
+	{0xB2AD, 0xB2C7, propLVT},
+	{0xB2C8, 0xB2C8, propLV},
+	{0xB2C9, 0xB2E3, propLVT},
+	{0xB2E4, 0xB2E4, propLV},
+	{0xB2E5, 0xB2FF, propLVT},
+	{0xB300, 0xB300, propLV},
+	{0xB301, 0xB31B, propLVT},
+	{0xB31C, 0xB31C, propLV},
+	{0xB31D, 0xB337, propLVT},
+	{0xB338, 0xB338, propLV},
+	{0xB339, 0xB353, propLVT},
+	{0xB354, 0xB354, propLV},
+	{0xB355, 0xB36F, propLVT},
+	{0xB370, 0xB370, propLV},
+	{0xB371, 0xB38B, propLVT},
+	{0xB38C, 0xB38C, propLV},
+	{0xB38D, 0xB3A7, propLVT},
+	{0xB3A8, 0xB3A8, propLV},
+	{0xB3A9, 0xB3C3, propLVT},
+	{0xB3C4, 0xB3C4, propLV},
+	{0xB3C5, 0xB3DF, propLVT},
+	{0xB3E0, 0xB3E0, propLV},
+	{0xB3E1, 0xB3FB, propLVT},
+	{0xB3FC, 0xB3FC, propLV},
+	{0xB3FD, 0xB417, propLVT},
+	{0xB418, 0xB418, propLV},
+	{0xB419, 0xB433, propLVT},
+	{0xB434, 0xB434, propLV},
+	{0xB435, 0xB44F, propLVT},
+	{0xB450, 0xB450, propLV},
+	{0xB451, 0xB46B, propLVT},
+	{0xB46C, 0xB46C, propLV},
+	{0xB46D, 0xB487, propLVT},
+	{0xB488, 0xB488, propLV},
+	{0xB489, 0xB4A3, propLVT},
+	{0xB4A4, 0xB4A4, propLV},
+	{0xB4A5, 0xB4BF, propLVT},
+	{0xB4C0, 0xB4C0, propLV},
+	{0xB4C1, 0xB4DB, propLVT},
+	{0xB4DC, 0xB4DC, propLV},
+	{0xB4DD, 0xB4F7, propLVT},
+	{0xB4F8, 0xB4F8, propLV},
+	{0xB4F9, 0xB513, propLVT},
+	{0xB514, 0xB514, propLV},
+	{0xB515, 0xB52F, propLVT},
+	{0xB530, 0xB530, propLV},
+	{0xB531, 0xB54B, propLVT},
+	{0xB54C, 0xB54C, propLV},
+	{0xB54D, 0xB567, propLVT},
+	{0xB568, 0xB568, propLV},
+	{0xB569, 0xB583, propLVT},
+	{0xB584, 0xB584, propLV},
+	{0xB585, 0xB59F, propLVT},
+	{0xB5A0, 0xB5A0, propLV},
+	{0xB5A1, 0xB5BB, propLVT},
+	{0xB5BC, 0xB5BC, propLV},
+	{0xB5BD, 0xB5D7, propLVT},
+	{0xB5D8, 0xB5D8, propLV},
+	{0xB5D9, 0xB5F3, propLVT},
+	{0xB5F4, 0xB5F4, propLV},
+	{0xB5F5, 0xB60F, propLVT},
+	{0xB610, 0xB610, propLV},
+	{0xB611, 0xB62B, propLVT},
+	{0xB62C, 0xB62C, propLV},
+	{0xB62D, 0xB647, propLVT},
+	{0xB648, 0xB648, propLV},
+	{0xB649, 0xB663, propLVT},
+	{0xB664, 0xB664, propLV},
+	{0xB665, 0xB67F, propLVT},
+	{0xB680, 0xB680, propLV},
+	{0xB681, 0xB69B, propLVT},
+	{0xB69C, 0xB69C, propLV},
+	{0xB69D, 0xB6B7, propLVT},
+	{0xB6B8, 0xB6B8, propLV},
+	{0xB6B9, 0xB6D3, propLVT},
+	{0xB6D4, 0xB6D4, propLV},
+	{0xB6D5, 0xB6EF, propLVT},
+	{0xB6F0, 0xB6F0, propLV},
+	{0xB6F1, 0xB70B, propLVT},
+	{0xB70C, 0xB70C, propLV},
+	{0xB70D, 0xB727, propLVT},
+	{0xB728, 0xB728, propLV},
+	{0xB729, 0xB743, propLVT},
+	{0xB744, 0xB744, propLV},
+	{0xB745, 0xB75F, propLVT},
+	{0xB760, 0xB760, propLV},
+	{0xB761, 0xB77B, propLVT},
+	{0xB77C, 0xB77C, propLV},
+	{0xB77D, 0xB797, propLVT},
+	{0xB798, 0xB798, propLV},
+	{0xB799, 0xB7B3, propLVT},
+	{0xB7B4, 0xB7B4, propLV},
+	{0xB7B5, 0xB7CF, propLVT},
+	{0xB7D0, 0xB7D0, propLV},
+	{0xB7D1, 0xB7EB, propLVT},
+	{0xB7EC, 0xB7EC, propLV},
+	{0xB7ED, 0xB807, propLVT},
+	{0xB808, 0xB808, propLV},
+	{0xB809, 0xB823, propLVT},
+	{0xB824, 0xB824, propLV},
+	{0xB825, 0xB83F, propLVT},
+	{0xB840, 0xB840, propLV},
+	{0xB841, 0xB85B, propLVT},
+	{0xB85C, 0xB85C, propLV},
+	{0xB85D, 0xB877, propLVT},
+	{0xB878, 0xB878, propLV},
+	{0xB879, 0xB893, propLVT},
+	{0xB894, 0xB894, propLV},
+	{0xB895, 0xB8AF, propLVT},
+	{0xB8B0, 0xB8B0, propLV},
+	{0xB8B1, 0xB8CB, propLVT},
+	{0xB8CC, 0xB8CC, propLV},
+	{0xB8CD, 0xB8E7, propLVT},
+	{0xB8E8, 0xB8E8, propLV},
+	{0xB8E9, 0xB903, propLVT},
+	{0xB904, 0xB904, propLV},
+	{0xB905, 0xB91F, propLVT},
+	{0xB920, 0xB920, propLV},
+	{0xB921, 0xB93B, propLVT},
+	{0xB93C, 0xB93C, propLV},
+	{0xB93D, 0xB957, propLVT},
+	{0xB958, 0xB958, propLV},
+	{0xB959, 0xB973, propLVT},
+	{0xB974, 0xB974, propLV},
+	{0xB975, 0xB98F, propLVT},
+	{0xB990, 0xB990, propLV},
+	{0xB991, 0xB9AB, propLVT},
+	{0xB9AC, 0xB9AC, propLV},
+	{0xB9AD, 0xB9C7, propLVT},
+	{0xB9C8, 0xB9C8, propLV},
+	{0xB9C9, 0xB9E3, propLVT},
+	{0xB9E4, 0xB9E4, propLV},
+	{0xB9E5, 0xB9FF, propLVT},
+	{0xBA00, 0xBA00, propLV},
+	{0xBA01, 0xBA1B, propLVT},
+	{0xBA1C, 0xBA1C, propLV},
+	{0xBA1D, 0xBA37, propLVT},
+	{0xBA38, 0xBA38, propLV},
+	{0xBA39, 0xBA53, propLVT},
+	{0xBA54, 0xBA54, propLV},
+	{0xBA55, 0xBA6F, propLVT},
+	{0xBA70, 0xBA70, propLV},
+	{0xBA71, 0xBA8B, propLVT},
+	{0xBA8C, 0xBA8C, propLV},
+	{0xBA8D, 0xBAA7, propLVT},
+	{0xBAA8, 0xBAA8, propLV},
+	{0xBAA9, 0xBAC3, propLVT},
+	{0xBAC4, 0xBAC4, propLV},
+	{0xBAC5, 0xBADF, propLVT},
+	{0xBAE0, 0xBAE0, propLV},
+	{0xBAE1, 0xBAFB, propLVT},
+	{0xBAFC, 0xBAFC, propLV},
+	{0xBAFD, 0xBB17, propLVT},
+	{0xBB18, 0xBB18, propLV},
+	{0xBB19, 0xBB33, propLVT},
+	{0xBB34, 0xBB34, propLV},
+	{0xBB35, 0xBB4F, propLVT},
+	{0xBB50, 0xBB50, propLV},
+	{0xBB51, 0xBB6B, propLVT},
+	{0xBB6C, 0xBB6C, propLV},
+	{0xBB6D, 0xBB87, propLVT},
+	{0xBB88, 0xBB88, propLV},
+	{0xBB89, 0xBBA3, propLVT},
+	{0xBBA4, 0xBBA4, propLV},
+	{0xBBA5, 0xBBBF, propLVT},
+	{0xBBC0, 0xBBC0, propLV},
+	{0xBBC1, 0xBBDB, propLVT},
+	{0xBBDC, 0xBBDC, propLV},
+	{0xBBDD, 0xBBF7, propLVT},
+	{0xBBF8, 0xBBF8, propLV},
+	{0xBBF9, 0xBC13, propLVT},
+	{0xBC14, 0xBC14, propLV},
+	{0xBC15, 0xBC2F, propLVT},
+	{0xBC30, 0xBC30, propLV},
+	{0xBC31, 0xBC4B, propLVT},
+	{0xBC4C, 0xBC4C, propLV},
+	{0xBC4D, 0xBC67, propLVT},
+	{0xBC68, 0xBC68, propLV},
+	{0xBC69, 0xBC83, propLVT},
+	{0xBC84, 0xBC84, propLV},
+	{0xBC85, 0xBC9F, propLVT},
+	{0xBCA0, 0xBCA0, propLV},
+	{0xBCA1, 0xBCBB, propLVT},
+	{0xBCBC, 0xBCBC, propLV},
+	{0xBCBD, 0xBCD7, propLVT},
+	{0xBCD8, 0xBCD8, propLV},
+	{0xBCD9, 0xBCF3, propLVT},
+	{0xBCF4, 0xBCF4, propLV},
+	{0xBCF5, 0xBD0F, propLVT},
+	{0xBD10, 0xBD10, propLV},
+	{0xBD11, 0xBD2B, propLVT},
+	{0xBD2C, 0xBD2C, propLV},
+	{0xBD2D, 0xBD47, propLVT},
+	{0xBD48, 0xBD48, propLV},
+	{0xBD49, 0xBD63, propLVT},
+	{0xBD64, 0xBD64, propLV},
+	{0xBD65, 0xBD7F, propLVT},
+	{0xBD80, 0xBD80, propLV},
+	{0xBD81, 0xBD9B, propLVT},
+	{0xBD9C, 0xBD9C, propLV},
+	{0xBD9D, 0xBDB7, propLVT},
+	{0xBDB8, 0xBDB8, propLV},
+	{0xBDB9, 0xBDD3, propLVT},
+	{0xBDD4, 0xBDD4, propLV},
+	{0xBDD5, 0xBDEF, propLVT},
+	{0xBDF0, 0xBDF0, propLV},
+	{0xBDF1, 0xBE0B, propLVT},
+	{0xBE0C, 0xBE0C, propLV},
+	{0xBE0D, 0xBE27, propLVT},
+	{0xBE28, 0xBE28, propLV},
+	{0xBE29, 0xBE43, propLVT},
+	{0xBE44, 0xBE44, propLV},
+	{0xBE45, 0xBE5F, propLVT},
+	{0xBE60, 0xBE60, propLV},
+	{0xBE61, 0xBE7B, propLVT},
+	{0xBE7C, 0xBE7C, propLV},
+	{0xBE7D, 0xBE97, propLVT},
+	{0xBE98, 0xBE98, propLV},
+	{0xBE99, 0xBEB3, propLVT},
+	{0xBEB4, 0xBEB4, propLV},
+	{0xBEB5, 0xBECF, propLVT},
+	{0xBED0, 0xBED0, propLV},
+	{0xBED1, 0xBEEB, propLVT},
+	{0xBEEC, 0xBEEC, propLV},
+	{0xBEED, 0xBF07, propLVT},
+	{0xBF08, 0xBF08, propLV},
+	{0xBF09, 0xBF23, propLVT},
+	{0xBF24, 0xBF24, propLV},
+	{0xBF25, 0xBF3F, propLVT},
+	{0xBF40, 0xBF40, propLV},
+	{0xBF41, 0xBF5B, propLVT},
+	{0xBF5C, 0xBF5C, propLV},
+	{0xBF5D, 0xBF77, propLVT},
+	{0xBF78, 0xBF78, propLV},
+	{0xBF79, 0xBF93, propLVT},
+	{0xBF94, 0xBF94, propLV},
+	{0xBF95, 0xBFAF, propLVT},
+	{0xBFB0, 0xBFB0, propLV},
+	{0xBFB1, 0xBFCB, propLVT},
+	{0xBFCC, 0xBFCC, propLV},
+	{0xBFCD, 0xBFE7, propLVT},
+	{0xBFE8, 0xBFE8, propLV},
+	{0xBFE9, 0xC003, propLVT},
+	{0xC004, 0xC004, propLV},
+	{0xC005, 0xC01F, propLVT},
+	{0xC020, 0xC020, propLV},
+	{0xC021, 0xC03B, propLVT},
+	{0xC03C, 0xC03C, propLV},
+	{0xC03D, 0xC057, propLVT},
+	{0xC058, 0xC058, propLV},
+	{0xC059, 0xC073, propLVT},
+	{0xC074, 0xC074, propLV},
+	{0xC075, 0xC08F, propLVT},
+	{0xC090, 0xC090, propLV},
+	{0xC091, 0xC0AB, propLVT},
+	{0xC0AC, 0xC0AC, propLV},
+	{0xC0AD, 0xC0C7, propLVT},
+	{0xC0C8, 0xC0C8, propLV},
+	{0xC0C9, 0xC0E3, propLVT},
+	{0xC0E4, 0xC0E4, propLV},
+	{0xC0E5, 0xC0FF, propLVT},
+	{0xC100, 0xC100, propLV},
+	{0xC101, 0xC11B, propLVT},
+	{0xC11C, 0xC11C, propLV},
+	{0xC11D, 0xC137, propLVT},
+	{0xC138, 0xC138, propLV},
+	{0xC139, 0xC153, propLVT},
+	{0xC154, 0xC154, propLV},
+	{0xC155, 0xC16F, propLVT},
+	{0xC170, 0xC170, propLV},
+	{0xC171, 0xC18B, propLVT},
+	{0xC18C, 0xC18C, propLV},
+	{0xC18D, 0xC1A7, propLVT},
+	{0xC1A8, 0xC1A8, propLV},
+	{0xC1A9, 0xC1C3, propLVT},
+	{0xC1C4, 0xC1C4, propLV},
+	{0xC1C5, 0xC1DF, propLVT},
+	{0xC1E0, 0xC1E0, propLV},
+	{0xC1E1, 0xC1FB, propLVT},
+	{0xC1FC, 0xC1FC, propLV},
+	{0xC1FD, 0xC217, propLVT},
+	{0xC218, 0xC218, propLV},
+	{0xC219, 0xC233, propLVT},
+	{0xC234, 0xC234, propLV},
+	{0xC235, 0xC24F, propLVT},
+	{0xC250, 0xC250, propLV},
+	{0xC251, 0xC26B, propLVT},
+	{0xC26C, 0xC26C, propLV},
+	{0xC26D, 0xC287, propLVT},
+	{0xC288, 0xC288, propLV},
+	{0xC289, 0xC2A3, propLVT},
+	{0xC2A4, 0xC2A4, propLV},
+	{0xC2A5, 0xC2BF, propLVT},
+	{0xC2C0, 0xC2C0, propLV},
+	{0xC2C1, 0xC2DB, propLVT},
+	{0xC2DC, 0xC2DC, propLV},
+	{0xC2DD, 0xC2F7, propLVT},
+	{0xC2F8, 0xC2F8, propLV},
+	{0xC2F9, 0xC313, propLVT},
+	{0xC314, 0xC314, propLV},
+	{0xC315, 0xC32F, propLVT},
+	{0xC330, 0xC330, propLV},
+	{0xC331, 0xC34B, propLVT},
+	{0xC34C, 0xC34C, propLV},
+	{0xC34D, 0xC367, propLVT},
+	{0xC368, 0xC368, propLV},
+	{0xC369, 0xC383, propLVT},
+	{0xC384, 0xC384, propLV},
+	{0xC385, 0xC39F, propLVT},
+	{0xC3A0, 0xC3A0, propLV},
+	{0xC3A1, 0xC3BB, propLVT},
+	{0xC3BC, 0xC3BC, propLV},
+	{0xC3BD, 0xC3D7, propLVT},
+	{0xC3D8, 0xC3D8, propLV},
+	{0xC3D9, 0xC3F3, propLVT},
+	{0xC3F4, 0xC3F4, propLV},
+	{0xC3F5, 0xC40F, propLVT},
+	{0xC410, 0xC410, propLV},
+	{0xC411, 0xC42B, propLVT},
+	{0xC42C, 0xC42C, propLV},
+	{0xC42D, 0xC447, propLVT},
+	{0xC448, 0xC448, propLV},
+	{0xC449, 0xC463, propLVT},
+	{0xC464, 0xC464, propLV},
+	{0xC465, 0xC47F, propLVT},
+	{0xC480, 0xC480, propLV},
+	{0xC481, 0xC49B, propLVT},
+	{0xC49C, 0xC49C, propLV},
+	{0xC49D, 0xC4B7, propLVT},
+	{0xC4B8, 0xC4B8, propLV},
+	{0xC4B9, 0xC4D3, propLVT},
+	{0xC4D4, 0xC4D4, propLV},
+	{0xC4D5, 0xC4EF, propLVT},
+	{0xC4F0, 0xC4F0, propLV},
+	{0xC4F1, 0xC50B, propLVT},
+	{0xC50C, 0xC50C, propLV},
+	{0xC50D, 0xC527, propLVT},
+	{0xC528, 0xC528, propLV},
+	{0xC529, 0xC543, propLVT},
+	{0xC544, 0xC544, propLV},
+	{0xC545, 0xC55F, propLVT},
+	{0xC560, 0xC560, propLV},
+	{0xC561, 0xC57B, propLVT},
+	{0xC57C, 0xC57C, propLV},
+	{0xC57D, 0xC597, propLVT},
+	{0xC598, 0xC598, propLV},
+	{0xC599, 0xC5B3, propLVT},
+	{0xC5B4, 0xC5B4, propLV},
+	{0xC5B5, 0xC5CF, propLVT},
+	{0xC5D0, 0xC5D0, propLV},
+	{0xC5D1, 0xC5EB, propLVT},
+	{0xC5EC, 0xC5EC, propLV},
+	{0xC5ED, 0xC607, propLVT},
+	{0xC608, 0xC608, propLV},
+	{0xC609, 0xC623, propLVT},
+	{0xC624, 0xC624, propLV},
+	{0xC625, 0xC63F, propLVT},
+	{0xC640, 0xC640, propLV},
+	{0xC641, 0xC65B, propLVT},
+	{0xC65C, 0xC65C, propLV},
+	{0xC65D, 0xC677, propLVT},
+	{0xC678, 0xC678, propLV},
+	{0xC679, 0xC693, propLVT},
+	{0xC694, 0xC694, propLV},
+	{0xC695, 0xC6AF, propLVT},
+	{0xC6B0, 0xC6B0, propLV},
+	{0xC6B1, 0xC6CB, propLVT},
+	{0xC6CC, 0xC6CC, propLV},
+	{0xC6CD, 0xC6E7, propLVT},
+	{0xC6E8, 0xC6E8, propLV},
+	{0xC6E9, 0xC703, propLVT},
+	{0xC704, 0xC704, propLV},
+	{0xC705, 0xC71F, propLVT},
+	{0xC720, 0xC720, propLV},
+	{0xC721, 0xC73B, propLVT},
+	{0xC73C, 0xC73C, propLV},
+	{0xC73D, 0xC757, propLVT},
+	{0xC758, 0xC758, propLV},
+	{0xC759, 0xC773, propLVT},
+	{0xC774, 0xC774, propLV},
+	{0xC775, 0xC78F, propLVT},
+	{0xC790, 0xC790, propLV},
+	{0xC791, 0xC7AB, propLVT},
+	{0xC7AC, 0xC7AC, propLV},
+	{0xC7AD, 0xC7C7, propLVT},
+	{0xC7C8, 0xC7C8, propLV},
+	{0xC7C9, 0xC7E3, propLVT},
+	{0xC7E4, 0xC7E4, propLV},
+	{0xC7E5, 0xC7FF, propLVT},
+	{0xC800, 0xC800, propLV},
+	{0xC801, 0xC81B, propLVT},
+	{0xC81C, 0xC81C, propLV},
+	{0xC81D, 0xC837, propLVT},
+	{0xC838, 0xC838, propLV},
+	{0xC839, 0xC853, propLVT},
+	{0xC854, 0xC854, propLV},
+	{0xC855, 0xC86F, propLVT},
+	{0xC870, 0xC870, propLV},
+	{0xC871, 0xC88B, propLVT},
+	{0xC88C, 0xC88C, propLV},
+	{0xC88D, 0xC8A7, propLVT},
+	{0xC8A8, 0xC8A8, propLV},
+	{0xC8A9, 0xC8C3, propLVT},
+	{0xC8C4, 0xC8C4, propLV},
+	{0xC8C5, 0xC8DF, propLVT},
+	{0xC8E0, 0xC8E0, propLV},
+	{0xC8E1, 0xC8FB, propLVT},
+	{0xC8FC, 0xC8FC, propLV},
+	{0xC8FD, 0xC917, propLVT},
+	{0xC918, 0xC918, propLV},
+	{0xC919, 0xC933, propLVT},
+	{0xC934, 0xC934, propLV},
+	{0xC935, 0xC94F, propLVT},
+	{0xC950, 0xC950, propLV},
+	{0xC951, 0xC96B, propLVT},
+	{0xC96C, 0xC96C, propLV},
+	{0xC96D, 0xC987, propLVT},
+	{0xC988, 0xC988, propLV},
+	{0xC989, 0xC9A3, propLVT},
+	{0xC9A4, 0xC9A4, propLV},
+	{0xC9A5, 0xC9BF, propLVT},
+	{0xC9C0, 0xC9C0, propLV},
+	{0xC9C1, 0xC9DB, propLVT},
+	{0xC9DC, 0xC9DC, propLV},
+	{0xC9DD, 0xC9F7, propLVT},
+	{0xC9F8, 0xC9F8, propLV},
+	{0xC9F9, 0xCA13, propLVT},
+	{0xCA14, 0xCA14, propLV},
+	{0xCA15, 0xCA2F, propLVT},
+	{0xCA30, 0xCA30, propLV},
+	{0xCA31, 0xCA4B, propLVT},
+	{0xCA4C, 0xCA4C, propLV},
+	{0xCA4D, 0xCA67, propLVT},
+	{0xCA68, 0xCA68, propLV},
+	{0xCA69, 0xCA83, propLVT},
+	{0xCA84, 0xCA84, propLV},
+	{0xCA85, 0xCA9F, propLVT},
+	{0xCAA0, 0xCAA0, propLV},
+	{0xCAA1, 0xCABB, propLVT},
+	{0xCABC, 0xCABC, propLV},
+	{0xCABD, 0xCAD7, propLVT},
+	{0xCAD8, 0xCAD8, propLV},
+	{0xCAD9, 0xCAF3, propLVT},
+	{0xCAF4, 0xCAF4, propLV},
+	{0xCAF5, 0xCB0F, propLVT},
+	{0xCB10, 0xCB10, propLV},
+	{0xCB11, 0xCB2B, propLVT},
+	{0xCB2C, 0xCB2C, propLV},
+	{0xCB2D, 0xCB47, propLVT},
+	{0xCB48, 0xCB48, propLV},
+	{0xCB49, 0xCB63, propLVT},
+	{0xCB64, 0xCB64, propLV},
+	{0xCB65, 0xCB7F, propLVT},
+	{0xCB80, 0xCB80, propLV},
+	{0xCB81, 0xCB9B, propLVT},
+	{0xCB9C, 0xCB9C, propLV},
+	{0xCB9D, 0xCBB7, propLVT},
+	{0xCBB8, 0xCBB8, propLV},
+	{0xCBB9, 0xCBD3, propLVT},
+	{0xCBD4, 0xCBD4, propLV},
+	{0xCBD5, 0xCBEF, propLVT},
+	{0xCBF0, 0xCBF0, propLV},
+	{0xCBF1, 0xCC0B, propLVT},
+	{0xCC0C, 0xCC0C, propLV},
+	{0xCC0D, 0xCC27, propLVT},
+	{0xCC28, 0xCC28, propLV},
+	{0xCC29, 0xCC43, propLVT},
+	{0xCC44, 0xCC44, propLV},
+	{0xCC45, 0xCC5F, propLVT},
+	{0xCC60, 0xCC60, propLV},
+	{0xCC61, 0xCC7B, propLVT},
+	{0xCC7C, 0xCC7C, propLV},
+	{0xCC7D, 0xCC97, propLVT},
+	{0xCC98, 0xCC98, propLV},
+	{0xCC99, 0xCCB3, propLVT},
+	{0xCCB4, 0xCCB4, propLV},
+	{0xCCB5, 0xCCCF, propLVT},
+	{0xCCD0, 0xCCD0, propLV},
+	{0xCCD1, 0xCCEB, propLVT},
+	{0xCCEC, 0xCCEC, propLV},
+	{0xCCED, 0xCD07, propLVT},
+	{0xCD08, 0xCD08, propLV},
+	{0xCD09, 0xCD23, propLVT},
+	{0xCD24, 0xCD24, propLV},
+	{0xCD25, 0xCD3F, propLVT},
+	{0xCD40, 0xCD40, propLV},
+	{0xCD41, 0xCD5B, propLVT},
+	{0xCD5C, 0xCD5C, propLV},
+	{0xCD5D, 0xCD77, propLVT},
+	{0xCD78, 0xCD78, propLV},
+	{0xCD79, 0xCD93, propLVT},
+	{0xCD94, 0xCD94, propLV},
+	{0xCD95, 0xCDAF, propLVT},
+	{0xCDB0, 0xCDB0, propLV},
+	{0xCDB1, 0xCDCB, propLVT},
+	{0xCDCC, 0xCDCC, propLV},
+	{0xCDCD, 0xCDE7, propLVT},
+	{0xCDE8, 0xCDE8, propLV},
+	{0xCDE9, 0xCE03, propLVT},
+	{0xCE04, 0xCE04, propLV},
+	{0xCE05, 0xCE1F, propLVT},
+	{0xCE20, 0xCE20, propLV},
+	{0xCE21, 0xCE3B, propLVT},
+	{0xCE3C, 0xCE3C, propLV},
+	{0xCE3D, 0xCE57, propLVT},
+	{0xCE58, 0xCE58, propLV},
+	{0xCE59, 0xCE73, propLVT},
+	{0xCE74, 0xCE74, propLV},
+	{0xCE75, 0xCE8F, propLVT},
+	{0xCE90, 0xCE90, propLV},
+	{0xCE91, 0xCEAB, propLVT},
+	{0xCEAC, 0xCEAC, propLV},
+	{0xCEAD, 0xCEC7, propLVT},
+	{0xCEC8, 0xCEC8, propLV},
+	{0xCEC9, 0xCEE3, propLVT},
+	{0xCEE4, 0xCEE4, propLV},
+	{0xCEE5, 0xCEFF, propLVT},
+	{0xCF00, 0xCF00, propLV},
+	{0xCF01, 0xCF1B, propLVT},
+	{0xCF1C, 0xCF1C, propLV},
+	{0xCF1D, 0xCF37, propLVT},
+	{0xCF38, 0xCF38, propLV},
+	{0xCF39, 0xCF53, propLVT},
+	{0xCF54, 0xCF54, propLV},
+	{0xCF55, 0xCF6F, propLVT},
+	{0xCF70, 0xCF70, propLV},
+	{0xCF71, 0xCF8B, propLVT},
+	{0xCF8C, 0xCF8C, propLV},
+	{0xCF8D, 0xCFA7, propLVT},
+	{0xCFA8, 0xCFA8, propLV},
+	{0xCFA9, 0xCFC3, propLVT},
+	{0xCFC4, 0xCFC4, propLV},
+	{0xCFC5, 0xCFDF, propLVT},
+	{0xCFE0, 0xCFE0, propLV},
+	{0xCFE1, 0xCFFB, propLVT},
+	{0xCFFC, 0xCFFC, propLV},
+	{0xCFFD, 0xD017, propLVT},
+	{0xD018, 0xD018, propLV},
+	{0xD019, 0xD033, propLVT},
+	{0xD034, 0xD034, propLV},
+	{0xD035, 0xD04F, propLVT},
+	{0xD050, 0xD050, propLV},
+	{0xD051, 0xD06B, propLVT},
+	{0xD06C, 0xD06C, propLV},
+	{0xD06D, 0xD087, propLVT},
+	{0xD088, 0xD088, propLV},
+	{0xD089, 0xD0A3, propLVT},
+	{0xD0A4, 0xD0A4, propLV},
+	{0xD0A5, 0xD0BF, propLVT},
+	{0xD0C0, 0xD0C0, propLV},
+	{0xD0C1, 0xD0DB, propLVT},
+	{0xD0DC, 0xD0DC, propLV},
+	{0xD0DD, 0xD0F7, propLVT},
+	{0xD0F8, 0xD0F8, propLV},
+	{0xD0F9, 0xD113, propLVT},
+	{0xD114, 0xD114, propLV},
+	{0xD115, 0xD12F, propLVT},
+	{0xD130, 0xD130, propLV},
+	{0xD131, 0xD14B, propLVT},
+	{0xD14C, 0xD14C, propLV},
+	{0xD14D, 0xD167, propLVT},
+	{0xD168, 0xD168, propLV},
+	{0xD169, 0xD183, propLVT},
+	{0xD184, 0xD184, propLV},
+	{0xD185, 0xD19F, propLVT},
+	{0xD1A0, 0xD1A0, propLV},
+	{0xD1A1, 0xD1BB, propLVT},
+	{0xD1BC, 0xD1BC, propLV},
+	{0xD1BD, 0xD1D7, propLVT},
+	{0xD1D8, 0xD1D8, propLV},
+	{0xD1D9, 0xD1F3, propLVT},
+	{0xD1F4, 0xD1F4, propLV},
+	{0xD1F5, 0xD20F, propLVT},
+	{0xD210, 0xD210, propLV},
+	{0xD211, 0xD22B, propLVT},
+	{0xD22C, 0xD22C, propLV},
+	{0xD22D, 0xD247, propLVT},
+	{0xD248, 0xD248, propLV},
+	{0xD249, 0xD263, propLVT},
+	{0xD264, 0xD264, propLV},
+	{0xD265, 0xD27F, propLVT},
+	{0xD280, 0xD280, propLV},
+	{0xD281, 0xD29B, propLVT},
+	{0xD29C, 0xD29C, propLV},
+	{0xD29D, 0xD2B7, propLVT},
+	{0xD2B8, 0xD2B8, propLV},
+	{0xD2B9, 0xD2D3, propLVT},
+	{0xD2D4, 0xD2D4, propLV},
+	{0xD2D5, 0xD2EF, propLVT},
+	{0xD2F0, 0xD2F0, propLV},
+	{0xD2F1, 0xD30B, propLVT},
+	{0xD30C, 0xD30C, propLV},
+	{0xD30D, 0xD327, propLVT},
+	{0xD328, 0xD328, propLV},
+	{0xD329, 0xD343, propLVT},
+	{0xD344, 0xD344, propLV},
+	{0xD345, 0xD35F, propLVT},
+	{0xD360, 0xD360, propLV},
+	{0xD361, 0xD37B, propLVT},
+	{0xD37C, 0xD37C, propLV},
+	{0xD37D, 0xD397, propLVT},
+	{0xD398, 0xD398, propLV},
+	{0xD399, 0xD3B3, propLVT},
+	{0xD3B4, 0xD3B4, propLV},
+	{0xD3B5, 0xD3CF, propLVT},
+	{0xD3D0, 0xD3D0, propLV},
+	{0xD3D1, 0xD3EB, propLVT},
+	{0xD3EC, 0xD3EC, propLV},
+	{0xD3ED, 0xD407, propLVT},
+	{0xD408, 0xD408, propLV},
+	{0xD409, 0xD423, propLVT},
+	{0xD424, 0xD424, propLV},
+	{0xD425, 0xD43F, propLVT},
+	{0xD440, 0xD440, propLV},
+	{0xD441, 0xD45B, propLVT},
+	{0xD45C, 0xD45C, propLV},
+	{0xD45D, 0xD477, propLVT},
+	{0xD478, 0xD478, propLV},
+	{0xD479, 0xD493, propLVT},
+	{0xD494, 0xD494, propLV},
+	{0xD495, 0xD4AF, propLVT},
+	{0xD4B0, 0xD4B0, propLV},
+	{0xD4B1, 0xD4CB, propLVT},
+	{0xD4CC, 0xD4CC, propLV},
+	{0xD4CD, 0xD4E7, propLVT},
+	{0xD4E8, 0xD4E8, propLV},
+	{0xD4E9, 0xD503, propLVT},
+	{0xD504, 0xD504, propLV},
+	{0xD505, 0xD51F, propLVT},
+	{0xD520, 0xD520, propLV},
+	{0xD521, 0xD53B, propLVT},
+	{0xD53C, 0xD53C, propLV},
+	{0xD53D, 0xD557, propLVT},
+	{0xD558, 0xD558, propLV},
+	{0xD559, 0xD573, propLVT},
+	{0xD574, 0xD574, propLV},
+	{0xD575, 0xD58F, propLVT},
+	{0xD590, 0xD590, propLV},
+	{0xD591, 0xD5AB, propLVT},
+	{0xD5AC, 0xD5AC, propLV},
+	{0xD5AD, 0xD5C7, propLVT},
+	{0xD5C8, 0xD5C8, propLV},
+	{0xD5C9, 0xD5E3, propLVT},
+	{0xD5E4, 0xD5E4, propLV},
+	{0xD5E5, 0xD5FF, propLVT},
+	{0xD600, 0xD600, propLV},
+	{0xD601, 0xD61B, propLVT},
+	{0xD61C, 0xD61C, propLV},
+	{0xD61D, 0xD637, propLVT},
+	{0xD638, 0xD638, propLV},
+	{0xD639, 0xD653, propLVT},
+	{0xD654, 0xD654, propLV},
+	{0xD655, 0xD66F, propLVT},
+	{0xD670, 0xD670, propLV},
+	{0xD671, 0xD68B, propLVT},
+	{0xD68C, 0xD68C, propLV},
+	{0xD68D, 0xD6A7, propLVT},
+	{0xD6A8, 0xD6A8, propLV},
+	{0xD6A9, 0xD6C3, propLVT},
+	{0xD6C4, 0xD6C4, propLV},
+	{0xD6C5, 0xD6DF, propLVT},
+	{0xD6E0, 0xD6E0, propLV},
+	{0xD6E1, 0xD6FB, propLVT},
+	{0xD6FC, 0xD6FC, propLV},
+	{0xD6FD, 0xD717, propLVT},
+	{0xD718, 0xD718, propLV},
+	{0xD719, 0xD733, propLVT},
+	{0xD734, 0xD734, propLV},
+	{0xD735, 0xD74F, propLVT},
+	{0xD750, 0xD750, propLV},
+	{0xD751, 0xD76B, propLVT},
+	{0xD76C, 0xD76C, propLV},
+	{0xD76D, 0xD787, propLVT},
+	{0xD788, 0xD788, propLV},
+	{0xD789, 0xD7A3, propLVT},
+	{0xD7B0, 0xD7C6, propV},
+	{0xD7CB, 0xD7FB, propT},
+	{0xFB1E, 0xFB1E, propExtend},
+	{0xFE00, 0xFE0F, propExtend},
+	{0xFE20, 0xFE2F, propExtend},
+	{0xFEFF, 0xFEFF, propControl},
+	{0xFF9E, 0xFF9F, propExtend},
+	{0xFFF0, 0xFFFB, propControl},
+	{0x101FD, 0x101FD, propExtend},
+	{0x102E0, 0x102E0, propExtend},
+	{0x10376, 0x1037A, propExtend},
+	{0x10A01, 0x10A03, propExtend},
+	{0x10A05, 0x10A06, propExtend},
+	{0x10A0C, 0x10A0F, propExtend},
+	{0x10A38, 0x10A3A, propExtend},
+	{0x10A3F, 0x10A3F, propExtend},
+	{0x10AE5, 0x10AE6, propExtend},
+	{0x10D24, 0x10D27, propExtend},
+	{0x10EAB, 0x10EAC, propExtend},
+	{0x10EFD, 0x10EFF, propExtend},
+	{0x10F46, 0x10F50, propExtend},
+	{0x10F82, 0x10F85, propExtend},
+	{0x11000, 0x11000, propSpacingMark},
+	{0x11001, 0x11001, propExtend},
+	{0x11002, 0x11002, propSpacingMark},
+	{0x11038, 0x11046, propExtend},
+	{0x11070, 0x11070, propExtend},
+	{0x11073, 0x11074, propExtend},
+	{0x1107F, 0x11081, propExtend},
+	{0x11082, 0x11082, propSpacingMark},
+	{0x110B0, 0x110B2, propSpacingMark},
+	{0x110B3, 0x110B6, propExtend},
+	{0x110B7, 0x110B8, propSpacingMark},
+	{0x110B9, 0x110BA, propExtend},
+	{0x110BD, 0x110BD, propPrepend},
+	{0x110C2, 0x110C2, propExtend},
+	{0x110CD, 0x110CD, propPrepend},
+	{0x11100, 0x11102, propExtend},
+	{0x11127, 0x1112B, propExtend},
+	{0x1112C, 0x1112C, propSpacingMark},
+	{0x1112D, 0x11134, propExtend},
+	{0x11145, 0x11146, propSpacingMark},
+	{0x11173, 0x11173, propExtend},
+	{0x11180, 0x11181, propExtend},
+	{0x11182, 0x11182, propSpacingMark},
+	{0x111B3, 0x111B5, propSpacingMark},
+	{0x111B6, 0x111BE, propExtend},
+	{0x111BF, 0x111C0, propSpacingMark},
+	{0x111C2, 0x111C3, propPrepend},
+	{0x111C9, 0x111CC, propExtend},
+	{0x111CE, 0x111CE, propSpacingMark},
+	{0x111CF, 0x111CF, propExtend},
+	{0x1122C, 0x1122E, propSpacingMark},
+	{0x1122F, 0x11231, propExtend},
+	{0x11232, 0x11233, propSpacingMark},
+	{0x11234, 0x11234, propExtend},
+	{0x11235, 0x11235, propSpacingMark},
+	{0x11236, 0x11237, propExtend},
+	{0x1123E, 0x1123E, propExtend},
+	{0x11241, 0x11241, propExtend},
+	{0x112DF, 0x112DF, propExtend},
+	{0x112E0, 0x112E2, propSpacingMark},
+	{0x112E3, 0x112EA, propExtend},
+	{0x11300, 0x11301, propExtend},
+	{0x11302, 0x11303, propSpacingMark},
+	{0x1133B, 0x1133C, propExtend},
+	{0x1133E, 0x1133E, propExtend},
+	{0x1133F, 0x1133F, propSpacingMark},
+	{0x11340, 0x11340, propExtend},
+	{0x11341, 0x11344, propSpacingMark},
+	{0x11347, 0x11348, propSpacingMark},
+	{0x1134B, 0x1134D, propSpacingMark},
+	{0x11357, 0x11357, propExtend},
+	{0x11362, 0x11363, propSpacingMark},
+	{0x11366, 0x1136C, propExtend},
+	{0x11370, 0x11374, propExtend},
+	{0x11435, 0x11437, propSpacingMark},
+	{0x11438, 0x1143F, propExtend},
+	{0x11440, 0x11441, propSpacingMark},
+	{0x11442, 0x11444, propExtend},
+	{0x11445, 0x11445, propSpacingMark},
+	{0x11446, 0x11446, propExtend},
+	{0x1145E, 0x1145E, propExtend},
+	{0x114B0, 0x114B0, propExtend},
+	{0x114B1, 0x114B2, propSpacingMark},
+	{0x114B3, 0x114B8, propExtend},
+	{0x114B9, 0x114B9, propSpacingMark},
+	{0x114BA, 0x114BA, propExtend},
+	{0x114BB, 0x114BC, propSpacingMark},
+	{0x114BD, 0x114BD, propExtend},
+	{0x114BE, 0x114BE, propSpacingMark},
+	{0x114BF, 0x114C0, propExtend},
+	{0x114C1, 0x114C1, propSpacingMark},
+	{0x114C2, 0x114C3, propExtend},
+	{0x115AF, 0x115AF, propExtend},
+	{0x115B0, 0x115B1, propSpacingMark},
+	{0x115B2, 0x115B5, propExtend},
+	{0x115B8, 0x115BB, propSpacingMark},
+	{0x115BC, 0x115BD, propExtend},
+	{0x115BE, 0x115BE, propSpacingMark},
+	{0x115BF, 0x115C0, propExtend},
+	{0x115DC, 0x115DD, propExtend},
+	{0x11630, 0x11632, propSpacingMark},
+	{0x11633, 0x1163A, propExtend},
+	{0x1163B, 0x1163C, propSpacingMark},
+	{0x1163D, 0x1163D, propExtend},
+	{0x1163E, 0x1163E, propSpacingMark},
+	{0x1163F, 0x11640, propExtend},
+	{0x116AB, 0x116AB, propExtend},
+	{0x116AC, 0x116AC, propSpacingMark},
+	{0x116AD, 0x116AD, propExtend},
+	{0x116AE, 0x116AF, propSpacingMark},
+	{0x116B0, 0x116B5, propExtend},
+	{0x116B6, 0x116B6, propSpacingMark},
+	{0x116B7, 0x116B7, propExtend},
+	{0x1171D, 0x1171F, propExtend},
+	{0x11722, 0x11725, propExtend},
+	{0x11726, 0x11726, propSpacingMark},
+	{0x11727, 0x1172B, propExtend},
+	{0x1182C, 0x1182E, propSpacingMark},
+	{0x1182F, 0x11837, propExtend},
+	{0x11838, 0x11838, propSpacingMark},
+	{0x11839, 0x1183A, propExtend},
+	{0x11930, 0x11930, propExtend},
+	{0x11931, 0x11935, propSpacingMark},
+	{0x11937, 0x11938, propSpacingMark},
+	{0x1193B, 0x1193C, propExtend},
+	{0x1193D, 0x1193D, propSpacingMark},
+	{0x1193E, 0x1193E, propExtend},
+	{0x1193F, 0x1193F, propPrepend},
+	{0x11940, 0x11940, propSpacingMark},
+	{0x11941, 0x11941, propPrepend},
+	{0x11942, 0x11942, propSpacingMark},
+	{0x11943, 0x11943, propExtend},
+	{0x119D1, 0x119D3, propSpacingMark},
+	{0x119D4, 0x119D7, propExtend},
+	{0x119DA, 0x119DB, propExtend},
+	{0x119DC, 0x119DF, propSpacingMark},
+	{0x119E0, 0x119E0, propExtend},
+	{0x119E4, 0x119E4, propSpacingMark},
+	{0x11A01, 0x11A0A, propExtend},
+	{0x11A33, 0x11A38, propExtend},
+	{0x11A39, 0x11A39, propSpacingMark},
+	{0x11A3A, 0x11A3A, propPrepend},
+	{0x11A3B, 0x11A3E, propExtend},
+	{0x11A47, 0x11A47, propExtend},
+	{0x11A51, 0x11A56, propExtend},
+	{0x11A57, 0x11A58, propSpacingMark},
+	{0x11A59, 0x11A5B, propExtend},
+	{0x11A84, 0x11A89, propPrepend},
+	{0x11A8A, 0x11A96, propExtend},
+	{0x11A97, 0x11A97, propSpacingMark},
+	{0x11A98, 0x11A99, propExtend},
+	{0x11C2F, 0x11C2F, propSpacingMark},
+	{0x11C30, 0x11C36, propExtend},
+	{0x11C38, 0x11C3D, propExtend},
+	{0x11C3E, 0x11C3E, propSpacingMark},
+	{0x11C3F, 0x11C3F, propExtend},
+	{0x11C92, 0x11CA7, propExtend},
+	{0x11CA9, 0x11CA9, propSpacingMark},
+	{0x11CAA, 0x11CB0, propExtend},
+	{0x11CB1, 0x11CB1, propSpacingMark},
+	{0x11CB2, 0x11CB3, propExtend},
+	{0x11CB4, 0x11CB4, propSpacingMark},
+	{0x11CB5, 0x11CB6, propExtend},
+	{0x11D31, 0x11D36, propExtend},
+	{0x11D3A, 0x11D3A, propExtend},
+	{0x11D3C, 0x11D3D, propExtend},
+	{0x11D3F, 0x11D45, propExtend},
+	{0x11D46, 0x11D46, propPrepend},
+	{0x11D47, 0x11D47, propExtend},
+	{0x11D8A, 0x11D8E, propSpacingMark},
+	{0x11D90, 0x11D91, propExtend},
+	{0x11D93, 0x11D94, propSpacingMark},
+	{0x11D95, 0x11D95, propExtend},
+	{0x11D96, 0x11D96, propSpacingMark},
+	{0x11D97, 0x11D97, propExtend},
+	{0x11EF3, 0x11EF4, propExtend},
+	{0x11EF5, 0x11EF6, propSpacingMark},
+	{0x11F00, 0x11F01, propExtend},
+	{0x11F02, 0x11F02, propPrepend},
+	{0x11F03, 0x11F03, propSpacingMark},
+	{0x11F34, 0x11F35, propSpacingMark},
+	{0x11F36, 0x11F3A, propExtend},
+	{0x11F3E, 0x11F3F, propSpacingMark},
+	{0x11F40, 0x11F40, propExtend},
+	{0x11F41, 0x11F41, propSpacingMark},
+	{0x11F42, 0x11F42, propExtend},
+	{0x13430, 0x1343F, propControl},
+	{0x13440, 0x13440, propExtend},
+	{0x13447, 0x13455, propExtend},
+	{0x16AF0, 0x16AF4, propExtend},
+	{0x16B30, 0x16B36, propExtend},
+	{0x16F4F, 0x16F4F, propExtend},
+	{0x16F51, 0x16F87, propSpacingMark},
+	{0x16F8F, 0x16F92, propExtend},
+	{0x16FE4, 0x16FE4, propExtend},
+	{0x16FF0, 0x16FF1, propSpacingMark},
+	{0x1BC9D, 0x1BC9E, propExtend},
+	{0x1BCA0, 0x1BCA3, propControl},
+	{0x1CF00, 0x1CF2D, propExtend},
+	{0x1CF30, 0x1CF46, propExtend},
+	{0x1D165, 0x1D165, propExtend},
+	{0x1D166, 0x1D166, propSpacingMark},
+	{0x1D167, 0x1D169, propExtend},
+	{0x1D16D, 0x1D16D, propSpacingMark},
+	{0x1D16E, 0x1D172, propExtend},
+	{0x1D173, 0x1D17A, propControl},
+	{0x1D17B, 0x1D182, propExtend},
+	{0x1D185, 0x1D18B, propExtend},
+	{0x1D1AA, 0x1D1AD, propExtend},
+	{0x1D242, 0x1D244, propExtend},
+	{0x1DA00, 0x1DA36, propExtend},
+	{0x1DA3B, 0x1DA6C, propExtend},
+	{0x1DA75, 0x1DA75, propExtend},
+	{0x1DA84, 0x1DA84, propExtend},
+	{0x1DA9B, 0x1DA9F, propExtend},
+	{0x1DAA1, 0x1DAAF, propExtend},
+	{0x1E000, 0x1E006, propExtend},
+	{0x1E008, 0x1E018, propExtend},
+	{0x1E01B, 0x1E021, propExtend},
+	{0x1E023, 0x1E024, propExtend},
+	{0x1E026, 0x1E02A, propExtend},
+	{0x1E08F, 0x1E08F, propExtend},
+	{0x1E130, 0x1E136, propExtend},
+	{0x1E2AE, 0x1E2AE, propExtend},
+	{0x1E2EC, 0x1E2EF, propExtend},
+	{0x1E4EC, 0x1E4EF, propExtend},
+	{0x1E8D0, 0x1E8D6, propExtend},
+	{0x1E944, 0x1E94A, propExtend},
+	{0x1F000, 0x1F0FF, propExtendedPictographic},
+	{0x1F10D, 0x1F10F, propExtendedPictographic},
+	{0x1F12F, 0x1F12F, propExtendedPictographic},
+	{0x1F16C, 0x1F171, propExtendedPictographic},
+	{0x1F17E, 0x1F17F, propExtendedPictographic},
+	{0x1F18E, 0x1F18E, propExtendedPictographic},
+	{0x1F191, 0x1F19A, propExtendedPictographic},
+	{0x1F1AD, 0x1F1E5, propExtendedPictographic},
+	{0x1F1E6, 0x1F1FF, propRegionalIndicator},
+	{0x1F201, 0x1F20F, propExtendedPictographic},
+	{0x1F21A, 0x1F21A, propExtendedPictographic},
+	{0x1F22F, 0x1F22F, propExtendedPictographic},
+	{0x1F232, 0x1F23A, propExtendedPictographic},
+	{0x1F23C, 0x1F23F, propExtendedPictographic},
+	{0x1F249, 0x1F3FA, propExtendedPictographic},
+	{0x1F3FB, 0x1F3FF, propExtend},
+	{0x1F400, 0x1F53D, propExtendedPictographic},
+	{0x1F546, 0x1F64F, propExtendedPictographic},
+	{0x1F680, 0x1F6FF, propExtendedPictographic},
+	{0x1F774, 0x1F77F, propExtendedPictographic},
+	{0x1F7D5, 0x1F7FF, propExtendedPictographic},
+	{0x1F80C, 0x1F80F, propExtendedPictographic},
+	{0x1F848, 0x1F84F, propExtendedPictographic},
+	{0x1F85A, 0x1F85F, propExtendedPictographic},
+	{0x1F888, 0x1F88F, propExtendedPictographic},
+	{0x1F8AE, 0x1F8FF, propExtendedPictographic},
+	{0x1F90C, 0x1F93A, propExtendedPictographic},
+	{0x1F93C, 0x1F945, propExtendedPictographic},
+	{0x1F947, 0x1FAFF, propExtendedPictographic},
+	{0x1FC00, 0x1FFFD, propExtendedPictographic},
+	{0xE0000, 0xE001F, propControl},
+	{0xE0020, 0xE007F, propExtend},
+	{0xE0080, 0xE00FF, propControl},
+	{0xE0100, 0xE01EF, propExtend},
+	{0xE01F0, 0xE0FFF, propControl},
+}
@@ -8,3 +8,8 @@ import (
 func AsString[S ~string | ~[]byte](s S) string {
 	return *(*string)(unsafe.Pointer(&s))
 }
+
+// AsBytes returns its input as a byte slice. The result must not be modified.
+func AsBytes[S ~string | ~[]byte](s S) []byte {
+	return unsafe.Slice(unsafe.StringData(AsString(s)), len(s))
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+	"unicode"
+
+	. "github.com/pgavlin/text"
+)
+
+func collect[S String](seq iter.Seq[S]) []string {
+	a := []string{}
+	for s := range seq {
+		a = append(a, string(s))
+	}
+	return a
+}
+
+func TestSplitSeq(t *testing.T) {
+	for _, tt := range splittests {
+		if tt.n >= 0 {
+			continue
+		}
+		if a := collect(SplitSeq(tt.s, tt.sep)); !eq(a, tt.a) {
+			t.Errorf("SplitSeq(%q, %q) = %v; want %v", tt.s, tt.sep, a, tt.a)
+		}
+		if a := collect(SplitSeq([]byte(tt.s), tt.sep)); !eq(a, tt.a) {
+			t.Errorf("SplitSeq([]byte(%q), %q) = %v; want %v", tt.s, tt.sep, a, tt.a)
+		}
+	}
+}
+
+func TestSplitAfterSeq(t *testing.T) {
+	for _, tt := range splitaftertests {
+		if tt.n >= 0 {
+			continue
+		}
+		if a := collect(SplitAfterSeq(tt.s, tt.sep)); !eq(a, tt.a) {
+			t.Errorf("SplitAfterSeq(%q, %q) = %v; want %v", tt.s, tt.sep, a, tt.a)
+		}
+		if a := collect(SplitAfterSeq([]byte(tt.s), tt.sep)); !eq(a, tt.a) {
+			t.Errorf("SplitAfterSeq([]byte(%q), %q) = %v; want %v", tt.s, tt.sep, a, tt.a)
+		}
+	}
+}
+
+func TestFieldsSeq(t *testing.T) {
+	for _, tt := range fieldstests {
+		if a := collect(FieldsSeq(tt.s)); !eq(a, tt.a) {
+			t.Errorf("FieldsSeq(%q) = %v; want %v", tt.s, a, tt.a)
+		}
+		if a := collect(FieldsSeq([]byte(tt.s))); !eq(a, tt.a) {
+			t.Errorf("FieldsSeq([]byte(%q)) = %v; want %v", tt.s, a, tt.a)
+		}
+	}
+}
+
+func TestFieldsFuncSeq(t *testing.T) {
+	for _, tt := range fieldstests {
+		if a := collect(FieldsFuncSeq(tt.s, unicode.IsSpace)); !eq(a, tt.a) {
+			t.Errorf("FieldsFuncSeq(%q, unicode.IsSpace) = %v; want %v", tt.s, a, tt.a)
+		}
+	}
+	pred := func(c rune) bool { return c == 'X' }
+	for _, tt := range FieldsFuncTests {
+		if a := collect(FieldsFuncSeq([]byte(tt.s), pred)); !eq(a, tt.a) {
+			t.Errorf("FieldsFuncSeq([]byte(%q)) = %v, want %v", tt.s, a, tt.a)
+		}
+	}
+}
+
+var linesTests = []struct {
+	s string
+	a []string
+}{
+	{"", []string{}},
+	{"abc", []string{"abc"}},
+	{"abc\n", []string{"abc\n"}},
+	{"abc\ndef", []string{"abc\n", "def"}},
+	{"\n\n", []string{"\n", "\n"}},
+	{"a\r\nb\r\n", []string{"a\r\n", "b\r\n"}},
+}
+
+func TestLines(t *testing.T) {
+	for _, tt := range linesTests {
+		if a := collect(Lines(tt.s)); !eq(a, tt.a) {
+			t.Errorf("Lines(%q) = %v; want %v", tt.s, a, tt.a)
+		}
+		if a := collect(Lines([]byte(tt.s))); !eq(a, tt.a) {
+			t.Errorf("Lines([]byte(%q)) = %v; want %v", tt.s, a, tt.a)
+		}
+	}
+}
+
+func TestSeqEarlyExit(t *testing.T) {
+	for s := range SplitSeq("a,b,c", ",") {
+		if s != "a" {
+			t.Errorf("SplitSeq yielded %q after break", s)
+		}
+		break
+	}
+	got := slices.Collect(func(yield func(string) bool) {
+		for s := range FieldsSeq(" a b c ") {
+			if !yield(s) || s == "b" {
+				return
+			}
+		}
+	})
+	if want := []string{"a", "b"}; !eq(got, want) {
+		t.Errorf("FieldsSeq early exit = %v; want %v", got, want)
+	}
+}
+
+func BenchmarkSplitSeqEmptySeparator(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for range SplitSeq(benchInputHard, "") {
+		}
+	}
+}
+
+func BenchmarkSplitSeqSingleByteSeparator(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for range SplitSeq(benchInputHard, "/") {
+		}
+	}
+}
+
+func BenchmarkSplitSeqMultiByteSeparator(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for range SplitSeq(benchInputHard, "hello") {
+		}
+	}
+}
+
+func BenchmarkFieldsSeq(b *testing.B) {
+	for _, sd := range stringdata {
+		b.Run(sd.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for range FieldsSeq(sd.data) {
+				}
+			}
+		})
+	}
+}
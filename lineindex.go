@@ -0,0 +1,209 @@
+package text
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/pgavlin/text/utf8"
+)
+
+// A ColumnUnit is the unit in which a LineIndex measures columns.
+type ColumnUnit int
+
+const (
+	ColumnBytes ColumnUnit = iota // columns count bytes
+	ColumnRunes                   // columns count runes
+	ColumnUTF16                   // columns count UTF-16 code units, as in the Language Server Protocol
+)
+
+// A LineIndex maps between byte offsets in a text and line and column
+// numbers. Lines are terminated by '\n'. Lines and columns start at 1.
+//
+// Finding the line of an offset or the start of a line takes O(log n) time
+// in the number of lines. Converting a column in runes or UTF-16 code units
+// additionally scans the line, up to the column being converted.
+type LineIndex[S String] struct {
+	s      S
+	starts []int // byte offset of the start of each line; starts[0] == 0
+}
+
+// NewLineIndex returns a new LineIndex for s.
+func NewLineIndex[S String](s S) *LineIndex[S] {
+	x := &LineIndex[S]{s: s}
+	x.starts = appendLineStarts([]int{0}, s, 0)
+	return x
+}
+
+// appendLineStarts appends the offset of each line started by a newline in s
+// to starts, where s begins at offset base.
+func appendLineStarts[S String](starts []int, s S, base int) []int {
+	for i := 0; ; {
+		j := IndexByte(s[i:], '\n')
+		if j < 0 {
+			return starts
+		}
+		i += j + 1
+		starts = append(starts, base+i)
+	}
+}
+
+// Text returns the indexed text.
+func (x *LineIndex[S]) Text() S {
+	return x.s
+}
+
+// Len returns the length in bytes of the indexed text.
+func (x *LineIndex[S]) Len() int {
+	return len(x.s)
+}
+
+// LineCount returns the number of lines in the text. A text always has at
+// least one line, and a final newline starts an empty last line.
+func (x *LineIndex[S]) LineCount() int {
+	if x.starts == nil {
+		return 1
+	}
+	return len(x.starts)
+}
+
+// Line returns the text of the given line, including its terminating newline
+// if any. Line returns an empty text if line is out of range.
+func (x *LineIndex[S]) Line(line int) S {
+	if line < 1 || line > x.LineCount() {
+		return x.s[:0]
+	}
+	start, end := x.lineBounds(line)
+	return x.s[start:end]
+}
+
+// lineBounds returns the start and end offsets of the given line, including
+// its newline.
+func (x *LineIndex[S]) lineBounds(line int) (start, end int) {
+	if x.starts == nil {
+		return 0, len(x.s)
+	}
+	start, end = x.starts[line-1], len(x.s)
+	if line < len(x.starts) {
+		end = x.starts[line]
+	}
+	return start, end
+}
+
+// lineOf returns the line containing offset, which must be in range.
+func (x *LineIndex[S]) lineOf(offset int) int {
+	return sort.Search(len(x.starts), func(i int) bool { return x.starts[i] > offset })
+}
+
+// LineColumn returns the line and column of the given byte offset, which is
+// clamped to the bounds of the text. The column is measured in the given unit;
+// an offset inside a multibyte rune has the rune's column if the unit is
+// ColumnRunes or ColumnUTF16.
+func (x *LineIndex[S]) LineColumn(offset int, unit ColumnUnit) (line, column int) {
+	offset = max(min(offset, len(x.s)), 0)
+	line = 1
+	if x.starts != nil {
+		line = x.lineOf(offset)
+	}
+	start, _ := x.lineBounds(line)
+	return line, columnWidth(x.s[start:], offset-start, unit) + 1
+}
+
+// Position returns the position of the given byte offset, which is clamped to
+// the bounds of the text.
+func (x *LineIndex[S]) Position(offset int) Position {
+	offset = max(min(offset, len(x.s)), 0)
+	line, col := x.LineColumn(offset, ColumnRunes)
+	start, _ := x.lineBounds(line)
+	return Position{Offset: offset, Line: line, Column: offset - start + 1, RuneColumn: col}
+}
+
+// Offset returns the byte offset of the given line and column, where the
+// column is measured in the given unit. A line before the first line or after
+// the last maps to the start or end of the text, respectively, and a column
+// past the end of its line maps to the line's terminating newline, or to the
+// end of the text on the last line. A column inside a rune, such as one that
+// splits a UTF-16 surrogate pair, maps to the start of that rune.
+func (x *LineIndex[S]) Offset(line, column int, unit ColumnUnit) int {
+	switch {
+	case line < 1:
+		return 0
+	case line > x.LineCount():
+		return len(x.s)
+	}
+	start, end := x.lineBounds(line)
+	if end > start && x.s[end-1] == '\n' {
+		end--
+	}
+	s := x.s[start:end]
+
+	column = max(column-1, 0)
+	if unit == ColumnBytes {
+		return start + min(column, len(s))
+	}
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRune(s[i:])
+		w := 1
+		if unit == ColumnUTF16 && r >= 0x10000 {
+			w = 2
+		}
+		if column < w {
+			break
+		}
+		column -= w
+		i += size
+	}
+	return start + i
+}
+
+// columnWidth returns the width in the given unit of the runes of s that end
+// at or before index end. A rune that starts before end but ends after it
+// does not count.
+func columnWidth[S String](s S, end int, unit ColumnUnit) int {
+	if unit == ColumnBytes {
+		return end
+	}
+	n := 0
+	for i := 0; i < end; {
+		r, size := utf8.DecodeRune(s[i:])
+		if i+size > end {
+			break
+		}
+		n++
+		if unit == ColumnUTF16 && r >= 0x10000 {
+			n++
+		}
+		i += size
+	}
+	return n
+}
+
+// Replace replaces the bytes of the text in [start, end) with new and updates
+// the index. Only new is scanned for line breaks and the starts of later lines
+// are shifted, but the text is copied, so Replace takes O(n) time in the
+// length of the text. Replace panics if the range is out of bounds.
+func (x *LineIndex[S]) Replace(start, end int, new S) {
+	if start < 0 || end < start || end > len(x.s) {
+		panic("text.LineIndex.Replace: range out of bounds")
+	}
+	if x.starts == nil {
+		x.starts = []int{0}
+	}
+
+	var b Builder[S]
+	b.Grow(len(x.s) - (end - start) + len(new))
+	b.WriteText(x.s[:start])
+	b.WriteText(new)
+	b.WriteText(x.s[end:])
+	x.s = b.Text()
+
+	// Lines starting in (start, end] are removed; lines starting after end
+	// are shifted.
+	lo, hi := x.lineOf(start), x.lineOf(end)
+	added := appendLineStarts(nil, new, start)
+	x.starts = slices.Replace(x.starts, lo, hi, added...)
+	delta := len(new) - (end - start)
+	for i := lo + len(added); i < len(x.starts); i++ {
+		x.starts[i] += delta
+	}
+}
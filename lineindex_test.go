@@ -0,0 +1,212 @@
+package text_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/pgavlin/text"
+)
+
+func TestLineIndex(t *testing.T) {
+	// "é" is two bytes and one UTF-16 unit; "𝄞" is four bytes and two UTF-16
+	// units.
+	const s = "ab\né𝄞x\n\nend"
+	x := NewLineIndex(s)
+	if got := x.LineCount(); got != 4 {
+		t.Errorf("LineCount() = %d, want 4", got)
+	}
+
+	tests := []struct {
+		offset, line        int
+		bytes, runes, utf16 int
+	}{
+		{0, 1, 1, 1, 1},
+		{2, 1, 3, 3, 3},
+		{3, 2, 1, 1, 1},
+		{4, 2, 2, 1, 1}, // inside é
+		{5, 2, 3, 2, 2},
+		{7, 2, 5, 2, 2}, // inside 𝄞
+		{9, 2, 7, 3, 4},
+		{10, 2, 8, 4, 5},
+		{11, 3, 1, 1, 1},
+		{12, 4, 1, 1, 1},
+		{15, 4, 4, 4, 4},
+		{100, 4, 4, 4, 4},
+		{-1, 1, 1, 1, 1},
+	}
+	for _, tt := range tests {
+		for unit, want := range []int{tt.bytes, tt.runes, tt.utf16} {
+			line, col := x.LineColumn(tt.offset, ColumnUnit(unit))
+			if line != tt.line || col != want {
+				t.Errorf("LineColumn(%d, %d) = %d:%d, want %d:%d", tt.offset, unit, line, col, tt.line, want)
+			}
+		}
+	}
+
+	if got, want := x.Position(9), (Position{Offset: 9, Line: 2, Column: 7, RuneColumn: 3}); got != want {
+		t.Errorf("Position(9) = %+v, want %+v", got, want)
+	}
+	if got := x.Line(2); got != "é𝄞x\n" {
+		t.Errorf("Line(2) = %q", got)
+	}
+	if got := x.Line(5); got != "" {
+		t.Errorf("Line(5) = %q", got)
+	}
+}
+
+func TestLineIndexOffset(t *testing.T) {
+	x := NewLineIndex([]byte("ab\né𝄞x\n\nend"))
+	tests := []struct {
+		line, col int
+		unit      ColumnUnit
+		want      int
+	}{
+		{1, 1, ColumnBytes, 0},
+		{1, 3, ColumnBytes, 2},
+		{1, 10, ColumnBytes, 2},
+		{2, 2, ColumnBytes, 4},
+		{2, 2, ColumnRunes, 5},
+		{2, 3, ColumnRunes, 9},
+		{2, 2, ColumnUTF16, 5},
+		{2, 3, ColumnUTF16, 5}, // inside the surrogate pair
+		{2, 4, ColumnUTF16, 9},
+		{2, 5, ColumnUTF16, 10},
+		{2, 50, ColumnUTF16, 10},
+		{3, 5, ColumnRunes, 11},
+		{4, 2, ColumnRunes, 13},
+		{4, 50, ColumnRunes, 15},
+		{0, 1, ColumnRunes, 0},
+		{5, 1, ColumnRunes, 15},
+		{2, 0, ColumnRunes, 3},
+	}
+	for _, tt := range tests {
+		if got := x.Offset(tt.line, tt.col, tt.unit); got != tt.want {
+			t.Errorf("Offset(%d, %d, %d) = %d, want %d", tt.line, tt.col, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestLineIndexRoundTrip(t *testing.T) {
+	const s = "héllo\n𝄞 wörld\r\n\n\xffx\n\xf0a\xe2\x82x\nend\n"
+	x := NewLineIndex(s)
+
+	// runeStart[i] reports whether a rune of s starts at i.
+	runeStart := make([]bool, len(s)+1)
+	for i := 0; i < len(s); {
+		runeStart[i] = true
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	runeStart[len(s)] = true
+
+	for _, unit := range []ColumnUnit{ColumnBytes, ColumnRunes, ColumnUTF16} {
+		for i := 0; i <= len(s); i++ {
+			line, col := x.LineColumn(i, unit)
+			want := i
+			if unit != ColumnBytes {
+				for !runeStart[want] {
+					want--
+				}
+			}
+			if got := x.Offset(line, col, unit); got != want {
+				t.Errorf("unit %d: Offset(LineColumn(%d)) = %d, want %d", unit, i, got, want)
+			}
+		}
+	}
+}
+
+func TestLineIndexZero(t *testing.T) {
+	var x LineIndex[string]
+	if got := x.LineCount(); got != 1 {
+		t.Errorf("LineCount() = %d, want 1", got)
+	}
+	if line, col := x.LineColumn(3, ColumnRunes); line != 1 || col != 1 {
+		t.Errorf("LineColumn(3) = %d:%d, want 1:1", line, col)
+	}
+	x.Replace(0, 0, "a\nb")
+	if got := x.LineCount(); got != 2 {
+		t.Errorf("LineCount() after Replace = %d, want 2", got)
+	}
+}
+
+func lineStarts(x *LineIndex[string]) []int {
+	starts := make([]int, x.LineCount())
+	for i := range starts {
+		starts[i] = x.Offset(i+1, 1, ColumnBytes)
+	}
+	return starts
+}
+
+func TestLineIndexReplace(t *testing.T) {
+	tests := []struct {
+		s          string
+		start, end int
+		new        string
+		want       string
+	}{
+		{"a\nb\nc", 1, 3, "", "a\nc"},
+		{"a\nb\nc", 2, 2, "x\ny\n", "a\nx\ny\nb\nc"},
+		{"a\nb\nc", 0, 5, "z", "z"},
+		{"a\nb\nc", 5, 5, "\n", "a\nb\nc\n"},
+		{"a\nb\nc", 1, 2, "\n\n", "a\n\nb\nc"},
+		{"", 0, 0, "\n", "\n"},
+	}
+	for _, tt := range tests {
+		x := NewLineIndex(tt.s)
+		x.Replace(tt.start, tt.end, tt.new)
+		if got := x.Text(); got != tt.want {
+			t.Errorf("Replace(%q, %d, %d, %q) text = %q, want %q", tt.s, tt.start, tt.end, tt.new, got, tt.want)
+		}
+		if got, want := lineStarts(x), lineStarts(NewLineIndex(tt.want)); !slices.Equal(got, want) {
+			t.Errorf("Replace(%q, %d, %d, %q) line starts = %v, want %v", tt.s, tt.start, tt.end, tt.new, got, want)
+		}
+	}
+}
+
+func TestLineIndexReplaceRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randText := func() string {
+		b := make([]byte, rng.Intn(8))
+		for i := range b {
+			b[i] = "ab\n"[rng.Intn(3)]
+		}
+		return string(b)
+	}
+
+	x := NewLineIndex(randText())
+	for i := 0; i < 1000; i++ {
+		s := x.Text()
+		start := rng.Intn(len(s) + 1)
+		end := start + rng.Intn(len(s)-start+1)
+		new := randText()
+		x.Replace(start, end, new)
+
+		want := s[:start] + new + s[end:]
+		if x.Text() != want {
+			t.Fatalf("text = %q, want %q", x.Text(), want)
+		}
+		if got, want := lineStarts(x), lineStarts(NewLineIndex(want)); !slices.Equal(got, want) {
+			t.Fatalf("Replace(%q, %d, %d, %q): line starts = %v, want %v", s, start, end, new, got, want)
+		}
+	}
+}
+
+func TestLineIndexReplacePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Replace with an invalid range did not panic")
+		}
+	}()
+	NewLineIndex("abc").Replace(2, 4, "")
+}
+
+func BenchmarkLineIndex(b *testing.B) {
+	s := Repeat("a line of text with ünïcödé\n", 10000)
+	x := NewLineIndex(s)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.LineColumn(i*7919%len(s), ColumnUTF16)
+	}
+}
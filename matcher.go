@@ -0,0 +1,257 @@
+package text
+
+import (
+	"iter"
+)
+
+// MatchKind determines which matches a Matcher reports when occurrences of
+// its patterns overlap.
+type MatchKind int
+
+const (
+	// MatchOverlapping reports every occurrence of every pattern, including
+	// occurrences that overlap each other. Matches are reported in order of
+	// their end offsets; matches that end at the same offset are reported
+	// longest first.
+	MatchOverlapping MatchKind = iota
+	// MatchLeftmostFirst reports non-overlapping matches. At the leftmost
+	// offset at which any pattern matches, the pattern that appears first in
+	// the argument list to NewMatcher is chosen. This is the rule used by
+	// Replacer.
+	MatchLeftmostFirst
+	// MatchLeftmostLongest reports non-overlapping matches. At the leftmost
+	// offset at which any pattern matches, the longest matching pattern is
+	// chosen.
+	MatchLeftmostLongest
+)
+
+// A Match describes an occurrence of one of a Matcher's patterns in a text.
+type Match struct {
+	// Pattern is the index of the matched pattern in the argument list to
+	// NewMatcher.
+	Pattern int
+	// Start and End are the byte offsets of the match in the text, so the
+	// matched text is text[Start:End].
+	Start, End int
+}
+
+// acNode is a state in the Aho-Corasick automaton that backs a Matcher.
+type acNode struct {
+	// depth is the length of the pattern prefix that this state represents.
+	depth int
+	// match is the index of the pattern that ends at this state, or -1.
+	// If several patterns are identical, match is the first of them.
+	match int
+	// dict is the nearest state on this state's failure chain (excluding the
+	// state itself) that has a match, or -1 if there is no such state.
+	dict int
+}
+
+// A Matcher finds occurrences of a set of patterns in a text in a single
+// pass, using the Aho-Corasick algorithm. An empty pattern matches at every
+// byte offset of a text.
+// It is safe for concurrent use by multiple goroutines.
+type Matcher[S String] struct {
+	kind MatchKind
+	// lens holds the length of each pattern.
+	lens  []int
+	nodes []acNode
+	// delta is the automaton's transition table. The transitions for state
+	// s are delta[s*stride : (s+1)*stride], indexed by mapped byte.
+	delta  []int
+	stride int
+	// mapping maps from pattern bytes to a dense index into delta. All bytes
+	// that do not occur in any pattern map to stride-1, whose transitions
+	// always lead back to the root.
+	mapping [256]byte
+}
+
+// NewMatcher returns a new Matcher that finds occurrences of patterns using
+// the given match semantics.
+func NewMatcher[S String](kind MatchKind, patterns ...S) *Matcher[S] {
+	m := &Matcher[S]{kind: kind, lens: make([]int, len(patterns))}
+
+	// Find each byte used, then assign them each an index.
+	var used [256]bool
+	for _, p := range patterns {
+		for j := 0; j < len(p); j++ {
+			used[p[j]] = true
+		}
+	}
+	var tableSize int
+	for b, u := range used {
+		if u {
+			m.mapping[b] = byte(tableSize)
+			tableSize++
+		}
+	}
+	for b, u := range used {
+		if !u {
+			m.mapping[b] = byte(tableSize)
+		}
+	}
+	m.stride = tableSize + 1
+
+	// Build the trie of patterns. Missing transitions are marked with -1.
+	newNode := func(depth int) int {
+		m.nodes = append(m.nodes, acNode{depth: depth, match: -1, dict: -1})
+		for i := 0; i < m.stride; i++ {
+			m.delta = append(m.delta, -1)
+		}
+		return len(m.nodes) - 1
+	}
+	newNode(0)
+	for i, p := range patterns {
+		m.lens[i] = len(p)
+		s := 0
+		for j := 0; j < len(p); j++ {
+			t := &m.delta[s*m.stride+int(m.mapping[p[j]])]
+			if *t < 0 {
+				next := newNode(j + 1)
+				t = &m.delta[s*m.stride+int(m.mapping[p[j]])]
+				*t = next
+			}
+			s = *t
+		}
+		if m.nodes[s].match < 0 {
+			m.nodes[s].match = i
+		}
+	}
+
+	// Compute failure links in breadth-first order, and use them to fill in
+	// the missing transitions so that the automaton becomes a DFA.
+	fail := make([]int, len(m.nodes))
+	queue := make([]int, 0, len(m.nodes))
+	for c := 0; c < m.stride; c++ {
+		t := &m.delta[c]
+		if *t < 0 {
+			*t = 0
+		} else {
+			queue = append(queue, *t)
+			if m.nodes[0].match >= 0 {
+				m.nodes[*t].dict = 0
+			}
+		}
+	}
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		for c := 0; c < m.stride; c++ {
+			t := &m.delta[s*m.stride+c]
+			if *t < 0 {
+				*t = m.delta[fail[s]*m.stride+c]
+				continue
+			}
+			f := m.delta[fail[s]*m.stride+c]
+			fail[*t] = f
+			if m.nodes[f].match >= 0 {
+				m.nodes[*t].dict = f
+			} else {
+				m.nodes[*t].dict = m.nodes[f].dict
+			}
+			queue = append(queue, *t)
+		}
+	}
+	return m
+}
+
+// Kind returns the match semantics used by m.
+func (m *Matcher[S]) Kind() MatchKind {
+	return m.kind
+}
+
+// step returns the state reached from state s after reading the byte c.
+func (m *Matcher[S]) step(s int, c byte) int {
+	return m.delta[s*m.stride+int(m.mapping[c])]
+}
+
+// overlapping calls yield for each match in s, including overlapping matches,
+// until yield returns false.
+func (m *Matcher[S]) overlapping(s S, yield func(Match) bool) {
+	if p := m.nodes[0].match; p >= 0 && !yield(Match{p, 0, 0}) {
+		return
+	}
+	state := 0
+	for i := 0; i < len(s); i++ {
+		state = m.step(state, s[i])
+		for n := state; n >= 0; n = m.nodes[n].dict {
+			if p := m.nodes[n].match; p >= 0 && !yield(Match{p, i + 1 - m.lens[p], i + 1}) {
+				return
+			}
+		}
+	}
+}
+
+// better reports whether a is preferred over b, which starts at the same offset.
+func (m *Matcher[S]) better(a, b Match) bool {
+	if m.kind == MatchLeftmostLongest {
+		return a.End > b.End
+	}
+	return a.Pattern < b.Pattern
+}
+
+// leftmost returns the preferred leftmost match in s that starts at or after
+// offset at. If skipEmpty is true, empty matches at offset at are ignored.
+func (m *Matcher[S]) leftmost(s S, at int, skipEmpty bool) (best Match, found bool) {
+	if p := m.nodes[0].match; p >= 0 && !skipEmpty {
+		best, found = Match{p, at, at}, true
+	}
+	state := 0
+	for i := at; i < len(s); i++ {
+		// Every match that has yet to be seen starts at or after
+		// i-depth, so once that is past the best match's start, the best
+		// match cannot be displaced.
+		if found && i-m.nodes[state].depth > best.Start {
+			break
+		}
+		state = m.step(state, s[i])
+		for n := state; n >= 0; n = m.nodes[n].dict {
+			p := m.nodes[n].match
+			if p < 0 {
+				continue
+			}
+			match := Match{p, i + 1 - m.lens[p], i + 1}
+			if !found || match.Start < best.Start || match.Start == best.Start && m.better(match, best) {
+				best, found = match, true
+			}
+		}
+	}
+	return
+}
+
+// Find returns the first match in s according to m's match semantics and
+// reports whether any match was found. For MatchOverlapping, the first match
+// is the match that ends first.
+func (m *Matcher[S]) Find(s S) (match Match, found bool) {
+	for match = range m.FindAll(s) {
+		return match, true
+	}
+	return Match{}, false
+}
+
+// FindAll returns an iterator over the matches in s according to m's match
+// semantics. For the leftmost match kinds, the matches do not overlap; as
+// with Replacer, an empty match is never reported at the same offset as the
+// previous empty match.
+func (m *Matcher[S]) FindAll(s S) iter.Seq[Match] {
+	return func(yield func(Match) bool) {
+		if m.kind == MatchOverlapping {
+			m.overlapping(s, yield)
+			return
+		}
+		at, skipEmpty := 0, false
+		for at <= len(s) {
+			match, ok := m.leftmost(s, at, skipEmpty)
+			if !ok || !yield(match) {
+				return
+			}
+			at, skipEmpty = match.End, match.Start == match.End
+		}
+	}
+}
+
+// Contains reports whether any of m's patterns occurs in s.
+func (m *Matcher[S]) Contains(s S) bool {
+	_, found := m.Find(s)
+	return found
+}
@@ -0,0 +1,163 @@
+package text_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+var matcherTests = []struct {
+	kind     MatchKind
+	patterns []string
+	in       string
+	out      []Match
+}{
+	{MatchOverlapping, nil, "abc", nil},
+	{MatchOverlapping, []string{"he", "she", "his", "hers"}, "ushers", []Match{{1, 1, 4}, {0, 2, 4}, {3, 2, 6}}},
+	{MatchOverlapping, []string{"a", "aa"}, "aaa", []Match{{0, 0, 1}, {1, 0, 2}, {0, 1, 2}, {1, 1, 3}, {0, 2, 3}}},
+	{MatchOverlapping, []string{"", "a"}, "a", []Match{{0, 0, 0}, {1, 0, 1}, {0, 1, 1}}},
+	{MatchLeftmostFirst, []string{"he", "she", "his", "hers"}, "ushers", []Match{{1, 1, 4}}},
+	{MatchLeftmostFirst, []string{"he", "hers"}, "hers", []Match{{0, 0, 2}}},
+	{MatchLeftmostFirst, []string{"hers", "he"}, "hers", []Match{{0, 0, 4}}},
+	{MatchLeftmostFirst, []string{"b", "abcd", "bc"}, "abcdbc", []Match{{1, 0, 4}, {0, 4, 5}}},
+	{MatchLeftmostFirst, []string{"b", "abcd", "bc"}, "abcbc", []Match{{0, 1, 2}, {0, 3, 4}}},
+	{MatchLeftmostFirst, []string{"a", "aa"}, "aaa", []Match{{0, 0, 1}, {0, 1, 2}, {0, 2, 3}}},
+	{MatchLeftmostFirst, []string{"", "a"}, "ab", []Match{{0, 0, 0}, {1, 0, 1}, {0, 1, 1}, {0, 2, 2}}},
+	{MatchLeftmostLongest, []string{"he", "hers"}, "hers", []Match{{1, 0, 4}}},
+	{MatchLeftmostLongest, []string{"a", "aa"}, "aaa", []Match{{1, 0, 2}, {0, 2, 3}}},
+	{MatchLeftmostLongest, []string{"abc", "b", "bcde"}, "abcde", []Match{{0, 0, 3}}},
+	{MatchLeftmostLongest, []string{"x", "x"}, "xx", []Match{{0, 0, 1}, {0, 1, 2}}},
+	{MatchLeftmostLongest, []string{"", "a"}, "ab", []Match{{1, 0, 1}, {0, 1, 1}, {0, 2, 2}}},
+	{MatchLeftmostLongest, []string{"日本", "本語"}, "日本語", []Match{{0, 0, 6}}},
+}
+
+func TestMatcher(t *testing.T) {
+	for _, tt := range matcherTests {
+		m := NewMatcher(tt.kind, tt.patterns...)
+		if got := slices.Collect(m.FindAll(tt.in)); !slices.Equal(got, tt.out) {
+			t.Errorf("NewMatcher(%v, %q).FindAll(%q) = %v; want %v", tt.kind, tt.patterns, tt.in, got, tt.out)
+		}
+		bm := NewMatcher(tt.kind, toBytes(tt.patterns)...)
+		if got := slices.Collect(bm.FindAll([]byte(tt.in))); !slices.Equal(got, tt.out) {
+			t.Errorf("NewMatcher(%v, %q).FindAll([]byte(%q)) = %v; want %v", tt.kind, tt.patterns, tt.in, got, tt.out)
+		}
+		match, found := m.Find(tt.in)
+		if found != (len(tt.out) > 0) || found && match != tt.out[0] {
+			t.Errorf("NewMatcher(%v, %q).Find(%q) = %v, %v", tt.kind, tt.patterns, tt.in, match, found)
+		}
+	}
+}
+
+func toBytes(a []string) [][]byte {
+	b := make([][]byte, len(a))
+	for i, s := range a {
+		b[i] = []byte(s)
+	}
+	return b
+}
+
+// naiveMatches returns the matches that a Matcher of the given kind should
+// report, computed by brute force.
+func naiveMatches(kind MatchKind, patterns []string, s string) []Match {
+	var out []Match
+	if kind == MatchOverlapping {
+		for end := 0; end <= len(s); end++ {
+			var atEnd []Match
+			for i, p := range patterns {
+				if strings.HasSuffix(s[:end], p) && !slices.Contains(patterns[:i], p) {
+					atEnd = append(atEnd, Match{i, end - len(p), end})
+				}
+			}
+			slices.SortStableFunc(atEnd, func(a, b Match) int { return a.Start - b.Start })
+			out = append(out, atEnd...)
+		}
+		return out
+	}
+	at, skipEmpty := 0, false
+	for at <= len(s) {
+		found := false
+		var best Match
+		for start := at; start <= len(s) && !found; start++ {
+			for i, p := range patterns {
+				if !strings.HasPrefix(s[start:], p) || p == "" && skipEmpty && start == at {
+					continue
+				}
+				m := Match{i, start, start + len(p)}
+				switch {
+				case !found:
+					best, found = m, true
+				case kind == MatchLeftmostLongest && m.End > best.End:
+					best = m
+				}
+			}
+		}
+		if !found {
+			break
+		}
+		out = append(out, best)
+		at, skipEmpty = best.End, best.Start == best.End
+	}
+	return out
+}
+
+func TestMatcherRandom(t *testing.T) {
+	const chars = "abc"
+	random := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = chars[rand.Intn(len(chars))]
+		}
+		return string(b)
+	}
+	for _, kind := range []MatchKind{MatchOverlapping, MatchLeftmostFirst, MatchLeftmostLongest} {
+		for i := 0; i < 500; i++ {
+			patterns := make([]string, 1+rand.Intn(5))
+			for j := range patterns {
+				patterns[j] = random(rand.Intn(4))
+			}
+			s := random(rand.Intn(32))
+			got := slices.Collect(NewMatcher(kind, patterns...).FindAll(s))
+			if want := naiveMatches(kind, patterns, s); !slices.Equal(got, want) {
+				t.Fatalf("NewMatcher(%v, %q).FindAll(%q) = %v; want %v", kind, patterns, s, got, want)
+			}
+		}
+	}
+}
+
+// TestMatcherReplacerAgreement checks that MatchLeftmostFirst finds the same
+// matches that Replacer replaces.
+func TestMatcherReplacerAgreement(t *testing.T) {
+	patterns := []string{"a", "ab", "bc", "cab", "", "bca"}
+	oldnew := make([]string, 0, 2*len(patterns))
+	for i, p := range patterns {
+		oldnew = append(oldnew, p, fmt.Sprintf("<%d>", i))
+	}
+	r := NewReplacer(oldnew...)
+	m := NewMatcher(MatchLeftmostFirst, patterns...)
+	for _, s := range []string{"", "abcabc", "cabbca", "xyz", "aabbcc"} {
+		var b strings.Builder
+		last := 0
+		for match := range m.FindAll(s) {
+			fmt.Fprintf(&b, "%s<%d>", s[last:match.Start], match.Pattern)
+			last = match.End
+		}
+		b.WriteString(s[last:])
+		if got, want := b.String(), r.Replace(s); got != want {
+			t.Errorf("matches in %q = %q; Replacer gives %q", s, got, want)
+		}
+	}
+}
+
+func BenchmarkMatcher(b *testing.B) {
+	keywords := []string{"hello", "world", "</pre>", "<b>", "xyzzy", "needle", "haystack"}
+	m := NewMatcher(MatchLeftmostFirst, keywords...)
+	b.SetBytes(int64(len(benchInputHard)))
+	for i := 0; i < b.N; i++ {
+		for range m.FindAll(benchInputHard) {
+		}
+	}
+}
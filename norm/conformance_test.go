@@ -0,0 +1,164 @@
+package norm_test
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/pgavlin/text/norm"
+	"github.com/pgavlin/text/utf8"
+)
+
+// The conformance tests need the Unicode Character Database, which is not
+// checked in. Run them with, for example,
+//
+//	go test -ucd https://www.unicode.org/Public/15.0.0/ucd
+var ucd = flag.String("ucd", "", "directory or URL of the Unicode Character Database")
+
+// openUCD opens the named file of the Unicode Character Database, skipping
+// the test if -ucd is not set.
+func openUCD(t *testing.T, name string) io.ReadCloser {
+	t.Helper()
+	if *ucd == "" {
+		t.Skip("-ucd not set")
+	}
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		resp, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			t.Fatalf("fetching %v: %v", name, resp.Status)
+		}
+		return resp.Body
+	}
+	f, err := os.Open(filepath.Join(*ucd, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// TestNormalizationTest checks the invariants listed in NormalizationTest.txt.
+func TestNormalizationTest(t *testing.T) {
+	r := openUCD(t, "NormalizationTest.txt")
+	defer r.Close()
+
+	check := func(line int, f Form, name, in, want string) {
+		t.Helper()
+		if got := Normalize(f, in); got != want {
+			t.Errorf("line %d: %s(%+q) = %+q, want %+q", line, name, in, got, want)
+		}
+		if got := Normalize(f, []byte(in)); string(got) != want {
+			t.Errorf("line %d: %s([]byte(%+q)) = %+q, want %+q", line, name, in, got, want)
+		}
+		if got := IsNormal(f, in); got != (in == want) {
+			t.Errorf("line %d: IsNormal(%s, %+q) = %v, want %v", line, name, in, got, in == want)
+		}
+	}
+
+	// Every code point that is not listed in part 1 normalizes to itself.
+	part, listed := "", map[rune]bool{}
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text, _, _ := strings.Cut(s.Text(), "#")
+		if text = strings.TrimSpace(text); text == "" {
+			continue
+		}
+		if strings.HasPrefix(text, "@") {
+			part = text
+			continue
+		}
+
+		fields := strings.Split(text, ";")
+		if len(fields) < 5 {
+			t.Fatalf("line %d: malformed test %q", line, s.Text())
+		}
+		var c [5]string
+		for i := range c {
+			var b strings.Builder
+			for _, cp := range strings.Fields(fields[i]) {
+				r, err := strconv.ParseUint(cp, 16, 32)
+				if err != nil {
+					t.Fatalf("line %d: %v", line, err)
+				}
+				b.WriteRune(rune(r))
+			}
+			c[i] = b.String()
+		}
+		if part == "@Part1" {
+			r, _ := utf8.DecodeRune(c[0])
+			listed[r] = true
+		}
+
+		// c2 ==  toNFC(c1) ==  toNFC(c2) ==  toNFC(c3)
+		// c4 ==  toNFC(c4) ==  toNFC(c5)
+		// c3 ==  toNFD(c1) ==  toNFD(c2) ==  toNFD(c3)
+		// c5 ==  toNFD(c4) ==  toNFD(c5)
+		// c4 == toNFKC(c1) == toNFKC(c2) == toNFKC(c3) == toNFKC(c4) == toNFKC(c5)
+		// c5 == toNFKD(c1) == toNFKD(c2) == toNFKD(c3) == toNFKD(c4) == toNFKD(c5)
+		for i, in := range c {
+			if i < 3 {
+				check(line, NFC, "NFC", in, c[1])
+				check(line, NFD, "NFD", in, c[2])
+			} else {
+				check(line, NFC, "NFC", in, c[3])
+				check(line, NFD, "NFD", in, c[4])
+			}
+			check(line, NFKC, "NFKC", in, c[3])
+			check(line, NFKD, "NFKD", in, c[4])
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) == 0 {
+		t.Fatal("NormalizationTest.txt has no part 1")
+	}
+
+	for r := rune(0); r <= utf8.MaxRune; r++ {
+		if listed[r] || !utf8.ValidRune(r) {
+			continue
+		}
+		in := string(r)
+		for _, f := range forms {
+			if got := Normalize(f.form, in); got != in {
+				t.Errorf("%s(%+q) = %+q, want %+q", f.name, in, got, in)
+			}
+		}
+	}
+}
+
+// TestTables checks that gen.go reproduces tables.go from the Unicode
+// Character Database given by -ucd.
+func TestTables(t *testing.T) {
+	if *ucd == "" {
+		t.Skip("-ucd not set")
+	}
+	output := filepath.Join(t.TempDir(), "tables.go")
+	cmd := exec.Command("go", "run", "gen.go", "-ucd", *ucd, "-output", output)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go run gen.go: %v\n%s", err, out)
+	}
+
+	got, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("tables.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("tables.go is out of date; run go generate")
+	}
+}
@@ -0,0 +1,313 @@
+//go:build ignore
+
+// This program generates tables.go from the Unicode Character Database.
+//
+// It reads UnicodeData.txt and CompositionExclusions.txt from the directory
+// or URL given by the -ucd flag.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucd     = flag.String("ucd", "https://www.unicode.org/Public/15.0.0/ucd", "directory or URL of the Unicode Character Database")
+	version = flag.String("version", "15.0.0", "version of the Unicode Character Database")
+	output  = flag.String("output", "tables.go", "output file")
+)
+
+const (
+	nfcNo = 1 << iota
+	nfcMaybe
+	nfkcNo
+	nfkcMaybe
+)
+
+const (
+	vBase, vCount = 0x1161, 21
+	tBase, tCount = 0x11A7, 28
+)
+
+type char struct {
+	ccc     uint8
+	compat  bool
+	mapping []rune
+
+	flags           uint8
+	decomp, kdecomp []rune
+}
+
+var chars = map[rune]*char{}
+
+func open(name string) io.ReadCloser {
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		resp, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("fetching %v: %v", name, resp.Status)
+		}
+		return resp.Body
+	}
+	f, err := os.Open(filepath.Join(*ucd, name))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return f
+}
+
+// parse calls fn with the fields of each data line in the named file.
+func parse(name string, fn func(fields []string)) {
+	r := open(name)
+	defer r.Close()
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i, f := range fields {
+			fields[i] = strings.TrimSpace(f)
+		}
+		fn(fields)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+func ccc(r rune) uint8 {
+	if c, ok := chars[r]; ok {
+		return c.ccc
+	}
+	return 0
+}
+
+// decompose appends the full decomposition of r to d.
+func decompose(d []rune, r rune, compat bool) []rune {
+	c, ok := chars[r]
+	if !ok || len(c.mapping) == 0 || c.compat && !compat {
+		return append(d, r)
+	}
+	for _, m := range c.mapping {
+		d = decompose(d, m, compat)
+	}
+	return d
+}
+
+// reorder puts d in canonical order by stably sorting each run of
+// non-starters by combining class.
+func reorder(d []rune) []rune {
+	for i := 1; i < len(d); i++ {
+		for j := i; j > 0 && ccc(d[j]) != 0 && ccc(d[j-1]) > ccc(d[j]); j-- {
+			d[j], d[j-1] = d[j-1], d[j]
+		}
+	}
+	return d
+}
+
+func main() {
+	flag.Parse()
+
+	parse("UnicodeData.txt", func(fields []string) {
+		if len(fields) < 6 {
+			log.Fatalf("malformed line in UnicodeData.txt: %v", fields)
+		}
+		c := &char{}
+		n, err := strconv.ParseUint(fields[3], 10, 8)
+		if err != nil {
+			log.Fatal(err)
+		}
+		c.ccc = uint8(n)
+		if m := fields[5]; m != "" {
+			if strings.HasPrefix(m, "<") {
+				c.compat = true
+				_, m, _ = strings.Cut(m, ">")
+			}
+			for _, f := range strings.Fields(m) {
+				c.mapping = append(c.mapping, parseRune(f))
+			}
+		}
+		if c.ccc != 0 || len(c.mapping) != 0 {
+			chars[parseRune(fields[0])] = c
+		}
+	})
+
+	excluded := map[rune]bool{}
+	parse("CompositionExclusions.txt", func(fields []string) {
+		lo, hi, ok := strings.Cut(fields[0], "..")
+		if !ok {
+			hi = lo
+		}
+		for r := parseRune(lo); r <= parseRune(hi); r++ {
+			excluded[r] = true
+		}
+	})
+
+	// Build the composition table from the primary composites, and mark
+	// every character that may combine with a preceding character.
+	compositions := map[[2]rune]rune{}
+	maybe := map[rune]bool{}
+	for r, c := range chars {
+		if c.compat || len(c.mapping) == 0 {
+			continue
+		}
+		if len(c.mapping) == 1 || c.ccc != 0 || ccc(c.mapping[0]) != 0 {
+			excluded[r] = true
+		}
+		if !excluded[r] {
+			compositions[[2]rune{c.mapping[0], c.mapping[1]}] = r
+			maybe[c.mapping[1]] = true
+		}
+	}
+	for r := rune(vBase); r < vBase+vCount; r++ {
+		maybe[r] = true
+	}
+	for r := rune(tBase + 1); r < tBase+tCount; r++ {
+		maybe[r] = true
+	}
+
+	for r, c := range chars {
+		if len(c.mapping) != 0 {
+			if !c.compat {
+				c.decomp = reorder(decompose(nil, r, false))
+			}
+			c.kdecomp = reorder(decompose(nil, r, true))
+		}
+		switch {
+		case excluded[r] && !c.compat:
+			c.flags |= nfcNo | nfkcNo
+		case maybe[r]:
+			c.flags |= nfcMaybe
+		}
+		switch {
+		case string(c.kdecomp) != string(c.decomp):
+			c.flags |= nfkcNo
+		case maybe[r] && c.flags&nfkcNo == 0:
+			c.flags |= nfkcMaybe
+		}
+	}
+	for r := range maybe {
+		if _, ok := chars[r]; !ok {
+			chars[r] = &char{flags: nfcMaybe | nfkcMaybe}
+		}
+	}
+
+	runes := make([]rune, 0, len(chars))
+	for r := range chars {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	// Lay out the decompositions, each preceded by its length, sharing
+	// identical decompositions. Offset 0 is reserved to mean "none".
+	data := []rune{0}
+	offsets := map[string]int{}
+	offset := func(d []rune) int {
+		if len(d) == 0 {
+			return 0
+		}
+		if off, ok := offsets[string(d)]; ok {
+			return off
+		}
+		off := len(data)
+		if off > 0xffff {
+			log.Fatal("decomposition table overflow")
+		}
+		offsets[string(d)] = off
+		data = append(data, rune(len(d)))
+		data = append(data, d...)
+		return off
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by running \"go generate\" in github.com/pgavlin/text/norm. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package norm\n\n")
+	fmt.Fprintf(&buf, "// UnicodeVersion is the Unicode edition from which the tables are derived.\n")
+	fmt.Fprintf(&buf, "const UnicodeVersion = %q\n\n", *version)
+
+	fmt.Fprintf(&buf, "// infoRunes holds the runes described by infoTable, in ascending order.\n")
+	fmt.Fprintf(&buf, "var infoRunes = [...]rune{\n")
+	for i, r := range runes {
+		if i%8 == 0 {
+			buf.WriteString("\t")
+		}
+		fmt.Fprintf(&buf, "0x%04X,", r)
+		if i%8 == 7 || i == len(runes)-1 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// infoTable holds the normalization properties of the runes in infoRunes.\n")
+	fmt.Fprintf(&buf, "var infoTable = [...]runeInfo{\n")
+	for _, r := range runes {
+		c := chars[r]
+		fmt.Fprintf(&buf, "\t{%d, 0x%02X, %d, %d}, // U+%04X\n", c.ccc, c.flags, offset(c.decomp), offset(c.kdecomp), r)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// decompData holds the full decompositions referenced by infoTable. Each\n")
+	fmt.Fprintf(&buf, "// decomposition is preceded by its length.\n")
+	fmt.Fprintf(&buf, "var decompData = [...]rune{\n")
+	for i, r := range data {
+		if i%8 == 0 {
+			buf.WriteString("\t")
+		}
+		fmt.Fprintf(&buf, "0x%04X,", r)
+		if i%8 == 7 || i == len(data)-1 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	pairs := make([][2]rune, 0, len(compositions))
+	for p := range compositions {
+		pairs = append(pairs, p)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0] || pairs[i][0] == pairs[j][0] && pairs[i][1] < pairs[j][1]
+	})
+	fmt.Fprintf(&buf, "// compositions maps pairs of runes to their primary composites. The key\n")
+	fmt.Fprintf(&buf, "// for a pair (a, b) is a<<32 | b.\n")
+	fmt.Fprintf(&buf, "var compositions = map[uint64]rune{\n")
+	for _, p := range pairs {
+		fmt.Fprintf(&buf, "\t0x%08X%08X: 0x%04X,\n", p[0], p[1], compositions[p])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,322 @@
+// Package norm implements the Unicode normalization forms described in
+// Unicode Standard Annex #15.
+//
+// The normalization tables are generated from the Unicode Character Database
+// and compiled into the package, so no data is loaded at run time.
+package norm
+
+import (
+	"github.com/pgavlin/text"
+	"github.com/pgavlin/text/internal/bytealg"
+	"github.com/pgavlin/text/utf8"
+)
+
+//go:generate go run gen.go
+
+// A Form denotes a canonical representation of Unicode code points.
+type Form int
+
+const (
+	// NFC is Normalization Form C: canonical decomposition followed by
+	// canonical composition.
+	NFC Form = iota
+	// NFD is Normalization Form D: canonical decomposition.
+	NFD
+	// NFKC is Normalization Form KC: compatibility decomposition followed by
+	// canonical composition.
+	NFKC
+	// NFKD is Normalization Form KD: compatibility decomposition.
+	NFKD
+)
+
+// Flags for runeInfo.flags, derived from the NFC_Quick_Check and
+// NFKC_Quick_Check properties.
+const (
+	nfcNo = 1 << iota
+	nfcMaybe
+	nfkcNo
+	nfkcMaybe
+)
+
+// runeInfo holds the normalization properties of a rune.
+type runeInfo struct {
+	// ccc is the rune's canonical combining class.
+	ccc uint8
+	// flags holds the rune's quick check properties for the composing forms.
+	flags uint8
+	// decomp and kdecomp are the offsets in decompData of the rune's full
+	// canonical and compatibility decompositions, or 0 if the rune does not
+	// decompose.
+	decomp, kdecomp uint16
+}
+
+// Constants for the algorithmic decomposition and composition of Hangul
+// syllables.
+const (
+	hangulBase = 0xAC00
+	hangulEnd  = hangulBase + jamoLVTCount
+
+	jamoLBase  = 0x1100
+	jamoLCount = 19
+	jamoVBase  = 0x1161
+	jamoVCount = 21
+	jamoTBase  = 0x11A7
+	jamoTCount = 28
+
+	jamoVTCount  = jamoVCount * jamoTCount
+	jamoLVTCount = jamoLCount * jamoVTCount
+)
+
+// lookup returns the normalization properties of r.
+func lookup(r rune) runeInfo {
+	if r < infoRunes[0] {
+		return runeInfo{}
+	}
+	lo, hi := 0, len(infoRunes)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch {
+		case infoRunes[m] < r:
+			lo = m + 1
+		case infoRunes[m] > r:
+			hi = m
+		default:
+			return infoTable[m]
+		}
+	}
+	return runeInfo{}
+}
+
+func (f Form) compat() bool {
+	return f == NFKC || f == NFKD
+}
+
+func (f Form) composing() bool {
+	return f == NFC || f == NFKC
+}
+
+// isHangul reports whether r is a precomposed Hangul syllable.
+func isHangul(r rune) bool {
+	return r >= hangulBase && r < hangulEnd
+}
+
+// isYes reports whether a rune with the given properties is unchanged by f
+// regardless of its context, aside from canonical reordering.
+func (f Form) isYes(r rune, info runeInfo) bool {
+	switch f {
+	case NFC:
+		return info.flags&(nfcNo|nfcMaybe) == 0
+	case NFKC:
+		return info.flags&(nfkcNo|nfkcMaybe) == 0
+	case NFD:
+		return info.decomp == 0 && !isHangul(r)
+	default:
+		return info.kdecomp == 0 && !isHangul(r)
+	}
+}
+
+// quickSpan returns the length of the longest prefix of s that is known to be
+// in form f and that ends at a segment boundary, so that normalizing the rest
+// of s does not affect it.
+func (f Form) quickSpan(s string) int {
+	boundary, lastCCC := 0, uint8(0)
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf {
+			boundary, lastCCC = i, 0
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			// Invalid UTF-8 is passed through unchanged and treated as a
+			// starter.
+			boundary, lastCCC = i, 0
+			i++
+			continue
+		}
+		info := lookup(r)
+		if !f.isYes(r, info) || info.ccc != 0 && lastCCC > info.ccc {
+			return boundary
+		}
+		if info.ccc == 0 {
+			boundary = i
+		}
+		lastCCC = info.ccc
+		i += size
+	}
+	return len(s)
+}
+
+// boundaryBefore reports whether r, with the given properties, begins a new
+// segment under f: no rune before it can reorder or combine with it or with
+// any rune after it.
+func (f Form) boundaryBefore(r rune, info runeInfo) bool {
+	if info.ccc != 0 {
+		return false
+	}
+	if isHangul(r) {
+		return true
+	}
+	off := info.decomp
+	if f.compat() {
+		off = info.kdecomp
+	}
+	if off != 0 {
+		if info = lookup(decompData[off+1]); info.ccc != 0 {
+			return false
+		}
+	}
+	switch f {
+	case NFC:
+		return info.flags&nfcMaybe == 0
+	case NFKC:
+		return info.flags&nfkcMaybe == 0
+	default:
+		return true
+	}
+}
+
+// decompose appends the full decomposition of r under f to d.
+func (f Form) decompose(d []rune, r rune, info runeInfo) []rune {
+	if isHangul(r) {
+		s := r - hangulBase
+		d = append(d, jamoLBase+s/jamoVTCount, jamoVBase+(s%jamoVTCount)/jamoTCount)
+		if t := s % jamoTCount; t != 0 {
+			d = append(d, jamoTBase+t)
+		}
+		return d
+	}
+	off := info.decomp
+	if f.compat() {
+		off = info.kdecomp
+	}
+	if off == 0 {
+		return append(d, r)
+	}
+	n := uint16(decompData[off])
+	return append(d, decompData[off+1:off+1+n]...)
+}
+
+// reorder puts d in canonical order by stably sorting each run of
+// non-starters by combining class.
+func reorder(d []rune) {
+	for i := 1; i < len(d); i++ {
+		c := lookup(d[i]).ccc
+		if c == 0 {
+			continue
+		}
+		for j := i; j > 0; j-- {
+			p := lookup(d[j-1]).ccc
+			if p <= c {
+				break
+			}
+			d[j], d[j-1] = d[j-1], d[j]
+		}
+	}
+}
+
+// combine returns the primary composite of a and b, if any.
+func combine(a, b rune) (rune, bool) {
+	switch {
+	case a >= jamoLBase && a < jamoLBase+jamoLCount && b >= jamoVBase && b < jamoVBase+jamoVCount:
+		return hangulBase + ((a-jamoLBase)*jamoVCount+(b-jamoVBase))*jamoTCount, true
+	case isHangul(a) && (a-hangulBase)%jamoTCount == 0 && b > jamoTBase && b < jamoTBase+jamoTCount:
+		return a + (b - jamoTBase), true
+	}
+	c, ok := compositions[uint64(a)<<32|uint64(b)]
+	return c, ok
+}
+
+// compose applies the canonical composition algorithm to d, which must be
+// in canonical order, and returns the composed runes.
+func compose(d []rune) []rune {
+	starter, w := -1, 0
+	var lastCCC uint8
+	for _, r := range d {
+		ccc := lookup(r).ccc
+		if starter >= 0 && (w == starter+1 || lastCCC != 0 && lastCCC < ccc) {
+			if c, ok := combine(d[starter], r); ok {
+				d[starter] = c
+				continue
+			}
+		}
+		if ccc == 0 {
+			starter = w
+		}
+		lastCCC = ccc
+		d[w] = r
+		w++
+	}
+	return d[:w]
+}
+
+// flush normalizes the decomposed segment d and writes it to b.
+func flush[S text.String](f Form, b *text.Builder[S], d []rune) {
+	reorder(d)
+	if f.composing() {
+		d = compose(d)
+	}
+	for _, r := range d {
+		b.WriteRune(r)
+	}
+}
+
+// appendSlow writes the normalized form of s to b.
+func appendSlow[S text.String](f Form, b *text.Builder[S], s string) {
+	var segment []rune
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			flush(f, b, segment)
+			segment = segment[:0]
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		info := lookup(r)
+		if len(segment) > 0 && f.boundaryBefore(r, info) {
+			flush(f, b, segment)
+			segment = segment[:0]
+		}
+		segment = f.decompose(segment, r, info)
+		i += size
+	}
+	flush(f, b, segment)
+}
+
+// Normalize returns the form f of s. If s is already in form f, Normalize
+// returns s without allocating.
+func Normalize[S text.String](f Form, s S) S {
+	str := bytealg.AsString(s)
+	n := f.quickSpan(str)
+	if n == len(str) {
+		return s
+	}
+	var b text.Builder[S]
+	b.Grow(len(str) + len(str)/4)
+	b.WriteString(str[:n])
+	appendSlow(f, &b, str[n:])
+	return b.Text()
+}
+
+// IsNormal reports whether s is in form f.
+func IsNormal[S text.String](f Form, s S) bool {
+	str := bytealg.AsString(s)
+	n := f.quickSpan(str)
+	if n == len(str) {
+		return true
+	}
+	var b text.Builder[string]
+	appendSlow(f, &b, str[n:])
+	return b.String() == str[n:]
+}
+
+// Append appends the form f of s to b.
+func Append[S1, S2 text.String](f Form, b *text.Builder[S1], s S2) {
+	str := bytealg.AsString(s)
+	n := f.quickSpan(str)
+	b.WriteString(str[:n])
+	if n < len(str) {
+		appendSlow(f, b, str[n:])
+	}
+}
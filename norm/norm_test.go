@@ -0,0 +1,135 @@
+package norm_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pgavlin/text"
+	. "github.com/pgavlin/text/norm"
+)
+
+var forms = []struct {
+	name string
+	form Form
+}{
+	{"NFC", NFC},
+	{"NFD", NFD},
+	{"NFKC", NFKC},
+	{"NFKD", NFKD},
+}
+
+var normTests = []struct {
+	in                   string
+	nfc, nfd, nfkc, nfkd string
+}{
+	{"", "", "", "", ""},
+	{"abc", "abc", "abc", "abc", "abc"},
+	{"\u00e9", "\u00e9", "e\u0301", "\u00e9", "e\u0301"},
+	{"e\u0301", "\u00e9", "e\u0301", "\u00e9", "e\u0301"},
+	{"Ame\u0301lie", "Am\u00e9lie", "Ame\u0301lie", "Am\u00e9lie", "Ame\u0301lie"},
+	// Canonical reordering of non-starters.
+	{"a\u0301\u0323", "\u1ea1\u0301", "a\u0323\u0301", "\u1ea1\u0301", "a\u0323\u0301"},
+	{"\u1e69", "\u1e69", "s\u0323\u0307", "\u1e69", "s\u0323\u0307"},
+	{"s\u0307\u0323", "\u1e69", "s\u0323\u0307", "\u1e69", "s\u0323\u0307"},
+	// Blocked composition.
+	{"a\u0308\u0308", "\u00e4\u0308", "a\u0308\u0308", "\u00e4\u0308", "a\u0308\u0308"},
+	// Singletons and composition exclusions.
+	{"\u212b", "\u00c5", "A\u030a", "\u00c5", "A\u030a"},
+	{"\u2126", "\u03a9", "\u03a9", "\u03a9", "\u03a9"},
+	{"\u0958", "\u0915\u093c", "\u0915\u093c", "\u0915\u093c", "\u0915\u093c"},
+	{"\u1fd3", "\u0390", "\u03b9\u0308\u0301", "\u0390", "\u03b9\u0308\u0301"},
+	// Compatibility decompositions.
+	{"\ufb01", "\ufb01", "\ufb01", "fi", "fi"},
+	{"x\u00b2", "x\u00b2", "x\u00b2", "x2", "x2"},
+	{"\u1e9b\u0323", "\u1e9b\u0323", "\u017f\u0323\u0307", "\u1e69", "s\u0323\u0307"},
+	{"\uff76\uff9e", "\uff76\uff9e", "\uff76\uff9e", "\u30ac", "\u30ab\u3099"},
+	// Hangul.
+	{"\uac00", "\uac00", "\u1100\u1161", "\uac00", "\u1100\u1161"},
+	{"\uac01", "\uac01", "\u1100\u1161\u11a8", "\uac01", "\u1100\u1161\u11a8"},
+	{"\u1100\u1161\u11a8", "\uac01", "\u1100\u1161\u11a8", "\uac01", "\u1100\u1161\u11a8"},
+	{"\uac00\u11a8", "\uac01", "\u1100\u1161\u11a8", "\uac01", "\u1100\u1161\u11a8"},
+	// Starters that combine with a preceding starter.
+	{"\u0cc6\u0cc2", "\u0cca", "\u0cc6\u0cc2", "\u0cca", "\u0cc6\u0cc2"},
+	{"I\u0cc2\u0308", "I\u0cc2\u0308", "I\u0cc2\u0308", "I\u0cc2\u0308", "I\u0cc2\u0308"},
+	// Invalid UTF-8 is passed through.
+	{"e\xff\u0301", "e\xff\u0301", "e\xff\u0301", "e\xff\u0301", "e\xff\u0301"},
+	{"\xffe\u0301\xff", "\xff\u00e9\xff", "\xffe\u0301\xff", "\xff\u00e9\xff", "\xffe\u0301\xff"},
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range normTests {
+		want := []string{tt.nfc, tt.nfd, tt.nfkc, tt.nfkd}
+		for i, f := range forms {
+			if got := Normalize(f.form, tt.in); got != want[i] {
+				t.Errorf("Normalize(%s, %+q) = %+q; want %+q", f.name, tt.in, got, want[i])
+			}
+			if got := Normalize(f.form, []byte(tt.in)); string(got) != want[i] {
+				t.Errorf("Normalize(%s, []byte(%+q)) = %+q; want %+q", f.name, tt.in, got, want[i])
+			}
+			if got := IsNormal(f.form, tt.in); got != (tt.in == want[i]) {
+				t.Errorf("IsNormal(%s, %+q) = %v", f.name, tt.in, got)
+			}
+			if got := Normalize(f.form, want[i]); got != want[i] {
+				t.Errorf("Normalize(%s, %+q) is not idempotent: got %+q", f.name, want[i], got)
+			}
+		}
+	}
+}
+
+func TestAppend(t *testing.T) {
+	for _, tt := range normTests {
+		want := []string{tt.nfc, tt.nfd, tt.nfkc, tt.nfkd}
+		for i, f := range forms {
+			var b text.Builder[[]byte]
+			b.WriteString("prefix:")
+			Append(f.form, &b, tt.in)
+			if got := b.String(); got != "prefix:"+want[i] {
+				t.Errorf("Append(%s, %+q) wrote %+q; want %+q", f.name, tt.in, got, "prefix:"+want[i])
+			}
+		}
+	}
+}
+
+func TestNormalizeLong(t *testing.T) {
+	in := strings.Repeat("Ame\u0301lie \uac00\u11a8 ", 1000)
+	want := strings.Repeat("Am\u00e9lie \uac01 ", 1000)
+	if got := Normalize(NFC, in); got != want {
+		t.Errorf("Normalize(NFC, long input) differs")
+	}
+	if got := Normalize(NFD, Normalize(NFC, in)); got != Normalize(NFD, in) {
+		t.Errorf("NFD(NFC(s)) != NFD(s)")
+	}
+}
+
+func TestNormalizeNoAlloc(t *testing.T) {
+	ascii := strings.Repeat("The quick brown fox. ", 100)
+	composed := strings.Repeat("Am\u00e9lie ", 100)
+	for _, f := range forms {
+		n := testing.AllocsPerRun(10, func() {
+			Normalize(f.form, ascii)
+			IsNormal(f.form, ascii)
+		})
+		if n != 0 {
+			t.Errorf("%s: normalizing ASCII allocated %v times", f.name, n)
+		}
+	}
+	if n := testing.AllocsPerRun(10, func() { Normalize(NFC, composed) }); n != 0 {
+		t.Errorf("NFC: normalizing composed input allocated %v times", n)
+	}
+}
+
+func BenchmarkNormalizeASCII(b *testing.B) {
+	s := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 100)
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		Normalize(NFC, s)
+	}
+}
+
+func BenchmarkNormalizeNFD(b *testing.B) {
+	s := strings.Repeat("Amélie Poulain, café crème, 한국어 ", 100)
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		Normalize(NFD, s)
+	}
+}
@@ -0,0 +1,9977 @@
+// Code generated by running "go generate" in github.com/pgavlin/text/norm. DO NOT EDIT.
+
+package norm
+
+// UnicodeVersion is the Unicode edition from which the tables are derived.
+const UnicodeVersion = "15.0.0"
+
+// infoRunes holds the runes described by infoTable, in ascending order.
+var infoRunes = [...]rune{
+	0x00A0, 0x00A8, 0x00AA, 0x00AF, 0x00B2, 0x00B3, 0x00B4, 0x00B5,
+	0x00B8, 0x00B9, 0x00BA, 0x00BC, 0x00BD, 0x00BE, 0x00C0, 0x00C1,
+	0x00C2, 0x00C3, 0x00C4, 0x00C5, 0x00C7, 0x00C8, 0x00C9, 0x00CA,
+	0x00CB, 0x00CC, 0x00CD, 0x00CE, 0x00CF, 0x00D1, 0x00D2, 0x00D3,
+	0x00D4, 0x00D5, 0x00D6, 0x00D9, 0x00DA, 0x00DB, 0x00DC, 0x00DD,
+	0x00E0, 0x00E1, 0x00E2, 0x00E3, 0x00E4, 0x00E5, 0x00E7, 0x00E8,
+	0x00E9, 0x00EA, 0x00EB, 0x00EC, 0x00ED, 0x00EE, 0x00EF, 0x00F1,
+	0x00F2, 0x00F3, 0x00F4, 0x00F5, 0x00F6, 0x00F9, 0x00FA, 0x00FB,
+	0x00FC, 0x00FD, 0x00FF, 0x0100, 0x0101, 0x0102, 0x0103, 0x0104,
+	0x0105, 0x0106, 0x0107, 0x0108, 0x0109, 0x010A, 0x010B, 0x010C,
+	0x010D, 0x010E, 0x010F, 0x0112, 0x0113, 0x0114, 0x0115, 0x0116,
+	0x0117, 0x0118, 0x0119, 0x011A, 0x011B, 0x011C, 0x011D, 0x011E,
+	0x011F, 0x0120, 0x0121, 0x0122, 0x0123, 0x0124, 0x0125, 0x0128,
+	0x0129, 0x012A, 0x012B, 0x012C, 0x012D, 0x012E, 0x012F, 0x0130,
+	0x0132, 0x0133, 0x0134, 0x0135, 0x0136, 0x0137, 0x0139, 0x013A,
+	0x013B, 0x013C, 0x013D, 0x013E, 0x013F, 0x0140, 0x0143, 0x0144,
+	0x0145, 0x0146, 0x0147, 0x0148, 0x0149, 0x014C, 0x014D, 0x014E,
+	0x014F, 0x0150, 0x0151, 0x0154, 0x0155, 0x0156, 0x0157, 0x0158,
+	0x0159, 0x015A, 0x015B, 0x015C, 0x015D, 0x015E, 0x015F, 0x0160,
+	0x0161, 0x0162, 0x0163, 0x0164, 0x0165, 0x0168, 0x0169, 0x016A,
+	0x016B, 0x016C, 0x016D, 0x016E, 0x016F, 0x0170, 0x0171, 0x0172,
+	0x0173, 0x0174, 0x0175, 0x0176, 0x0177, 0x0178, 0x0179, 0x017A,
+	0x017B, 0x017C, 0x017D, 0x017E, 0x017F, 0x01A0, 0x01A1, 0x01AF,
+	0x01B0, 0x01C4, 0x01C5, 0x01C6, 0x01C7, 0x01C8, 0x01C9, 0x01CA,
+	0x01CB, 0x01CC, 0x01CD, 0x01CE, 0x01CF, 0x01D0, 0x01D1, 0x01D2,
+	0x01D3, 0x01D4, 0x01D5, 0x01D6, 0x01D7, 0x01D8, 0x01D9, 0x01DA,
+	0x01DB, 0x01DC, 0x01DE, 0x01DF, 0x01E0, 0x01E1, 0x01E2, 0x01E3,
+	0x01E6, 0x01E7, 0x01E8, 0x01E9, 0x01EA, 0x01EB, 0x01EC, 0x01ED,
+	0x01EE, 0x01EF, 0x01F0, 0x01F1, 0x01F2, 0x01F3, 0x01F4, 0x01F5,
+	0x01F8, 0x01F9, 0x01FA, 0x01FB, 0x01FC, 0x01FD, 0x01FE, 0x01FF,
+	0x0200, 0x0201, 0x0202, 0x0203, 0x0204, 0x0205, 0x0206, 0x0207,
+	0x0208, 0x0209, 0x020A, 0x020B, 0x020C, 0x020D, 0x020E, 0x020F,
+	0x0210, 0x0211, 0x0212, 0x0213, 0x0214, 0x0215, 0x0216, 0x0217,
+	0x0218, 0x0219, 0x021A, 0x021B, 0x021E, 0x021F, 0x0226, 0x0227,
+	0x0228, 0x0229, 0x022A, 0x022B, 0x022C, 0x022D, 0x022E, 0x022F,
+	0x0230, 0x0231, 0x0232, 0x0233, 0x02B0, 0x02B1, 0x02B2, 0x02B3,
+	0x02B4, 0x02B5, 0x02B6, 0x02B7, 0x02B8, 0x02D8, 0x02D9, 0x02DA,
+	0x02DB, 0x02DC, 0x02DD, 0x02E0, 0x02E1, 0x02E2, 0x02E3, 0x02E4,
+	0x0300, 0x0301, 0x0302, 0x0303, 0x0304, 0x0305, 0x0306, 0x0307,
+	0x0308, 0x0309, 0x030A, 0x030B, 0x030C, 0x030D, 0x030E, 0x030F,
+	0x0310, 0x0311, 0x0312, 0x0313, 0x0314, 0x0315, 0x0316, 0x0317,
+	0x0318, 0x0319, 0x031A, 0x031B, 0x031C, 0x031D, 0x031E, 0x031F,
+	0x0320, 0x0321, 0x0322, 0x0323, 0x0324, 0x0325, 0x0326, 0x0327,
+	0x0328, 0x0329, 0x032A, 0x032B, 0x032C, 0x032D, 0x032E, 0x032F,
+	0x0330, 0x0331, 0x0332, 0x0333, 0x0334, 0x0335, 0x0336, 0x0337,
+	0x0338, 0x0339, 0x033A, 0x033B, 0x033C, 0x033D, 0x033E, 0x033F,
+	0x0340, 0x0341, 0x0342, 0x0343, 0x0344, 0x0345, 0x0346, 0x0347,
+	0x0348, 0x0349, 0x034A, 0x034B, 0x034C, 0x034D, 0x034E, 0x0350,
+	0x0351, 0x0352, 0x0353, 0x0354, 0x0355, 0x0356, 0x0357, 0x0358,
+	0x0359, 0x035A, 0x035B, 0x035C, 0x035D, 0x035E, 0x035F, 0x0360,
+	0x0361, 0x0362, 0x0363, 0x0364, 0x0365, 0x0366, 0x0367, 0x0368,
+	0x0369, 0x036A, 0x036B, 0x036C, 0x036D, 0x036E, 0x036F, 0x0374,
+	0x037A, 0x037E, 0x0384, 0x0385, 0x0386, 0x0387, 0x0388, 0x0389,
+	0x038A, 0x038C, 0x038E, 0x038F, 0x0390, 0x03AA, 0x03AB, 0x03AC,
+	0x03AD, 0x03AE, 0x03AF, 0x03B0, 0x03CA, 0x03CB, 0x03CC, 0x03CD,
+	0x03CE, 0x03D0, 0x03D1, 0x03D2, 0x03D3, 0x03D4, 0x03D5, 0x03D6,
+	0x03F0, 0x03F1, 0x03F2, 0x03F4, 0x03F5, 0x03F9, 0x0400, 0x0401,
+	0x0403, 0x0407, 0x040C, 0x040D, 0x040E, 0x0419, 0x0439, 0x0450,
+	0x0451, 0x0453, 0x0457, 0x045C, 0x045D, 0x045E, 0x0476, 0x0477,
+	0x0483, 0x0484, 0x0485, 0x0486, 0x0487, 0x04C1, 0x04C2, 0x04D0,
+	0x04D1, 0x04D2, 0x04D3, 0x04D6, 0x04D7, 0x04DA, 0x04DB, 0x04DC,
+	0x04DD, 0x04DE, 0x04DF, 0x04E2, 0x04E3, 0x04E4, 0x04E5, 0x04E6,
+	0x04E7, 0x04EA, 0x04EB, 0x04EC, 0x04ED, 0x04EE, 0x04EF, 0x04F0,
+	0x04F1, 0x04F2, 0x04F3, 0x04F4, 0x04F5, 0x04F8, 0x04F9, 0x0587,
+	0x0591, 0x0592, 0x0593, 0x0594, 0x0595, 0x0596, 0x0597, 0x0598,
+	0x0599, 0x059A, 0x059B, 0x059C, 0x059D, 0x059E, 0x059F, 0x05A0,
+	0x05A1, 0x05A2, 0x05A3, 0x05A4, 0x05A5, 0x05A6, 0x05A7, 0x05A8,
+	0x05A9, 0x05AA, 0x05AB, 0x05AC, 0x05AD, 0x05AE, 0x05AF, 0x05B0,
+	0x05B1, 0x05B2, 0x05B3, 0x05B4, 0x05B5, 0x05B6, 0x05B7, 0x05B8,
+	0x05B9, 0x05BA, 0x05BB, 0x05BC, 0x05BD, 0x05BF, 0x05C1, 0x05C2,
+	0x05C4, 0x05C5, 0x05C7, 0x0610, 0x0611, 0x0612, 0x0613, 0x0614,
+	0x0615, 0x0616, 0x0617, 0x0618, 0x0619, 0x061A, 0x0622, 0x0623,
+	0x0624, 0x0625, 0x0626, 0x064B, 0x064C, 0x064D, 0x064E, 0x064F,
+	0x0650, 0x0651, 0x0652, 0x0653, 0x0654, 0x0655, 0x0656, 0x0657,
+	0x0658, 0x0659, 0x065A, 0x065B, 0x065C, 0x065D, 0x065E, 0x065F,
+	0x0670, 0x0675, 0x0676, 0x0677, 0x0678, 0x06C0, 0x06C2, 0x06D3,
+	0x06D6, 0x06D7, 0x06D8, 0x06D9, 0x06DA, 0x06DB, 0x06DC, 0x06DF,
+	0x06E0, 0x06E1, 0x06E2, 0x06E3, 0x06E4, 0x06E7, 0x06E8, 0x06EA,
+	0x06EB, 0x06EC, 0x06ED, 0x0711, 0x0730, 0x0731, 0x0732, 0x0733,
+	0x0734, 0x0735, 0x0736, 0x0737, 0x0738, 0x0739, 0x073A, 0x073B,
+	0x073C, 0x073D, 0x073E, 0x073F, 0x0740, 0x0741, 0x0742, 0x0743,
+	0x0744, 0x0745, 0x0746, 0x0747, 0x0748, 0x0749, 0x074A, 0x07EB,
+	0x07EC, 0x07ED, 0x07EE, 0x07EF, 0x07F0, 0x07F1, 0x07F2, 0x07F3,
+	0x07FD, 0x0816, 0x0817, 0x0818, 0x0819, 0x081B, 0x081C, 0x081D,
+	0x081E, 0x081F, 0x0820, 0x0821, 0x0822, 0x0823, 0x0825, 0x0826,
+	0x0827, 0x0829, 0x082A, 0x082B, 0x082C, 0x082D, 0x0859, 0x085A,
+	0x085B, 0x0898, 0x0899, 0x089A, 0x089B, 0x089C, 0x089D, 0x089E,
+	0x089F, 0x08CA, 0x08CB, 0x08CC, 0x08CD, 0x08CE, 0x08CF, 0x08D0,
+	0x08D1, 0x08D2, 0x08D3, 0x08D4, 0x08D5, 0x08D6, 0x08D7, 0x08D8,
+	0x08D9, 0x08DA, 0x08DB, 0x08DC, 0x08DD, 0x08DE, 0x08DF, 0x08E0,
+	0x08E1, 0x08E3, 0x08E4, 0x08E5, 0x08E6, 0x08E7, 0x08E8, 0x08E9,
+	0x08EA, 0x08EB, 0x08EC, 0x08ED, 0x08EE, 0x08EF, 0x08F0, 0x08F1,
+	0x08F2, 0x08F3, 0x08F4, 0x08F5, 0x08F6, 0x08F7, 0x08F8, 0x08F9,
+	0x08FA, 0x08FB, 0x08FC, 0x08FD, 0x08FE, 0x08FF, 0x0929, 0x0931,
+	0x0934, 0x093C, 0x094D, 0x0951, 0x0952, 0x0953, 0x0954, 0x0958,
+	0x0959, 0x095A, 0x095B, 0x095C, 0x095D, 0x095E, 0x095F, 0x09BC,
+	0x09BE, 0x09CB, 0x09CC, 0x09CD, 0x09D7, 0x09DC, 0x09DD, 0x09DF,
+	0x09FE, 0x0A33, 0x0A36, 0x0A3C, 0x0A4D, 0x0A59, 0x0A5A, 0x0A5B,
+	0x0A5E, 0x0ABC, 0x0ACD, 0x0B3C, 0x0B3E, 0x0B48, 0x0B4B, 0x0B4C,
+	0x0B4D, 0x0B56, 0x0B57, 0x0B5C, 0x0B5D, 0x0B94, 0x0BBE, 0x0BCA,
+	0x0BCB, 0x0BCC, 0x0BCD, 0x0BD7, 0x0C3C, 0x0C48, 0x0C4D, 0x0C55,
+	0x0C56, 0x0CBC, 0x0CC0, 0x0CC2, 0x0CC7, 0x0CC8, 0x0CCA, 0x0CCB,
+	0x0CCD, 0x0CD5, 0x0CD6, 0x0D3B, 0x0D3C, 0x0D3E, 0x0D4A, 0x0D4B,
+	0x0D4C, 0x0D4D, 0x0D57, 0x0DCA, 0x0DCF, 0x0DDA, 0x0DDC, 0x0DDD,
+	0x0DDE, 0x0DDF, 0x0E33, 0x0E38, 0x0E39, 0x0E3A, 0x0E48, 0x0E49,
+	0x0E4A, 0x0E4B, 0x0EB3, 0x0EB8, 0x0EB9, 0x0EBA, 0x0EC8, 0x0EC9,
+	0x0ECA, 0x0ECB, 0x0EDC, 0x0EDD, 0x0F0C, 0x0F18, 0x0F19, 0x0F35,
+	0x0F37, 0x0F39, 0x0F43, 0x0F4D, 0x0F52, 0x0F57, 0x0F5C, 0x0F69,
+	0x0F71, 0x0F72, 0x0F73, 0x0F74, 0x0F75, 0x0F76, 0x0F77, 0x0F78,
+	0x0F79, 0x0F7A, 0x0F7B, 0x0F7C, 0x0F7D, 0x0F80, 0x0F81, 0x0F82,
+	0x0F83, 0x0F84, 0x0F86, 0x0F87, 0x0F93, 0x0F9D, 0x0FA2, 0x0FA7,
+	0x0FAC, 0x0FB9, 0x0FC6, 0x1026, 0x102E, 0x1037, 0x1039, 0x103A,
+	0x108D, 0x10FC, 0x1161, 0x1162, 0x1163, 0x1164, 0x1165, 0x1166,
+	0x1167, 0x1168, 0x1169, 0x116A, 0x116B, 0x116C, 0x116D, 0x116E,
+	0x116F, 0x1170, 0x1171, 0x1172, 0x1173, 0x1174, 0x1175, 0x11A8,
+	0x11A9, 0x11AA, 0x11AB, 0x11AC, 0x11AD, 0x11AE, 0x11AF, 0x11B0,
+	0x11B1, 0x11B2, 0x11B3, 0x11B4, 0x11B5, 0x11B6, 0x11B7, 0x11B8,
+	0x11B9, 0x11BA, 0x11BB, 0x11BC, 0x11BD, 0x11BE, 0x11BF, 0x11C0,
+	0x11C1, 0x11C2, 0x135D, 0x135E, 0x135F, 0x1714, 0x1715, 0x1734,
+	0x17D2, 0x17DD, 0x18A9, 0x1939, 0x193A, 0x193B, 0x1A17, 0x1A18,
+	0x1A60, 0x1A75, 0x1A76, 0x1A77, 0x1A78, 0x1A79, 0x1A7A, 0x1A7B,
+	0x1A7C, 0x1A7F, 0x1AB0, 0x1AB1, 0x1AB2, 0x1AB3, 0x1AB4, 0x1AB5,
+	0x1AB6, 0x1AB7, 0x1AB8, 0x1AB9, 0x1ABA, 0x1ABB, 0x1ABC, 0x1ABD,
+	0x1ABF, 0x1AC0, 0x1AC1, 0x1AC2, 0x1AC3, 0x1AC4, 0x1AC5, 0x1AC6,
+	0x1AC7, 0x1AC8, 0x1AC9, 0x1ACA, 0x1ACB, 0x1ACC, 0x1ACD, 0x1ACE,
+	0x1B06, 0x1B08, 0x1B0A, 0x1B0C, 0x1B0E, 0x1B12, 0x1B34, 0x1B35,
+	0x1B3B, 0x1B3D, 0x1B40, 0x1B41, 0x1B43, 0x1B44, 0x1B6B, 0x1B6C,
+	0x1B6D, 0x1B6E, 0x1B6F, 0x1B70, 0x1B71, 0x1B72, 0x1B73, 0x1BAA,
+	0x1BAB, 0x1BE6, 0x1BF2, 0x1BF3, 0x1C37, 0x1CD0, 0x1CD1, 0x1CD2,
+	0x1CD4, 0x1CD5, 0x1CD6, 0x1CD7, 0x1CD8, 0x1CD9, 0x1CDA, 0x1CDB,
+	0x1CDC, 0x1CDD, 0x1CDE, 0x1CDF, 0x1CE0, 0x1CE2, 0x1CE3, 0x1CE4,
+	0x1CE5, 0x1CE6, 0x1CE7, 0x1CE8, 0x1CED, 0x1CF4, 0x1CF8, 0x1CF9,
+	0x1D2C, 0x1D2D, 0x1D2E, 0x1D30, 0x1D31, 0x1D32, 0x1D33, 0x1D34,
+	0x1D35, 0x1D36, 0x1D37, 0x1D38, 0x1D39, 0x1D3A, 0x1D3C, 0x1D3D,
+	0x1D3E, 0x1D3F, 0x1D40, 0x1D41, 0x1D42, 0x1D43, 0x1D44, 0x1D45,
+	0x1D46, 0x1D47, 0x1D48, 0x1D49, 0x1D4A, 0x1D4B, 0x1D4C, 0x1D4D,
+	0x1D4F, 0x1D50, 0x1D51, 0x1D52, 0x1D53, 0x1D54, 0x1D55, 0x1D56,
+	0x1D57, 0x1D58, 0x1D59, 0x1D5A, 0x1D5B, 0x1D5C, 0x1D5D, 0x1D5E,
+	0x1D5F, 0x1D60, 0x1D61, 0x1D62, 0x1D63, 0x1D64, 0x1D65, 0x1D66,
+	0x1D67, 0x1D68, 0x1D69, 0x1D6A, 0x1D78, 0x1D9B, 0x1D9C, 0x1D9D,
+	0x1D9E, 0x1D9F, 0x1DA0, 0x1DA1, 0x1DA2, 0x1DA3, 0x1DA4, 0x1DA5,
+	0x1DA6, 0x1DA7, 0x1DA8, 0x1DA9, 0x1DAA, 0x1DAB, 0x1DAC, 0x1DAD,
+	0x1DAE, 0x1DAF, 0x1DB0, 0x1DB1, 0x1DB2, 0x1DB3, 0x1DB4, 0x1DB5,
+	0x1DB6, 0x1DB7, 0x1DB8, 0x1DB9, 0x1DBA, 0x1DBB, 0x1DBC, 0x1DBD,
+	0x1DBE, 0x1DBF, 0x1DC0, 0x1DC1, 0x1DC2, 0x1DC3, 0x1DC4, 0x1DC5,
+	0x1DC6, 0x1DC7, 0x1DC8, 0x1DC9, 0x1DCA, 0x1DCB, 0x1DCC, 0x1DCD,
+	0x1DCE, 0x1DCF, 0x1DD0, 0x1DD1, 0x1DD2, 0x1DD3, 0x1DD4, 0x1DD5,
+	0x1DD6, 0x1DD7, 0x1DD8, 0x1DD9, 0x1DDA, 0x1DDB, 0x1DDC, 0x1DDD,
+	0x1DDE, 0x1DDF, 0x1DE0, 0x1DE1, 0x1DE2, 0x1DE3, 0x1DE4, 0x1DE5,
+	0x1DE6, 0x1DE7, 0x1DE8, 0x1DE9, 0x1DEA, 0x1DEB, 0x1DEC, 0x1DED,
+	0x1DEE, 0x1DEF, 0x1DF0, 0x1DF1, 0x1DF2, 0x1DF3, 0x1DF4, 0x1DF5,
+	0x1DF6, 0x1DF7, 0x1DF8, 0x1DF9, 0x1DFA, 0x1DFB, 0x1DFC, 0x1DFD,
+	0x1DFE, 0x1DFF, 0x1E00, 0x1E01, 0x1E02, 0x1E03, 0x1E04, 0x1E05,
+	0x1E06, 0x1E07, 0x1E08, 0x1E09, 0x1E0A, 0x1E0B, 0x1E0C, 0x1E0D,
+	0x1E0E, 0x1E0F, 0x1E10, 0x1E11, 0x1E12, 0x1E13, 0x1E14, 0x1E15,
+	0x1E16, 0x1E17, 0x1E18, 0x1E19, 0x1E1A, 0x1E1B, 0x1E1C, 0x1E1D,
+	0x1E1E, 0x1E1F, 0x1E20, 0x1E21, 0x1E22, 0x1E23, 0x1E24, 0x1E25,
+	0x1E26, 0x1E27, 0x1E28, 0x1E29, 0x1E2A, 0x1E2B, 0x1E2C, 0x1E2D,
+	0x1E2E, 0x1E2F, 0x1E30, 0x1E31, 0x1E32, 0x1E33, 0x1E34, 0x1E35,
+	0x1E36, 0x1E37, 0x1E38, 0x1E39, 0x1E3A, 0x1E3B, 0x1E3C, 0x1E3D,
+	0x1E3E, 0x1E3F, 0x1E40, 0x1E41, 0x1E42, 0x1E43, 0x1E44, 0x1E45,
+	0x1E46, 0x1E47, 0x1E48, 0x1E49, 0x1E4A, 0x1E4B, 0x1E4C, 0x1E4D,
+	0x1E4E, 0x1E4F, 0x1E50, 0x1E51, 0x1E52, 0x1E53, 0x1E54, 0x1E55,
+	0x1E56, 0x1E57, 0x1E58, 0x1E59, 0x1E5A, 0x1E5B, 0x1E5C, 0x1E5D,
+	0x1E5E, 0x1E5F, 0x1E60, 0x1E61, 0x1E62, 0x1E63, 0x1E64, 0x1E65,
+	0x1E66, 0x1E67, 0x1E68, 0x1E69, 0x1E6A, 0x1E6B, 0x1E6C, 0x1E6D,
+	0x1E6E, 0x1E6F, 0x1E70, 0x1E71, 0x1E72, 0x1E73, 0x1E74, 0x1E75,
+	0x1E76, 0x1E77, 0x1E78, 0x1E79, 0x1E7A, 0x1E7B, 0x1E7C, 0x1E7D,
+	0x1E7E, 0x1E7F, 0x1E80, 0x1E81, 0x1E82, 0x1E83, 0x1E84, 0x1E85,
+	0x1E86, 0x1E87, 0x1E88, 0x1E89, 0x1E8A, 0x1E8B, 0x1E8C, 0x1E8D,
+	0x1E8E, 0x1E8F, 0x1E90, 0x1E91, 0x1E92, 0x1E93, 0x1E94, 0x1E95,
+	0x1E96, 0x1E97, 0x1E98, 0x1E99, 0x1E9A, 0x1E9B, 0x1EA0, 0x1EA1,
+	0x1EA2, 0x1EA3, 0x1EA4, 0x1EA5, 0x1EA6, 0x1EA7, 0x1EA8, 0x1EA9,
+	0x1EAA, 0x1EAB, 0x1EAC, 0x1EAD, 0x1EAE, 0x1EAF, 0x1EB0, 0x1EB1,
+	0x1EB2, 0x1EB3, 0x1EB4, 0x1EB5, 0x1EB6, 0x1EB7, 0x1EB8, 0x1EB9,
+	0x1EBA, 0x1EBB, 0x1EBC, 0x1EBD, 0x1EBE, 0x1EBF, 0x1EC0, 0x1EC1,
+	0x1EC2, 0x1EC3, 0x1EC4, 0x1EC5, 0x1EC6, 0x1EC7, 0x1EC8, 0x1EC9,
+	0x1ECA, 0x1ECB, 0x1ECC, 0x1ECD, 0x1ECE, 0x1ECF, 0x1ED0, 0x1ED1,
+	0x1ED2, 0x1ED3, 0x1ED4, 0x1ED5, 0x1ED6, 0x1ED7, 0x1ED8, 0x1ED9,
+	0x1EDA, 0x1EDB, 0x1EDC, 0x1EDD, 0x1EDE, 0x1EDF, 0x1EE0, 0x1EE1,
+	0x1EE2, 0x1EE3, 0x1EE4, 0x1EE5, 0x1EE6, 0x1EE7, 0x1EE8, 0x1EE9,
+	0x1EEA, 0x1EEB, 0x1EEC, 0x1EED, 0x1EEE, 0x1EEF, 0x1EF0, 0x1EF1,
+	0x1EF2, 0x1EF3, 0x1EF4, 0x1EF5, 0x1EF6, 0x1EF7, 0x1EF8, 0x1EF9,
+	0x1F00, 0x1F01, 0x1F02, 0x1F03, 0x1F04, 0x1F05, 0x1F06, 0x1F07,
+	0x1F08, 0x1F09, 0x1F0A, 0x1F0B, 0x1F0C, 0x1F0D, 0x1F0E, 0x1F0F,
+	0x1F10, 0x1F11, 0x1F12, 0x1F13, 0x1F14, 0x1F15, 0x1F18, 0x1F19,
+	0x1F1A, 0x1F1B, 0x1F1C, 0x1F1D, 0x1F20, 0x1F21, 0x1F22, 0x1F23,
+	0x1F24, 0x1F25, 0x1F26, 0x1F27, 0x1F28, 0x1F29, 0x1F2A, 0x1F2B,
+	0x1F2C, 0x1F2D, 0x1F2E, 0x1F2F, 0x1F30, 0x1F31, 0x1F32, 0x1F33,
+	0x1F34, 0x1F35, 0x1F36, 0x1F37, 0x1F38, 0x1F39, 0x1F3A, 0x1F3B,
+	0x1F3C, 0x1F3D, 0x1F3E, 0x1F3F, 0x1F40, 0x1F41, 0x1F42, 0x1F43,
+	0x1F44, 0x1F45, 0x1F48, 0x1F49, 0x1F4A, 0x1F4B, 0x1F4C, 0x1F4D,
+	0x1F50, 0x1F51, 0x1F52, 0x1F53, 0x1F54, 0x1F55, 0x1F56, 0x1F57,
+	0x1F59, 0x1F5B, 0x1F5D, 0x1F5F, 0x1F60, 0x1F61, 0x1F62, 0x1F63,
+	0x1F64, 0x1F65, 0x1F66, 0x1F67, 0x1F68, 0x1F69, 0x1F6A, 0x1F6B,
+	0x1F6C, 0x1F6D, 0x1F6E, 0x1F6F, 0x1F70, 0x1F71, 0x1F72, 0x1F73,
+	0x1F74, 0x1F75, 0x1F76, 0x1F77, 0x1F78, 0x1F79, 0x1F7A, 0x1F7B,
+	0x1F7C, 0x1F7D, 0x1F80, 0x1F81, 0x1F82, 0x1F83, 0x1F84, 0x1F85,
+	0x1F86, 0x1F87, 0x1F88, 0x1F89, 0x1F8A, 0x1F8B, 0x1F8C, 0x1F8D,
+	0x1F8E, 0x1F8F, 0x1F90, 0x1F91, 0x1F92, 0x1F93, 0x1F94, 0x1F95,
+	0x1F96, 0x1F97, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D,
+	0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1FA2, 0x1FA3, 0x1FA4, 0x1FA5,
+	0x1FA6, 0x1FA7, 0x1FA8, 0x1FA9, 0x1FAA, 0x1FAB, 0x1FAC, 0x1FAD,
+	0x1FAE, 0x1FAF, 0x1FB0, 0x1FB1, 0x1FB2, 0x1FB3, 0x1FB4, 0x1FB6,
+	0x1FB7, 0x1FB8, 0x1FB9, 0x1FBA, 0x1FBB, 0x1FBC, 0x1FBD, 0x1FBE,
+	0x1FBF, 0x1FC0, 0x1FC1, 0x1FC2, 0x1FC3, 0x1FC4, 0x1FC6, 0x1FC7,
+	0x1FC8, 0x1FC9, 0x1FCA, 0x1FCB, 0x1FCC, 0x1FCD, 0x1FCE, 0x1FCF,
+	0x1FD0, 0x1FD1, 0x1FD2, 0x1FD3, 0x1FD6, 0x1FD7, 0x1FD8, 0x1FD9,
+	0x1FDA, 0x1FDB, 0x1FDD, 0x1FDE, 0x1FDF, 0x1FE0, 0x1FE1, 0x1FE2,
+	0x1FE3, 0x1FE4, 0x1FE5, 0x1FE6, 0x1FE7, 0x1FE8, 0x1FE9, 0x1FEA,
+	0x1FEB, 0x1FEC, 0x1FED, 0x1FEE, 0x1FEF, 0x1FF2, 0x1FF3, 0x1FF4,
+	0x1FF6, 0x1FF7, 0x1FF8, 0x1FF9, 0x1FFA, 0x1FFB, 0x1FFC, 0x1FFD,
+	0x1FFE, 0x2000, 0x2001, 0x2002, 0x2003, 0x2004, 0x2005, 0x2006,
+	0x2007, 0x2008, 0x2009, 0x200A, 0x2011, 0x2017, 0x2024, 0x2025,
+	0x2026, 0x202F, 0x2033, 0x2034, 0x2036, 0x2037, 0x203C, 0x203E,
+	0x2047, 0x2048, 0x2049, 0x2057, 0x205F, 0x2070, 0x2071, 0x2074,
+	0x2075, 0x2076, 0x2077, 0x2078, 0x2079, 0x207A, 0x207B, 0x207C,
+	0x207D, 0x207E, 0x207F, 0x2080, 0x2081, 0x2082, 0x2083, 0x2084,
+	0x2085, 0x2086, 0x2087, 0x2088, 0x2089, 0x208A, 0x208B, 0x208C,
+	0x208D, 0x208E, 0x2090, 0x2091, 0x2092, 0x2093, 0x2094, 0x2095,
+	0x2096, 0x2097, 0x2098, 0x2099, 0x209A, 0x209B, 0x209C, 0x20A8,
+	0x20D0, 0x20D1, 0x20D2, 0x20D3, 0x20D4, 0x20D5, 0x20D6, 0x20D7,
+	0x20D8, 0x20D9, 0x20DA, 0x20DB, 0x20DC, 0x20E1, 0x20E5, 0x20E6,
+	0x20E7, 0x20E8, 0x20E9, 0x20EA, 0x20EB, 0x20EC, 0x20ED, 0x20EE,
+	0x20EF, 0x20F0, 0x2100, 0x2101, 0x2102, 0x2103, 0x2105, 0x2106,
+	0x2107, 0x2109, 0x210A, 0x210B, 0x210C, 0x210D, 0x210E, 0x210F,
+	0x2110, 0x2111, 0x2112, 0x2113, 0x2115, 0x2116, 0x2119, 0x211A,
+	0x211B, 0x211C, 0x211D, 0x2120, 0x2121, 0x2122, 0x2124, 0x2126,
+	0x2128, 0x212A, 0x212B, 0x212C, 0x212D, 0x212F, 0x2130, 0x2131,
+	0x2133, 0x2134, 0x2135, 0x2136, 0x2137, 0x2138, 0x2139, 0x213B,
+	0x213C, 0x213D, 0x213E, 0x213F, 0x2140, 0x2145, 0x2146, 0x2147,
+	0x2148, 0x2149, 0x2150, 0x2151, 0x2152, 0x2153, 0x2154, 0x2155,
+	0x2156, 0x2157, 0x2158, 0x2159, 0x215A, 0x215B, 0x215C, 0x215D,
+	0x215E, 0x215F, 0x2160, 0x2161, 0x2162, 0x2163, 0x2164, 0x2165,
+	0x2166, 0x2167, 0x2168, 0x2169, 0x216A, 0x216B, 0x216C, 0x216D,
+	0x216E, 0x216F, 0x2170, 0x2171, 0x2172, 0x2173, 0x2174, 0x2175,
+	0x2176, 0x2177, 0x2178, 0x2179, 0x217A, 0x217B, 0x217C, 0x217D,
+	0x217E, 0x217F, 0x2189, 0x219A, 0x219B, 0x21AE, 0x21CD, 0x21CE,
+	0x21CF, 0x2204, 0x2209, 0x220C, 0x2224, 0x2226, 0x222C, 0x222D,
+	0x222F, 0x2230, 0x2241, 0x2244, 0x2247, 0x2249, 0x2260, 0x2262,
+	0x226D, 0x226E, 0x226F, 0x2270, 0x2271, 0x2274, 0x2275, 0x2278,
+	0x2279, 0x2280, 0x2281, 0x2284, 0x2285, 0x2288, 0x2289, 0x22AC,
+	0x22AD, 0x22AE, 0x22AF, 0x22E0, 0x22E1, 0x22E2, 0x22E3, 0x22EA,
+	0x22EB, 0x22EC, 0x22ED, 0x2329, 0x232A, 0x2460, 0x2461, 0x2462,
+	0x2463, 0x2464, 0x2465, 0x2466, 0x2467, 0x2468, 0x2469, 0x246A,
+	0x246B, 0x246C, 0x246D, 0x246E, 0x246F, 0x2470, 0x2471, 0x2472,
+	0x2473, 0x2474, 0x2475, 0x2476, 0x2477, 0x2478, 0x2479, 0x247A,
+	0x247B, 0x247C, 0x247D, 0x247E, 0x247F, 0x2480, 0x2481, 0x2482,
+	0x2483, 0x2484, 0x2485, 0x2486, 0x2487, 0x2488, 0x2489, 0x248A,
+	0x248B, 0x248C, 0x248D, 0x248E, 0x248F, 0x2490, 0x2491, 0x2492,
+	0x2493, 0x2494, 0x2495, 0x2496, 0x2497, 0x2498, 0x2499, 0x249A,
+	0x249B, 0x249C, 0x249D, 0x249E, 0x249F, 0x24A0, 0x24A1, 0x24A2,
+	0x24A3, 0x24A4, 0x24A5, 0x24A6, 0x24A7, 0x24A8, 0x24A9, 0x24AA,
+	0x24AB, 0x24AC, 0x24AD, 0x24AE, 0x24AF, 0x24B0, 0x24B1, 0x24B2,
+	0x24B3, 0x24B4, 0x24B5, 0x24B6, 0x24B7, 0x24B8, 0x24B9, 0x24BA,
+	0x24BB, 0x24BC, 0x24BD, 0x24BE, 0x24BF, 0x24C0, 0x24C1, 0x24C2,
+	0x24C3, 0x24C4, 0x24C5, 0x24C6, 0x24C7, 0x24C8, 0x24C9, 0x24CA,
+	0x24CB, 0x24CC, 0x24CD, 0x24CE, 0x24CF, 0x24D0, 0x24D1, 0x24D2,
+	0x24D3, 0x24D4, 0x24D5, 0x24D6, 0x24D7, 0x24D8, 0x24D9, 0x24DA,
+	0x24DB, 0x24DC, 0x24DD, 0x24DE, 0x24DF, 0x24E0, 0x24E1, 0x24E2,
+	0x24E3, 0x24E4, 0x24E5, 0x24E6, 0x24E7, 0x24E8, 0x24E9, 0x24EA,
+	0x2A0C, 0x2A74, 0x2A75, 0x2A76, 0x2ADC, 0x2C7C, 0x2C7D, 0x2CEF,
+	0x2CF0, 0x2CF1, 0x2D6F, 0x2D7F, 0x2DE0, 0x2DE1, 0x2DE2, 0x2DE3,
+	0x2DE4, 0x2DE5, 0x2DE6, 0x2DE7, 0x2DE8, 0x2DE9, 0x2DEA, 0x2DEB,
+	0x2DEC, 0x2DED, 0x2DEE, 0x2DEF, 0x2DF0, 0x2DF1, 0x2DF2, 0x2DF3,
+	0x2DF4, 0x2DF5, 0x2DF6, 0x2DF7, 0x2DF8, 0x2DF9, 0x2DFA, 0x2DFB,
+	0x2DFC, 0x2DFD, 0x2DFE, 0x2DFF, 0x2E9F, 0x2EF3, 0x2F00, 0x2F01,
+	0x2F02, 0x2F03, 0x2F04, 0x2F05, 0x2F06, 0x2F07, 0x2F08, 0x2F09,
+	0x2F0A, 0x2F0B, 0x2F0C, 0x2F0D, 0x2F0E, 0x2F0F, 0x2F10, 0x2F11,
+	0x2F12, 0x2F13, 0x2F14, 0x2F15, 0x2F16, 0x2F17, 0x2F18, 0x2F19,
+	0x2F1A, 0x2F1B, 0x2F1C, 0x2F1D, 0x2F1E, 0x2F1F, 0x2F20, 0x2F21,
+	0x2F22, 0x2F23, 0x2F24, 0x2F25, 0x2F26, 0x2F27, 0x2F28, 0x2F29,
+	0x2F2A, 0x2F2B, 0x2F2C, 0x2F2D, 0x2F2E, 0x2F2F, 0x2F30, 0x2F31,
+	0x2F32, 0x2F33, 0x2F34, 0x2F35, 0x2F36, 0x2F37, 0x2F38, 0x2F39,
+	0x2F3A, 0x2F3B, 0x2F3C, 0x2F3D, 0x2F3E, 0x2F3F, 0x2F40, 0x2F41,
+	0x2F42, 0x2F43, 0x2F44, 0x2F45, 0x2F46, 0x2F47, 0x2F48, 0x2F49,
+	0x2F4A, 0x2F4B, 0x2F4C, 0x2F4D, 0x2F4E, 0x2F4F, 0x2F50, 0x2F51,
+	0x2F52, 0x2F53, 0x2F54, 0x2F55, 0x2F56, 0x2F57, 0x2F58, 0x2F59,
+	0x2F5A, 0x2F5B, 0x2F5C, 0x2F5D, 0x2F5E, 0x2F5F, 0x2F60, 0x2F61,
+	0x2F62, 0x2F63, 0x2F64, 0x2F65, 0x2F66, 0x2F67, 0x2F68, 0x2F69,
+	0x2F6A, 0x2F6B, 0x2F6C, 0x2F6D, 0x2F6E, 0x2F6F, 0x2F70, 0x2F71,
+	0x2F72, 0x2F73, 0x2F74, 0x2F75, 0x2F76, 0x2F77, 0x2F78, 0x2F79,
+	0x2F7A, 0x2F7B, 0x2F7C, 0x2F7D, 0x2F7E, 0x2F7F, 0x2F80, 0x2F81,
+	0x2F82, 0x2F83, 0x2F84, 0x2F85, 0x2F86, 0x2F87, 0x2F88, 0x2F89,
+	0x2F8A, 0x2F8B, 0x2F8C, 0x2F8D, 0x2F8E, 0x2F8F, 0x2F90, 0x2F91,
+	0x2F92, 0x2F93, 0x2F94, 0x2F95, 0x2F96, 0x2F97, 0x2F98, 0x2F99,
+	0x2F9A, 0x2F9B, 0x2F9C, 0x2F9D, 0x2F9E, 0x2F9F, 0x2FA0, 0x2FA1,
+	0x2FA2, 0x2FA3, 0x2FA4, 0x2FA5, 0x2FA6, 0x2FA7, 0x2FA8, 0x2FA9,
+	0x2FAA, 0x2FAB, 0x2FAC, 0x2FAD, 0x2FAE, 0x2FAF, 0x2FB0, 0x2FB1,
+	0x2FB2, 0x2FB3, 0x2FB4, 0x2FB5, 0x2FB6, 0x2FB7, 0x2FB8, 0x2FB9,
+	0x2FBA, 0x2FBB, 0x2FBC, 0x2FBD, 0x2FBE, 0x2FBF, 0x2FC0, 0x2FC1,
+	0x2FC2, 0x2FC3, 0x2FC4, 0x2FC5, 0x2FC6, 0x2FC7, 0x2FC8, 0x2FC9,
+	0x2FCA, 0x2FCB, 0x2FCC, 0x2FCD, 0x2FCE, 0x2FCF, 0x2FD0, 0x2FD1,
+	0x2FD2, 0x2FD3, 0x2FD4, 0x2FD5, 0x3000, 0x302A, 0x302B, 0x302C,
+	0x302D, 0x302E, 0x302F, 0x3036, 0x3038, 0x3039, 0x303A, 0x304C,
+	0x304E, 0x3050, 0x3052, 0x3054, 0x3056, 0x3058, 0x305A, 0x305C,
+	0x305E, 0x3060, 0x3062, 0x3065, 0x3067, 0x3069, 0x3070, 0x3071,
+	0x3073, 0x3074, 0x3076, 0x3077, 0x3079, 0x307A, 0x307C, 0x307D,
+	0x3094, 0x3099, 0x309A, 0x309B, 0x309C, 0x309E, 0x309F, 0x30AC,
+	0x30AE, 0x30B0, 0x30B2, 0x30B4, 0x30B6, 0x30B8, 0x30BA, 0x30BC,
+	0x30BE, 0x30C0, 0x30C2, 0x30C5, 0x30C7, 0x30C9, 0x30D0, 0x30D1,
+	0x30D3, 0x30D4, 0x30D6, 0x30D7, 0x30D9, 0x30DA, 0x30DC, 0x30DD,
+	0x30F4, 0x30F7, 0x30F8, 0x30F9, 0x30FA, 0x30FE, 0x30FF, 0x3131,
+	0x3132, 0x3133, 0x3134, 0x3135, 0x3136, 0x3137, 0x3138, 0x3139,
+	0x313A, 0x313B, 0x313C, 0x313D, 0x313E, 0x313F, 0x3140, 0x3141,
+	0x3142, 0x3143, 0x3144, 0x3145, 0x3146, 0x3147, 0x3148, 0x3149,
+	0x314A, 0x314B, 0x314C, 0x314D, 0x314E, 0x314F, 0x3150, 0x3151,
+	0x3152, 0x3153, 0x3154, 0x3155, 0x3156, 0x3157, 0x3158, 0x3159,
+	0x315A, 0x315B, 0x315C, 0x315D, 0x315E, 0x315F, 0x3160, 0x3161,
+	0x3162, 0x3163, 0x3164, 0x3165, 0x3166, 0x3167, 0x3168, 0x3169,
+	0x316A, 0x316B, 0x316C, 0x316D, 0x316E, 0x316F, 0x3170, 0x3171,
+	0x3172, 0x3173, 0x3174, 0x3175, 0x3176, 0x3177, 0x3178, 0x3179,
+	0x317A, 0x317B, 0x317C, 0x317D, 0x317E, 0x317F, 0x3180, 0x3181,
+	0x3182, 0x3183, 0x3184, 0x3185, 0x3186, 0x3187, 0x3188, 0x3189,
+	0x318A, 0x318B, 0x318C, 0x318D, 0x318E, 0x3192, 0x3193, 0x3194,
+	0x3195, 0x3196, 0x3197, 0x3198, 0x3199, 0x319A, 0x319B, 0x319C,
+	0x319D, 0x319E, 0x319F, 0x3200, 0x3201, 0x3202, 0x3203, 0x3204,
+	0x3205, 0x3206, 0x3207, 0x3208, 0x3209, 0x320A, 0x320B, 0x320C,
+	0x320D, 0x320E, 0x320F, 0x3210, 0x3211, 0x3212, 0x3213, 0x3214,
+	0x3215, 0x3216, 0x3217, 0x3218, 0x3219, 0x321A, 0x321B, 0x321C,
+	0x321D, 0x321E, 0x3220, 0x3221, 0x3222, 0x3223, 0x3224, 0x3225,
+	0x3226, 0x3227, 0x3228, 0x3229, 0x322A, 0x322B, 0x322C, 0x322D,
+	0x322E, 0x322F, 0x3230, 0x3231, 0x3232, 0x3233, 0x3234, 0x3235,
+	0x3236, 0x3237, 0x3238, 0x3239, 0x323A, 0x323B, 0x323C, 0x323D,
+	0x323E, 0x323F, 0x3240, 0x3241, 0x3242, 0x3243, 0x3244, 0x3245,
+	0x3246, 0x3247, 0x3250, 0x3251, 0x3252, 0x3253, 0x3254, 0x3255,
+	0x3256, 0x3257, 0x3258, 0x3259, 0x325A, 0x325B, 0x325C, 0x325D,
+	0x325E, 0x325F, 0x3260, 0x3261, 0x3262, 0x3263, 0x3264, 0x3265,
+	0x3266, 0x3267, 0x3268, 0x3269, 0x326A, 0x326B, 0x326C, 0x326D,
+	0x326E, 0x326F, 0x3270, 0x3271, 0x3272, 0x3273, 0x3274, 0x3275,
+	0x3276, 0x3277, 0x3278, 0x3279, 0x327A, 0x327B, 0x327C, 0x327D,
+	0x327E, 0x3280, 0x3281, 0x3282, 0x3283, 0x3284, 0x3285, 0x3286,
+	0x3287, 0x3288, 0x3289, 0x328A, 0x328B, 0x328C, 0x328D, 0x328E,
+	0x328F, 0x3290, 0x3291, 0x3292, 0x3293, 0x3294, 0x3295, 0x3296,
+	0x3297, 0x3298, 0x3299, 0x329A, 0x329B, 0x329C, 0x329D, 0x329E,
+	0x329F, 0x32A0, 0x32A1, 0x32A2, 0x32A3, 0x32A4, 0x32A5, 0x32A6,
+	0x32A7, 0x32A8, 0x32A9, 0x32AA, 0x32AB, 0x32AC, 0x32AD, 0x32AE,
+	0x32AF, 0x32B0, 0x32B1, 0x32B2, 0x32B3, 0x32B4, 0x32B5, 0x32B6,
+	0x32B7, 0x32B8, 0x32B9, 0x32BA, 0x32BB, 0x32BC, 0x32BD, 0x32BE,
+	0x32BF, 0x32C0, 0x32C1, 0x32C2, 0x32C3, 0x32C4, 0x32C5, 0x32C6,
+	0x32C7, 0x32C8, 0x32C9, 0x32CA, 0x32CB, 0x32CC, 0x32CD, 0x32CE,
+	0x32CF, 0x32D0, 0x32D1, 0x32D2, 0x32D3, 0x32D4, 0x32D5, 0x32D6,
+	0x32D7, 0x32D8, 0x32D9, 0x32DA, 0x32DB, 0x32DC, 0x32DD, 0x32DE,
+	0x32DF, 0x32E0, 0x32E1, 0x32E2, 0x32E3, 0x32E4, 0x32E5, 0x32E6,
+	0x32E7, 0x32E8, 0x32E9, 0x32EA, 0x32EB, 0x32EC, 0x32ED, 0x32EE,
+	0x32EF, 0x32F0, 0x32F1, 0x32F2, 0x32F3, 0x32F4, 0x32F5, 0x32F6,
+	0x32F7, 0x32F8, 0x32F9, 0x32FA, 0x32FB, 0x32FC, 0x32FD, 0x32FE,
+	0x32FF, 0x3300, 0x3301, 0x3302, 0x3303, 0x3304, 0x3305, 0x3306,
+	0x3307, 0x3308, 0x3309, 0x330A, 0x330B, 0x330C, 0x330D, 0x330E,
+	0x330F, 0x3310, 0x3311, 0x3312, 0x3313, 0x3314, 0x3315, 0x3316,
+	0x3317, 0x3318, 0x3319, 0x331A, 0x331B, 0x331C, 0x331D, 0x331E,
+	0x331F, 0x3320, 0x3321, 0x3322, 0x3323, 0x3324, 0x3325, 0x3326,
+	0x3327, 0x3328, 0x3329, 0x332A, 0x332B, 0x332C, 0x332D, 0x332E,
+	0x332F, 0x3330, 0x3331, 0x3332, 0x3333, 0x3334, 0x3335, 0x3336,
+	0x3337, 0x3338, 0x3339, 0x333A, 0x333B, 0x333C, 0x333D, 0x333E,
+	0x333F, 0x3340, 0x3341, 0x3342, 0x3343, 0x3344, 0x3345, 0x3346,
+	0x3347, 0x3348, 0x3349, 0x334A, 0x334B, 0x334C, 0x334D, 0x334E,
+	0x334F, 0x3350, 0x3351, 0x3352, 0x3353, 0x3354, 0x3355, 0x3356,
+	0x3357, 0x3358, 0x3359, 0x335A, 0x335B, 0x335C, 0x335D, 0x335E,
+	0x335F, 0x3360, 0x3361, 0x3362, 0x3363, 0x3364, 0x3365, 0x3366,
+	0x3367, 0x3368, 0x3369, 0x336A, 0x336B, 0x336C, 0x336D, 0x336E,
+	0x336F, 0x3370, 0x3371, 0x3372, 0x3373, 0x3374, 0x3375, 0x3376,
+	0x3377, 0x3378, 0x3379, 0x337A, 0x337B, 0x337C, 0x337D, 0x337E,
+	0x337F, 0x3380, 0x3381, 0x3382, 0x3383, 0x3384, 0x3385, 0x3386,
+	0x3387, 0x3388, 0x3389, 0x338A, 0x338B, 0x338C, 0x338D, 0x338E,
+	0x338F, 0x3390, 0x3391, 0x3392, 0x3393, 0x3394, 0x3395, 0x3396,
+	0x3397, 0x3398, 0x3399, 0x339A, 0x339B, 0x339C, 0x339D, 0x339E,
+	0x339F, 0x33A0, 0x33A1, 0x33A2, 0x33A3, 0x33A4, 0x33A5, 0x33A6,
+	0x33A7, 0x33A8, 0x33A9, 0x33AA, 0x33AB, 0x33AC, 0x33AD, 0x33AE,
+	0x33AF, 0x33B0, 0x33B1, 0x33B2, 0x33B3, 0x33B4, 0x33B5, 0x33B6,
+	0x33B7, 0x33B8, 0x33B9, 0x33BA, 0x33BB, 0x33BC, 0x33BD, 0x33BE,
+	0x33BF, 0x33C0, 0x33C1, 0x33C2, 0x33C3, 0x33C4, 0x33C5, 0x33C6,
+	0x33C7, 0x33C8, 0x33C9, 0x33CA, 0x33CB, 0x33CC, 0x33CD, 0x33CE,
+	0x33CF, 0x33D0, 0x33D1, 0x33D2, 0x33D3, 0x33D4, 0x33D5, 0x33D6,
+	0x33D7, 0x33D8, 0x33D9, 0x33DA, 0x33DB, 0x33DC, 0x33DD, 0x33DE,
+	0x33DF, 0x33E0, 0x33E1, 0x33E2, 0x33E3, 0x33E4, 0x33E5, 0x33E6,
+	0x33E7, 0x33E8, 0x33E9, 0x33EA, 0x33EB, 0x33EC, 0x33ED, 0x33EE,
+	0x33EF, 0x33F0, 0x33F1, 0x33F2, 0x33F3, 0x33F4, 0x33F5, 0x33F6,
+	0x33F7, 0x33F8, 0x33F9, 0x33FA, 0x33FB, 0x33FC, 0x33FD, 0x33FE,
+	0x33FF, 0xA66F, 0xA674, 0xA675, 0xA676, 0xA677, 0xA678, 0xA679,
+	0xA67A, 0xA67B, 0xA67C, 0xA67D, 0xA69C, 0xA69D, 0xA69E, 0xA69F,
+	0xA6F0, 0xA6F1, 0xA770, 0xA7F2, 0xA7F3, 0xA7F4, 0xA7F8, 0xA7F9,
+	0xA806, 0xA82C, 0xA8C4, 0xA8E0, 0xA8E1, 0xA8E2, 0xA8E3, 0xA8E4,
+	0xA8E5, 0xA8E6, 0xA8E7, 0xA8E8, 0xA8E9, 0xA8EA, 0xA8EB, 0xA8EC,
+	0xA8ED, 0xA8EE, 0xA8EF, 0xA8F0, 0xA8F1, 0xA92B, 0xA92C, 0xA92D,
+	0xA953, 0xA9B3, 0xA9C0, 0xAAB0, 0xAAB2, 0xAAB3, 0xAAB4, 0xAAB7,
+	0xAAB8, 0xAABE, 0xAABF, 0xAAC1, 0xAAF6, 0xAB5C, 0xAB5D, 0xAB5E,
+	0xAB5F, 0xAB69, 0xABED, 0xF900, 0xF901, 0xF902, 0xF903, 0xF904,
+	0xF905, 0xF906, 0xF907, 0xF908, 0xF909, 0xF90A, 0xF90B, 0xF90C,
+	0xF90D, 0xF90E, 0xF90F, 0xF910, 0xF911, 0xF912, 0xF913, 0xF914,
+	0xF915, 0xF916, 0xF917, 0xF918, 0xF919, 0xF91A, 0xF91B, 0xF91C,
+	0xF91D, 0xF91E, 0xF91F, 0xF920, 0xF921, 0xF922, 0xF923, 0xF924,
+	0xF925, 0xF926, 0xF927, 0xF928, 0xF929, 0xF92A, 0xF92B, 0xF92C,
+	0xF92D, 0xF92E, 0xF92F, 0xF930, 0xF931, 0xF932, 0xF933, 0xF934,
+	0xF935, 0xF936, 0xF937, 0xF938, 0xF939, 0xF93A, 0xF93B, 0xF93C,
+	0xF93D, 0xF93E, 0xF93F, 0xF940, 0xF941, 0xF942, 0xF943, 0xF944,
+	0xF945, 0xF946, 0xF947, 0xF948, 0xF949, 0xF94A, 0xF94B, 0xF94C,
+	0xF94D, 0xF94E, 0xF94F, 0xF950, 0xF951, 0xF952, 0xF953, 0xF954,
+	0xF955, 0xF956, 0xF957, 0xF958, 0xF959, 0xF95A, 0xF95B, 0xF95C,
+	0xF95D, 0xF95E, 0xF95F, 0xF960, 0xF961, 0xF962, 0xF963, 0xF964,
+	0xF965, 0xF966, 0xF967, 0xF968, 0xF969, 0xF96A, 0xF96B, 0xF96C,
+	0xF96D, 0xF96E, 0xF96F, 0xF970, 0xF971, 0xF972, 0xF973, 0xF974,
+	0xF975, 0xF976, 0xF977, 0xF978, 0xF979, 0xF97A, 0xF97B, 0xF97C,
+	0xF97D, 0xF97E, 0xF97F, 0xF980, 0xF981, 0xF982, 0xF983, 0xF984,
+	0xF985, 0xF986, 0xF987, 0xF988, 0xF989, 0xF98A, 0xF98B, 0xF98C,
+	0xF98D, 0xF98E, 0xF98F, 0xF990, 0xF991, 0xF992, 0xF993, 0xF994,
+	0xF995, 0xF996, 0xF997, 0xF998, 0xF999, 0xF99A, 0xF99B, 0xF99C,
+	0xF99D, 0xF99E, 0xF99F, 0xF9A0, 0xF9A1, 0xF9A2, 0xF9A3, 0xF9A4,
+	0xF9A5, 0xF9A6, 0xF9A7, 0xF9A8, 0xF9A9, 0xF9AA, 0xF9AB, 0xF9AC,
+	0xF9AD, 0xF9AE, 0xF9AF, 0xF9B0, 0xF9B1, 0xF9B2, 0xF9B3, 0xF9B4,
+	0xF9B5, 0xF9B6, 0xF9B7, 0xF9B8, 0xF9B9, 0xF9BA, 0xF9BB, 0xF9BC,
+	0xF9BD, 0xF9BE, 0xF9BF, 0xF9C0, 0xF9C1, 0xF9C2, 0xF9C3, 0xF9C4,
+	0xF9C5, 0xF9C6, 0xF9C7, 0xF9C8, 0xF9C9, 0xF9CA, 0xF9CB, 0xF9CC,
+	0xF9CD, 0xF9CE, 0xF9CF, 0xF9D0, 0xF9D1, 0xF9D2, 0xF9D3, 0xF9D4,
+	0xF9D5, 0xF9D6, 0xF9D7, 0xF9D8, 0xF9D9, 0xF9DA, 0xF9DB, 0xF9DC,
+	0xF9DD, 0xF9DE, 0xF9DF, 0xF9E0, 0xF9E1, 0xF9E2, 0xF9E3, 0xF9E4,
+	0xF9E5, 0xF9E6, 0xF9E7, 0xF9E8, 0xF9E9, 0xF9EA, 0xF9EB, 0xF9EC,
+	0xF9ED, 0xF9EE, 0xF9EF, 0xF9F0, 0xF9F1, 0xF9F2, 0xF9F3, 0xF9F4,
+	0xF9F5, 0xF9F6, 0xF9F7, 0xF9F8, 0xF9F9, 0xF9FA, 0xF9FB, 0xF9FC,
+	0xF9FD, 0xF9FE, 0xF9FF, 0xFA00, 0xFA01, 0xFA02, 0xFA03, 0xFA04,
+	0xFA05, 0xFA06, 0xFA07, 0xFA08, 0xFA09, 0xFA0A, 0xFA0B, 0xFA0C,
+	0xFA0D, 0xFA10, 0xFA12, 0xFA15, 0xFA16, 0xFA17, 0xFA18, 0xFA19,
+	0xFA1A, 0xFA1B, 0xFA1C, 0xFA1D, 0xFA1E, 0xFA20, 0xFA22, 0xFA25,
+	0xFA26, 0xFA2A, 0xFA2B, 0xFA2C, 0xFA2D, 0xFA2E, 0xFA2F, 0xFA30,
+	0xFA31, 0xFA32, 0xFA33, 0xFA34, 0xFA35, 0xFA36, 0xFA37, 0xFA38,
+	0xFA39, 0xFA3A, 0xFA3B, 0xFA3C, 0xFA3D, 0xFA3E, 0xFA3F, 0xFA40,
+	0xFA41, 0xFA42, 0xFA43, 0xFA44, 0xFA45, 0xFA46, 0xFA47, 0xFA48,
+	0xFA49, 0xFA4A, 0xFA4B, 0xFA4C, 0xFA4D, 0xFA4E, 0xFA4F, 0xFA50,
+	0xFA51, 0xFA52, 0xFA53, 0xFA54, 0xFA55, 0xFA56, 0xFA57, 0xFA58,
+	0xFA59, 0xFA5A, 0xFA5B, 0xFA5C, 0xFA5D, 0xFA5E, 0xFA5F, 0xFA60,
+	0xFA61, 0xFA62, 0xFA63, 0xFA64, 0xFA65, 0xFA66, 0xFA67, 0xFA68,
+	0xFA69, 0xFA6A, 0xFA6B, 0xFA6C, 0xFA6D, 0xFA70, 0xFA71, 0xFA72,
+	0xFA73, 0xFA74, 0xFA75, 0xFA76, 0xFA77, 0xFA78, 0xFA79, 0xFA7A,
+	0xFA7B, 0xFA7C, 0xFA7D, 0xFA7E, 0xFA7F, 0xFA80, 0xFA81, 0xFA82,
+	0xFA83, 0xFA84, 0xFA85, 0xFA86, 0xFA87, 0xFA88, 0xFA89, 0xFA8A,
+	0xFA8B, 0xFA8C, 0xFA8D, 0xFA8E, 0xFA8F, 0xFA90, 0xFA91, 0xFA92,
+	0xFA93, 0xFA94, 0xFA95, 0xFA96, 0xFA97, 0xFA98, 0xFA99, 0xFA9A,
+	0xFA9B, 0xFA9C, 0xFA9D, 0xFA9E, 0xFA9F, 0xFAA0, 0xFAA1, 0xFAA2,
+	0xFAA3, 0xFAA4, 0xFAA5, 0xFAA6, 0xFAA7, 0xFAA8, 0xFAA9, 0xFAAA,
+	0xFAAB, 0xFAAC, 0xFAAD, 0xFAAE, 0xFAAF, 0xFAB0, 0xFAB1, 0xFAB2,
+	0xFAB3, 0xFAB4, 0xFAB5, 0xFAB6, 0xFAB7, 0xFAB8, 0xFAB9, 0xFABA,
+	0xFABB, 0xFABC, 0xFABD, 0xFABE, 0xFABF, 0xFAC0, 0xFAC1, 0xFAC2,
+	0xFAC3, 0xFAC4, 0xFAC5, 0xFAC6, 0xFAC7, 0xFAC8, 0xFAC9, 0xFACA,
+	0xFACB, 0xFACC, 0xFACD, 0xFACE, 0xFACF, 0xFAD0, 0xFAD1, 0xFAD2,
+	0xFAD3, 0xFAD4, 0xFAD5, 0xFAD6, 0xFAD7, 0xFAD8, 0xFAD9, 0xFB00,
+	0xFB01, 0xFB02, 0xFB03, 0xFB04, 0xFB05, 0xFB06, 0xFB13, 0xFB14,
+	0xFB15, 0xFB16, 0xFB17, 0xFB1D, 0xFB1E, 0xFB1F, 0xFB20, 0xFB21,
+	0xFB22, 0xFB23, 0xFB24, 0xFB25, 0xFB26, 0xFB27, 0xFB28, 0xFB29,
+	0xFB2A, 0xFB2B, 0xFB2C, 0xFB2D, 0xFB2E, 0xFB2F, 0xFB30, 0xFB31,
+	0xFB32, 0xFB33, 0xFB34, 0xFB35, 0xFB36, 0xFB38, 0xFB39, 0xFB3A,
+	0xFB3B, 0xFB3C, 0xFB3E, 0xFB40, 0xFB41, 0xFB43, 0xFB44, 0xFB46,
+	0xFB47, 0xFB48, 0xFB49, 0xFB4A, 0xFB4B, 0xFB4C, 0xFB4D, 0xFB4E,
+	0xFB4F, 0xFB50, 0xFB51, 0xFB52, 0xFB53, 0xFB54, 0xFB55, 0xFB56,
+	0xFB57, 0xFB58, 0xFB59, 0xFB5A, 0xFB5B, 0xFB5C, 0xFB5D, 0xFB5E,
+	0xFB5F, 0xFB60, 0xFB61, 0xFB62, 0xFB63, 0xFB64, 0xFB65, 0xFB66,
+	0xFB67, 0xFB68, 0xFB69, 0xFB6A, 0xFB6B, 0xFB6C, 0xFB6D, 0xFB6E,
+	0xFB6F, 0xFB70, 0xFB71, 0xFB72, 0xFB73, 0xFB74, 0xFB75, 0xFB76,
+	0xFB77, 0xFB78, 0xFB79, 0xFB7A, 0xFB7B, 0xFB7C, 0xFB7D, 0xFB7E,
+	0xFB7F, 0xFB80, 0xFB81, 0xFB82, 0xFB83, 0xFB84, 0xFB85, 0xFB86,
+	0xFB87, 0xFB88, 0xFB89, 0xFB8A, 0xFB8B, 0xFB8C, 0xFB8D, 0xFB8E,
+	0xFB8F, 0xFB90, 0xFB91, 0xFB92, 0xFB93, 0xFB94, 0xFB95, 0xFB96,
+	0xFB97, 0xFB98, 0xFB99, 0xFB9A, 0xFB9B, 0xFB9C, 0xFB9D, 0xFB9E,
+	0xFB9F, 0xFBA0, 0xFBA1, 0xFBA2, 0xFBA3, 0xFBA4, 0xFBA5, 0xFBA6,
+	0xFBA7, 0xFBA8, 0xFBA9, 0xFBAA, 0xFBAB, 0xFBAC, 0xFBAD, 0xFBAE,
+	0xFBAF, 0xFBB0, 0xFBB1, 0xFBD3, 0xFBD4, 0xFBD5, 0xFBD6, 0xFBD7,
+	0xFBD8, 0xFBD9, 0xFBDA, 0xFBDB, 0xFBDC, 0xFBDD, 0xFBDE, 0xFBDF,
+	0xFBE0, 0xFBE1, 0xFBE2, 0xFBE3, 0xFBE4, 0xFBE5, 0xFBE6, 0xFBE7,
+	0xFBE8, 0xFBE9, 0xFBEA, 0xFBEB, 0xFBEC, 0xFBED, 0xFBEE, 0xFBEF,
+	0xFBF0, 0xFBF1, 0xFBF2, 0xFBF3, 0xFBF4, 0xFBF5, 0xFBF6, 0xFBF7,
+	0xFBF8, 0xFBF9, 0xFBFA, 0xFBFB, 0xFBFC, 0xFBFD, 0xFBFE, 0xFBFF,
+	0xFC00, 0xFC01, 0xFC02, 0xFC03, 0xFC04, 0xFC05, 0xFC06, 0xFC07,
+	0xFC08, 0xFC09, 0xFC0A, 0xFC0B, 0xFC0C, 0xFC0D, 0xFC0E, 0xFC0F,
+	0xFC10, 0xFC11, 0xFC12, 0xFC13, 0xFC14, 0xFC15, 0xFC16, 0xFC17,
+	0xFC18, 0xFC19, 0xFC1A, 0xFC1B, 0xFC1C, 0xFC1D, 0xFC1E, 0xFC1F,
+	0xFC20, 0xFC21, 0xFC22, 0xFC23, 0xFC24, 0xFC25, 0xFC26, 0xFC27,
+	0xFC28, 0xFC29, 0xFC2A, 0xFC2B, 0xFC2C, 0xFC2D, 0xFC2E, 0xFC2F,
+	0xFC30, 0xFC31, 0xFC32, 0xFC33, 0xFC34, 0xFC35, 0xFC36, 0xFC37,
+	0xFC38, 0xFC39, 0xFC3A, 0xFC3B, 0xFC3C, 0xFC3D, 0xFC3E, 0xFC3F,
+	0xFC40, 0xFC41, 0xFC42, 0xFC43, 0xFC44, 0xFC45, 0xFC46, 0xFC47,
+	0xFC48, 0xFC49, 0xFC4A, 0xFC4B, 0xFC4C, 0xFC4D, 0xFC4E, 0xFC4F,
+	0xFC50, 0xFC51, 0xFC52, 0xFC53, 0xFC54, 0xFC55, 0xFC56, 0xFC57,
+	0xFC58, 0xFC59, 0xFC5A, 0xFC5B, 0xFC5C, 0xFC5D, 0xFC5E, 0xFC5F,
+	0xFC60, 0xFC61, 0xFC62, 0xFC63, 0xFC64, 0xFC65, 0xFC66, 0xFC67,
+	0xFC68, 0xFC69, 0xFC6A, 0xFC6B, 0xFC6C, 0xFC6D, 0xFC6E, 0xFC6F,
+	0xFC70, 0xFC71, 0xFC72, 0xFC73, 0xFC74, 0xFC75, 0xFC76, 0xFC77,
+	0xFC78, 0xFC79, 0xFC7A, 0xFC7B, 0xFC7C, 0xFC7D, 0xFC7E, 0xFC7F,
+	0xFC80, 0xFC81, 0xFC82, 0xFC83, 0xFC84, 0xFC85, 0xFC86, 0xFC87,
+	0xFC88, 0xFC89, 0xFC8A, 0xFC8B, 0xFC8C, 0xFC8D, 0xFC8E, 0xFC8F,
+	0xFC90, 0xFC91, 0xFC92, 0xFC93, 0xFC94, 0xFC95, 0xFC96, 0xFC97,
+	0xFC98, 0xFC99, 0xFC9A, 0xFC9B, 0xFC9C, 0xFC9D, 0xFC9E, 0xFC9F,
+	0xFCA0, 0xFCA1, 0xFCA2, 0xFCA3, 0xFCA4, 0xFCA5, 0xFCA6, 0xFCA7,
+	0xFCA8, 0xFCA9, 0xFCAA, 0xFCAB, 0xFCAC, 0xFCAD, 0xFCAE, 0xFCAF,
+	0xFCB0, 0xFCB1, 0xFCB2, 0xFCB3, 0xFCB4, 0xFCB5, 0xFCB6, 0xFCB7,
+	0xFCB8, 0xFCB9, 0xFCBA, 0xFCBB, 0xFCBC, 0xFCBD, 0xFCBE, 0xFCBF,
+	0xFCC0, 0xFCC1, 0xFCC2, 0xFCC3, 0xFCC4, 0xFCC5, 0xFCC6, 0xFCC7,
+	0xFCC8, 0xFCC9, 0xFCCA, 0xFCCB, 0xFCCC, 0xFCCD, 0xFCCE, 0xFCCF,
+	0xFCD0, 0xFCD1, 0xFCD2, 0xFCD3, 0xFCD4, 0xFCD5, 0xFCD6, 0xFCD7,
+	0xFCD8, 0xFCD9, 0xFCDA, 0xFCDB, 0xFCDC, 0xFCDD, 0xFCDE, 0xFCDF,
+	0xFCE0, 0xFCE1, 0xFCE2, 0xFCE3, 0xFCE4, 0xFCE5, 0xFCE6, 0xFCE7,
+	0xFCE8, 0xFCE9, 0xFCEA, 0xFCEB, 0xFCEC, 0xFCED, 0xFCEE, 0xFCEF,
+	0xFCF0, 0xFCF1, 0xFCF2, 0xFCF3, 0xFCF4, 0xFCF5, 0xFCF6, 0xFCF7,
+	0xFCF8, 0xFCF9, 0xFCFA, 0xFCFB, 0xFCFC, 0xFCFD, 0xFCFE, 0xFCFF,
+	0xFD00, 0xFD01, 0xFD02, 0xFD03, 0xFD04, 0xFD05, 0xFD06, 0xFD07,
+	0xFD08, 0xFD09, 0xFD0A, 0xFD0B, 0xFD0C, 0xFD0D, 0xFD0E, 0xFD0F,
+	0xFD10, 0xFD11, 0xFD12, 0xFD13, 0xFD14, 0xFD15, 0xFD16, 0xFD17,
+	0xFD18, 0xFD19, 0xFD1A, 0xFD1B, 0xFD1C, 0xFD1D, 0xFD1E, 0xFD1F,
+	0xFD20, 0xFD21, 0xFD22, 0xFD23, 0xFD24, 0xFD25, 0xFD26, 0xFD27,
+	0xFD28, 0xFD29, 0xFD2A, 0xFD2B, 0xFD2C, 0xFD2D, 0xFD2E, 0xFD2F,
+	0xFD30, 0xFD31, 0xFD32, 0xFD33, 0xFD34, 0xFD35, 0xFD36, 0xFD37,
+	0xFD38, 0xFD39, 0xFD3A, 0xFD3B, 0xFD3C, 0xFD3D, 0xFD50, 0xFD51,
+	0xFD52, 0xFD53, 0xFD54, 0xFD55, 0xFD56, 0xFD57, 0xFD58, 0xFD59,
+	0xFD5A, 0xFD5B, 0xFD5C, 0xFD5D, 0xFD5E, 0xFD5F, 0xFD60, 0xFD61,
+	0xFD62, 0xFD63, 0xFD64, 0xFD65, 0xFD66, 0xFD67, 0xFD68, 0xFD69,
+	0xFD6A, 0xFD6B, 0xFD6C, 0xFD6D, 0xFD6E, 0xFD6F, 0xFD70, 0xFD71,
+	0xFD72, 0xFD73, 0xFD74, 0xFD75, 0xFD76, 0xFD77, 0xFD78, 0xFD79,
+	0xFD7A, 0xFD7B, 0xFD7C, 0xFD7D, 0xFD7E, 0xFD7F, 0xFD80, 0xFD81,
+	0xFD82, 0xFD83, 0xFD84, 0xFD85, 0xFD86, 0xFD87, 0xFD88, 0xFD89,
+	0xFD8A, 0xFD8B, 0xFD8C, 0xFD8D, 0xFD8E, 0xFD8F, 0xFD92, 0xFD93,
+	0xFD94, 0xFD95, 0xFD96, 0xFD97, 0xFD98, 0xFD99, 0xFD9A, 0xFD9B,
+	0xFD9C, 0xFD9D, 0xFD9E, 0xFD9F, 0xFDA0, 0xFDA1, 0xFDA2, 0xFDA3,
+	0xFDA4, 0xFDA5, 0xFDA6, 0xFDA7, 0xFDA8, 0xFDA9, 0xFDAA, 0xFDAB,
+	0xFDAC, 0xFDAD, 0xFDAE, 0xFDAF, 0xFDB0, 0xFDB1, 0xFDB2, 0xFDB3,
+	0xFDB4, 0xFDB5, 0xFDB6, 0xFDB7, 0xFDB8, 0xFDB9, 0xFDBA, 0xFDBB,
+	0xFDBC, 0xFDBD, 0xFDBE, 0xFDBF, 0xFDC0, 0xFDC1, 0xFDC2, 0xFDC3,
+	0xFDC4, 0xFDC5, 0xFDC6, 0xFDC7, 0xFDF0, 0xFDF1, 0xFDF2, 0xFDF3,
+	0xFDF4, 0xFDF5, 0xFDF6, 0xFDF7, 0xFDF8, 0xFDF9, 0xFDFA, 0xFDFB,
+	0xFDFC, 0xFE10, 0xFE11, 0xFE12, 0xFE13, 0xFE14, 0xFE15, 0xFE16,
+	0xFE17, 0xFE18, 0xFE19, 0xFE20, 0xFE21, 0xFE22, 0xFE23, 0xFE24,
+	0xFE25, 0xFE26, 0xFE27, 0xFE28, 0xFE29, 0xFE2A, 0xFE2B, 0xFE2C,
+	0xFE2D, 0xFE2E, 0xFE2F, 0xFE30, 0xFE31, 0xFE32, 0xFE33, 0xFE34,
+	0xFE35, 0xFE36, 0xFE37, 0xFE38, 0xFE39, 0xFE3A, 0xFE3B, 0xFE3C,
+	0xFE3D, 0xFE3E, 0xFE3F, 0xFE40, 0xFE41, 0xFE42, 0xFE43, 0xFE44,
+	0xFE47, 0xFE48, 0xFE49, 0xFE4A, 0xFE4B, 0xFE4C, 0xFE4D, 0xFE4E,
+	0xFE4F, 0xFE50, 0xFE51, 0xFE52, 0xFE54, 0xFE55, 0xFE56, 0xFE57,
+	0xFE58, 0xFE59, 0xFE5A, 0xFE5B, 0xFE5C, 0xFE5D, 0xFE5E, 0xFE5F,
+	0xFE60, 0xFE61, 0xFE62, 0xFE63, 0xFE64, 0xFE65, 0xFE66, 0xFE68,
+	0xFE69, 0xFE6A, 0xFE6B, 0xFE70, 0xFE71, 0xFE72, 0xFE74, 0xFE76,
+	0xFE77, 0xFE78, 0xFE79, 0xFE7A, 0xFE7B, 0xFE7C, 0xFE7D, 0xFE7E,
+	0xFE7F, 0xFE80, 0xFE81, 0xFE82, 0xFE83, 0xFE84, 0xFE85, 0xFE86,
+	0xFE87, 0xFE88, 0xFE89, 0xFE8A, 0xFE8B, 0xFE8C, 0xFE8D, 0xFE8E,
+	0xFE8F, 0xFE90, 0xFE91, 0xFE92, 0xFE93, 0xFE94, 0xFE95, 0xFE96,
+	0xFE97, 0xFE98, 0xFE99, 0xFE9A, 0xFE9B, 0xFE9C, 0xFE9D, 0xFE9E,
+	0xFE9F, 0xFEA0, 0xFEA1, 0xFEA2, 0xFEA3, 0xFEA4, 0xFEA5, 0xFEA6,
+	0xFEA7, 0xFEA8, 0xFEA9, 0xFEAA, 0xFEAB, 0xFEAC, 0xFEAD, 0xFEAE,
+	0xFEAF, 0xFEB0, 0xFEB1, 0xFEB2, 0xFEB3, 0xFEB4, 0xFEB5, 0xFEB6,
+	0xFEB7, 0xFEB8, 0xFEB9, 0xFEBA, 0xFEBB, 0xFEBC, 0xFEBD, 0xFEBE,
+	0xFEBF, 0xFEC0, 0xFEC1, 0xFEC2, 0xFEC3, 0xFEC4, 0xFEC5, 0xFEC6,
+	0xFEC7, 0xFEC8, 0xFEC9, 0xFECA, 0xFECB, 0xFECC, 0xFECD, 0xFECE,
+	0xFECF, 0xFED0, 0xFED1, 0xFED2, 0xFED3, 0xFED4, 0xFED5, 0xFED6,
+	0xFED7, 0xFED8, 0xFED9, 0xFEDA, 0xFEDB, 0xFEDC, 0xFEDD, 0xFEDE,
+	0xFEDF, 0xFEE0, 0xFEE1, 0xFEE2, 0xFEE3, 0xFEE4, 0xFEE5, 0xFEE6,
+	0xFEE7, 0xFEE8, 0xFEE9, 0xFEEA, 0xFEEB, 0xFEEC, 0xFEED, 0xFEEE,
+	0xFEEF, 0xFEF0, 0xFEF1, 0xFEF2, 0xFEF3, 0xFEF4, 0xFEF5, 0xFEF6,
+	0xFEF7, 0xFEF8, 0xFEF9, 0xFEFA, 0xFEFB, 0xFEFC, 0xFF01, 0xFF02,
+	0xFF03, 0xFF04, 0xFF05, 0xFF06, 0xFF07, 0xFF08, 0xFF09, 0xFF0A,
+	0xFF0B, 0xFF0C, 0xFF0D, 0xFF0E, 0xFF0F, 0xFF10, 0xFF11, 0xFF12,
+	0xFF13, 0xFF14, 0xFF15, 0xFF16, 0xFF17, 0xFF18, 0xFF19, 0xFF1A,
+	0xFF1B, 0xFF1C, 0xFF1D, 0xFF1E, 0xFF1F, 0xFF20, 0xFF21, 0xFF22,
+	0xFF23, 0xFF24, 0xFF25, 0xFF26, 0xFF27, 0xFF28, 0xFF29, 0xFF2A,
+	0xFF2B, 0xFF2C, 0xFF2D, 0xFF2E, 0xFF2F, 0xFF30, 0xFF31, 0xFF32,
+	0xFF33, 0xFF34, 0xFF35, 0xFF36, 0xFF37, 0xFF38, 0xFF39, 0xFF3A,
+	0xFF3B, 0xFF3C, 0xFF3D, 0xFF3E, 0xFF3F, 0xFF40, 0xFF41, 0xFF42,
+	0xFF43, 0xFF44, 0xFF45, 0xFF46, 0xFF47, 0xFF48, 0xFF49, 0xFF4A,
+	0xFF4B, 0xFF4C, 0xFF4D, 0xFF4E, 0xFF4F, 0xFF50, 0xFF51, 0xFF52,
+	0xFF53, 0xFF54, 0xFF55, 0xFF56, 0xFF57, 0xFF58, 0xFF59, 0xFF5A,
+	0xFF5B, 0xFF5C, 0xFF5D, 0xFF5E, 0xFF5F, 0xFF60, 0xFF61, 0xFF62,
+	0xFF63, 0xFF64, 0xFF65, 0xFF66, 0xFF67, 0xFF68, 0xFF69, 0xFF6A,
+	0xFF6B, 0xFF6C, 0xFF6D, 0xFF6E, 0xFF6F, 0xFF70, 0xFF71, 0xFF72,
+	0xFF73, 0xFF74, 0xFF75, 0xFF76, 0xFF77, 0xFF78, 0xFF79, 0xFF7A,
+	0xFF7B, 0xFF7C, 0xFF7D, 0xFF7E, 0xFF7F, 0xFF80, 0xFF81, 0xFF82,
+	0xFF83, 0xFF84, 0xFF85, 0xFF86, 0xFF87, 0xFF88, 0xFF89, 0xFF8A,
+	0xFF8B, 0xFF8C, 0xFF8D, 0xFF8E, 0xFF8F, 0xFF90, 0xFF91, 0xFF92,
+	0xFF93, 0xFF94, 0xFF95, 0xFF96, 0xFF97, 0xFF98, 0xFF99, 0xFF9A,
+	0xFF9B, 0xFF9C, 0xFF9D, 0xFF9E, 0xFF9F, 0xFFA0, 0xFFA1, 0xFFA2,
+	0xFFA3, 0xFFA4, 0xFFA5, 0xFFA6, 0xFFA7, 0xFFA8, 0xFFA9, 0xFFAA,
+	0xFFAB, 0xFFAC, 0xFFAD, 0xFFAE, 0xFFAF, 0xFFB0, 0xFFB1, 0xFFB2,
+	0xFFB3, 0xFFB4, 0xFFB5, 0xFFB6, 0xFFB7, 0xFFB8, 0xFFB9, 0xFFBA,
+	0xFFBB, 0xFFBC, 0xFFBD, 0xFFBE, 0xFFC2, 0xFFC3, 0xFFC4, 0xFFC5,
+	0xFFC6, 0xFFC7, 0xFFCA, 0xFFCB, 0xFFCC, 0xFFCD, 0xFFCE, 0xFFCF,
+	0xFFD2, 0xFFD3, 0xFFD4, 0xFFD5, 0xFFD6, 0xFFD7, 0xFFDA, 0xFFDB,
+	0xFFDC, 0xFFE0, 0xFFE1, 0xFFE2, 0xFFE3, 0xFFE4, 0xFFE5, 0xFFE6,
+	0xFFE8, 0xFFE9, 0xFFEA, 0xFFEB, 0xFFEC, 0xFFED, 0xFFEE, 0x101FD,
+	0x102E0, 0x10376, 0x10377, 0x10378, 0x10379, 0x1037A, 0x10781, 0x10782,
+	0x10783, 0x10784, 0x10785, 0x10787, 0x10788, 0x10789, 0x1078A, 0x1078B,
+	0x1078C, 0x1078D, 0x1078E, 0x1078F, 0x10790, 0x10791, 0x10792, 0x10793,
+	0x10794, 0x10795, 0x10796, 0x10797, 0x10798, 0x10799, 0x1079A, 0x1079B,
+	0x1079C, 0x1079D, 0x1079E, 0x1079F, 0x107A0, 0x107A1, 0x107A2, 0x107A3,
+	0x107A4, 0x107A5, 0x107A6, 0x107A7, 0x107A8, 0x107A9, 0x107AA, 0x107AB,
+	0x107AC, 0x107AD, 0x107AE, 0x107AF, 0x107B0, 0x107B2, 0x107B3, 0x107B4,
+	0x107B5, 0x107B6, 0x107B7, 0x107B8, 0x107B9, 0x107BA, 0x10A0D, 0x10A0F,
+	0x10A38, 0x10A39, 0x10A3A, 0x10A3F, 0x10AE5, 0x10AE6, 0x10D24, 0x10D25,
+	0x10D26, 0x10D27, 0x10EAB, 0x10EAC, 0x10EFD, 0x10EFE, 0x10EFF, 0x10F46,
+	0x10F47, 0x10F48, 0x10F49, 0x10F4A, 0x10F4B, 0x10F4C, 0x10F4D, 0x10F4E,
+	0x10F4F, 0x10F50, 0x10F82, 0x10F83, 0x10F84, 0x10F85, 0x11046, 0x11070,
+	0x1107F, 0x1109A, 0x1109C, 0x110AB, 0x110B9, 0x110BA, 0x11100, 0x11101,
+	0x11102, 0x11127, 0x1112E, 0x1112F, 0x11133, 0x11134, 0x11173, 0x111C0,
+	0x111CA, 0x11235, 0x11236, 0x112E9, 0x112EA, 0x1133B, 0x1133C, 0x1133E,
+	0x1134B, 0x1134C, 0x1134D, 0x11357, 0x11366, 0x11367, 0x11368, 0x11369,
+	0x1136A, 0x1136B, 0x1136C, 0x11370, 0x11371, 0x11372, 0x11373, 0x11374,
+	0x11442, 0x11446, 0x1145E, 0x114B0, 0x114BA, 0x114BB, 0x114BC, 0x114BD,
+	0x114BE, 0x114C2, 0x114C3, 0x115AF, 0x115BA, 0x115BB, 0x115BF, 0x115C0,
+	0x1163F, 0x116B6, 0x116B7, 0x1172B, 0x11839, 0x1183A, 0x11930, 0x11938,
+	0x1193D, 0x1193E, 0x11943, 0x119E0, 0x11A34, 0x11A47, 0x11A99, 0x11C3F,
+	0x11D42, 0x11D44, 0x11D45, 0x11D97, 0x11F41, 0x11F42, 0x16AF0, 0x16AF1,
+	0x16AF2, 0x16AF3, 0x16AF4, 0x16B30, 0x16B31, 0x16B32, 0x16B33, 0x16B34,
+	0x16B35, 0x16B36, 0x16FF0, 0x16FF1, 0x1BC9E, 0x1D15E, 0x1D15F, 0x1D160,
+	0x1D161, 0x1D162, 0x1D163, 0x1D164, 0x1D165, 0x1D166, 0x1D167, 0x1D168,
+	0x1D169, 0x1D16D, 0x1D16E, 0x1D16F, 0x1D170, 0x1D171, 0x1D172, 0x1D17B,
+	0x1D17C, 0x1D17D, 0x1D17E, 0x1D17F, 0x1D180, 0x1D181, 0x1D182, 0x1D185,
+	0x1D186, 0x1D187, 0x1D188, 0x1D189, 0x1D18A, 0x1D18B, 0x1D1AA, 0x1D1AB,
+	0x1D1AC, 0x1D1AD, 0x1D1BB, 0x1D1BC, 0x1D1BD, 0x1D1BE, 0x1D1BF, 0x1D1C0,
+	0x1D242, 0x1D243, 0x1D244, 0x1D400, 0x1D401, 0x1D402, 0x1D403, 0x1D404,
+	0x1D405, 0x1D406, 0x1D407, 0x1D408, 0x1D409, 0x1D40A, 0x1D40B, 0x1D40C,
+	0x1D40D, 0x1D40E, 0x1D40F, 0x1D410, 0x1D411, 0x1D412, 0x1D413, 0x1D414,
+	0x1D415, 0x1D416, 0x1D417, 0x1D418, 0x1D419, 0x1D41A, 0x1D41B, 0x1D41C,
+	0x1D41D, 0x1D41E, 0x1D41F, 0x1D420, 0x1D421, 0x1D422, 0x1D423, 0x1D424,
+	0x1D425, 0x1D426, 0x1D427, 0x1D428, 0x1D429, 0x1D42A, 0x1D42B, 0x1D42C,
+	0x1D42D, 0x1D42E, 0x1D42F, 0x1D430, 0x1D431, 0x1D432, 0x1D433, 0x1D434,
+	0x1D435, 0x1D436, 0x1D437, 0x1D438, 0x1D439, 0x1D43A, 0x1D43B, 0x1D43C,
+	0x1D43D, 0x1D43E, 0x1D43F, 0x1D440, 0x1D441, 0x1D442, 0x1D443, 0x1D444,
+	0x1D445, 0x1D446, 0x1D447, 0x1D448, 0x1D449, 0x1D44A, 0x1D44B, 0x1D44C,
+	0x1D44D, 0x1D44E, 0x1D44F, 0x1D450, 0x1D451, 0x1D452, 0x1D453, 0x1D454,
+	0x1D456, 0x1D457, 0x1D458, 0x1D459, 0x1D45A, 0x1D45B, 0x1D45C, 0x1D45D,
+	0x1D45E, 0x1D45F, 0x1D460, 0x1D461, 0x1D462, 0x1D463, 0x1D464, 0x1D465,
+	0x1D466, 0x1D467, 0x1D468, 0x1D469, 0x1D46A, 0x1D46B, 0x1D46C, 0x1D46D,
+	0x1D46E, 0x1D46F, 0x1D470, 0x1D471, 0x1D472, 0x1D473, 0x1D474, 0x1D475,
+	0x1D476, 0x1D477, 0x1D478, 0x1D479, 0x1D47A, 0x1D47B, 0x1D47C, 0x1D47D,
+	0x1D47E, 0x1D47F, 0x1D480, 0x1D481, 0x1D482, 0x1D483, 0x1D484, 0x1D485,
+	0x1D486, 0x1D487, 0x1D488, 0x1D489, 0x1D48A, 0x1D48B, 0x1D48C, 0x1D48D,
+	0x1D48E, 0x1D48F, 0x1D490, 0x1D491, 0x1D492, 0x1D493, 0x1D494, 0x1D495,
+	0x1D496, 0x1D497, 0x1D498, 0x1D499, 0x1D49A, 0x1D49B, 0x1D49C, 0x1D49E,
+	0x1D49F, 0x1D4A2, 0x1D4A5, 0x1D4A6, 0x1D4A9, 0x1D4AA, 0x1D4AB, 0x1D4AC,
+	0x1D4AE, 0x1D4AF, 0x1D4B0, 0x1D4B1, 0x1D4B2, 0x1D4B3, 0x1D4B4, 0x1D4B5,
+	0x1D4B6, 0x1D4B7, 0x1D4B8, 0x1D4B9, 0x1D4BB, 0x1D4BD, 0x1D4BE, 0x1D4BF,
+	0x1D4C0, 0x1D4C1, 0x1D4C2, 0x1D4C3, 0x1D4C5, 0x1D4C6, 0x1D4C7, 0x1D4C8,
+	0x1D4C9, 0x1D4CA, 0x1D4CB, 0x1D4CC, 0x1D4CD, 0x1D4CE, 0x1D4CF, 0x1D4D0,
+	0x1D4D1, 0x1D4D2, 0x1D4D3, 0x1D4D4, 0x1D4D5, 0x1D4D6, 0x1D4D7, 0x1D4D8,
+	0x1D4D9, 0x1D4DA, 0x1D4DB, 0x1D4DC, 0x1D4DD, 0x1D4DE, 0x1D4DF, 0x1D4E0,
+	0x1D4E1, 0x1D4E2, 0x1D4E3, 0x1D4E4, 0x1D4E5, 0x1D4E6, 0x1D4E7, 0x1D4E8,
+	0x1D4E9, 0x1D4EA, 0x1D4EB, 0x1D4EC, 0x1D4ED, 0x1D4EE, 0x1D4EF, 0x1D4F0,
+	0x1D4F1, 0x1D4F2, 0x1D4F3, 0x1D4F4, 0x1D4F5, 0x1D4F6, 0x1D4F7, 0x1D4F8,
+	0x1D4F9, 0x1D4FA, 0x1D4FB, 0x1D4FC, 0x1D4FD, 0x1D4FE, 0x1D4FF, 0x1D500,
+	0x1D501, 0x1D502, 0x1D503, 0x1D504, 0x1D505, 0x1D507, 0x1D508, 0x1D509,
+	0x1D50A, 0x1D50D, 0x1D50E, 0x1D50F, 0x1D510, 0x1D511, 0x1D512, 0x1D513,
+	0x1D514, 0x1D516, 0x1D517, 0x1D518, 0x1D519, 0x1D51A, 0x1D51B, 0x1D51C,
+	0x1D51E, 0x1D51F, 0x1D520, 0x1D521, 0x1D522, 0x1D523, 0x1D524, 0x1D525,
+	0x1D526, 0x1D527, 0x1D528, 0x1D529, 0x1D52A, 0x1D52B, 0x1D52C, 0x1D52D,
+	0x1D52E, 0x1D52F, 0x1D530, 0x1D531, 0x1D532, 0x1D533, 0x1D534, 0x1D535,
+	0x1D536, 0x1D537, 0x1D538, 0x1D539, 0x1D53B, 0x1D53C, 0x1D53D, 0x1D53E,
+	0x1D540, 0x1D541, 0x1D542, 0x1D543, 0x1D544, 0x1D546, 0x1D54A, 0x1D54B,
+	0x1D54C, 0x1D54D, 0x1D54E, 0x1D54F, 0x1D550, 0x1D552, 0x1D553, 0x1D554,
+	0x1D555, 0x1D556, 0x1D557, 0x1D558, 0x1D559, 0x1D55A, 0x1D55B, 0x1D55C,
+	0x1D55D, 0x1D55E, 0x1D55F, 0x1D560, 0x1D561, 0x1D562, 0x1D563, 0x1D564,
+	0x1D565, 0x1D566, 0x1D567, 0x1D568, 0x1D569, 0x1D56A, 0x1D56B, 0x1D56C,
+	0x1D56D, 0x1D56E, 0x1D56F, 0x1D570, 0x1D571, 0x1D572, 0x1D573, 0x1D574,
+	0x1D575, 0x1D576, 0x1D577, 0x1D578, 0x1D579, 0x1D57A, 0x1D57B, 0x1D57C,
+	0x1D57D, 0x1D57E, 0x1D57F, 0x1D580, 0x1D581, 0x1D582, 0x1D583, 0x1D584,
+	0x1D585, 0x1D586, 0x1D587, 0x1D588, 0x1D589, 0x1D58A, 0x1D58B, 0x1D58C,
+	0x1D58D, 0x1D58E, 0x1D58F, 0x1D590, 0x1D591, 0x1D592, 0x1D593, 0x1D594,
+	0x1D595, 0x1D596, 0x1D597, 0x1D598, 0x1D599, 0x1D59A, 0x1D59B, 0x1D59C,
+	0x1D59D, 0x1D59E, 0x1D59F, 0x1D5A0, 0x1D5A1, 0x1D5A2, 0x1D5A3, 0x1D5A4,
+	0x1D5A5, 0x1D5A6, 0x1D5A7, 0x1D5A8, 0x1D5A9, 0x1D5AA, 0x1D5AB, 0x1D5AC,
+	0x1D5AD, 0x1D5AE, 0x1D5AF, 0x1D5B0, 0x1D5B1, 0x1D5B2, 0x1D5B3, 0x1D5B4,
+	0x1D5B5, 0x1D5B6, 0x1D5B7, 0x1D5B8, 0x1D5B9, 0x1D5BA, 0x1D5BB, 0x1D5BC,
+	0x1D5BD, 0x1D5BE, 0x1D5BF, 0x1D5C0, 0x1D5C1, 0x1D5C2, 0x1D5C3, 0x1D5C4,
+	0x1D5C5, 0x1D5C6, 0x1D5C7, 0x1D5C8, 0x1D5C9, 0x1D5CA, 0x1D5CB, 0x1D5CC,
+	0x1D5CD, 0x1D5CE, 0x1D5CF, 0x1D5D0, 0x1D5D1, 0x1D5D2, 0x1D5D3, 0x1D5D4,
+	0x1D5D5, 0x1D5D6, 0x1D5D7, 0x1D5D8, 0x1D5D9, 0x1D5DA, 0x1D5DB, 0x1D5DC,
+	0x1D5DD, 0x1D5DE, 0x1D5DF, 0x1D5E0, 0x1D5E1, 0x1D5E2, 0x1D5E3, 0x1D5E4,
+	0x1D5E5, 0x1D5E6, 0x1D5E7, 0x1D5E8, 0x1D5E9, 0x1D5EA, 0x1D5EB, 0x1D5EC,
+	0x1D5ED, 0x1D5EE, 0x1D5EF, 0x1D5F0, 0x1D5F1, 0x1D5F2, 0x1D5F3, 0x1D5F4,
+	0x1D5F5, 0x1D5F6, 0x1D5F7, 0x1D5F8, 0x1D5F9, 0x1D5FA, 0x1D5FB, 0x1D5FC,
+	0x1D5FD, 0x1D5FE, 0x1D5FF, 0x1D600, 0x1D601, 0x1D602, 0x1D603, 0x1D604,
+	0x1D605, 0x1D606, 0x1D607, 0x1D608, 0x1D609, 0x1D60A, 0x1D60B, 0x1D60C,
+	0x1D60D, 0x1D60E, 0x1D60F, 0x1D610, 0x1D611, 0x1D612, 0x1D613, 0x1D614,
+	0x1D615, 0x1D616, 0x1D617, 0x1D618, 0x1D619, 0x1D61A, 0x1D61B, 0x1D61C,
+	0x1D61D, 0x1D61E, 0x1D61F, 0x1D620, 0x1D621, 0x1D622, 0x1D623, 0x1D624,
+	0x1D625, 0x1D626, 0x1D627, 0x1D628, 0x1D629, 0x1D62A, 0x1D62B, 0x1D62C,
+	0x1D62D, 0x1D62E, 0x1D62F, 0x1D630, 0x1D631, 0x1D632, 0x1D633, 0x1D634,
+	0x1D635, 0x1D636, 0x1D637, 0x1D638, 0x1D639, 0x1D63A, 0x1D63B, 0x1D63C,
+	0x1D63D, 0x1D63E, 0x1D63F, 0x1D640, 0x1D641, 0x1D642, 0x1D643, 0x1D644,
+	0x1D645, 0x1D646, 0x1D647, 0x1D648, 0x1D649, 0x1D64A, 0x1D64B, 0x1D64C,
+	0x1D64D, 0x1D64E, 0x1D64F, 0x1D650, 0x1D651, 0x1D652, 0x1D653, 0x1D654,
+	0x1D655, 0x1D656, 0x1D657, 0x1D658, 0x1D659, 0x1D65A, 0x1D65B, 0x1D65C,
+	0x1D65D, 0x1D65E, 0x1D65F, 0x1D660, 0x1D661, 0x1D662, 0x1D663, 0x1D664,
+	0x1D665, 0x1D666, 0x1D667, 0x1D668, 0x1D669, 0x1D66A, 0x1D66B, 0x1D66C,
+	0x1D66D, 0x1D66E, 0x1D66F, 0x1D670, 0x1D671, 0x1D672, 0x1D673, 0x1D674,
+	0x1D675, 0x1D676, 0x1D677, 0x1D678, 0x1D679, 0x1D67A, 0x1D67B, 0x1D67C,
+	0x1D67D, 0x1D67E, 0x1D67F, 0x1D680, 0x1D681, 0x1D682, 0x1D683, 0x1D684,
+	0x1D685, 0x1D686, 0x1D687, 0x1D688, 0x1D689, 0x1D68A, 0x1D68B, 0x1D68C,
+	0x1D68D, 0x1D68E, 0x1D68F, 0x1D690, 0x1D691, 0x1D692, 0x1D693, 0x1D694,
+	0x1D695, 0x1D696, 0x1D697, 0x1D698, 0x1D699, 0x1D69A, 0x1D69B, 0x1D69C,
+	0x1D69D, 0x1D69E, 0x1D69F, 0x1D6A0, 0x1D6A1, 0x1D6A2, 0x1D6A3, 0x1D6A4,
+	0x1D6A5, 0x1D6A8, 0x1D6A9, 0x1D6AA, 0x1D6AB, 0x1D6AC, 0x1D6AD, 0x1D6AE,
+	0x1D6AF, 0x1D6B0, 0x1D6B1, 0x1D6B2, 0x1D6B3, 0x1D6B4, 0x1D6B5, 0x1D6B6,
+	0x1D6B7, 0x1D6B8, 0x1D6B9, 0x1D6BA, 0x1D6BB, 0x1D6BC, 0x1D6BD, 0x1D6BE,
+	0x1D6BF, 0x1D6C0, 0x1D6C1, 0x1D6C2, 0x1D6C3, 0x1D6C4, 0x1D6C5, 0x1D6C6,
+	0x1D6C7, 0x1D6C8, 0x1D6C9, 0x1D6CA, 0x1D6CB, 0x1D6CC, 0x1D6CD, 0x1D6CE,
+	0x1D6CF, 0x1D6D0, 0x1D6D1, 0x1D6D2, 0x1D6D3, 0x1D6D4, 0x1D6D5, 0x1D6D6,
+	0x1D6D7, 0x1D6D8, 0x1D6D9, 0x1D6DA, 0x1D6DB, 0x1D6DC, 0x1D6DD, 0x1D6DE,
+	0x1D6DF, 0x1D6E0, 0x1D6E1, 0x1D6E2, 0x1D6E3, 0x1D6E4, 0x1D6E5, 0x1D6E6,
+	0x1D6E7, 0x1D6E8, 0x1D6E9, 0x1D6EA, 0x1D6EB, 0x1D6EC, 0x1D6ED, 0x1D6EE,
+	0x1D6EF, 0x1D6F0, 0x1D6F1, 0x1D6F2, 0x1D6F3, 0x1D6F4, 0x1D6F5, 0x1D6F6,
+	0x1D6F7, 0x1D6F8, 0x1D6F9, 0x1D6FA, 0x1D6FB, 0x1D6FC, 0x1D6FD, 0x1D6FE,
+	0x1D6FF, 0x1D700, 0x1D701, 0x1D702, 0x1D703, 0x1D704, 0x1D705, 0x1D706,
+	0x1D707, 0x1D708, 0x1D709, 0x1D70A, 0x1D70B, 0x1D70C, 0x1D70D, 0x1D70E,
+	0x1D70F, 0x1D710, 0x1D711, 0x1D712, 0x1D713, 0x1D714, 0x1D715, 0x1D716,
+	0x1D717, 0x1D718, 0x1D719, 0x1D71A, 0x1D71B, 0x1D71C, 0x1D71D, 0x1D71E,
+	0x1D71F, 0x1D720, 0x1D721, 0x1D722, 0x1D723, 0x1D724, 0x1D725, 0x1D726,
+	0x1D727, 0x1D728, 0x1D729, 0x1D72A, 0x1D72B, 0x1D72C, 0x1D72D, 0x1D72E,
+	0x1D72F, 0x1D730, 0x1D731, 0x1D732, 0x1D733, 0x1D734, 0x1D735, 0x1D736,
+	0x1D737, 0x1D738, 0x1D739, 0x1D73A, 0x1D73B, 0x1D73C, 0x1D73D, 0x1D73E,
+	0x1D73F, 0x1D740, 0x1D741, 0x1D742, 0x1D743, 0x1D744, 0x1D745, 0x1D746,
+	0x1D747, 0x1D748, 0x1D749, 0x1D74A, 0x1D74B, 0x1D74C, 0x1D74D, 0x1D74E,
+	0x1D74F, 0x1D750, 0x1D751, 0x1D752, 0x1D753, 0x1D754, 0x1D755, 0x1D756,
+	0x1D757, 0x1D758, 0x1D759, 0x1D75A, 0x1D75B, 0x1D75C, 0x1D75D, 0x1D75E,
+	0x1D75F, 0x1D760, 0x1D761, 0x1D762, 0x1D763, 0x1D764, 0x1D765, 0x1D766,
+	0x1D767, 0x1D768, 0x1D769, 0x1D76A, 0x1D76B, 0x1D76C, 0x1D76D, 0x1D76E,
+	0x1D76F, 0x1D770, 0x1D771, 0x1D772, 0x1D773, 0x1D774, 0x1D775, 0x1D776,
+	0x1D777, 0x1D778, 0x1D779, 0x1D77A, 0x1D77B, 0x1D77C, 0x1D77D, 0x1D77E,
+	0x1D77F, 0x1D780, 0x1D781, 0x1D782, 0x1D783, 0x1D784, 0x1D785, 0x1D786,
+	0x1D787, 0x1D788, 0x1D789, 0x1D78A, 0x1D78B, 0x1D78C, 0x1D78D, 0x1D78E,
+	0x1D78F, 0x1D790, 0x1D791, 0x1D792, 0x1D793, 0x1D794, 0x1D795, 0x1D796,
+	0x1D797, 0x1D798, 0x1D799, 0x1D79A, 0x1D79B, 0x1D79C, 0x1D79D, 0x1D79E,
+	0x1D79F, 0x1D7A0, 0x1D7A1, 0x1D7A2, 0x1D7A3, 0x1D7A4, 0x1D7A5, 0x1D7A6,
+	0x1D7A7, 0x1D7A8, 0x1D7A9, 0x1D7AA, 0x1D7AB, 0x1D7AC, 0x1D7AD, 0x1D7AE,
+	0x1D7AF, 0x1D7B0, 0x1D7B1, 0x1D7B2, 0x1D7B3, 0x1D7B4, 0x1D7B5, 0x1D7B6,
+	0x1D7B7, 0x1D7B8, 0x1D7B9, 0x1D7BA, 0x1D7BB, 0x1D7BC, 0x1D7BD, 0x1D7BE,
+	0x1D7BF, 0x1D7C0, 0x1D7C1, 0x1D7C2, 0x1D7C3, 0x1D7C4, 0x1D7C5, 0x1D7C6,
+	0x1D7C7, 0x1D7C8, 0x1D7C9, 0x1D7CA, 0x1D7CB, 0x1D7CE, 0x1D7CF, 0x1D7D0,
+	0x1D7D1, 0x1D7D2, 0x1D7D3, 0x1D7D4, 0x1D7D5, 0x1D7D6, 0x1D7D7, 0x1D7D8,
+	0x1D7D9, 0x1D7DA, 0x1D7DB, 0x1D7DC, 0x1D7DD, 0x1D7DE, 0x1D7DF, 0x1D7E0,
+	0x1D7E1, 0x1D7E2, 0x1D7E3, 0x1D7E4, 0x1D7E5, 0x1D7E6, 0x1D7E7, 0x1D7E8,
+	0x1D7E9, 0x1D7EA, 0x1D7EB, 0x1D7EC, 0x1D7ED, 0x1D7EE, 0x1D7EF, 0x1D7F0,
+	0x1D7F1, 0x1D7F2, 0x1D7F3, 0x1D7F4, 0x1D7F5, 0x1D7F6, 0x1D7F7, 0x1D7F8,
+	0x1D7F9, 0x1D7FA, 0x1D7FB, 0x1D7FC, 0x1D7FD, 0x1D7FE, 0x1D7FF, 0x1E000,
+	0x1E001, 0x1E002, 0x1E003, 0x1E004, 0x1E005, 0x1E006, 0x1E008, 0x1E009,
+	0x1E00A, 0x1E00B, 0x1E00C, 0x1E00D, 0x1E00E, 0x1E00F, 0x1E010, 0x1E011,
+	0x1E012, 0x1E013, 0x1E014, 0x1E015, 0x1E016, 0x1E017, 0x1E018, 0x1E01B,
+	0x1E01C, 0x1E01D, 0x1E01E, 0x1E01F, 0x1E020, 0x1E021, 0x1E023, 0x1E024,
+	0x1E026, 0x1E027, 0x1E028, 0x1E029, 0x1E02A, 0x1E030, 0x1E031, 0x1E032,
+	0x1E033, 0x1E034, 0x1E035, 0x1E036, 0x1E037, 0x1E038, 0x1E039, 0x1E03A,
+	0x1E03B, 0x1E03C, 0x1E03D, 0x1E03E, 0x1E03F, 0x1E040, 0x1E041, 0x1E042,
+	0x1E043, 0x1E044, 0x1E045, 0x1E046, 0x1E047, 0x1E048, 0x1E049, 0x1E04A,
+	0x1E04B, 0x1E04C, 0x1E04D, 0x1E04E, 0x1E04F, 0x1E050, 0x1E051, 0x1E052,
+	0x1E053, 0x1E054, 0x1E055, 0x1E056, 0x1E057, 0x1E058, 0x1E059, 0x1E05A,
+	0x1E05B, 0x1E05C, 0x1E05D, 0x1E05E, 0x1E05F, 0x1E060, 0x1E061, 0x1E062,
+	0x1E063, 0x1E064, 0x1E065, 0x1E066, 0x1E067, 0x1E068, 0x1E069, 0x1E06A,
+	0x1E06B, 0x1E06C, 0x1E06D, 0x1E08F, 0x1E130, 0x1E131, 0x1E132, 0x1E133,
+	0x1E134, 0x1E135, 0x1E136, 0x1E2AE, 0x1E2EC, 0x1E2ED, 0x1E2EE, 0x1E2EF,
+	0x1E4EC, 0x1E4ED, 0x1E4EE, 0x1E4EF, 0x1E8D0, 0x1E8D1, 0x1E8D2, 0x1E8D3,
+	0x1E8D4, 0x1E8D5, 0x1E8D6, 0x1E944, 0x1E945, 0x1E946, 0x1E947, 0x1E948,
+	0x1E949, 0x1E94A, 0x1EE00, 0x1EE01, 0x1EE02, 0x1EE03, 0x1EE05, 0x1EE06,
+	0x1EE07, 0x1EE08, 0x1EE09, 0x1EE0A, 0x1EE0B, 0x1EE0C, 0x1EE0D, 0x1EE0E,
+	0x1EE0F, 0x1EE10, 0x1EE11, 0x1EE12, 0x1EE13, 0x1EE14, 0x1EE15, 0x1EE16,
+	0x1EE17, 0x1EE18, 0x1EE19, 0x1EE1A, 0x1EE1B, 0x1EE1C, 0x1EE1D, 0x1EE1E,
+	0x1EE1F, 0x1EE21, 0x1EE22, 0x1EE24, 0x1EE27, 0x1EE29, 0x1EE2A, 0x1EE2B,
+	0x1EE2C, 0x1EE2D, 0x1EE2E, 0x1EE2F, 0x1EE30, 0x1EE31, 0x1EE32, 0x1EE34,
+	0x1EE35, 0x1EE36, 0x1EE37, 0x1EE39, 0x1EE3B, 0x1EE42, 0x1EE47, 0x1EE49,
+	0x1EE4B, 0x1EE4D, 0x1EE4E, 0x1EE4F, 0x1EE51, 0x1EE52, 0x1EE54, 0x1EE57,
+	0x1EE59, 0x1EE5B, 0x1EE5D, 0x1EE5F, 0x1EE61, 0x1EE62, 0x1EE64, 0x1EE67,
+	0x1EE68, 0x1EE69, 0x1EE6A, 0x1EE6C, 0x1EE6D, 0x1EE6E, 0x1EE6F, 0x1EE70,
+	0x1EE71, 0x1EE72, 0x1EE74, 0x1EE75, 0x1EE76, 0x1EE77, 0x1EE79, 0x1EE7A,
+	0x1EE7B, 0x1EE7C, 0x1EE7E, 0x1EE80, 0x1EE81, 0x1EE82, 0x1EE83, 0x1EE84,
+	0x1EE85, 0x1EE86, 0x1EE87, 0x1EE88, 0x1EE89, 0x1EE8B, 0x1EE8C, 0x1EE8D,
+	0x1EE8E, 0x1EE8F, 0x1EE90, 0x1EE91, 0x1EE92, 0x1EE93, 0x1EE94, 0x1EE95,
+	0x1EE96, 0x1EE97, 0x1EE98, 0x1EE99, 0x1EE9A, 0x1EE9B, 0x1EEA1, 0x1EEA2,
+	0x1EEA3, 0x1EEA5, 0x1EEA6, 0x1EEA7, 0x1EEA8, 0x1EEA9, 0x1EEAB, 0x1EEAC,
+	0x1EEAD, 0x1EEAE, 0x1EEAF, 0x1EEB0, 0x1EEB1, 0x1EEB2, 0x1EEB3, 0x1EEB4,
+	0x1EEB5, 0x1EEB6, 0x1EEB7, 0x1EEB8, 0x1EEB9, 0x1EEBA, 0x1EEBB, 0x1F100,
+	0x1F101, 0x1F102, 0x1F103, 0x1F104, 0x1F105, 0x1F106, 0x1F107, 0x1F108,
+	0x1F109, 0x1F10A, 0x1F110, 0x1F111, 0x1F112, 0x1F113, 0x1F114, 0x1F115,
+	0x1F116, 0x1F117, 0x1F118, 0x1F119, 0x1F11A, 0x1F11B, 0x1F11C, 0x1F11D,
+	0x1F11E, 0x1F11F, 0x1F120, 0x1F121, 0x1F122, 0x1F123, 0x1F124, 0x1F125,
+	0x1F126, 0x1F127, 0x1F128, 0x1F129, 0x1F12A, 0x1F12B, 0x1F12C, 0x1F12D,
+	0x1F12E, 0x1F130, 0x1F131, 0x1F132, 0x1F133, 0x1F134, 0x1F135, 0x1F136,
+	0x1F137, 0x1F138, 0x1F139, 0x1F13A, 0x1F13B, 0x1F13C, 0x1F13D, 0x1F13E,
+	0x1F13F, 0x1F140, 0x1F141, 0x1F142, 0x1F143, 0x1F144, 0x1F145, 0x1F146,
+	0x1F147, 0x1F148, 0x1F149, 0x1F14A, 0x1F14B, 0x1F14C, 0x1F14D, 0x1F14E,
+	0x1F14F, 0x1F16A, 0x1F16B, 0x1F16C, 0x1F190, 0x1F200, 0x1F201, 0x1F202,
+	0x1F210, 0x1F211, 0x1F212, 0x1F213, 0x1F214, 0x1F215, 0x1F216, 0x1F217,
+	0x1F218, 0x1F219, 0x1F21A, 0x1F21B, 0x1F21C, 0x1F21D, 0x1F21E, 0x1F21F,
+	0x1F220, 0x1F221, 0x1F222, 0x1F223, 0x1F224, 0x1F225, 0x1F226, 0x1F227,
+	0x1F228, 0x1F229, 0x1F22A, 0x1F22B, 0x1F22C, 0x1F22D, 0x1F22E, 0x1F22F,
+	0x1F230, 0x1F231, 0x1F232, 0x1F233, 0x1F234, 0x1F235, 0x1F236, 0x1F237,
+	0x1F238, 0x1F239, 0x1F23A, 0x1F23B, 0x1F240, 0x1F241, 0x1F242, 0x1F243,
+	0x1F244, 0x1F245, 0x1F246, 0x1F247, 0x1F248, 0x1F250, 0x1F251, 0x1FBF0,
+	0x1FBF1, 0x1FBF2, 0x1FBF3, 0x1FBF4, 0x1FBF5, 0x1FBF6, 0x1FBF7, 0x1FBF8,
+	0x1FBF9, 0x2F800, 0x2F801, 0x2F802, 0x2F803, 0x2F804, 0x2F805, 0x2F806,
+	0x2F807, 0x2F808, 0x2F809, 0x2F80A, 0x2F80B, 0x2F80C, 0x2F80D, 0x2F80E,
+	0x2F80F, 0x2F810, 0x2F811, 0x2F812, 0x2F813, 0x2F814, 0x2F815, 0x2F816,
+	0x2F817, 0x2F818, 0x2F819, 0x2F81A, 0x2F81B, 0x2F81C, 0x2F81D, 0x2F81E,
+	0x2F81F, 0x2F820, 0x2F821, 0x2F822, 0x2F823, 0x2F824, 0x2F825, 0x2F826,
+	0x2F827, 0x2F828, 0x2F829, 0x2F82A, 0x2F82B, 0x2F82C, 0x2F82D, 0x2F82E,
+	0x2F82F, 0x2F830, 0x2F831, 0x2F832, 0x2F833, 0x2F834, 0x2F835, 0x2F836,
+	0x2F837, 0x2F838, 0x2F839, 0x2F83A, 0x2F83B, 0x2F83C, 0x2F83D, 0x2F83E,
+	0x2F83F, 0x2F840, 0x2F841, 0x2F842, 0x2F843, 0x2F844, 0x2F845, 0x2F846,
+	0x2F847, 0x2F848, 0x2F849, 0x2F84A, 0x2F84B, 0x2F84C, 0x2F84D, 0x2F84E,
+	0x2F84F, 0x2F850, 0x2F851, 0x2F852, 0x2F853, 0x2F854, 0x2F855, 0x2F856,
+	0x2F857, 0x2F858, 0x2F859, 0x2F85A, 0x2F85B, 0x2F85C, 0x2F85D, 0x2F85E,
+	0x2F85F, 0x2F860, 0x2F861, 0x2F862, 0x2F863, 0x2F864, 0x2F865, 0x2F866,
+	0x2F867, 0x2F868, 0x2F869, 0x2F86A, 0x2F86B, 0x2F86C, 0x2F86D, 0x2F86E,
+	0x2F86F, 0x2F870, 0x2F871, 0x2F872, 0x2F873, 0x2F874, 0x2F875, 0x2F876,
+	0x2F877, 0x2F878, 0x2F879, 0x2F87A, 0x2F87B, 0x2F87C, 0x2F87D, 0x2F87E,
+	0x2F87F, 0x2F880, 0x2F881, 0x2F882, 0x2F883, 0x2F884, 0x2F885, 0x2F886,
+	0x2F887, 0x2F888, 0x2F889, 0x2F88A, 0x2F88B, 0x2F88C, 0x2F88D, 0x2F88E,
+	0x2F88F, 0x2F890, 0x2F891, 0x2F892, 0x2F893, 0x2F894, 0x2F895, 0x2F896,
+	0x2F897, 0x2F898, 0x2F899, 0x2F89A, 0x2F89B, 0x2F89C, 0x2F89D, 0x2F89E,
+	0x2F89F, 0x2F8A0, 0x2F8A1, 0x2F8A2, 0x2F8A3, 0x2F8A4, 0x2F8A5, 0x2F8A6,
+	0x2F8A7, 0x2F8A8, 0x2F8A9, 0x2F8AA, 0x2F8AB, 0x2F8AC, 0x2F8AD, 0x2F8AE,
+	0x2F8AF, 0x2F8B0, 0x2F8B1, 0x2F8B2, 0x2F8B3, 0x2F8B4, 0x2F8B5, 0x2F8B6,
+	0x2F8B7, 0x2F8B8, 0x2F8B9, 0x2F8BA, 0x2F8BB, 0x2F8BC, 0x2F8BD, 0x2F8BE,
+	0x2F8BF, 0x2F8C0, 0x2F8C1, 0x2F8C2, 0x2F8C3, 0x2F8C4, 0x2F8C5, 0x2F8C6,
+	0x2F8C7, 0x2F8C8, 0x2F8C9, 0x2F8CA, 0x2F8CB, 0x2F8CC, 0x2F8CD, 0x2F8CE,
+	0x2F8CF, 0x2F8D0, 0x2F8D1, 0x2F8D2, 0x2F8D3, 0x2F8D4, 0x2F8D5, 0x2F8D6,
+	0x2F8D7, 0x2F8D8, 0x2F8D9, 0x2F8DA, 0x2F8DB, 0x2F8DC, 0x2F8DD, 0x2F8DE,
+	0x2F8DF, 0x2F8E0, 0x2F8E1, 0x2F8E2, 0x2F8E3, 0x2F8E4, 0x2F8E5, 0x2F8E6,
+	0x2F8E7, 0x2F8E8, 0x2F8E9, 0x2F8EA, 0x2F8EB, 0x2F8EC, 0x2F8ED, 0x2F8EE,
+	0x2F8EF, 0x2F8F0, 0x2F8F1, 0x2F8F2, 0x2F8F3, 0x2F8F4, 0x2F8F5, 0x2F8F6,
+	0x2F8F7, 0x2F8F8, 0x2F8F9, 0x2F8FA, 0x2F8FB, 0x2F8FC, 0x2F8FD, 0x2F8FE,
+	0x2F8FF, 0x2F900, 0x2F901, 0x2F902, 0x2F903, 0x2F904, 0x2F905, 0x2F906,
+	0x2F907, 0x2F908, 0x2F909, 0x2F90A, 0x2F90B, 0x2F90C, 0x2F90D, 0x2F90E,
+	0x2F90F, 0x2F910, 0x2F911, 0x2F912, 0x2F913, 0x2F914, 0x2F915, 0x2F916,
+	0x2F917, 0x2F918, 0x2F919, 0x2F91A, 0x2F91B, 0x2F91C, 0x2F91D, 0x2F91E,
+	0x2F91F, 0x2F920, 0x2F921, 0x2F922, 0x2F923, 0x2F924, 0x2F925, 0x2F926,
+	0x2F927, 0x2F928, 0x2F929, 0x2F92A, 0x2F92B, 0x2F92C, 0x2F92D, 0x2F92E,
+	0x2F92F, 0x2F930, 0x2F931, 0x2F932, 0x2F933, 0x2F934, 0x2F935, 0x2F936,
+	0x2F937, 0x2F938, 0x2F939, 0x2F93A, 0x2F93B, 0x2F93C, 0x2F93D, 0x2F93E,
+	0x2F93F, 0x2F940, 0x2F941, 0x2F942, 0x2F943, 0x2F944, 0x2F945, 0x2F946,
+	0x2F947, 0x2F948, 0x2F949, 0x2F94A, 0x2F94B, 0x2F94C, 0x2F94D, 0x2F94E,
+	0x2F94F, 0x2F950, 0x2F951, 0x2F952, 0x2F953, 0x2F954, 0x2F955, 0x2F956,
+	0x2F957, 0x2F958, 0x2F959, 0x2F95A, 0x2F95B, 0x2F95C, 0x2F95D, 0x2F95E,
+	0x2F95F, 0x2F960, 0x2F961, 0x2F962, 0x2F963, 0x2F964, 0x2F965, 0x2F966,
+	0x2F967, 0x2F968, 0x2F969, 0x2F96A, 0x2F96B, 0x2F96C, 0x2F96D, 0x2F96E,
+	0x2F96F, 0x2F970, 0x2F971, 0x2F972, 0x2F973, 0x2F974, 0x2F975, 0x2F976,
+	0x2F977, 0x2F978, 0x2F979, 0x2F97A, 0x2F97B, 0x2F97C, 0x2F97D, 0x2F97E,
+	0x2F97F, 0x2F980, 0x2F981, 0x2F982, 0x2F983, 0x2F984, 0x2F985, 0x2F986,
+	0x2F987, 0x2F988, 0x2F989, 0x2F98A, 0x2F98B, 0x2F98C, 0x2F98D, 0x2F98E,
+	0x2F98F, 0x2F990, 0x2F991, 0x2F992, 0x2F993, 0x2F994, 0x2F995, 0x2F996,
+	0x2F997, 0x2F998, 0x2F999, 0x2F99A, 0x2F99B, 0x2F99C, 0x2F99D, 0x2F99E,
+	0x2F99F, 0x2F9A0, 0x2F9A1, 0x2F9A2, 0x2F9A3, 0x2F9A4, 0x2F9A5, 0x2F9A6,
+	0x2F9A7, 0x2F9A8, 0x2F9A9, 0x2F9AA, 0x2F9AB, 0x2F9AC, 0x2F9AD, 0x2F9AE,
+	0x2F9AF, 0x2F9B0, 0x2F9B1, 0x2F9B2, 0x2F9B3, 0x2F9B4, 0x2F9B5, 0x2F9B6,
+	0x2F9B7, 0x2F9B8, 0x2F9B9, 0x2F9BA, 0x2F9BB, 0x2F9BC, 0x2F9BD, 0x2F9BE,
+	0x2F9BF, 0x2F9C0, 0x2F9C1, 0x2F9C2, 0x2F9C3, 0x2F9C4, 0x2F9C5, 0x2F9C6,
+	0x2F9C7, 0x2F9C8, 0x2F9C9, 0x2F9CA, 0x2F9CB, 0x2F9CC, 0x2F9CD, 0x2F9CE,
+	0x2F9CF, 0x2F9D0, 0x2F9D1, 0x2F9D2, 0x2F9D3, 0x2F9D4, 0x2F9D5, 0x2F9D6,
+	0x2F9D7, 0x2F9D8, 0x2F9D9, 0x2F9DA, 0x2F9DB, 0x2F9DC, 0x2F9DD, 0x2F9DE,
+	0x2F9DF, 0x2F9E0, 0x2F9E1, 0x2F9E2, 0x2F9E3, 0x2F9E4, 0x2F9E5, 0x2F9E6,
+	0x2F9E7, 0x2F9E8, 0x2F9E9, 0x2F9EA, 0x2F9EB, 0x2F9EC, 0x2F9ED, 0x2F9EE,
+	0x2F9EF, 0x2F9F0, 0x2F9F1, 0x2F9F2, 0x2F9F3, 0x2F9F4, 0x2F9F5, 0x2F9F6,
+	0x2F9F7, 0x2F9F8, 0x2F9F9, 0x2F9FA, 0x2F9FB, 0x2F9FC, 0x2F9FD, 0x2F9FE,
+	0x2F9FF, 0x2FA00, 0x2FA01, 0x2FA02, 0x2FA03, 0x2FA04, 0x2FA05, 0x2FA06,
+	0x2FA07, 0x2FA08, 0x2FA09, 0x2FA0A, 0x2FA0B, 0x2FA0C, 0x2FA0D, 0x2FA0E,
+	0x2FA0F, 0x2FA10, 0x2FA11, 0x2FA12, 0x2FA13, 0x2FA14, 0x2FA15, 0x2FA16,
+	0x2FA17, 0x2FA18, 0x2FA19, 0x2FA1A, 0x2FA1B, 0x2FA1C, 0x2FA1D,
+}
+
+// infoTable holds the normalization properties of the runes in infoRunes.
+var infoTable = [...]runeInfo{
+	{0, 0x04, 0, 1},         // U+00A0
+	{0, 0x04, 0, 3},         // U+00A8
+	{0, 0x04, 0, 6},         // U+00AA
+	{0, 0x04, 0, 8},         // U+00AF
+	{0, 0x04, 0, 11},        // U+00B2
+	{0, 0x04, 0, 13},        // U+00B3
+	{0, 0x04, 0, 15},        // U+00B4
+	{0, 0x04, 0, 18},        // U+00B5
+	{0, 0x04, 0, 20},        // U+00B8
+	{0, 0x04, 0, 23},        // U+00B9
+	{0, 0x04, 0, 25},        // U+00BA
+	{0, 0x04, 0, 27},        // U+00BC
+	{0, 0x04, 0, 31},        // U+00BD
+	{0, 0x04, 0, 35},        // U+00BE
+	{0, 0x00, 39, 39},       // U+00C0
+	{0, 0x00, 42, 42},       // U+00C1
+	{0, 0x00, 45, 45},       // U+00C2
+	{0, 0x00, 48, 48},       // U+00C3
+	{0, 0x00, 51, 51},       // U+00C4
+	{0, 0x00, 54, 54},       // U+00C5
+	{0, 0x00, 57, 57},       // U+00C7
+	{0, 0x00, 60, 60},       // U+00C8
+	{0, 0x00, 63, 63},       // U+00C9
+	{0, 0x00, 66, 66},       // U+00CA
+	{0, 0x00, 69, 69},       // U+00CB
+	{0, 0x00, 72, 72},       // U+00CC
+	{0, 0x00, 75, 75},       // U+00CD
+	{0, 0x00, 78, 78},       // U+00CE
+	{0, 0x00, 81, 81},       // U+00CF
+	{0, 0x00, 84, 84},       // U+00D1
+	{0, 0x00, 87, 87},       // U+00D2
+	{0, 0x00, 90, 90},       // U+00D3
+	{0, 0x00, 93, 93},       // U+00D4
+	{0, 0x00, 96, 96},       // U+00D5
+	{0, 0x00, 99, 99},       // U+00D6
+	{0, 0x00, 102, 102},     // U+00D9
+	{0, 0x00, 105, 105},     // U+00DA
+	{0, 0x00, 108, 108},     // U+00DB
+	{0, 0x00, 111, 111},     // U+00DC
+	{0, 0x00, 114, 114},     // U+00DD
+	{0, 0x00, 117, 117},     // U+00E0
+	{0, 0x00, 120, 120},     // U+00E1
+	{0, 0x00, 123, 123},     // U+00E2
+	{0, 0x00, 126, 126},     // U+00E3
+	{0, 0x00, 129, 129},     // U+00E4
+	{0, 0x00, 132, 132},     // U+00E5
+	{0, 0x00, 135, 135},     // U+00E7
+	{0, 0x00, 138, 138},     // U+00E8
+	{0, 0x00, 141, 141},     // U+00E9
+	{0, 0x00, 144, 144},     // U+00EA
+	{0, 0x00, 147, 147},     // U+00EB
+	{0, 0x00, 150, 150},     // U+00EC
+	{0, 0x00, 153, 153},     // U+00ED
+	{0, 0x00, 156, 156},     // U+00EE
+	{0, 0x00, 159, 159},     // U+00EF
+	{0, 0x00, 162, 162},     // U+00F1
+	{0, 0x00, 165, 165},     // U+00F2
+	{0, 0x00, 168, 168},     // U+00F3
+	{0, 0x00, 171, 171},     // U+00F4
+	{0, 0x00, 174, 174},     // U+00F5
+	{0, 0x00, 177, 177},     // U+00F6
+	{0, 0x00, 180, 180},     // U+00F9
+	{0, 0x00, 183, 183},     // U+00FA
+	{0, 0x00, 186, 186},     // U+00FB
+	{0, 0x00, 189, 189},     // U+00FC
+	{0, 0x00, 192, 192},     // U+00FD
+	{0, 0x00, 195, 195},     // U+00FF
+	{0, 0x00, 198, 198},     // U+0100
+	{0, 0x00, 201, 201},     // U+0101
+	{0, 0x00, 204, 204},     // U+0102
+	{0, 0x00, 207, 207},     // U+0103
+	{0, 0x00, 210, 210},     // U+0104
+	{0, 0x00, 213, 213},     // U+0105
+	{0, 0x00, 216, 216},     // U+0106
+	{0, 0x00, 219, 219},     // U+0107
+	{0, 0x00, 222, 222},     // U+0108
+	{0, 0x00, 225, 225},     // U+0109
+	{0, 0x00, 228, 228},     // U+010A
+	{0, 0x00, 231, 231},     // U+010B
+	{0, 0x00, 234, 234},     // U+010C
+	{0, 0x00, 237, 237},     // U+010D
+	{0, 0x00, 240, 240},     // U+010E
+	{0, 0x00, 243, 243},     // U+010F
+	{0, 0x00, 246, 246},     // U+0112
+	{0, 0x00, 249, 249},     // U+0113
+	{0, 0x00, 252, 252},     // U+0114
+	{0, 0x00, 255, 255},     // U+0115
+	{0, 0x00, 258, 258},     // U+0116
+	{0, 0x00, 261, 261},     // U+0117
+	{0, 0x00, 264, 264},     // U+0118
+	{0, 0x00, 267, 267},     // U+0119
+	{0, 0x00, 270, 270},     // U+011A
+	{0, 0x00, 273, 273},     // U+011B
+	{0, 0x00, 276, 276},     // U+011C
+	{0, 0x00, 279, 279},     // U+011D
+	{0, 0x00, 282, 282},     // U+011E
+	{0, 0x00, 285, 285},     // U+011F
+	{0, 0x00, 288, 288},     // U+0120
+	{0, 0x00, 291, 291},     // U+0121
+	{0, 0x00, 294, 294},     // U+0122
+	{0, 0x00, 297, 297},     // U+0123
+	{0, 0x00, 300, 300},     // U+0124
+	{0, 0x00, 303, 303},     // U+0125
+	{0, 0x00, 306, 306},     // U+0128
+	{0, 0x00, 309, 309},     // U+0129
+	{0, 0x00, 312, 312},     // U+012A
+	{0, 0x00, 315, 315},     // U+012B
+	{0, 0x00, 318, 318},     // U+012C
+	{0, 0x00, 321, 321},     // U+012D
+	{0, 0x00, 324, 324},     // U+012E
+	{0, 0x00, 327, 327},     // U+012F
+	{0, 0x00, 330, 330},     // U+0130
+	{0, 0x04, 0, 333},       // U+0132
+	{0, 0x04, 0, 336},       // U+0133
+	{0, 0x00, 339, 339},     // U+0134
+	{0, 0x00, 342, 342},     // U+0135
+	{0, 0x00, 345, 345},     // U+0136
+	{0, 0x00, 348, 348},     // U+0137
+	{0, 0x00, 351, 351},     // U+0139
+	{0, 0x00, 354, 354},     // U+013A
+	{0, 0x00, 357, 357},     // U+013B
+	{0, 0x00, 360, 360},     // U+013C
+	{0, 0x00, 363, 363},     // U+013D
+	{0, 0x00, 366, 366},     // U+013E
+	{0, 0x04, 0, 369},       // U+013F
+	{0, 0x04, 0, 372},       // U+0140
+	{0, 0x00, 375, 375},     // U+0143
+	{0, 0x00, 378, 378},     // U+0144
+	{0, 0x00, 381, 381},     // U+0145
+	{0, 0x00, 384, 384},     // U+0146
+	{0, 0x00, 387, 387},     // U+0147
+	{0, 0x00, 390, 390},     // U+0148
+	{0, 0x04, 0, 393},       // U+0149
+	{0, 0x00, 396, 396},     // U+014C
+	{0, 0x00, 399, 399},     // U+014D
+	{0, 0x00, 402, 402},     // U+014E
+	{0, 0x00, 405, 405},     // U+014F
+	{0, 0x00, 408, 408},     // U+0150
+	{0, 0x00, 411, 411},     // U+0151
+	{0, 0x00, 414, 414},     // U+0154
+	{0, 0x00, 417, 417},     // U+0155
+	{0, 0x00, 420, 420},     // U+0156
+	{0, 0x00, 423, 423},     // U+0157
+	{0, 0x00, 426, 426},     // U+0158
+	{0, 0x00, 429, 429},     // U+0159
+	{0, 0x00, 432, 432},     // U+015A
+	{0, 0x00, 435, 435},     // U+015B
+	{0, 0x00, 438, 438},     // U+015C
+	{0, 0x00, 441, 441},     // U+015D
+	{0, 0x00, 444, 444},     // U+015E
+	{0, 0x00, 447, 447},     // U+015F
+	{0, 0x00, 450, 450},     // U+0160
+	{0, 0x00, 453, 453},     // U+0161
+	{0, 0x00, 456, 456},     // U+0162
+	{0, 0x00, 459, 459},     // U+0163
+	{0, 0x00, 462, 462},     // U+0164
+	{0, 0x00, 465, 465},     // U+0165
+	{0, 0x00, 468, 468},     // U+0168
+	{0, 0x00, 471, 471},     // U+0169
+	{0, 0x00, 474, 474},     // U+016A
+	{0, 0x00, 477, 477},     // U+016B
+	{0, 0x00, 480, 480},     // U+016C
+	{0, 0x00, 483, 483},     // U+016D
+	{0, 0x00, 486, 486},     // U+016E
+	{0, 0x00, 489, 489},     // U+016F
+	{0, 0x00, 492, 492},     // U+0170
+	{0, 0x00, 495, 495},     // U+0171
+	{0, 0x00, 498, 498},     // U+0172
+	{0, 0x00, 501, 501},     // U+0173
+	{0, 0x00, 504, 504},     // U+0174
+	{0, 0x00, 507, 507},     // U+0175
+	{0, 0x00, 510, 510},     // U+0176
+	{0, 0x00, 513, 513},     // U+0177
+	{0, 0x00, 516, 516},     // U+0178
+	{0, 0x00, 519, 519},     // U+0179
+	{0, 0x00, 522, 522},     // U+017A
+	{0, 0x00, 525, 525},     // U+017B
+	{0, 0x00, 528, 528},     // U+017C
+	{0, 0x00, 531, 531},     // U+017D
+	{0, 0x00, 534, 534},     // U+017E
+	{0, 0x04, 0, 537},       // U+017F
+	{0, 0x00, 539, 539},     // U+01A0
+	{0, 0x00, 542, 542},     // U+01A1
+	{0, 0x00, 545, 545},     // U+01AF
+	{0, 0x00, 548, 548},     // U+01B0
+	{0, 0x04, 0, 551},       // U+01C4
+	{0, 0x04, 0, 555},       // U+01C5
+	{0, 0x04, 0, 559},       // U+01C6
+	{0, 0x04, 0, 563},       // U+01C7
+	{0, 0x04, 0, 566},       // U+01C8
+	{0, 0x04, 0, 569},       // U+01C9
+	{0, 0x04, 0, 572},       // U+01CA
+	{0, 0x04, 0, 575},       // U+01CB
+	{0, 0x04, 0, 578},       // U+01CC
+	{0, 0x00, 581, 581},     // U+01CD
+	{0, 0x00, 584, 584},     // U+01CE
+	{0, 0x00, 587, 587},     // U+01CF
+	{0, 0x00, 590, 590},     // U+01D0
+	{0, 0x00, 593, 593},     // U+01D1
+	{0, 0x00, 596, 596},     // U+01D2
+	{0, 0x00, 599, 599},     // U+01D3
+	{0, 0x00, 602, 602},     // U+01D4
+	{0, 0x00, 605, 605},     // U+01D5
+	{0, 0x00, 609, 609},     // U+01D6
+	{0, 0x00, 613, 613},     // U+01D7
+	{0, 0x00, 617, 617},     // U+01D8
+	{0, 0x00, 621, 621},     // U+01D9
+	{0, 0x00, 625, 625},     // U+01DA
+	{0, 0x00, 629, 629},     // U+01DB
+	{0, 0x00, 633, 633},     // U+01DC
+	{0, 0x00, 637, 637},     // U+01DE
+	{0, 0x00, 641, 641},     // U+01DF
+	{0, 0x00, 645, 645},     // U+01E0
+	{0, 0x00, 649, 649},     // U+01E1
+	{0, 0x00, 653, 653},     // U+01E2
+	{0, 0x00, 656, 656},     // U+01E3
+	{0, 0x00, 659, 659},     // U+01E6
+	{0, 0x00, 662, 662},     // U+01E7
+	{0, 0x00, 665, 665},     // U+01E8
+	{0, 0x00, 668, 668},     // U+01E9
+	{0, 0x00, 671, 671},     // U+01EA
+	{0, 0x00, 674, 674},     // U+01EB
+	{0, 0x00, 677, 677},     // U+01EC
+	{0, 0x00, 681, 681},     // U+01ED
+	{0, 0x00, 685, 685},     // U+01EE
+	{0, 0x00, 688, 688},     // U+01EF
+	{0, 0x00, 691, 691},     // U+01F0
+	{0, 0x04, 0, 694},       // U+01F1
+	{0, 0x04, 0, 697},       // U+01F2
+	{0, 0x04, 0, 700},       // U+01F3
+	{0, 0x00, 703, 703},     // U+01F4
+	{0, 0x00, 706, 706},     // U+01F5
+	{0, 0x00, 709, 709},     // U+01F8
+	{0, 0x00, 712, 712},     // U+01F9
+	{0, 0x00, 715, 715},     // U+01FA
+	{0, 0x00, 719, 719},     // U+01FB
+	{0, 0x00, 723, 723},     // U+01FC
+	{0, 0x00, 726, 726},     // U+01FD
+	{0, 0x00, 729, 729},     // U+01FE
+	{0, 0x00, 732, 732},     // U+01FF
+	{0, 0x00, 735, 735},     // U+0200
+	{0, 0x00, 738, 738},     // U+0201
+	{0, 0x00, 741, 741},     // U+0202
+	{0, 0x00, 744, 744},     // U+0203
+	{0, 0x00, 747, 747},     // U+0204
+	{0, 0x00, 750, 750},     // U+0205
+	{0, 0x00, 753, 753},     // U+0206
+	{0, 0x00, 756, 756},     // U+0207
+	{0, 0x00, 759, 759},     // U+0208
+	{0, 0x00, 762, 762},     // U+0209
+	{0, 0x00, 765, 765},     // U+020A
+	{0, 0x00, 768, 768},     // U+020B
+	{0, 0x00, 771, 771},     // U+020C
+	{0, 0x00, 774, 774},     // U+020D
+	{0, 0x00, 777, 777},     // U+020E
+	{0, 0x00, 780, 780},     // U+020F
+	{0, 0x00, 783, 783},     // U+0210
+	{0, 0x00, 786, 786},     // U+0211
+	{0, 0x00, 789, 789},     // U+0212
+	{0, 0x00, 792, 792},     // U+0213
+	{0, 0x00, 795, 795},     // U+0214
+	{0, 0x00, 798, 798},     // U+0215
+	{0, 0x00, 801, 801},     // U+0216
+	{0, 0x00, 804, 804},     // U+0217
+	{0, 0x00, 807, 807},     // U+0218
+	{0, 0x00, 810, 810},     // U+0219
+	{0, 0x00, 813, 813},     // U+021A
+	{0, 0x00, 816, 816},     // U+021B
+	{0, 0x00, 819, 819},     // U+021E
+	{0, 0x00, 822, 822},     // U+021F
+	{0, 0x00, 825, 825},     // U+0226
+	{0, 0x00, 828, 828},     // U+0227
+	{0, 0x00, 831, 831},     // U+0228
+	{0, 0x00, 834, 834},     // U+0229
+	{0, 0x00, 837, 837},     // U+022A
+	{0, 0x00, 841, 841},     // U+022B
+	{0, 0x00, 845, 845},     // U+022C
+	{0, 0x00, 849, 849},     // U+022D
+	{0, 0x00, 853, 853},     // U+022E
+	{0, 0x00, 856, 856},     // U+022F
+	{0, 0x00, 859, 859},     // U+0230
+	{0, 0x00, 863, 863},     // U+0231
+	{0, 0x00, 867, 867},     // U+0232
+	{0, 0x00, 870, 870},     // U+0233
+	{0, 0x04, 0, 873},       // U+02B0
+	{0, 0x04, 0, 875},       // U+02B1
+	{0, 0x04, 0, 877},       // U+02B2
+	{0, 0x04, 0, 879},       // U+02B3
+	{0, 0x04, 0, 881},       // U+02B4
+	{0, 0x04, 0, 883},       // U+02B5
+	{0, 0x04, 0, 885},       // U+02B6
+	{0, 0x04, 0, 887},       // U+02B7
+	{0, 0x04, 0, 889},       // U+02B8
+	{0, 0x04, 0, 891},       // U+02D8
+	{0, 0x04, 0, 894},       // U+02D9
+	{0, 0x04, 0, 897},       // U+02DA
+	{0, 0x04, 0, 900},       // U+02DB
+	{0, 0x04, 0, 903},       // U+02DC
+	{0, 0x04, 0, 906},       // U+02DD
+	{0, 0x04, 0, 909},       // U+02E0
+	{0, 0x04, 0, 911},       // U+02E1
+	{0, 0x04, 0, 537},       // U+02E2
+	{0, 0x04, 0, 913},       // U+02E3
+	{0, 0x04, 0, 915},       // U+02E4
+	{230, 0x0A, 0, 0},       // U+0300
+	{230, 0x0A, 0, 0},       // U+0301
+	{230, 0x0A, 0, 0},       // U+0302
+	{230, 0x0A, 0, 0},       // U+0303
+	{230, 0x0A, 0, 0},       // U+0304
+	{230, 0x00, 0, 0},       // U+0305
+	{230, 0x0A, 0, 0},       // U+0306
+	{230, 0x0A, 0, 0},       // U+0307
+	{230, 0x0A, 0, 0},       // U+0308
+	{230, 0x0A, 0, 0},       // U+0309
+	{230, 0x0A, 0, 0},       // U+030A
+	{230, 0x0A, 0, 0},       // U+030B
+	{230, 0x0A, 0, 0},       // U+030C
+	{230, 0x00, 0, 0},       // U+030D
+	{230, 0x00, 0, 0},       // U+030E
+	{230, 0x0A, 0, 0},       // U+030F
+	{230, 0x00, 0, 0},       // U+0310
+	{230, 0x0A, 0, 0},       // U+0311
+	{230, 0x00, 0, 0},       // U+0312
+	{230, 0x0A, 0, 0},       // U+0313
+	{230, 0x0A, 0, 0},       // U+0314
+	{232, 0x00, 0, 0},       // U+0315
+	{220, 0x00, 0, 0},       // U+0316
+	{220, 0x00, 0, 0},       // U+0317
+	{220, 0x00, 0, 0},       // U+0318
+	{220, 0x00, 0, 0},       // U+0319
+	{232, 0x00, 0, 0},       // U+031A
+	{216, 0x0A, 0, 0},       // U+031B
+	{220, 0x00, 0, 0},       // U+031C
+	{220, 0x00, 0, 0},       // U+031D
+	{220, 0x00, 0, 0},       // U+031E
+	{220, 0x00, 0, 0},       // U+031F
+	{220, 0x00, 0, 0},       // U+0320
+	{202, 0x00, 0, 0},       // U+0321
+	{202, 0x00, 0, 0},       // U+0322
+	{220, 0x0A, 0, 0},       // U+0323
+	{220, 0x0A, 0, 0},       // U+0324
+	{220, 0x0A, 0, 0},       // U+0325
+	{220, 0x0A, 0, 0},       // U+0326
+	{202, 0x0A, 0, 0},       // U+0327
+	{202, 0x0A, 0, 0},       // U+0328
+	{220, 0x00, 0, 0},       // U+0329
+	{220, 0x00, 0, 0},       // U+032A
+	{220, 0x00, 0, 0},       // U+032B
+	{220, 0x00, 0, 0},       // U+032C
+	{220, 0x0A, 0, 0},       // U+032D
+	{220, 0x0A, 0, 0},       // U+032E
+	{220, 0x00, 0, 0},       // U+032F
+	{220, 0x0A, 0, 0},       // U+0330
+	{220, 0x0A, 0, 0},       // U+0331
+	{220, 0x00, 0, 0},       // U+0332
+	{220, 0x00, 0, 0},       // U+0333
+	{1, 0x00, 0, 0},         // U+0334
+	{1, 0x00, 0, 0},         // U+0335
+	{1, 0x00, 0, 0},         // U+0336
+	{1, 0x00, 0, 0},         // U+0337
+	{1, 0x0A, 0, 0},         // U+0338
+	{220, 0x00, 0, 0},       // U+0339
+	{220, 0x00, 0, 0},       // U+033A
+	{220, 0x00, 0, 0},       // U+033B
+	{220, 0x00, 0, 0},       // U+033C
+	{230, 0x00, 0, 0},       // U+033D
+	{230, 0x00, 0, 0},       // U+033E
+	{230, 0x00, 0, 0},       // U+033F
+	{230, 0x05, 917, 917},   // U+0340
+	{230, 0x05, 919, 919},   // U+0341
+	{230, 0x0A, 0, 0},       // U+0342
+	{230, 0x05, 921, 921},   // U+0343
+	{230, 0x05, 923, 923},   // U+0344
+	{240, 0x0A, 0, 0},       // U+0345
+	{230, 0x00, 0, 0},       // U+0346
+	{220, 0x00, 0, 0},       // U+0347
+	{220, 0x00, 0, 0},       // U+0348
+	{220, 0x00, 0, 0},       // U+0349
+	{230, 0x00, 0, 0},       // U+034A
+	{230, 0x00, 0, 0},       // U+034B
+	{230, 0x00, 0, 0},       // U+034C
+	{220, 0x00, 0, 0},       // U+034D
+	{220, 0x00, 0, 0},       // U+034E
+	{230, 0x00, 0, 0},       // U+0350
+	{230, 0x00, 0, 0},       // U+0351
+	{230, 0x00, 0, 0},       // U+0352
+	{220, 0x00, 0, 0},       // U+0353
+	{220, 0x00, 0, 0},       // U+0354
+	{220, 0x00, 0, 0},       // U+0355
+	{220, 0x00, 0, 0},       // U+0356
+	{230, 0x00, 0, 0},       // U+0357
+	{232, 0x00, 0, 0},       // U+0358
+	{220, 0x00, 0, 0},       // U+0359
+	{220, 0x00, 0, 0},       // U+035A
+	{230, 0x00, 0, 0},       // U+035B
+	{233, 0x00, 0, 0},       // U+035C
+	{234, 0x00, 0, 0},       // U+035D
+	{234, 0x00, 0, 0},       // U+035E
+	{233, 0x00, 0, 0},       // U+035F
+	{234, 0x00, 0, 0},       // U+0360
+	{234, 0x00, 0, 0},       // U+0361
+	{233, 0x00, 0, 0},       // U+0362
+	{230, 0x00, 0, 0},       // U+0363
+	{230, 0x00, 0, 0},       // U+0364
+	{230, 0x00, 0, 0},       // U+0365
+	{230, 0x00, 0, 0},       // U+0366
+	{230, 0x00, 0, 0},       // U+0367
+	{230, 0x00, 0, 0},       // U+0368
+	{230, 0x00, 0, 0},       // U+0369
+	{230, 0x00, 0, 0},       // U+036A
+	{230, 0x00, 0, 0},       // U+036B
+	{230, 0x00, 0, 0},       // U+036C
+	{230, 0x00, 0, 0},       // U+036D
+	{230, 0x00, 0, 0},       // U+036E
+	{230, 0x00, 0, 0},       // U+036F
+	{0, 0x05, 926, 926},     // U+0374
+	{0, 0x04, 0, 928},       // U+037A
+	{0, 0x05, 931, 931},     // U+037E
+	{0, 0x04, 0, 15},        // U+0384
+	{0, 0x04, 933, 936},     // U+0385
+	{0, 0x00, 940, 940},     // U+0386
+	{0, 0x05, 943, 943},     // U+0387
+	{0, 0x00, 945, 945},     // U+0388
+	{0, 0x00, 948, 948},     // U+0389
+	{0, 0x00, 951, 951},     // U+038A
+	{0, 0x00, 954, 954},     // U+038C
+	{0, 0x00, 957, 957},     // U+038E
+	{0, 0x00, 960, 960},     // U+038F
+	{0, 0x00, 963, 963},     // U+0390
+	{0, 0x00, 967, 967},     // U+03AA
+	{0, 0x00, 970, 970},     // U+03AB
+	{0, 0x00, 973, 973},     // U+03AC
+	{0, 0x00, 976, 976},     // U+03AD
+	{0, 0x00, 979, 979},     // U+03AE
+	{0, 0x00, 982, 982},     // U+03AF
+	{0, 0x00, 985, 985},     // U+03B0
+	{0, 0x00, 989, 989},     // U+03CA
+	{0, 0x00, 992, 992},     // U+03CB
+	{0, 0x00, 995, 995},     // U+03CC
+	{0, 0x00, 998, 998},     // U+03CD
+	{0, 0x00, 1001, 1001},   // U+03CE
+	{0, 0x04, 0, 1004},      // U+03D0
+	{0, 0x04, 0, 1006},      // U+03D1
+	{0, 0x04, 0, 1008},      // U+03D2
+	{0, 0x04, 1010, 957},    // U+03D3
+	{0, 0x04, 1013, 970},    // U+03D4
+	{0, 0x04, 0, 1016},      // U+03D5
+	{0, 0x04, 0, 1018},      // U+03D6
+	{0, 0x04, 0, 1020},      // U+03F0
+	{0, 0x04, 0, 1022},      // U+03F1
+	{0, 0x04, 0, 1024},      // U+03F2
+	{0, 0x04, 0, 1026},      // U+03F4
+	{0, 0x04, 0, 1028},      // U+03F5
+	{0, 0x04, 0, 1030},      // U+03F9
+	{0, 0x00, 1032, 1032},   // U+0400
+	{0, 0x00, 1035, 1035},   // U+0401
+	{0, 0x00, 1038, 1038},   // U+0403
+	{0, 0x00, 1041, 1041},   // U+0407
+	{0, 0x00, 1044, 1044},   // U+040C
+	{0, 0x00, 1047, 1047},   // U+040D
+	{0, 0x00, 1050, 1050},   // U+040E
+	{0, 0x00, 1053, 1053},   // U+0419
+	{0, 0x00, 1056, 1056},   // U+0439
+	{0, 0x00, 1059, 1059},   // U+0450
+	{0, 0x00, 1062, 1062},   // U+0451
+	{0, 0x00, 1065, 1065},   // U+0453
+	{0, 0x00, 1068, 1068},   // U+0457
+	{0, 0x00, 1071, 1071},   // U+045C
+	{0, 0x00, 1074, 1074},   // U+045D
+	{0, 0x00, 1077, 1077},   // U+045E
+	{0, 0x00, 1080, 1080},   // U+0476
+	{0, 0x00, 1083, 1083},   // U+0477
+	{230, 0x00, 0, 0},       // U+0483
+	{230, 0x00, 0, 0},       // U+0484
+	{230, 0x00, 0, 0},       // U+0485
+	{230, 0x00, 0, 0},       // U+0486
+	{230, 0x00, 0, 0},       // U+0487
+	{0, 0x00, 1086, 1086},   // U+04C1
+	{0, 0x00, 1089, 1089},   // U+04C2
+	{0, 0x00, 1092, 1092},   // U+04D0
+	{0, 0x00, 1095, 1095},   // U+04D1
+	{0, 0x00, 1098, 1098},   // U+04D2
+	{0, 0x00, 1101, 1101},   // U+04D3
+	{0, 0x00, 1104, 1104},   // U+04D6
+	{0, 0x00, 1107, 1107},   // U+04D7
+	{0, 0x00, 1110, 1110},   // U+04DA
+	{0, 0x00, 1113, 1113},   // U+04DB
+	{0, 0x00, 1116, 1116},   // U+04DC
+	{0, 0x00, 1119, 1119},   // U+04DD
+	{0, 0x00, 1122, 1122},   // U+04DE
+	{0, 0x00, 1125, 1125},   // U+04DF
+	{0, 0x00, 1128, 1128},   // U+04E2
+	{0, 0x00, 1131, 1131},   // U+04E3
+	{0, 0x00, 1134, 1134},   // U+04E4
+	{0, 0x00, 1137, 1137},   // U+04E5
+	{0, 0x00, 1140, 1140},   // U+04E6
+	{0, 0x00, 1143, 1143},   // U+04E7
+	{0, 0x00, 1146, 1146},   // U+04EA
+	{0, 0x00, 1149, 1149},   // U+04EB
+	{0, 0x00, 1152, 1152},   // U+04EC
+	{0, 0x00, 1155, 1155},   // U+04ED
+	{0, 0x00, 1158, 1158},   // U+04EE
+	{0, 0x00, 1161, 1161},   // U+04EF
+	{0, 0x00, 1164, 1164},   // U+04F0
+	{0, 0x00, 1167, 1167},   // U+04F1
+	{0, 0x00, 1170, 1170},   // U+04F2
+	{0, 0x00, 1173, 1173},   // U+04F3
+	{0, 0x00, 1176, 1176},   // U+04F4
+	{0, 0x00, 1179, 1179},   // U+04F5
+	{0, 0x00, 1182, 1182},   // U+04F8
+	{0, 0x00, 1185, 1185},   // U+04F9
+	{0, 0x04, 0, 1188},      // U+0587
+	{220, 0x00, 0, 0},       // U+0591
+	{230, 0x00, 0, 0},       // U+0592
+	{230, 0x00, 0, 0},       // U+0593
+	{230, 0x00, 0, 0},       // U+0594
+	{230, 0x00, 0, 0},       // U+0595
+	{220, 0x00, 0, 0},       // U+0596
+	{230, 0x00, 0, 0},       // U+0597
+	{230, 0x00, 0, 0},       // U+0598
+	{230, 0x00, 0, 0},       // U+0599
+	{222, 0x00, 0, 0},       // U+059A
+	{220, 0x00, 0, 0},       // U+059B
+	{230, 0x00, 0, 0},       // U+059C
+	{230, 0x00, 0, 0},       // U+059D
+	{230, 0x00, 0, 0},       // U+059E
+	{230, 0x00, 0, 0},       // U+059F
+	{230, 0x00, 0, 0},       // U+05A0
+	{230, 0x00, 0, 0},       // U+05A1
+	{220, 0x00, 0, 0},       // U+05A2
+	{220, 0x00, 0, 0},       // U+05A3
+	{220, 0x00, 0, 0},       // U+05A4
+	{220, 0x00, 0, 0},       // U+05A5
+	{220, 0x00, 0, 0},       // U+05A6
+	{220, 0x00, 0, 0},       // U+05A7
+	{230, 0x00, 0, 0},       // U+05A8
+	{230, 0x00, 0, 0},       // U+05A9
+	{220, 0x00, 0, 0},       // U+05AA
+	{230, 0x00, 0, 0},       // U+05AB
+	{230, 0x00, 0, 0},       // U+05AC
+	{222, 0x00, 0, 0},       // U+05AD
+	{228, 0x00, 0, 0},       // U+05AE
+	{230, 0x00, 0, 0},       // U+05AF
+	{10, 0x00, 0, 0},        // U+05B0
+	{11, 0x00, 0, 0},        // U+05B1
+	{12, 0x00, 0, 0},        // U+05B2
+	{13, 0x00, 0, 0},        // U+05B3
+	{14, 0x00, 0, 0},        // U+05B4
+	{15, 0x00, 0, 0},        // U+05B5
+	{16, 0x00, 0, 0},        // U+05B6
+	{17, 0x00, 0, 0},        // U+05B7
+	{18, 0x00, 0, 0},        // U+05B8
+	{19, 0x00, 0, 0},        // U+05B9
+	{19, 0x00, 0, 0},        // U+05BA
+	{20, 0x00, 0, 0},        // U+05BB
+	{21, 0x00, 0, 0},        // U+05BC
+	{22, 0x00, 0, 0},        // U+05BD
+	{23, 0x00, 0, 0},        // U+05BF
+	{24, 0x00, 0, 0},        // U+05C1
+	{25, 0x00, 0, 0},        // U+05C2
+	{230, 0x00, 0, 0},       // U+05C4
+	{220, 0x00, 0, 0},       // U+05C5
+	{18, 0x00, 0, 0},        // U+05C7
+	{230, 0x00, 0, 0},       // U+0610
+	{230, 0x00, 0, 0},       // U+0611
+	{230, 0x00, 0, 0},       // U+0612
+	{230, 0x00, 0, 0},       // U+0613
+	{230, 0x00, 0, 0},       // U+0614
+	{230, 0x00, 0, 0},       // U+0615
+	{230, 0x00, 0, 0},       // U+0616
+	{230, 0x00, 0, 0},       // U+0617
+	{30, 0x00, 0, 0},        // U+0618
+	{31, 0x00, 0, 0},        // U+0619
+	{32, 0x00, 0, 0},        // U+061A
+	{0, 0x00, 1191, 1191},   // U+0622
+	{0, 0x00, 1194, 1194},   // U+0623
+	{0, 0x00, 1197, 1197},   // U+0624
+	{0, 0x00, 1200, 1200},   // U+0625
+	{0, 0x00, 1203, 1203},   // U+0626
+	{27, 0x00, 0, 0},        // U+064B
+	{28, 0x00, 0, 0},        // U+064C
+	{29, 0x00, 0, 0},        // U+064D
+	{30, 0x00, 0, 0},        // U+064E
+	{31, 0x00, 0, 0},        // U+064F
+	{32, 0x00, 0, 0},        // U+0650
+	{33, 0x00, 0, 0},        // U+0651
+	{34, 0x00, 0, 0},        // U+0652
+	{230, 0x0A, 0, 0},       // U+0653
+	{230, 0x0A, 0, 0},       // U+0654
+	{220, 0x0A, 0, 0},       // U+0655
+	{220, 0x00, 0, 0},       // U+0656
+	{230, 0x00, 0, 0},       // U+0657
+	{230, 0x00, 0, 0},       // U+0658
+	{230, 0x00, 0, 0},       // U+0659
+	{230, 0x00, 0, 0},       // U+065A
+	{230, 0x00, 0, 0},       // U+065B
+	{220, 0x00, 0, 0},       // U+065C
+	{230, 0x00, 0, 0},       // U+065D
+	{230, 0x00, 0, 0},       // U+065E
+	{220, 0x00, 0, 0},       // U+065F
+	{35, 0x00, 0, 0},        // U+0670
+	{0, 0x04, 0, 1206},      // U+0675
+	{0, 0x04, 0, 1209},      // U+0676
+	{0, 0x04, 0, 1212},      // U+0677
+	{0, 0x04, 0, 1215},      // U+0678
+	{0, 0x00, 1218, 1218},   // U+06C0
+	{0, 0x00, 1221, 1221},   // U+06C2
+	{0, 0x00, 1224, 1224},   // U+06D3
+	{230, 0x00, 0, 0},       // U+06D6
+	{230, 0x00, 0, 0},       // U+06D7
+	{230, 0x00, 0, 0},       // U+06D8
+	{230, 0x00, 0, 0},       // U+06D9
+	{230, 0x00, 0, 0},       // U+06DA
+	{230, 0x00, 0, 0},       // U+06DB
+	{230, 0x00, 0, 0},       // U+06DC
+	{230, 0x00, 0, 0},       // U+06DF
+	{230, 0x00, 0, 0},       // U+06E0
+	{230, 0x00, 0, 0},       // U+06E1
+	{230, 0x00, 0, 0},       // U+06E2
+	{220, 0x00, 0, 0},       // U+06E3
+	{230, 0x00, 0, 0},       // U+06E4
+	{230, 0x00, 0, 0},       // U+06E7
+	{230, 0x00, 0, 0},       // U+06E8
+	{220, 0x00, 0, 0},       // U+06EA
+	{230, 0x00, 0, 0},       // U+06EB
+	{230, 0x00, 0, 0},       // U+06EC
+	{220, 0x00, 0, 0},       // U+06ED
+	{36, 0x00, 0, 0},        // U+0711
+	{230, 0x00, 0, 0},       // U+0730
+	{220, 0x00, 0, 0},       // U+0731
+	{230, 0x00, 0, 0},       // U+0732
+	{230, 0x00, 0, 0},       // U+0733
+	{220, 0x00, 0, 0},       // U+0734
+	{230, 0x00, 0, 0},       // U+0735
+	{230, 0x00, 0, 0},       // U+0736
+	{220, 0x00, 0, 0},       // U+0737
+	{220, 0x00, 0, 0},       // U+0738
+	{220, 0x00, 0, 0},       // U+0739
+	{230, 0x00, 0, 0},       // U+073A
+	{220, 0x00, 0, 0},       // U+073B
+	{220, 0x00, 0, 0},       // U+073C
+	{230, 0x00, 0, 0},       // U+073D
+	{220, 0x00, 0, 0},       // U+073E
+	{230, 0x00, 0, 0},       // U+073F
+	{230, 0x00, 0, 0},       // U+0740
+	{230, 0x00, 0, 0},       // U+0741
+	{220, 0x00, 0, 0},       // U+0742
+	{230, 0x00, 0, 0},       // U+0743
+	{220, 0x00, 0, 0},       // U+0744
+	{230, 0x00, 0, 0},       // U+0745
+	{220, 0x00, 0, 0},       // U+0746
+	{230, 0x00, 0, 0},       // U+0747
+	{220, 0x00, 0, 0},       // U+0748
+	{230, 0x00, 0, 0},       // U+0749
+	{230, 0x00, 0, 0},       // U+074A
+	{230, 0x00, 0, 0},       // U+07EB
+	{230, 0x00, 0, 0},       // U+07EC
+	{230, 0x00, 0, 0},       // U+07ED
+	{230, 0x00, 0, 0},       // U+07EE
+	{230, 0x00, 0, 0},       // U+07EF
+	{230, 0x00, 0, 0},       // U+07F0
+	{230, 0x00, 0, 0},       // U+07F1
+	{220, 0x00, 0, 0},       // U+07F2
+	{230, 0x00, 0, 0},       // U+07F3
+	{220, 0x00, 0, 0},       // U+07FD
+	{230, 0x00, 0, 0},       // U+0816
+	{230, 0x00, 0, 0},       // U+0817
+	{230, 0x00, 0, 0},       // U+0818
+	{230, 0x00, 0, 0},       // U+0819
+	{230, 0x00, 0, 0},       // U+081B
+	{230, 0x00, 0, 0},       // U+081C
+	{230, 0x00, 0, 0},       // U+081D
+	{230, 0x00, 0, 0},       // U+081E
+	{230, 0x00, 0, 0},       // U+081F
+	{230, 0x00, 0, 0},       // U+0820
+	{230, 0x00, 0, 0},       // U+0821
+	{230, 0x00, 0, 0},       // U+0822
+	{230, 0x00, 0, 0},       // U+0823
+	{230, 0x00, 0, 0},       // U+0825
+	{230, 0x00, 0, 0},       // U+0826
+	{230, 0x00, 0, 0},       // U+0827
+	{230, 0x00, 0, 0},       // U+0829
+	{230, 0x00, 0, 0},       // U+082A
+	{230, 0x00, 0, 0},       // U+082B
+	{230, 0x00, 0, 0},       // U+082C
+	{230, 0x00, 0, 0},       // U+082D
+	{220, 0x00, 0, 0},       // U+0859
+	{220, 0x00, 0, 0},       // U+085A
+	{220, 0x00, 0, 0},       // U+085B
+	{230, 0x00, 0, 0},       // U+0898
+	{220, 0x00, 0, 0},       // U+0899
+	{220, 0x00, 0, 0},       // U+089A
+	{220, 0x00, 0, 0},       // U+089B
+	{230, 0x00, 0, 0},       // U+089C
+	{230, 0x00, 0, 0},       // U+089D
+	{230, 0x00, 0, 0},       // U+089E
+	{230, 0x00, 0, 0},       // U+089F
+	{230, 0x00, 0, 0},       // U+08CA
+	{230, 0x00, 0, 0},       // U+08CB
+	{230, 0x00, 0, 0},       // U+08CC
+	{230, 0x00, 0, 0},       // U+08CD
+	{230, 0x00, 0, 0},       // U+08CE
+	{220, 0x00, 0, 0},       // U+08CF
+	{220, 0x00, 0, 0},       // U+08D0
+	{220, 0x00, 0, 0},       // U+08D1
+	{220, 0x00, 0, 0},       // U+08D2
+	{220, 0x00, 0, 0},       // U+08D3
+	{230, 0x00, 0, 0},       // U+08D4
+	{230, 0x00, 0, 0},       // U+08D5
+	{230, 0x00, 0, 0},       // U+08D6
+	{230, 0x00, 0, 0},       // U+08D7
+	{230, 0x00, 0, 0},       // U+08D8
+	{230, 0x00, 0, 0},       // U+08D9
+	{230, 0x00, 0, 0},       // U+08DA
+	{230, 0x00, 0, 0},       // U+08DB
+	{230, 0x00, 0, 0},       // U+08DC
+	{230, 0x00, 0, 0},       // U+08DD
+	{230, 0x00, 0, 0},       // U+08DE
+	{230, 0x00, 0, 0},       // U+08DF
+	{230, 0x00, 0, 0},       // U+08E0
+	{230, 0x00, 0, 0},       // U+08E1
+	{220, 0x00, 0, 0},       // U+08E3
+	{230, 0x00, 0, 0},       // U+08E4
+	{230, 0x00, 0, 0},       // U+08E5
+	{220, 0x00, 0, 0},       // U+08E6
+	{230, 0x00, 0, 0},       // U+08E7
+	{230, 0x00, 0, 0},       // U+08E8
+	{220, 0x00, 0, 0},       // U+08E9
+	{230, 0x00, 0, 0},       // U+08EA
+	{230, 0x00, 0, 0},       // U+08EB
+	{230, 0x00, 0, 0},       // U+08EC
+	{220, 0x00, 0, 0},       // U+08ED
+	{220, 0x00, 0, 0},       // U+08EE
+	{220, 0x00, 0, 0},       // U+08EF
+	{27, 0x00, 0, 0},        // U+08F0
+	{28, 0x00, 0, 0},        // U+08F1
+	{29, 0x00, 0, 0},        // U+08F2
+	{230, 0x00, 0, 0},       // U+08F3
+	{230, 0x00, 0, 0},       // U+08F4
+	{230, 0x00, 0, 0},       // U+08F5
+	{220, 0x00, 0, 0},       // U+08F6
+	{230, 0x00, 0, 0},       // U+08F7
+	{230, 0x00, 0, 0},       // U+08F8
+	{220, 0x00, 0, 0},       // U+08F9
+	{220, 0x00, 0, 0},       // U+08FA
+	{230, 0x00, 0, 0},       // U+08FB
+	{230, 0x00, 0, 0},       // U+08FC
+	{230, 0x00, 0, 0},       // U+08FD
+	{230, 0x00, 0, 0},       // U+08FE
+	{230, 0x00, 0, 0},       // U+08FF
+	{0, 0x00, 1227, 1227},   // U+0929
+	{0, 0x00, 1230, 1230},   // U+0931
+	{0, 0x00, 1233, 1233},   // U+0934
+	{7, 0x0A, 0, 0},         // U+093C
+	{9, 0x00, 0, 0},         // U+094D
+	{230, 0x00, 0, 0},       // U+0951
+	{220, 0x00, 0, 0},       // U+0952
+	{230, 0x00, 0, 0},       // U+0953
+	{230, 0x00, 0, 0},       // U+0954
+	{0, 0x05, 1236, 1236},   // U+0958
+	{0, 0x05, 1239, 1239},   // U+0959
+	{0, 0x05, 1242, 1242},   // U+095A
+	{0, 0x05, 1245, 1245},   // U+095B
+	{0, 0x05, 1248, 1248},   // U+095C
+	{0, 0x05, 1251, 1251},   // U+095D
+	{0, 0x05, 1254, 1254},   // U+095E
+	{0, 0x05, 1257, 1257},   // U+095F
+	{7, 0x00, 0, 0},         // U+09BC
+	{0, 0x0A, 0, 0},         // U+09BE
+	{0, 0x00, 1260, 1260},   // U+09CB
+	{0, 0x00, 1263, 1263},   // U+09CC
+	{9, 0x00, 0, 0},         // U+09CD
+	{0, 0x0A, 0, 0},         // U+09D7
+	{0, 0x05, 1266, 1266},   // U+09DC
+	{0, 0x05, 1269, 1269},   // U+09DD
+	{0, 0x05, 1272, 1272},   // U+09DF
+	{230, 0x00, 0, 0},       // U+09FE
+	{0, 0x05, 1275, 1275},   // U+0A33
+	{0, 0x05, 1278, 1278},   // U+0A36
+	{7, 0x00, 0, 0},         // U+0A3C
+	{9, 0x00, 0, 0},         // U+0A4D
+	{0, 0x05, 1281, 1281},   // U+0A59
+	{0, 0x05, 1284, 1284},   // U+0A5A
+	{0, 0x05, 1287, 1287},   // U+0A5B
+	{0, 0x05, 1290, 1290},   // U+0A5E
+	{7, 0x00, 0, 0},         // U+0ABC
+	{9, 0x00, 0, 0},         // U+0ACD
+	{7, 0x00, 0, 0},         // U+0B3C
+	{0, 0x0A, 0, 0},         // U+0B3E
+	{0, 0x00, 1293, 1293},   // U+0B48
+	{0, 0x00, 1296, 1296},   // U+0B4B
+	{0, 0x00, 1299, 1299},   // U+0B4C
+	{9, 0x00, 0, 0},         // U+0B4D
+	{0, 0x0A, 0, 0},         // U+0B56
+	{0, 0x0A, 0, 0},         // U+0B57
+	{0, 0x05, 1302, 1302},   // U+0B5C
+	{0, 0x05, 1305, 1305},   // U+0B5D
+	{0, 0x00, 1308, 1308},   // U+0B94
+	{0, 0x0A, 0, 0},         // U+0BBE
+	{0, 0x00, 1311, 1311},   // U+0BCA
+	{0, 0x00, 1314, 1314},   // U+0BCB
+	{0, 0x00, 1317, 1317},   // U+0BCC
+	{9, 0x00, 0, 0},         // U+0BCD
+	{0, 0x0A, 0, 0},         // U+0BD7
+	{7, 0x00, 0, 0},         // U+0C3C
+	{0, 0x00, 1320, 1320},   // U+0C48
+	{9, 0x00, 0, 0},         // U+0C4D
+	{84, 0x00, 0, 0},        // U+0C55
+	{91, 0x0A, 0, 0},        // U+0C56
+	{7, 0x00, 0, 0},         // U+0CBC
+	{0, 0x00, 1323, 1323},   // U+0CC0
+	{0, 0x0A, 0, 0},         // U+0CC2
+	{0, 0x00, 1326, 1326},   // U+0CC7
+	{0, 0x00, 1329, 1329},   // U+0CC8
+	{0, 0x00, 1332, 1332},   // U+0CCA
+	{0, 0x00, 1335, 1335},   // U+0CCB
+	{9, 0x00, 0, 0},         // U+0CCD
+	{0, 0x0A, 0, 0},         // U+0CD5
+	{0, 0x0A, 0, 0},         // U+0CD6
+	{9, 0x00, 0, 0},         // U+0D3B
+	{9, 0x00, 0, 0},         // U+0D3C
+	{0, 0x0A, 0, 0},         // U+0D3E
+	{0, 0x00, 1339, 1339},   // U+0D4A
+	{0, 0x00, 1342, 1342},   // U+0D4B
+	{0, 0x00, 1345, 1345},   // U+0D4C
+	{9, 0x00, 0, 0},         // U+0D4D
+	{0, 0x0A, 0, 0},         // U+0D57
+	{9, 0x0A, 0, 0},         // U+0DCA
+	{0, 0x0A, 0, 0},         // U+0DCF
+	{0, 0x00, 1348, 1348},   // U+0DDA
+	{0, 0x00, 1351, 1351},   // U+0DDC
+	{0, 0x00, 1354, 1354},   // U+0DDD
+	{0, 0x00, 1358, 1358},   // U+0DDE
+	{0, 0x0A, 0, 0},         // U+0DDF
+	{0, 0x04, 0, 1361},      // U+0E33
+	{103, 0x00, 0, 0},       // U+0E38
+	{103, 0x00, 0, 0},       // U+0E39
+	{9, 0x00, 0, 0},         // U+0E3A
+	{107, 0x00, 0, 0},       // U+0E48
+	{107, 0x00, 0, 0},       // U+0E49
+	{107, 0x00, 0, 0},       // U+0E4A
+	{107, 0x00, 0, 0},       // U+0E4B
+	{0, 0x04, 0, 1364},      // U+0EB3
+	{118, 0x00, 0, 0},       // U+0EB8
+	{118, 0x00, 0, 0},       // U+0EB9
+	{9, 0x00, 0, 0},         // U+0EBA
+	{122, 0x00, 0, 0},       // U+0EC8
+	{122, 0x00, 0, 0},       // U+0EC9
+	{122, 0x00, 0, 0},       // U+0ECA
+	{122, 0x00, 0, 0},       // U+0ECB
+	{0, 0x04, 0, 1367},      // U+0EDC
+	{0, 0x04, 0, 1370},      // U+0EDD
+	{0, 0x04, 0, 1373},      // U+0F0C
+	{220, 0x00, 0, 0},       // U+0F18
+	{220, 0x00, 0, 0},       // U+0F19
+	{220, 0x00, 0, 0},       // U+0F35
+	{220, 0x00, 0, 0},       // U+0F37
+	{216, 0x00, 0, 0},       // U+0F39
+	{0, 0x05, 1375, 1375},   // U+0F43
+	{0, 0x05, 1378, 1378},   // U+0F4D
+	{0, 0x05, 1381, 1381},   // U+0F52
+	{0, 0x05, 1384, 1384},   // U+0F57
+	{0, 0x05, 1387, 1387},   // U+0F5C
+	{0, 0x05, 1390, 1390},   // U+0F69
+	{129, 0x00, 0, 0},       // U+0F71
+	{130, 0x00, 0, 0},       // U+0F72
+	{0, 0x05, 1393, 1393},   // U+0F73
+	{132, 0x00, 0, 0},       // U+0F74
+	{0, 0x05, 1396, 1396},   // U+0F75
+	{0, 0x05, 1399, 1399},   // U+0F76
+	{0, 0x04, 0, 1402},      // U+0F77
+	{0, 0x05, 1406, 1406},   // U+0F78
+	{0, 0x04, 0, 1409},      // U+0F79
+	{130, 0x00, 0, 0},       // U+0F7A
+	{130, 0x00, 0, 0},       // U+0F7B
+	{130, 0x00, 0, 0},       // U+0F7C
+	{130, 0x00, 0, 0},       // U+0F7D
+	{130, 0x00, 0, 0},       // U+0F80
+	{0, 0x05, 1413, 1413},   // U+0F81
+	{230, 0x00, 0, 0},       // U+0F82
+	{230, 0x00, 0, 0},       // U+0F83
+	{9, 0x00, 0, 0},         // U+0F84
+	{230, 0x00, 0, 0},       // U+0F86
+	{230, 0x00, 0, 0},       // U+0F87
+	{0, 0x05, 1416, 1416},   // U+0F93
+	{0, 0x05, 1419, 1419},   // U+0F9D
+	{0, 0x05, 1422, 1422},   // U+0FA2
+	{0, 0x05, 1425, 1425},   // U+0FA7
+	{0, 0x05, 1428, 1428},   // U+0FAC
+	{0, 0x05, 1431, 1431},   // U+0FB9
+	{220, 0x00, 0, 0},       // U+0FC6
+	{0, 0x00, 1434, 1434},   // U+1026
+	{0, 0x0A, 0, 0},         // U+102E
+	{7, 0x00, 0, 0},         // U+1037
+	{9, 0x00, 0, 0},         // U+1039
+	{9, 0x00, 0, 0},         // U+103A
+	{220, 0x00, 0, 0},       // U+108D
+	{0, 0x04, 0, 1437},      // U+10FC
+	{0, 0x0A, 0, 0},         // U+1161
+	{0, 0x0A, 0, 0},         // U+1162
+	{0, 0x0A, 0, 0},         // U+1163
+	{0, 0x0A, 0, 0},         // U+1164
+	{0, 0x0A, 0, 0},         // U+1165
+	{0, 0x0A, 0, 0},         // U+1166
+	{0, 0x0A, 0, 0},         // U+1167
+	{0, 0x0A, 0, 0},         // U+1168
+	{0, 0x0A, 0, 0},         // U+1169
+	{0, 0x0A, 0, 0},         // U+116A
+	{0, 0x0A, 0, 0},         // U+116B
+	{0, 0x0A, 0, 0},         // U+116C
+	{0, 0x0A, 0, 0},         // U+116D
+	{0, 0x0A, 0, 0},         // U+116E
+	{0, 0x0A, 0, 0},         // U+116F
+	{0, 0x0A, 0, 0},         // U+1170
+	{0, 0x0A, 0, 0},         // U+1171
+	{0, 0x0A, 0, 0},         // U+1172
+	{0, 0x0A, 0, 0},         // U+1173
+	{0, 0x0A, 0, 0},         // U+1174
+	{0, 0x0A, 0, 0},         // U+1175
+	{0, 0x0A, 0, 0},         // U+11A8
+	{0, 0x0A, 0, 0},         // U+11A9
+	{0, 0x0A, 0, 0},         // U+11AA
+	{0, 0x0A, 0, 0},         // U+11AB
+	{0, 0x0A, 0, 0},         // U+11AC
+	{0, 0x0A, 0, 0},         // U+11AD
+	{0, 0x0A, 0, 0},         // U+11AE
+	{0, 0x0A, 0, 0},         // U+11AF
+	{0, 0x0A, 0, 0},         // U+11B0
+	{0, 0x0A, 0, 0},         // U+11B1
+	{0, 0x0A, 0, 0},         // U+11B2
+	{0, 0x0A, 0, 0},         // U+11B3
+	{0, 0x0A, 0, 0},         // U+11B4
+	{0, 0x0A, 0, 0},         // U+11B5
+	{0, 0x0A, 0, 0},         // U+11B6
+	{0, 0x0A, 0, 0},         // U+11B7
+	{0, 0x0A, 0, 0},         // U+11B8
+	{0, 0x0A, 0, 0},         // U+11B9
+	{0, 0x0A, 0, 0},         // U+11BA
+	{0, 0x0A, 0, 0},         // U+11BB
+	{0, 0x0A, 0, 0},         // U+11BC
+	{0, 0x0A, 0, 0},         // U+11BD
+	{0, 0x0A, 0, 0},         // U+11BE
+	{0, 0x0A, 0, 0},         // U+11BF
+	{0, 0x0A, 0, 0},         // U+11C0
+	{0, 0x0A, 0, 0},         // U+11C1
+	{0, 0x0A, 0, 0},         // U+11C2
+	{230, 0x00, 0, 0},       // U+135D
+	{230, 0x00, 0, 0},       // U+135E
+	{230, 0x00, 0, 0},       // U+135F
+	{9, 0x00, 0, 0},         // U+1714
+	{9, 0x00, 0, 0},         // U+1715
+	{9, 0x00, 0, 0},         // U+1734
+	{9, 0x00, 0, 0},         // U+17D2
+	{230, 0x00, 0, 0},       // U+17DD
+	{228, 0x00, 0, 0},       // U+18A9
+	{222, 0x00, 0, 0},       // U+1939
+	{230, 0x00, 0, 0},       // U+193A
+	{220, 0x00, 0, 0},       // U+193B
+	{230, 0x00, 0, 0},       // U+1A17
+	{220, 0x00, 0, 0},       // U+1A18
+	{9, 0x00, 0, 0},         // U+1A60
+	{230, 0x00, 0, 0},       // U+1A75
+	{230, 0x00, 0, 0},       // U+1A76
+	{230, 0x00, 0, 0},       // U+1A77
+	{230, 0x00, 0, 0},       // U+1A78
+	{230, 0x00, 0, 0},       // U+1A79
+	{230, 0x00, 0, 0},       // U+1A7A
+	{230, 0x00, 0, 0},       // U+1A7B
+	{230, 0x00, 0, 0},       // U+1A7C
+	{220, 0x00, 0, 0},       // U+1A7F
+	{230, 0x00, 0, 0},       // U+1AB0
+	{230, 0x00, 0, 0},       // U+1AB1
+	{230, 0x00, 0, 0},       // U+1AB2
+	{230, 0x00, 0, 0},       // U+1AB3
+	{230, 0x00, 0, 0},       // U+1AB4
+	{220, 0x00, 0, 0},       // U+1AB5
+	{220, 0x00, 0, 0},       // U+1AB6
+	{220, 0x00, 0, 0},       // U+1AB7
+	{220, 0x00, 0, 0},       // U+1AB8
+	{220, 0x00, 0, 0},       // U+1AB9
+	{220, 0x00, 0, 0},       // U+1ABA
+	{230, 0x00, 0, 0},       // U+1ABB
+	{230, 0x00, 0, 0},       // U+1ABC
+	{220, 0x00, 0, 0},       // U+1ABD
+	{220, 0x00, 0, 0},       // U+1ABF
+	{220, 0x00, 0, 0},       // U+1AC0
+	{230, 0x00, 0, 0},       // U+1AC1
+	{230, 0x00, 0, 0},       // U+1AC2
+	{220, 0x00, 0, 0},       // U+1AC3
+	{220, 0x00, 0, 0},       // U+1AC4
+	{230, 0x00, 0, 0},       // U+1AC5
+	{230, 0x00, 0, 0},       // U+1AC6
+	{230, 0x00, 0, 0},       // U+1AC7
+	{230, 0x00, 0, 0},       // U+1AC8
+	{230, 0x00, 0, 0},       // U+1AC9
+	{220, 0x00, 0, 0},       // U+1ACA
+	{230, 0x00, 0, 0},       // U+1ACB
+	{230, 0x00, 0, 0},       // U+1ACC
+	{230, 0x00, 0, 0},       // U+1ACD
+	{230, 0x00, 0, 0},       // U+1ACE
+	{0, 0x00, 1439, 1439},   // U+1B06
+	{0, 0x00, 1442, 1442},   // U+1B08
+	{0, 0x00, 1445, 1445},   // U+1B0A
+	{0, 0x00, 1448, 1448},   // U+1B0C
+	{0, 0x00, 1451, 1451},   // U+1B0E
+	{0, 0x00, 1454, 1454},   // U+1B12
+	{7, 0x00, 0, 0},         // U+1B34
+	{0, 0x0A, 0, 0},         // U+1B35
+	{0, 0x00, 1457, 1457},   // U+1B3B
+	{0, 0x00, 1460, 1460},   // U+1B3D
+	{0, 0x00, 1463, 1463},   // U+1B40
+	{0, 0x00, 1466, 1466},   // U+1B41
+	{0, 0x00, 1469, 1469},   // U+1B43
+	{9, 0x00, 0, 0},         // U+1B44
+	{230, 0x00, 0, 0},       // U+1B6B
+	{220, 0x00, 0, 0},       // U+1B6C
+	{230, 0x00, 0, 0},       // U+1B6D
+	{230, 0x00, 0, 0},       // U+1B6E
+	{230, 0x00, 0, 0},       // U+1B6F
+	{230, 0x00, 0, 0},       // U+1B70
+	{230, 0x00, 0, 0},       // U+1B71
+	{230, 0x00, 0, 0},       // U+1B72
+	{230, 0x00, 0, 0},       // U+1B73
+	{9, 0x00, 0, 0},         // U+1BAA
+	{9, 0x00, 0, 0},         // U+1BAB
+	{7, 0x00, 0, 0},         // U+1BE6
+	{9, 0x00, 0, 0},         // U+1BF2
+	{9, 0x00, 0, 0},         // U+1BF3
+	{7, 0x00, 0, 0},         // U+1C37
+	{230, 0x00, 0, 0},       // U+1CD0
+	{230, 0x00, 0, 0},       // U+1CD1
+	{230, 0x00, 0, 0},       // U+1CD2
+	{1, 0x00, 0, 0},         // U+1CD4
+	{220, 0x00, 0, 0},       // U+1CD5
+	{220, 0x00, 0, 0},       // U+1CD6
+	{220, 0x00, 0, 0},       // U+1CD7
+	{220, 0x00, 0, 0},       // U+1CD8
+	{220, 0x00, 0, 0},       // U+1CD9
+	{230, 0x00, 0, 0},       // U+1CDA
+	{230, 0x00, 0, 0},       // U+1CDB
+	{220, 0x00, 0, 0},       // U+1CDC
+	{220, 0x00, 0, 0},       // U+1CDD
+	{220, 0x00, 0, 0},       // U+1CDE
+	{220, 0x00, 0, 0},       // U+1CDF
+	{230, 0x00, 0, 0},       // U+1CE0
+	{1, 0x00, 0, 0},         // U+1CE2
+	{1, 0x00, 0, 0},         // U+1CE3
+	{1, 0x00, 0, 0},         // U+1CE4
+	{1, 0x00, 0, 0},         // U+1CE5
+	{1, 0x00, 0, 0},         // U+1CE6
+	{1, 0x00, 0, 0},         // U+1CE7
+	{1, 0x00, 0, 0},         // U+1CE8
+	{220, 0x00, 0, 0},       // U+1CED
+	{230, 0x00, 0, 0},       // U+1CF4
+	{230, 0x00, 0, 0},       // U+1CF8
+	{230, 0x00, 0, 0},       // U+1CF9
+	{0, 0x04, 0, 1472},      // U+1D2C
+	{0, 0x04, 0, 1474},      // U+1D2D
+	{0, 0x04, 0, 1476},      // U+1D2E
+	{0, 0x04, 0, 1478},      // U+1D30
+	{0, 0x04, 0, 1480},      // U+1D31
+	{0, 0x04, 0, 1482},      // U+1D32
+	{0, 0x04, 0, 1484},      // U+1D33
+	{0, 0x04, 0, 1486},      // U+1D34
+	{0, 0x04, 0, 1488},      // U+1D35
+	{0, 0x04, 0, 1490},      // U+1D36
+	{0, 0x04, 0, 1492},      // U+1D37
+	{0, 0x04, 0, 1494},      // U+1D38
+	{0, 0x04, 0, 1496},      // U+1D39
+	{0, 0x04, 0, 1498},      // U+1D3A
+	{0, 0x04, 0, 1500},      // U+1D3C
+	{0, 0x04, 0, 1502},      // U+1D3D
+	{0, 0x04, 0, 1504},      // U+1D3E
+	{0, 0x04, 0, 1506},      // U+1D3F
+	{0, 0x04, 0, 1508},      // U+1D40
+	{0, 0x04, 0, 1510},      // U+1D41
+	{0, 0x04, 0, 1512},      // U+1D42
+	{0, 0x04, 0, 6},         // U+1D43
+	{0, 0x04, 0, 1514},      // U+1D44
+	{0, 0x04, 0, 1516},      // U+1D45
+	{0, 0x04, 0, 1518},      // U+1D46
+	{0, 0x04, 0, 1520},      // U+1D47
+	{0, 0x04, 0, 1522},      // U+1D48
+	{0, 0x04, 0, 1524},      // U+1D49
+	{0, 0x04, 0, 1526},      // U+1D4A
+	{0, 0x04, 0, 1528},      // U+1D4B
+	{0, 0x04, 0, 1530},      // U+1D4C
+	{0, 0x04, 0, 1532},      // U+1D4D
+	{0, 0x04, 0, 1534},      // U+1D4F
+	{0, 0x04, 0, 1536},      // U+1D50
+	{0, 0x04, 0, 1538},      // U+1D51
+	{0, 0x04, 0, 25},        // U+1D52
+	{0, 0x04, 0, 1540},      // U+1D53
+	{0, 0x04, 0, 1542},      // U+1D54
+	{0, 0x04, 0, 1544},      // U+1D55
+	{0, 0x04, 0, 1546},      // U+1D56
+	{0, 0x04, 0, 1548},      // U+1D57
+	{0, 0x04, 0, 1550},      // U+1D58
+	{0, 0x04, 0, 1552},      // U+1D59
+	{0, 0x04, 0, 1554},      // U+1D5A
+	{0, 0x04, 0, 1556},      // U+1D5B
+	{0, 0x04, 0, 1558},      // U+1D5C
+	{0, 0x04, 0, 1004},      // U+1D5D
+	{0, 0x04, 0, 1560},      // U+1D5E
+	{0, 0x04, 0, 1562},      // U+1D5F
+	{0, 0x04, 0, 1016},      // U+1D60
+	{0, 0x04, 0, 1564},      // U+1D61
+	{0, 0x04, 0, 1566},      // U+1D62
+	{0, 0x04, 0, 879},       // U+1D63
+	{0, 0x04, 0, 1550},      // U+1D64
+	{0, 0x04, 0, 1556},      // U+1D65
+	{0, 0x04, 0, 1004},      // U+1D66
+	{0, 0x04, 0, 1560},      // U+1D67
+	{0, 0x04, 0, 1022},      // U+1D68
+	{0, 0x04, 0, 1016},      // U+1D69
+	{0, 0x04, 0, 1564},      // U+1D6A
+	{0, 0x04, 0, 1568},      // U+1D78
+	{0, 0x04, 0, 1570},      // U+1D9B
+	{0, 0x04, 0, 1572},      // U+1D9C
+	{0, 0x04, 0, 1574},      // U+1D9D
+	{0, 0x04, 0, 1576},      // U+1D9E
+	{0, 0x04, 0, 1530},      // U+1D9F
+	{0, 0x04, 0, 1578},      // U+1DA0
+	{0, 0x04, 0, 1580},      // U+1DA1
+	{0, 0x04, 0, 1582},      // U+1DA2
+	{0, 0x04, 0, 1584},      // U+1DA3
+	{0, 0x04, 0, 1586},      // U+1DA4
+	{0, 0x04, 0, 1588},      // U+1DA5
+	{0, 0x04, 0, 1590},      // U+1DA6
+	{0, 0x04, 0, 1592},      // U+1DA7
+	{0, 0x04, 0, 1594},      // U+1DA8
+	{0, 0x04, 0, 1596},      // U+1DA9
+	{0, 0x04, 0, 1598},      // U+1DAA
+	{0, 0x04, 0, 1600},      // U+1DAB
+	{0, 0x04, 0, 1602},      // U+1DAC
+	{0, 0x04, 0, 1604},      // U+1DAD
+	{0, 0x04, 0, 1606},      // U+1DAE
+	{0, 0x04, 0, 1608},      // U+1DAF
+	{0, 0x04, 0, 1610},      // U+1DB0
+	{0, 0x04, 0, 1612},      // U+1DB1
+	{0, 0x04, 0, 1614},      // U+1DB2
+	{0, 0x04, 0, 1616},      // U+1DB3
+	{0, 0x04, 0, 1618},      // U+1DB4
+	{0, 0x04, 0, 1620},      // U+1DB5
+	{0, 0x04, 0, 1622},      // U+1DB6
+	{0, 0x04, 0, 1624},      // U+1DB7
+	{0, 0x04, 0, 1626},      // U+1DB8
+	{0, 0x04, 0, 1628},      // U+1DB9
+	{0, 0x04, 0, 1630},      // U+1DBA
+	{0, 0x04, 0, 1632},      // U+1DBB
+	{0, 0x04, 0, 1634},      // U+1DBC
+	{0, 0x04, 0, 1636},      // U+1DBD
+	{0, 0x04, 0, 1638},      // U+1DBE
+	{0, 0x04, 0, 1006},      // U+1DBF
+	{230, 0x00, 0, 0},       // U+1DC0
+	{230, 0x00, 0, 0},       // U+1DC1
+	{220, 0x00, 0, 0},       // U+1DC2
+	{230, 0x00, 0, 0},       // U+1DC3
+	{230, 0x00, 0, 0},       // U+1DC4
+	{230, 0x00, 0, 0},       // U+1DC5
+	{230, 0x00, 0, 0},       // U+1DC6
+	{230, 0x00, 0, 0},       // U+1DC7
+	{230, 0x00, 0, 0},       // U+1DC8
+	{230, 0x00, 0, 0},       // U+1DC9
+	{220, 0x00, 0, 0},       // U+1DCA
+	{230, 0x00, 0, 0},       // U+1DCB
+	{230, 0x00, 0, 0},       // U+1DCC
+	{234, 0x00, 0, 0},       // U+1DCD
+	{214, 0x00, 0, 0},       // U+1DCE
+	{220, 0x00, 0, 0},       // U+1DCF
+	{202, 0x00, 0, 0},       // U+1DD0
+	{230, 0x00, 0, 0},       // U+1DD1
+	{230, 0x00, 0, 0},       // U+1DD2
+	{230, 0x00, 0, 0},       // U+1DD3
+	{230, 0x00, 0, 0},       // U+1DD4
+	{230, 0x00, 0, 0},       // U+1DD5
+	{230, 0x00, 0, 0},       // U+1DD6
+	{230, 0x00, 0, 0},       // U+1DD7
+	{230, 0x00, 0, 0},       // U+1DD8
+	{230, 0x00, 0, 0},       // U+1DD9
+	{230, 0x00, 0, 0},       // U+1DDA
+	{230, 0x00, 0, 0},       // U+1DDB
+	{230, 0x00, 0, 0},       // U+1DDC
+	{230, 0x00, 0, 0},       // U+1DDD
+	{230, 0x00, 0, 0},       // U+1DDE
+	{230, 0x00, 0, 0},       // U+1DDF
+	{230, 0x00, 0, 0},       // U+1DE0
+	{230, 0x00, 0, 0},       // U+1DE1
+	{230, 0x00, 0, 0},       // U+1DE2
+	{230, 0x00, 0, 0},       // U+1DE3
+	{230, 0x00, 0, 0},       // U+1DE4
+	{230, 0x00, 0, 0},       // U+1DE5
+	{230, 0x00, 0, 0},       // U+1DE6
+	{230, 0x00, 0, 0},       // U+1DE7
+	{230, 0x00, 0, 0},       // U+1DE8
+	{230, 0x00, 0, 0},       // U+1DE9
+	{230, 0x00, 0, 0},       // U+1DEA
+	{230, 0x00, 0, 0},       // U+1DEB
+	{230, 0x00, 0, 0},       // U+1DEC
+	{230, 0x00, 0, 0},       // U+1DED
+	{230, 0x00, 0, 0},       // U+1DEE
+	{230, 0x00, 0, 0},       // U+1DEF
+	{230, 0x00, 0, 0},       // U+1DF0
+	{230, 0x00, 0, 0},       // U+1DF1
+	{230, 0x00, 0, 0},       // U+1DF2
+	{230, 0x00, 0, 0},       // U+1DF3
+	{230, 0x00, 0, 0},       // U+1DF4
+	{230, 0x00, 0, 0},       // U+1DF5
+	{232, 0x00, 0, 0},       // U+1DF6
+	{228, 0x00, 0, 0},       // U+1DF7
+	{228, 0x00, 0, 0},       // U+1DF8
+	{220, 0x00, 0, 0},       // U+1DF9
+	{218, 0x00, 0, 0},       // U+1DFA
+	{230, 0x00, 0, 0},       // U+1DFB
+	{233, 0x00, 0, 0},       // U+1DFC
+	{220, 0x00, 0, 0},       // U+1DFD
+	{230, 0x00, 0, 0},       // U+1DFE
+	{220, 0x00, 0, 0},       // U+1DFF
+	{0, 0x00, 1640, 1640},   // U+1E00
+	{0, 0x00, 1643, 1643},   // U+1E01
+	{0, 0x00, 1646, 1646},   // U+1E02
+	{0, 0x00, 1649, 1649},   // U+1E03
+	{0, 0x00, 1652, 1652},   // U+1E04
+	{0, 0x00, 1655, 1655},   // U+1E05
+	{0, 0x00, 1658, 1658},   // U+1E06
+	{0, 0x00, 1661, 1661},   // U+1E07
+	{0, 0x00, 1664, 1664},   // U+1E08
+	{0, 0x00, 1668, 1668},   // U+1E09
+	{0, 0x00, 1672, 1672},   // U+1E0A
+	{0, 0x00, 1675, 1675},   // U+1E0B
+	{0, 0x00, 1678, 1678},   // U+1E0C
+	{0, 0x00, 1681, 1681},   // U+1E0D
+	{0, 0x00, 1684, 1684},   // U+1E0E
+	{0, 0x00, 1687, 1687},   // U+1E0F
+	{0, 0x00, 1690, 1690},   // U+1E10
+	{0, 0x00, 1693, 1693},   // U+1E11
+	{0, 0x00, 1696, 1696},   // U+1E12
+	{0, 0x00, 1699, 1699},   // U+1E13
+	{0, 0x00, 1702, 1702},   // U+1E14
+	{0, 0x00, 1706, 1706},   // U+1E15
+	{0, 0x00, 1710, 1710},   // U+1E16
+	{0, 0x00, 1714, 1714},   // U+1E17
+	{0, 0x00, 1718, 1718},   // U+1E18
+	{0, 0x00, 1721, 1721},   // U+1E19
+	{0, 0x00, 1724, 1724},   // U+1E1A
+	{0, 0x00, 1727, 1727},   // U+1E1B
+	{0, 0x00, 1730, 1730},   // U+1E1C
+	{0, 0x00, 1734, 1734},   // U+1E1D
+	{0, 0x00, 1738, 1738},   // U+1E1E
+	{0, 0x00, 1741, 1741},   // U+1E1F
+	{0, 0x00, 1744, 1744},   // U+1E20
+	{0, 0x00, 1747, 1747},   // U+1E21
+	{0, 0x00, 1750, 1750},   // U+1E22
+	{0, 0x00, 1753, 1753},   // U+1E23
+	{0, 0x00, 1756, 1756},   // U+1E24
+	{0, 0x00, 1759, 1759},   // U+1E25
+	{0, 0x00, 1762, 1762},   // U+1E26
+	{0, 0x00, 1765, 1765},   // U+1E27
+	{0, 0x00, 1768, 1768},   // U+1E28
+	{0, 0x00, 1771, 1771},   // U+1E29
+	{0, 0x00, 1774, 1774},   // U+1E2A
+	{0, 0x00, 1777, 1777},   // U+1E2B
+	{0, 0x00, 1780, 1780},   // U+1E2C
+	{0, 0x00, 1783, 1783},   // U+1E2D
+	{0, 0x00, 1786, 1786},   // U+1E2E
+	{0, 0x00, 1790, 1790},   // U+1E2F
+	{0, 0x00, 1794, 1794},   // U+1E30
+	{0, 0x00, 1797, 1797},   // U+1E31
+	{0, 0x00, 1800, 1800},   // U+1E32
+	{0, 0x00, 1803, 1803},   // U+1E33
+	{0, 0x00, 1806, 1806},   // U+1E34
+	{0, 0x00, 1809, 1809},   // U+1E35
+	{0, 0x00, 1812, 1812},   // U+1E36
+	{0, 0x00, 1815, 1815},   // U+1E37
+	{0, 0x00, 1818, 1818},   // U+1E38
+	{0, 0x00, 1822, 1822},   // U+1E39
+	{0, 0x00, 1826, 1826},   // U+1E3A
+	{0, 0x00, 1829, 1829},   // U+1E3B
+	{0, 0x00, 1832, 1832},   // U+1E3C
+	{0, 0x00, 1835, 1835},   // U+1E3D
+	{0, 0x00, 1838, 1838},   // U+1E3E
+	{0, 0x00, 1841, 1841},   // U+1E3F
+	{0, 0x00, 1844, 1844},   // U+1E40
+	{0, 0x00, 1847, 1847},   // U+1E41
+	{0, 0x00, 1850, 1850},   // U+1E42
+	{0, 0x00, 1853, 1853},   // U+1E43
+	{0, 0x00, 1856, 1856},   // U+1E44
+	{0, 0x00, 1859, 1859},   // U+1E45
+	{0, 0x00, 1862, 1862},   // U+1E46
+	{0, 0x00, 1865, 1865},   // U+1E47
+	{0, 0x00, 1868, 1868},   // U+1E48
+	{0, 0x00, 1871, 1871},   // U+1E49
+	{0, 0x00, 1874, 1874},   // U+1E4A
+	{0, 0x00, 1877, 1877},   // U+1E4B
+	{0, 0x00, 1880, 1880},   // U+1E4C
+	{0, 0x00, 1884, 1884},   // U+1E4D
+	{0, 0x00, 1888, 1888},   // U+1E4E
+	{0, 0x00, 1892, 1892},   // U+1E4F
+	{0, 0x00, 1896, 1896},   // U+1E50
+	{0, 0x00, 1900, 1900},   // U+1E51
+	{0, 0x00, 1904, 1904},   // U+1E52
+	{0, 0x00, 1908, 1908},   // U+1E53
+	{0, 0x00, 1912, 1912},   // U+1E54
+	{0, 0x00, 1915, 1915},   // U+1E55
+	{0, 0x00, 1918, 1918},   // U+1E56
+	{0, 0x00, 1921, 1921},   // U+1E57
+	{0, 0x00, 1924, 1924},   // U+1E58
+	{0, 0x00, 1927, 1927},   // U+1E59
+	{0, 0x00, 1930, 1930},   // U+1E5A
+	{0, 0x00, 1933, 1933},   // U+1E5B
+	{0, 0x00, 1936, 1936},   // U+1E5C
+	{0, 0x00, 1940, 1940},   // U+1E5D
+	{0, 0x00, 1944, 1944},   // U+1E5E
+	{0, 0x00, 1947, 1947},   // U+1E5F
+	{0, 0x00, 1950, 1950},   // U+1E60
+	{0, 0x00, 1953, 1953},   // U+1E61
+	{0, 0x00, 1956, 1956},   // U+1E62
+	{0, 0x00, 1959, 1959},   // U+1E63
+	{0, 0x00, 1962, 1962},   // U+1E64
+	{0, 0x00, 1966, 1966},   // U+1E65
+	{0, 0x00, 1970, 1970},   // U+1E66
+	{0, 0x00, 1974, 1974},   // U+1E67
+	{0, 0x00, 1978, 1978},   // U+1E68
+	{0, 0x00, 1982, 1982},   // U+1E69
+	{0, 0x00, 1986, 1986},   // U+1E6A
+	{0, 0x00, 1989, 1989},   // U+1E6B
+	{0, 0x00, 1992, 1992},   // U+1E6C
+	{0, 0x00, 1995, 1995},   // U+1E6D
+	{0, 0x00, 1998, 1998},   // U+1E6E
+	{0, 0x00, 2001, 2001},   // U+1E6F
+	{0, 0x00, 2004, 2004},   // U+1E70
+	{0, 0x00, 2007, 2007},   // U+1E71
+	{0, 0x00, 2010, 2010},   // U+1E72
+	{0, 0x00, 2013, 2013},   // U+1E73
+	{0, 0x00, 2016, 2016},   // U+1E74
+	{0, 0x00, 2019, 2019},   // U+1E75
+	{0, 0x00, 2022, 2022},   // U+1E76
+	{0, 0x00, 2025, 2025},   // U+1E77
+	{0, 0x00, 2028, 2028},   // U+1E78
+	{0, 0x00, 2032, 2032},   // U+1E79
+	{0, 0x00, 2036, 2036},   // U+1E7A
+	{0, 0x00, 2040, 2040},   // U+1E7B
+	{0, 0x00, 2044, 2044},   // U+1E7C
+	{0, 0x00, 2047, 2047},   // U+1E7D
+	{0, 0x00, 2050, 2050},   // U+1E7E
+	{0, 0x00, 2053, 2053},   // U+1E7F
+	{0, 0x00, 2056, 2056},   // U+1E80
+	{0, 0x00, 2059, 2059},   // U+1E81
+	{0, 0x00, 2062, 2062},   // U+1E82
+	{0, 0x00, 2065, 2065},   // U+1E83
+	{0, 0x00, 2068, 2068},   // U+1E84
+	{0, 0x00, 2071, 2071},   // U+1E85
+	{0, 0x00, 2074, 2074},   // U+1E86
+	{0, 0x00, 2077, 2077},   // U+1E87
+	{0, 0x00, 2080, 2080},   // U+1E88
+	{0, 0x00, 2083, 2083},   // U+1E89
+	{0, 0x00, 2086, 2086},   // U+1E8A
+	{0, 0x00, 2089, 2089},   // U+1E8B
+	{0, 0x00, 2092, 2092},   // U+1E8C
+	{0, 0x00, 2095, 2095},   // U+1E8D
+	{0, 0x00, 2098, 2098},   // U+1E8E
+	{0, 0x00, 2101, 2101},   // U+1E8F
+	{0, 0x00, 2104, 2104},   // U+1E90
+	{0, 0x00, 2107, 2107},   // U+1E91
+	{0, 0x00, 2110, 2110},   // U+1E92
+	{0, 0x00, 2113, 2113},   // U+1E93
+	{0, 0x00, 2116, 2116},   // U+1E94
+	{0, 0x00, 2119, 2119},   // U+1E95
+	{0, 0x00, 2122, 2122},   // U+1E96
+	{0, 0x00, 2125, 2125},   // U+1E97
+	{0, 0x00, 2128, 2128},   // U+1E98
+	{0, 0x00, 2131, 2131},   // U+1E99
+	{0, 0x04, 0, 2134},      // U+1E9A
+	{0, 0x04, 2137, 1953},   // U+1E9B
+	{0, 0x00, 2140, 2140},   // U+1EA0
+	{0, 0x00, 2143, 2143},   // U+1EA1
+	{0, 0x00, 2146, 2146},   // U+1EA2
+	{0, 0x00, 2149, 2149},   // U+1EA3
+	{0, 0x00, 2152, 2152},   // U+1EA4
+	{0, 0x00, 2156, 2156},   // U+1EA5
+	{0, 0x00, 2160, 2160},   // U+1EA6
+	{0, 0x00, 2164, 2164},   // U+1EA7
+	{0, 0x00, 2168, 2168},   // U+1EA8
+	{0, 0x00, 2172, 2172},   // U+1EA9
+	{0, 0x00, 2176, 2176},   // U+1EAA
+	{0, 0x00, 2180, 2180},   // U+1EAB
+	{0, 0x00, 2184, 2184},   // U+1EAC
+	{0, 0x00, 2188, 2188},   // U+1EAD
+	{0, 0x00, 2192, 2192},   // U+1EAE
+	{0, 0x00, 2196, 2196},   // U+1EAF
+	{0, 0x00, 2200, 2200},   // U+1EB0
+	{0, 0x00, 2204, 2204},   // U+1EB1
+	{0, 0x00, 2208, 2208},   // U+1EB2
+	{0, 0x00, 2212, 2212},   // U+1EB3
+	{0, 0x00, 2216, 2216},   // U+1EB4
+	{0, 0x00, 2220, 2220},   // U+1EB5
+	{0, 0x00, 2224, 2224},   // U+1EB6
+	{0, 0x00, 2228, 2228},   // U+1EB7
+	{0, 0x00, 2232, 2232},   // U+1EB8
+	{0, 0x00, 2235, 2235},   // U+1EB9
+	{0, 0x00, 2238, 2238},   // U+1EBA
+	{0, 0x00, 2241, 2241},   // U+1EBB
+	{0, 0x00, 2244, 2244},   // U+1EBC
+	{0, 0x00, 2247, 2247},   // U+1EBD
+	{0, 0x00, 2250, 2250},   // U+1EBE
+	{0, 0x00, 2254, 2254},   // U+1EBF
+	{0, 0x00, 2258, 2258},   // U+1EC0
+	{0, 0x00, 2262, 2262},   // U+1EC1
+	{0, 0x00, 2266, 2266},   // U+1EC2
+	{0, 0x00, 2270, 2270},   // U+1EC3
+	{0, 0x00, 2274, 2274},   // U+1EC4
+	{0, 0x00, 2278, 2278},   // U+1EC5
+	{0, 0x00, 2282, 2282},   // U+1EC6
+	{0, 0x00, 2286, 2286},   // U+1EC7
+	{0, 0x00, 2290, 2290},   // U+1EC8
+	{0, 0x00, 2293, 2293},   // U+1EC9
+	{0, 0x00, 2296, 2296},   // U+1ECA
+	{0, 0x00, 2299, 2299},   // U+1ECB
+	{0, 0x00, 2302, 2302},   // U+1ECC
+	{0, 0x00, 2305, 2305},   // U+1ECD
+	{0, 0x00, 2308, 2308},   // U+1ECE
+	{0, 0x00, 2311, 2311},   // U+1ECF
+	{0, 0x00, 2314, 2314},   // U+1ED0
+	{0, 0x00, 2318, 2318},   // U+1ED1
+	{0, 0x00, 2322, 2322},   // U+1ED2
+	{0, 0x00, 2326, 2326},   // U+1ED3
+	{0, 0x00, 2330, 2330},   // U+1ED4
+	{0, 0x00, 2334, 2334},   // U+1ED5
+	{0, 0x00, 2338, 2338},   // U+1ED6
+	{0, 0x00, 2342, 2342},   // U+1ED7
+	{0, 0x00, 2346, 2346},   // U+1ED8
+	{0, 0x00, 2350, 2350},   // U+1ED9
+	{0, 0x00, 2354, 2354},   // U+1EDA
+	{0, 0x00, 2358, 2358},   // U+1EDB
+	{0, 0x00, 2362, 2362},   // U+1EDC
+	{0, 0x00, 2366, 2366},   // U+1EDD
+	{0, 0x00, 2370, 2370},   // U+1EDE
+	{0, 0x00, 2374, 2374},   // U+1EDF
+	{0, 0x00, 2378, 2378},   // U+1EE0
+	{0, 0x00, 2382, 2382},   // U+1EE1
+	{0, 0x00, 2386, 2386},   // U+1EE2
+	{0, 0x00, 2390, 2390},   // U+1EE3
+	{0, 0x00, 2394, 2394},   // U+1EE4
+	{0, 0x00, 2397, 2397},   // U+1EE5
+	{0, 0x00, 2400, 2400},   // U+1EE6
+	{0, 0x00, 2403, 2403},   // U+1EE7
+	{0, 0x00, 2406, 2406},   // U+1EE8
+	{0, 0x00, 2410, 2410},   // U+1EE9
+	{0, 0x00, 2414, 2414},   // U+1EEA
+	{0, 0x00, 2418, 2418},   // U+1EEB
+	{0, 0x00, 2422, 2422},   // U+1EEC
+	{0, 0x00, 2426, 2426},   // U+1EED
+	{0, 0x00, 2430, 2430},   // U+1EEE
+	{0, 0x00, 2434, 2434},   // U+1EEF
+	{0, 0x00, 2438, 2438},   // U+1EF0
+	{0, 0x00, 2442, 2442},   // U+1EF1
+	{0, 0x00, 2446, 2446},   // U+1EF2
+	{0, 0x00, 2449, 2449},   // U+1EF3
+	{0, 0x00, 2452, 2452},   // U+1EF4
+	{0, 0x00, 2455, 2455},   // U+1EF5
+	{0, 0x00, 2458, 2458},   // U+1EF6
+	{0, 0x00, 2461, 2461},   // U+1EF7
+	{0, 0x00, 2464, 2464},   // U+1EF8
+	{0, 0x00, 2467, 2467},   // U+1EF9
+	{0, 0x00, 2470, 2470},   // U+1F00
+	{0, 0x00, 2473, 2473},   // U+1F01
+	{0, 0x00, 2476, 2476},   // U+1F02
+	{0, 0x00, 2480, 2480},   // U+1F03
+	{0, 0x00, 2484, 2484},   // U+1F04
+	{0, 0x00, 2488, 2488},   // U+1F05
+	{0, 0x00, 2492, 2492},   // U+1F06
+	{0, 0x00, 2496, 2496},   // U+1F07
+	{0, 0x00, 2500, 2500},   // U+1F08
+	{0, 0x00, 2503, 2503},   // U+1F09
+	{0, 0x00, 2506, 2506},   // U+1F0A
+	{0, 0x00, 2510, 2510},   // U+1F0B
+	{0, 0x00, 2514, 2514},   // U+1F0C
+	{0, 0x00, 2518, 2518},   // U+1F0D
+	{0, 0x00, 2522, 2522},   // U+1F0E
+	{0, 0x00, 2526, 2526},   // U+1F0F
+	{0, 0x00, 2530, 2530},   // U+1F10
+	{0, 0x00, 2533, 2533},   // U+1F11
+	{0, 0x00, 2536, 2536},   // U+1F12
+	{0, 0x00, 2540, 2540},   // U+1F13
+	{0, 0x00, 2544, 2544},   // U+1F14
+	{0, 0x00, 2548, 2548},   // U+1F15
+	{0, 0x00, 2552, 2552},   // U+1F18
+	{0, 0x00, 2555, 2555},   // U+1F19
+	{0, 0x00, 2558, 2558},   // U+1F1A
+	{0, 0x00, 2562, 2562},   // U+1F1B
+	{0, 0x00, 2566, 2566},   // U+1F1C
+	{0, 0x00, 2570, 2570},   // U+1F1D
+	{0, 0x00, 2574, 2574},   // U+1F20
+	{0, 0x00, 2577, 2577},   // U+1F21
+	{0, 0x00, 2580, 2580},   // U+1F22
+	{0, 0x00, 2584, 2584},   // U+1F23
+	{0, 0x00, 2588, 2588},   // U+1F24
+	{0, 0x00, 2592, 2592},   // U+1F25
+	{0, 0x00, 2596, 2596},   // U+1F26
+	{0, 0x00, 2600, 2600},   // U+1F27
+	{0, 0x00, 2604, 2604},   // U+1F28
+	{0, 0x00, 2607, 2607},   // U+1F29
+	{0, 0x00, 2610, 2610},   // U+1F2A
+	{0, 0x00, 2614, 2614},   // U+1F2B
+	{0, 0x00, 2618, 2618},   // U+1F2C
+	{0, 0x00, 2622, 2622},   // U+1F2D
+	{0, 0x00, 2626, 2626},   // U+1F2E
+	{0, 0x00, 2630, 2630},   // U+1F2F
+	{0, 0x00, 2634, 2634},   // U+1F30
+	{0, 0x00, 2637, 2637},   // U+1F31
+	{0, 0x00, 2640, 2640},   // U+1F32
+	{0, 0x00, 2644, 2644},   // U+1F33
+	{0, 0x00, 2648, 2648},   // U+1F34
+	{0, 0x00, 2652, 2652},   // U+1F35
+	{0, 0x00, 2656, 2656},   // U+1F36
+	{0, 0x00, 2660, 2660},   // U+1F37
+	{0, 0x00, 2664, 2664},   // U+1F38
+	{0, 0x00, 2667, 2667},   // U+1F39
+	{0, 0x00, 2670, 2670},   // U+1F3A
+	{0, 0x00, 2674, 2674},   // U+1F3B
+	{0, 0x00, 2678, 2678},   // U+1F3C
+	{0, 0x00, 2682, 2682},   // U+1F3D
+	{0, 0x00, 2686, 2686},   // U+1F3E
+	{0, 0x00, 2690, 2690},   // U+1F3F
+	{0, 0x00, 2694, 2694},   // U+1F40
+	{0, 0x00, 2697, 2697},   // U+1F41
+	{0, 0x00, 2700, 2700},   // U+1F42
+	{0, 0x00, 2704, 2704},   // U+1F43
+	{0, 0x00, 2708, 2708},   // U+1F44
+	{0, 0x00, 2712, 2712},   // U+1F45
+	{0, 0x00, 2716, 2716},   // U+1F48
+	{0, 0x00, 2719, 2719},   // U+1F49
+	{0, 0x00, 2722, 2722},   // U+1F4A
+	{0, 0x00, 2726, 2726},   // U+1F4B
+	{0, 0x00, 2730, 2730},   // U+1F4C
+	{0, 0x00, 2734, 2734},   // U+1F4D
+	{0, 0x00, 2738, 2738},   // U+1F50
+	{0, 0x00, 2741, 2741},   // U+1F51
+	{0, 0x00, 2744, 2744},   // U+1F52
+	{0, 0x00, 2748, 2748},   // U+1F53
+	{0, 0x00, 2752, 2752},   // U+1F54
+	{0, 0x00, 2756, 2756},   // U+1F55
+	{0, 0x00, 2760, 2760},   // U+1F56
+	{0, 0x00, 2764, 2764},   // U+1F57
+	{0, 0x00, 2768, 2768},   // U+1F59
+	{0, 0x00, 2771, 2771},   // U+1F5B
+	{0, 0x00, 2775, 2775},   // U+1F5D
+	{0, 0x00, 2779, 2779},   // U+1F5F
+	{0, 0x00, 2783, 2783},   // U+1F60
+	{0, 0x00, 2786, 2786},   // U+1F61
+	{0, 0x00, 2789, 2789},   // U+1F62
+	{0, 0x00, 2793, 2793},   // U+1F63
+	{0, 0x00, 2797, 2797},   // U+1F64
+	{0, 0x00, 2801, 2801},   // U+1F65
+	{0, 0x00, 2805, 2805},   // U+1F66
+	{0, 0x00, 2809, 2809},   // U+1F67
+	{0, 0x00, 2813, 2813},   // U+1F68
+	{0, 0x00, 2816, 2816},   // U+1F69
+	{0, 0x00, 2819, 2819},   // U+1F6A
+	{0, 0x00, 2823, 2823},   // U+1F6B
+	{0, 0x00, 2827, 2827},   // U+1F6C
+	{0, 0x00, 2831, 2831},   // U+1F6D
+	{0, 0x00, 2835, 2835},   // U+1F6E
+	{0, 0x00, 2839, 2839},   // U+1F6F
+	{0, 0x00, 2843, 2843},   // U+1F70
+	{0, 0x05, 973, 973},     // U+1F71
+	{0, 0x00, 2846, 2846},   // U+1F72
+	{0, 0x05, 976, 976},     // U+1F73
+	{0, 0x00, 2849, 2849},   // U+1F74
+	{0, 0x05, 979, 979},     // U+1F75
+	{0, 0x00, 2852, 2852},   // U+1F76
+	{0, 0x05, 982, 982},     // U+1F77
+	{0, 0x00, 2855, 2855},   // U+1F78
+	{0, 0x05, 995, 995},     // U+1F79
+	{0, 0x00, 2858, 2858},   // U+1F7A
+	{0, 0x05, 998, 998},     // U+1F7B
+	{0, 0x00, 2861, 2861},   // U+1F7C
+	{0, 0x05, 1001, 1001},   // U+1F7D
+	{0, 0x00, 2864, 2864},   // U+1F80
+	{0, 0x00, 2868, 2868},   // U+1F81
+	{0, 0x00, 2872, 2872},   // U+1F82
+	{0, 0x00, 2877, 2877},   // U+1F83
+	{0, 0x00, 2882, 2882},   // U+1F84
+	{0, 0x00, 2887, 2887},   // U+1F85
+	{0, 0x00, 2892, 2892},   // U+1F86
+	{0, 0x00, 2897, 2897},   // U+1F87
+	{0, 0x00, 2902, 2902},   // U+1F88
+	{0, 0x00, 2906, 2906},   // U+1F89
+	{0, 0x00, 2910, 2910},   // U+1F8A
+	{0, 0x00, 2915, 2915},   // U+1F8B
+	{0, 0x00, 2920, 2920},   // U+1F8C
+	{0, 0x00, 2925, 2925},   // U+1F8D
+	{0, 0x00, 2930, 2930},   // U+1F8E
+	{0, 0x00, 2935, 2935},   // U+1F8F
+	{0, 0x00, 2940, 2940},   // U+1F90
+	{0, 0x00, 2944, 2944},   // U+1F91
+	{0, 0x00, 2948, 2948},   // U+1F92
+	{0, 0x00, 2953, 2953},   // U+1F93
+	{0, 0x00, 2958, 2958},   // U+1F94
+	{0, 0x00, 2963, 2963},   // U+1F95
+	{0, 0x00, 2968, 2968},   // U+1F96
+	{0, 0x00, 2973, 2973},   // U+1F97
+	{0, 0x00, 2978, 2978},   // U+1F98
+	{0, 0x00, 2982, 2982},   // U+1F99
+	{0, 0x00, 2986, 2986},   // U+1F9A
+	{0, 0x00, 2991, 2991},   // U+1F9B
+	{0, 0x00, 2996, 2996},   // U+1F9C
+	{0, 0x00, 3001, 3001},   // U+1F9D
+	{0, 0x00, 3006, 3006},   // U+1F9E
+	{0, 0x00, 3011, 3011},   // U+1F9F
+	{0, 0x00, 3016, 3016},   // U+1FA0
+	{0, 0x00, 3020, 3020},   // U+1FA1
+	{0, 0x00, 3024, 3024},   // U+1FA2
+	{0, 0x00, 3029, 3029},   // U+1FA3
+	{0, 0x00, 3034, 3034},   // U+1FA4
+	{0, 0x00, 3039, 3039},   // U+1FA5
+	{0, 0x00, 3044, 3044},   // U+1FA6
+	{0, 0x00, 3049, 3049},   // U+1FA7
+	{0, 0x00, 3054, 3054},   // U+1FA8
+	{0, 0x00, 3058, 3058},   // U+1FA9
+	{0, 0x00, 3062, 3062},   // U+1FAA
+	{0, 0x00, 3067, 3067},   // U+1FAB
+	{0, 0x00, 3072, 3072},   // U+1FAC
+	{0, 0x00, 3077, 3077},   // U+1FAD
+	{0, 0x00, 3082, 3082},   // U+1FAE
+	{0, 0x00, 3087, 3087},   // U+1FAF
+	{0, 0x00, 3092, 3092},   // U+1FB0
+	{0, 0x00, 3095, 3095},   // U+1FB1
+	{0, 0x00, 3098, 3098},   // U+1FB2
+	{0, 0x00, 3102, 3102},   // U+1FB3
+	{0, 0x00, 3105, 3105},   // U+1FB4
+	{0, 0x00, 3109, 3109},   // U+1FB6
+	{0, 0x00, 3112, 3112},   // U+1FB7
+	{0, 0x00, 3116, 3116},   // U+1FB8
+	{0, 0x00, 3119, 3119},   // U+1FB9
+	{0, 0x00, 3122, 3122},   // U+1FBA
+	{0, 0x05, 940, 940},     // U+1FBB
+	{0, 0x00, 3125, 3125},   // U+1FBC
+	{0, 0x04, 0, 3128},      // U+1FBD
+	{0, 0x05, 3131, 3131},   // U+1FBE
+	{0, 0x04, 0, 3128},      // U+1FBF
+	{0, 0x04, 0, 3133},      // U+1FC0
+	{0, 0x04, 3136, 3139},   // U+1FC1
+	{0, 0x00, 3143, 3143},   // U+1FC2
+	{0, 0x00, 3147, 3147},   // U+1FC3
+	{0, 0x00, 3150, 3150},   // U+1FC4
+	{0, 0x00, 3154, 3154},   // U+1FC6
+	{0, 0x00, 3157, 3157},   // U+1FC7
+	{0, 0x00, 3161, 3161},   // U+1FC8
+	{0, 0x05, 945, 945},     // U+1FC9
+	{0, 0x00, 3164, 3164},   // U+1FCA
+	{0, 0x05, 948, 948},     // U+1FCB
+	{0, 0x00, 3167, 3167},   // U+1FCC
+	{0, 0x04, 3170, 3173},   // U+1FCD
+	{0, 0x04, 3177, 3180},   // U+1FCE
+	{0, 0x04, 3184, 3187},   // U+1FCF
+	{0, 0x00, 3191, 3191},   // U+1FD0
+	{0, 0x00, 3194, 3194},   // U+1FD1
+	{0, 0x00, 3197, 3197},   // U+1FD2
+	{0, 0x05, 963, 963},     // U+1FD3
+	{0, 0x00, 3201, 3201},   // U+1FD6
+	{0, 0x00, 3204, 3204},   // U+1FD7
+	{0, 0x00, 3208, 3208},   // U+1FD8
+	{0, 0x00, 3211, 3211},   // U+1FD9
+	{0, 0x00, 3214, 3214},   // U+1FDA
+	{0, 0x05, 951, 951},     // U+1FDB
+	{0, 0x04, 3217, 3220},   // U+1FDD
+	{0, 0x04, 3224, 3227},   // U+1FDE
+	{0, 0x04, 3231, 3234},   // U+1FDF
+	{0, 0x00, 3238, 3238},   // U+1FE0
+	{0, 0x00, 3241, 3241},   // U+1FE1
+	{0, 0x00, 3244, 3244},   // U+1FE2
+	{0, 0x05, 985, 985},     // U+1FE3
+	{0, 0x00, 3248, 3248},   // U+1FE4
+	{0, 0x00, 3251, 3251},   // U+1FE5
+	{0, 0x00, 3254, 3254},   // U+1FE6
+	{0, 0x00, 3257, 3257},   // U+1FE7
+	{0, 0x00, 3261, 3261},   // U+1FE8
+	{0, 0x00, 3264, 3264},   // U+1FE9
+	{0, 0x00, 3267, 3267},   // U+1FEA
+	{0, 0x05, 957, 957},     // U+1FEB
+	{0, 0x00, 3270, 3270},   // U+1FEC
+	{0, 0x04, 3273, 3276},   // U+1FED
+	{0, 0x05, 933, 936},     // U+1FEE
+	{0, 0x05, 3280, 3280},   // U+1FEF
+	{0, 0x00, 3282, 3282},   // U+1FF2
+	{0, 0x00, 3286, 3286},   // U+1FF3
+	{0, 0x00, 3289, 3289},   // U+1FF4
+	{0, 0x00, 3293, 3293},   // U+1FF6
+	{0, 0x00, 3296, 3296},   // U+1FF7
+	{0, 0x00, 3300, 3300},   // U+1FF8
+	{0, 0x05, 954, 954},     // U+1FF9
+	{0, 0x00, 3303, 3303},   // U+1FFA
+	{0, 0x05, 960, 960},     // U+1FFB
+	{0, 0x00, 3306, 3306},   // U+1FFC
+	{0, 0x05, 3309, 15},     // U+1FFD
+	{0, 0x04, 0, 3311},      // U+1FFE
+	{0, 0x05, 3314, 1},      // U+2000
+	{0, 0x05, 3316, 1},      // U+2001
+	{0, 0x04, 0, 1},         // U+2002
+	{0, 0x04, 0, 1},         // U+2003
+	{0, 0x04, 0, 1},         // U+2004
+	{0, 0x04, 0, 1},         // U+2005
+	{0, 0x04, 0, 1},         // U+2006
+	{0, 0x04, 0, 1},         // U+2007
+	{0, 0x04, 0, 1},         // U+2008
+	{0, 0x04, 0, 1},         // U+2009
+	{0, 0x04, 0, 1},         // U+200A
+	{0, 0x04, 0, 3318},      // U+2011
+	{0, 0x04, 0, 3320},      // U+2017
+	{0, 0x04, 0, 3323},      // U+2024
+	{0, 0x04, 0, 3325},      // U+2025
+	{0, 0x04, 0, 3328},      // U+2026
+	{0, 0x04, 0, 1},         // U+202F
+	{0, 0x04, 0, 3332},      // U+2033
+	{0, 0x04, 0, 3335},      // U+2034
+	{0, 0x04, 0, 3339},      // U+2036
+	{0, 0x04, 0, 3342},      // U+2037
+	{0, 0x04, 0, 3346},      // U+203C
+	{0, 0x04, 0, 3349},      // U+203E
+	{0, 0x04, 0, 3352},      // U+2047
+	{0, 0x04, 0, 3355},      // U+2048
+	{0, 0x04, 0, 3358},      // U+2049
+	{0, 0x04, 0, 3361},      // U+2057
+	{0, 0x04, 0, 1},         // U+205F
+	{0, 0x04, 0, 3366},      // U+2070
+	{0, 0x04, 0, 1566},      // U+2071
+	{0, 0x04, 0, 3368},      // U+2074
+	{0, 0x04, 0, 3370},      // U+2075
+	{0, 0x04, 0, 3372},      // U+2076
+	{0, 0x04, 0, 3374},      // U+2077
+	{0, 0x04, 0, 3376},      // U+2078
+	{0, 0x04, 0, 3378},      // U+2079
+	{0, 0x04, 0, 3380},      // U+207A
+	{0, 0x04, 0, 3382},      // U+207B
+	{0, 0x04, 0, 3384},      // U+207C
+	{0, 0x04, 0, 3386},      // U+207D
+	{0, 0x04, 0, 3388},      // U+207E
+	{0, 0x04, 0, 3390},      // U+207F
+	{0, 0x04, 0, 3366},      // U+2080
+	{0, 0x04, 0, 23},        // U+2081
+	{0, 0x04, 0, 11},        // U+2082
+	{0, 0x04, 0, 13},        // U+2083
+	{0, 0x04, 0, 3368},      // U+2084
+	{0, 0x04, 0, 3370},      // U+2085
+	{0, 0x04, 0, 3372},      // U+2086
+	{0, 0x04, 0, 3374},      // U+2087
+	{0, 0x04, 0, 3376},      // U+2088
+	{0, 0x04, 0, 3378},      // U+2089
+	{0, 0x04, 0, 3380},      // U+208A
+	{0, 0x04, 0, 3382},      // U+208B
+	{0, 0x04, 0, 3384},      // U+208C
+	{0, 0x04, 0, 3386},      // U+208D
+	{0, 0x04, 0, 3388},      // U+208E
+	{0, 0x04, 0, 6},         // U+2090
+	{0, 0x04, 0, 1524},      // U+2091
+	{0, 0x04, 0, 25},        // U+2092
+	{0, 0x04, 0, 913},       // U+2093
+	{0, 0x04, 0, 1526},      // U+2094
+	{0, 0x04, 0, 873},       // U+2095
+	{0, 0x04, 0, 1534},      // U+2096
+	{0, 0x04, 0, 911},       // U+2097
+	{0, 0x04, 0, 1536},      // U+2098
+	{0, 0x04, 0, 3390},      // U+2099
+	{0, 0x04, 0, 1546},      // U+209A
+	{0, 0x04, 0, 537},       // U+209B
+	{0, 0x04, 0, 1548},      // U+209C
+	{0, 0x04, 0, 3392},      // U+20A8
+	{230, 0x00, 0, 0},       // U+20D0
+	{230, 0x00, 0, 0},       // U+20D1
+	{1, 0x00, 0, 0},         // U+20D2
+	{1, 0x00, 0, 0},         // U+20D3
+	{230, 0x00, 0, 0},       // U+20D4
+	{230, 0x00, 0, 0},       // U+20D5
+	{230, 0x00, 0, 0},       // U+20D6
+	{230, 0x00, 0, 0},       // U+20D7
+	{1, 0x00, 0, 0},         // U+20D8
+	{1, 0x00, 0, 0},         // U+20D9
+	{1, 0x00, 0, 0},         // U+20DA
+	{230, 0x00, 0, 0},       // U+20DB
+	{230, 0x00, 0, 0},       // U+20DC
+	{230, 0x00, 0, 0},       // U+20E1
+	{1, 0x00, 0, 0},         // U+20E5
+	{1, 0x00, 0, 0},         // U+20E6
+	{230, 0x00, 0, 0},       // U+20E7
+	{220, 0x00, 0, 0},       // U+20E8
+	{230, 0x00, 0, 0},       // U+20E9
+	{1, 0x00, 0, 0},         // U+20EA
+	{1, 0x00, 0, 0},         // U+20EB
+	{220, 0x00, 0, 0},       // U+20EC
+	{220, 0x00, 0, 0},       // U+20ED
+	{220, 0x00, 0, 0},       // U+20EE
+	{220, 0x00, 0, 0},       // U+20EF
+	{230, 0x00, 0, 0},       // U+20F0
+	{0, 0x04, 0, 3395},      // U+2100
+	{0, 0x04, 0, 3399},      // U+2101
+	{0, 0x04, 0, 3403},      // U+2102
+	{0, 0x04, 0, 3405},      // U+2103
+	{0, 0x04, 0, 3408},      // U+2105
+	{0, 0x04, 0, 3412},      // U+2106
+	{0, 0x04, 0, 3416},      // U+2107
+	{0, 0x04, 0, 3418},      // U+2109
+	{0, 0x04, 0, 1532},      // U+210A
+	{0, 0x04, 0, 1486},      // U+210B
+	{0, 0x04, 0, 1486},      // U+210C
+	{0, 0x04, 0, 1486},      // U+210D
+	{0, 0x04, 0, 873},       // U+210E
+	{0, 0x04, 0, 3421},      // U+210F
+	{0, 0x04, 0, 1488},      // U+2110
+	{0, 0x04, 0, 1488},      // U+2111
+	{0, 0x04, 0, 1494},      // U+2112
+	{0, 0x04, 0, 911},       // U+2113
+	{0, 0x04, 0, 1498},      // U+2115
+	{0, 0x04, 0, 3423},      // U+2116
+	{0, 0x04, 0, 1504},      // U+2119
+	{0, 0x04, 0, 3426},      // U+211A
+	{0, 0x04, 0, 1506},      // U+211B
+	{0, 0x04, 0, 1506},      // U+211C
+	{0, 0x04, 0, 1506},      // U+211D
+	{0, 0x04, 0, 3428},      // U+2120
+	{0, 0x04, 0, 3431},      // U+2121
+	{0, 0x04, 0, 3435},      // U+2122
+	{0, 0x04, 0, 3438},      // U+2124
+	{0, 0x05, 3440, 3440},   // U+2126
+	{0, 0x04, 0, 3438},      // U+2128
+	{0, 0x05, 1492, 1492},   // U+212A
+	{0, 0x05, 54, 54},       // U+212B
+	{0, 0x04, 0, 1476},      // U+212C
+	{0, 0x04, 0, 3403},      // U+212D
+	{0, 0x04, 0, 1524},      // U+212F
+	{0, 0x04, 0, 1480},      // U+2130
+	{0, 0x04, 0, 3442},      // U+2131
+	{0, 0x04, 0, 1496},      // U+2133
+	{0, 0x04, 0, 25},        // U+2134
+	{0, 0x04, 0, 3444},      // U+2135
+	{0, 0x04, 0, 3446},      // U+2136
+	{0, 0x04, 0, 3448},      // U+2137
+	{0, 0x04, 0, 3450},      // U+2138
+	{0, 0x04, 0, 1566},      // U+2139
+	{0, 0x04, 0, 3452},      // U+213B
+	{0, 0x04, 0, 1018},      // U+213C
+	{0, 0x04, 0, 1560},      // U+213D
+	{0, 0x04, 0, 3456},      // U+213E
+	{0, 0x04, 0, 3458},      // U+213F
+	{0, 0x04, 0, 3460},      // U+2140
+	{0, 0x04, 0, 1478},      // U+2145
+	{0, 0x04, 0, 1522},      // U+2146
+	{0, 0x04, 0, 1524},      // U+2147
+	{0, 0x04, 0, 1566},      // U+2148
+	{0, 0x04, 0, 877},       // U+2149
+	{0, 0x04, 0, 3462},      // U+2150
+	{0, 0x04, 0, 3466},      // U+2151
+	{0, 0x04, 0, 3470},      // U+2152
+	{0, 0x04, 0, 3475},      // U+2153
+	{0, 0x04, 0, 3479},      // U+2154
+	{0, 0x04, 0, 3483},      // U+2155
+	{0, 0x04, 0, 3487},      // U+2156
+	{0, 0x04, 0, 3491},      // U+2157
+	{0, 0x04, 0, 3495},      // U+2158
+	{0, 0x04, 0, 3499},      // U+2159
+	{0, 0x04, 0, 3503},      // U+215A
+	{0, 0x04, 0, 3507},      // U+215B
+	{0, 0x04, 0, 3511},      // U+215C
+	{0, 0x04, 0, 3515},      // U+215D
+	{0, 0x04, 0, 3519},      // U+215E
+	{0, 0x04, 0, 3523},      // U+215F
+	{0, 0x04, 0, 1488},      // U+2160
+	{0, 0x04, 0, 3526},      // U+2161
+	{0, 0x04, 0, 3529},      // U+2162
+	{0, 0x04, 0, 3533},      // U+2163
+	{0, 0x04, 0, 3536},      // U+2164
+	{0, 0x04, 0, 3538},      // U+2165
+	{0, 0x04, 0, 3541},      // U+2166
+	{0, 0x04, 0, 3545},      // U+2167
+	{0, 0x04, 0, 3550},      // U+2168
+	{0, 0x04, 0, 3553},      // U+2169
+	{0, 0x04, 0, 3555},      // U+216A
+	{0, 0x04, 0, 3558},      // U+216B
+	{0, 0x04, 0, 1494},      // U+216C
+	{0, 0x04, 0, 3403},      // U+216D
+	{0, 0x04, 0, 1478},      // U+216E
+	{0, 0x04, 0, 1496},      // U+216F
+	{0, 0x04, 0, 1566},      // U+2170
+	{0, 0x04, 0, 3562},      // U+2171
+	{0, 0x04, 0, 3565},      // U+2172
+	{0, 0x04, 0, 3569},      // U+2173
+	{0, 0x04, 0, 1556},      // U+2174
+	{0, 0x04, 0, 3572},      // U+2175
+	{0, 0x04, 0, 3575},      // U+2176
+	{0, 0x04, 0, 3579},      // U+2177
+	{0, 0x04, 0, 3584},      // U+2178
+	{0, 0x04, 0, 913},       // U+2179
+	{0, 0x04, 0, 3587},      // U+217A
+	{0, 0x04, 0, 3590},      // U+217B
+	{0, 0x04, 0, 911},       // U+217C
+	{0, 0x04, 0, 1572},      // U+217D
+	{0, 0x04, 0, 1522},      // U+217E
+	{0, 0x04, 0, 1536},      // U+217F
+	{0, 0x04, 0, 3594},      // U+2189
+	{0, 0x00, 3598, 3598},   // U+219A
+	{0, 0x00, 3601, 3601},   // U+219B
+	{0, 0x00, 3604, 3604},   // U+21AE
+	{0, 0x00, 3607, 3607},   // U+21CD
+	{0, 0x00, 3610, 3610},   // U+21CE
+	{0, 0x00, 3613, 3613},   // U+21CF
+	{0, 0x00, 3616, 3616},   // U+2204
+	{0, 0x00, 3619, 3619},   // U+2209
+	{0, 0x00, 3622, 3622},   // U+220C
+	{0, 0x00, 3625, 3625},   // U+2224
+	{0, 0x00, 3628, 3628},   // U+2226
+	{0, 0x04, 0, 3631},      // U+222C
+	{0, 0x04, 0, 3634},      // U+222D
+	{0, 0x04, 0, 3638},      // U+222F
+	{0, 0x04, 0, 3641},      // U+2230
+	{0, 0x00, 3645, 3645},   // U+2241
+	{0, 0x00, 3648, 3648},   // U+2244
+	{0, 0x00, 3651, 3651},   // U+2247
+	{0, 0x00, 3654, 3654},   // U+2249
+	{0, 0x00, 3657, 3657},   // U+2260
+	{0, 0x00, 3660, 3660},   // U+2262
+	{0, 0x00, 3663, 3663},   // U+226D
+	{0, 0x00, 3666, 3666},   // U+226E
+	{0, 0x00, 3669, 3669},   // U+226F
+	{0, 0x00, 3672, 3672},   // U+2270
+	{0, 0x00, 3675, 3675},   // U+2271
+	{0, 0x00, 3678, 3678},   // U+2274
+	{0, 0x00, 3681, 3681},   // U+2275
+	{0, 0x00, 3684, 3684},   // U+2278
+	{0, 0x00, 3687, 3687},   // U+2279
+	{0, 0x00, 3690, 3690},   // U+2280
+	{0, 0x00, 3693, 3693},   // U+2281
+	{0, 0x00, 3696, 3696},   // U+2284
+	{0, 0x00, 3699, 3699},   // U+2285
+	{0, 0x00, 3702, 3702},   // U+2288
+	{0, 0x00, 3705, 3705},   // U+2289
+	{0, 0x00, 3708, 3708},   // U+22AC
+	{0, 0x00, 3711, 3711},   // U+22AD
+	{0, 0x00, 3714, 3714},   // U+22AE
+	{0, 0x00, 3717, 3717},   // U+22AF
+	{0, 0x00, 3720, 3720},   // U+22E0
+	{0, 0x00, 3723, 3723},   // U+22E1
+	{0, 0x00, 3726, 3726},   // U+22E2
+	{0, 0x00, 3729, 3729},   // U+22E3
+	{0, 0x00, 3732, 3732},   // U+22EA
+	{0, 0x00, 3735, 3735},   // U+22EB
+	{0, 0x00, 3738, 3738},   // U+22EC
+	{0, 0x00, 3741, 3741},   // U+22ED
+	{0, 0x05, 3744, 3744},   // U+2329
+	{0, 0x05, 3746, 3746},   // U+232A
+	{0, 0x04, 0, 23},        // U+2460
+	{0, 0x04, 0, 11},        // U+2461
+	{0, 0x04, 0, 13},        // U+2462
+	{0, 0x04, 0, 3368},      // U+2463
+	{0, 0x04, 0, 3370},      // U+2464
+	{0, 0x04, 0, 3372},      // U+2465
+	{0, 0x04, 0, 3374},      // U+2466
+	{0, 0x04, 0, 3376},      // U+2467
+	{0, 0x04, 0, 3378},      // U+2468
+	{0, 0x04, 0, 3748},      // U+2469
+	{0, 0x04, 0, 3751},      // U+246A
+	{0, 0x04, 0, 3754},      // U+246B
+	{0, 0x04, 0, 3757},      // U+246C
+	{0, 0x04, 0, 3760},      // U+246D
+	{0, 0x04, 0, 3763},      // U+246E
+	{0, 0x04, 0, 3766},      // U+246F
+	{0, 0x04, 0, 3769},      // U+2470
+	{0, 0x04, 0, 3772},      // U+2471
+	{0, 0x04, 0, 3775},      // U+2472
+	{0, 0x04, 0, 3778},      // U+2473
+	{0, 0x04, 0, 3781},      // U+2474
+	{0, 0x04, 0, 3785},      // U+2475
+	{0, 0x04, 0, 3789},      // U+2476
+	{0, 0x04, 0, 3793},      // U+2477
+	{0, 0x04, 0, 3797},      // U+2478
+	{0, 0x04, 0, 3801},      // U+2479
+	{0, 0x04, 0, 3805},      // U+247A
+	{0, 0x04, 0, 3809},      // U+247B
+	{0, 0x04, 0, 3813},      // U+247C
+	{0, 0x04, 0, 3817},      // U+247D
+	{0, 0x04, 0, 3822},      // U+247E
+	{0, 0x04, 0, 3827},      // U+247F
+	{0, 0x04, 0, 3832},      // U+2480
+	{0, 0x04, 0, 3837},      // U+2481
+	{0, 0x04, 0, 3842},      // U+2482
+	{0, 0x04, 0, 3847},      // U+2483
+	{0, 0x04, 0, 3852},      // U+2484
+	{0, 0x04, 0, 3857},      // U+2485
+	{0, 0x04, 0, 3862},      // U+2486
+	{0, 0x04, 0, 3867},      // U+2487
+	{0, 0x04, 0, 3872},      // U+2488
+	{0, 0x04, 0, 3875},      // U+2489
+	{0, 0x04, 0, 3878},      // U+248A
+	{0, 0x04, 0, 3881},      // U+248B
+	{0, 0x04, 0, 3884},      // U+248C
+	{0, 0x04, 0, 3887},      // U+248D
+	{0, 0x04, 0, 3890},      // U+248E
+	{0, 0x04, 0, 3893},      // U+248F
+	{0, 0x04, 0, 3896},      // U+2490
+	{0, 0x04, 0, 3899},      // U+2491
+	{0, 0x04, 0, 3903},      // U+2492
+	{0, 0x04, 0, 3907},      // U+2493
+	{0, 0x04, 0, 3911},      // U+2494
+	{0, 0x04, 0, 3915},      // U+2495
+	{0, 0x04, 0, 3919},      // U+2496
+	{0, 0x04, 0, 3923},      // U+2497
+	{0, 0x04, 0, 3927},      // U+2498
+	{0, 0x04, 0, 3931},      // U+2499
+	{0, 0x04, 0, 3935},      // U+249A
+	{0, 0x04, 0, 3939},      // U+249B
+	{0, 0x04, 0, 3943},      // U+249C
+	{0, 0x04, 0, 3947},      // U+249D
+	{0, 0x04, 0, 3951},      // U+249E
+	{0, 0x04, 0, 3955},      // U+249F
+	{0, 0x04, 0, 3959},      // U+24A0
+	{0, 0x04, 0, 3963},      // U+24A1
+	{0, 0x04, 0, 3967},      // U+24A2
+	{0, 0x04, 0, 3971},      // U+24A3
+	{0, 0x04, 0, 3975},      // U+24A4
+	{0, 0x04, 0, 3979},      // U+24A5
+	{0, 0x04, 0, 3983},      // U+24A6
+	{0, 0x04, 0, 3987},      // U+24A7
+	{0, 0x04, 0, 3991},      // U+24A8
+	{0, 0x04, 0, 3995},      // U+24A9
+	{0, 0x04, 0, 3999},      // U+24AA
+	{0, 0x04, 0, 4003},      // U+24AB
+	{0, 0x04, 0, 4007},      // U+24AC
+	{0, 0x04, 0, 4011},      // U+24AD
+	{0, 0x04, 0, 4015},      // U+24AE
+	{0, 0x04, 0, 4019},      // U+24AF
+	{0, 0x04, 0, 4023},      // U+24B0
+	{0, 0x04, 0, 4027},      // U+24B1
+	{0, 0x04, 0, 4031},      // U+24B2
+	{0, 0x04, 0, 4035},      // U+24B3
+	{0, 0x04, 0, 4039},      // U+24B4
+	{0, 0x04, 0, 4043},      // U+24B5
+	{0, 0x04, 0, 1472},      // U+24B6
+	{0, 0x04, 0, 1476},      // U+24B7
+	{0, 0x04, 0, 3403},      // U+24B8
+	{0, 0x04, 0, 1478},      // U+24B9
+	{0, 0x04, 0, 1480},      // U+24BA
+	{0, 0x04, 0, 3442},      // U+24BB
+	{0, 0x04, 0, 1484},      // U+24BC
+	{0, 0x04, 0, 1486},      // U+24BD
+	{0, 0x04, 0, 1488},      // U+24BE
+	{0, 0x04, 0, 1490},      // U+24BF
+	{0, 0x04, 0, 1492},      // U+24C0
+	{0, 0x04, 0, 1494},      // U+24C1
+	{0, 0x04, 0, 1496},      // U+24C2
+	{0, 0x04, 0, 1498},      // U+24C3
+	{0, 0x04, 0, 1500},      // U+24C4
+	{0, 0x04, 0, 1504},      // U+24C5
+	{0, 0x04, 0, 3426},      // U+24C6
+	{0, 0x04, 0, 1506},      // U+24C7
+	{0, 0x04, 0, 4047},      // U+24C8
+	{0, 0x04, 0, 1508},      // U+24C9
+	{0, 0x04, 0, 1510},      // U+24CA
+	{0, 0x04, 0, 3536},      // U+24CB
+	{0, 0x04, 0, 1512},      // U+24CC
+	{0, 0x04, 0, 3553},      // U+24CD
+	{0, 0x04, 0, 4049},      // U+24CE
+	{0, 0x04, 0, 3438},      // U+24CF
+	{0, 0x04, 0, 6},         // U+24D0
+	{0, 0x04, 0, 1520},      // U+24D1
+	{0, 0x04, 0, 1572},      // U+24D2
+	{0, 0x04, 0, 1522},      // U+24D3
+	{0, 0x04, 0, 1524},      // U+24D4
+	{0, 0x04, 0, 1578},      // U+24D5
+	{0, 0x04, 0, 1532},      // U+24D6
+	{0, 0x04, 0, 873},       // U+24D7
+	{0, 0x04, 0, 1566},      // U+24D8
+	{0, 0x04, 0, 877},       // U+24D9
+	{0, 0x04, 0, 1534},      // U+24DA
+	{0, 0x04, 0, 911},       // U+24DB
+	{0, 0x04, 0, 1536},      // U+24DC
+	{0, 0x04, 0, 3390},      // U+24DD
+	{0, 0x04, 0, 25},        // U+24DE
+	{0, 0x04, 0, 1546},      // U+24DF
+	{0, 0x04, 0, 4051},      // U+24E0
+	{0, 0x04, 0, 879},       // U+24E1
+	{0, 0x04, 0, 537},       // U+24E2
+	{0, 0x04, 0, 1548},      // U+24E3
+	{0, 0x04, 0, 1550},      // U+24E4
+	{0, 0x04, 0, 1556},      // U+24E5
+	{0, 0x04, 0, 887},       // U+24E6
+	{0, 0x04, 0, 913},       // U+24E7
+	{0, 0x04, 0, 889},       // U+24E8
+	{0, 0x04, 0, 1632},      // U+24E9
+	{0, 0x04, 0, 3366},      // U+24EA
+	{0, 0x04, 0, 4053},      // U+2A0C
+	{0, 0x04, 0, 4058},      // U+2A74
+	{0, 0x04, 0, 4062},      // U+2A75
+	{0, 0x04, 0, 4065},      // U+2A76
+	{0, 0x05, 4069, 4069},   // U+2ADC
+	{0, 0x04, 0, 877},       // U+2C7C
+	{0, 0x04, 0, 3536},      // U+2C7D
+	{230, 0x00, 0, 0},       // U+2CEF
+	{230, 0x00, 0, 0},       // U+2CF0
+	{230, 0x00, 0, 0},       // U+2CF1
+	{0, 0x04, 0, 4072},      // U+2D6F
+	{9, 0x00, 0, 0},         // U+2D7F
+	{230, 0x00, 0, 0},       // U+2DE0
+	{230, 0x00, 0, 0},       // U+2DE1
+	{230, 0x00, 0, 0},       // U+2DE2
+	{230, 0x00, 0, 0},       // U+2DE3
+	{230, 0x00, 0, 0},       // U+2DE4
+	{230, 0x00, 0, 0},       // U+2DE5
+	{230, 0x00, 0, 0},       // U+2DE6
+	{230, 0x00, 0, 0},       // U+2DE7
+	{230, 0x00, 0, 0},       // U+2DE8
+	{230, 0x00, 0, 0},       // U+2DE9
+	{230, 0x00, 0, 0},       // U+2DEA
+	{230, 0x00, 0, 0},       // U+2DEB
+	{230, 0x00, 0, 0},       // U+2DEC
+	{230, 0x00, 0, 0},       // U+2DED
+	{230, 0x00, 0, 0},       // U+2DEE
+	{230, 0x00, 0, 0},       // U+2DEF
+	{230, 0x00, 0, 0},       // U+2DF0
+	{230, 0x00, 0, 0},       // U+2DF1
+	{230, 0x00, 0, 0},       // U+2DF2
+	{230, 0x00, 0, 0},       // U+2DF3
+	{230, 0x00, 0, 0},       // U+2DF4
+	{230, 0x00, 0, 0},       // U+2DF5
+	{230, 0x00, 0, 0},       // U+2DF6
+	{230, 0x00, 0, 0},       // U+2DF7
+	{230, 0x00, 0, 0},       // U+2DF8
+	{230, 0x00, 0, 0},       // U+2DF9
+	{230, 0x00, 0, 0},       // U+2DFA
+	{230, 0x00, 0, 0},       // U+2DFB
+	{230, 0x00, 0, 0},       // U+2DFC
+	{230, 0x00, 0, 0},       // U+2DFD
+	{230, 0x00, 0, 0},       // U+2DFE
+	{230, 0x00, 0, 0},       // U+2DFF
+	{0, 0x04, 0, 4074},      // U+2E9F
+	{0, 0x04, 0, 4076},      // U+2EF3
+	{0, 0x04, 0, 4078},      // U+2F00
+	{0, 0x04, 0, 4080},      // U+2F01
+	{0, 0x04, 0, 4082},      // U+2F02
+	{0, 0x04, 0, 4084},      // U+2F03
+	{0, 0x04, 0, 4086},      // U+2F04
+	{0, 0x04, 0, 4088},      // U+2F05
+	{0, 0x04, 0, 4090},      // U+2F06
+	{0, 0x04, 0, 4092},      // U+2F07
+	{0, 0x04, 0, 4094},      // U+2F08
+	{0, 0x04, 0, 4096},      // U+2F09
+	{0, 0x04, 0, 4098},      // U+2F0A
+	{0, 0x04, 0, 4100},      // U+2F0B
+	{0, 0x04, 0, 4102},      // U+2F0C
+	{0, 0x04, 0, 4104},      // U+2F0D
+	{0, 0x04, 0, 4106},      // U+2F0E
+	{0, 0x04, 0, 4108},      // U+2F0F
+	{0, 0x04, 0, 4110},      // U+2F10
+	{0, 0x04, 0, 4112},      // U+2F11
+	{0, 0x04, 0, 4114},      // U+2F12
+	{0, 0x04, 0, 4116},      // U+2F13
+	{0, 0x04, 0, 4118},      // U+2F14
+	{0, 0x04, 0, 4120},      // U+2F15
+	{0, 0x04, 0, 4122},      // U+2F16
+	{0, 0x04, 0, 4124},      // U+2F17
+	{0, 0x04, 0, 4126},      // U+2F18
+	{0, 0x04, 0, 4128},      // U+2F19
+	{0, 0x04, 0, 4130},      // U+2F1A
+	{0, 0x04, 0, 4132},      // U+2F1B
+	{0, 0x04, 0, 4134},      // U+2F1C
+	{0, 0x04, 0, 4136},      // U+2F1D
+	{0, 0x04, 0, 4138},      // U+2F1E
+	{0, 0x04, 0, 4140},      // U+2F1F
+	{0, 0x04, 0, 4142},      // U+2F20
+	{0, 0x04, 0, 4144},      // U+2F21
+	{0, 0x04, 0, 4146},      // U+2F22
+	{0, 0x04, 0, 4148},      // U+2F23
+	{0, 0x04, 0, 4150},      // U+2F24
+	{0, 0x04, 0, 4152},      // U+2F25
+	{0, 0x04, 0, 4154},      // U+2F26
+	{0, 0x04, 0, 4156},      // U+2F27
+	{0, 0x04, 0, 4158},      // U+2F28
+	{0, 0x04, 0, 4160},      // U+2F29
+	{0, 0x04, 0, 4162},      // U+2F2A
+	{0, 0x04, 0, 4164},      // U+2F2B
+	{0, 0x04, 0, 4166},      // U+2F2C
+	{0, 0x04, 0, 4168},      // U+2F2D
+	{0, 0x04, 0, 4170},      // U+2F2E
+	{0, 0x04, 0, 4172},      // U+2F2F
+	{0, 0x04, 0, 4174},      // U+2F30
+	{0, 0x04, 0, 4176},      // U+2F31
+	{0, 0x04, 0, 4178},      // U+2F32
+	{0, 0x04, 0, 4180},      // U+2F33
+	{0, 0x04, 0, 4182},      // U+2F34
+	{0, 0x04, 0, 4184},      // U+2F35
+	{0, 0x04, 0, 4186},      // U+2F36
+	{0, 0x04, 0, 4188},      // U+2F37
+	{0, 0x04, 0, 4190},      // U+2F38
+	{0, 0x04, 0, 4192},      // U+2F39
+	{0, 0x04, 0, 4194},      // U+2F3A
+	{0, 0x04, 0, 4196},      // U+2F3B
+	{0, 0x04, 0, 4198},      // U+2F3C
+	{0, 0x04, 0, 4200},      // U+2F3D
+	{0, 0x04, 0, 4202},      // U+2F3E
+	{0, 0x04, 0, 4204},      // U+2F3F
+	{0, 0x04, 0, 4206},      // U+2F40
+	{0, 0x04, 0, 4208},      // U+2F41
+	{0, 0x04, 0, 4210},      // U+2F42
+	{0, 0x04, 0, 4212},      // U+2F43
+	{0, 0x04, 0, 4214},      // U+2F44
+	{0, 0x04, 0, 4216},      // U+2F45
+	{0, 0x04, 0, 4218},      // U+2F46
+	{0, 0x04, 0, 4220},      // U+2F47
+	{0, 0x04, 0, 4222},      // U+2F48
+	{0, 0x04, 0, 4224},      // U+2F49
+	{0, 0x04, 0, 4226},      // U+2F4A
+	{0, 0x04, 0, 4228},      // U+2F4B
+	{0, 0x04, 0, 4230},      // U+2F4C
+	{0, 0x04, 0, 4232},      // U+2F4D
+	{0, 0x04, 0, 4234},      // U+2F4E
+	{0, 0x04, 0, 4236},      // U+2F4F
+	{0, 0x04, 0, 4238},      // U+2F50
+	{0, 0x04, 0, 4240},      // U+2F51
+	{0, 0x04, 0, 4242},      // U+2F52
+	{0, 0x04, 0, 4244},      // U+2F53
+	{0, 0x04, 0, 4246},      // U+2F54
+	{0, 0x04, 0, 4248},      // U+2F55
+	{0, 0x04, 0, 4250},      // U+2F56
+	{0, 0x04, 0, 4252},      // U+2F57
+	{0, 0x04, 0, 4254},      // U+2F58
+	{0, 0x04, 0, 4256},      // U+2F59
+	{0, 0x04, 0, 4258},      // U+2F5A
+	{0, 0x04, 0, 4260},      // U+2F5B
+	{0, 0x04, 0, 4262},      // U+2F5C
+	{0, 0x04, 0, 4264},      // U+2F5D
+	{0, 0x04, 0, 4266},      // U+2F5E
+	{0, 0x04, 0, 4268},      // U+2F5F
+	{0, 0x04, 0, 4270},      // U+2F60
+	{0, 0x04, 0, 4272},      // U+2F61
+	{0, 0x04, 0, 4274},      // U+2F62
+	{0, 0x04, 0, 4276},      // U+2F63
+	{0, 0x04, 0, 4278},      // U+2F64
+	{0, 0x04, 0, 4280},      // U+2F65
+	{0, 0x04, 0, 4282},      // U+2F66
+	{0, 0x04, 0, 4284},      // U+2F67
+	{0, 0x04, 0, 4286},      // U+2F68
+	{0, 0x04, 0, 4288},      // U+2F69
+	{0, 0x04, 0, 4290},      // U+2F6A
+	{0, 0x04, 0, 4292},      // U+2F6B
+	{0, 0x04, 0, 4294},      // U+2F6C
+	{0, 0x04, 0, 4296},      // U+2F6D
+	{0, 0x04, 0, 4298},      // U+2F6E
+	{0, 0x04, 0, 4300},      // U+2F6F
+	{0, 0x04, 0, 4302},      // U+2F70
+	{0, 0x04, 0, 4304},      // U+2F71
+	{0, 0x04, 0, 4306},      // U+2F72
+	{0, 0x04, 0, 4308},      // U+2F73
+	{0, 0x04, 0, 4310},      // U+2F74
+	{0, 0x04, 0, 4312},      // U+2F75
+	{0, 0x04, 0, 4314},      // U+2F76
+	{0, 0x04, 0, 4316},      // U+2F77
+	{0, 0x04, 0, 4318},      // U+2F78
+	{0, 0x04, 0, 4320},      // U+2F79
+	{0, 0x04, 0, 4322},      // U+2F7A
+	{0, 0x04, 0, 4324},      // U+2F7B
+	{0, 0x04, 0, 4326},      // U+2F7C
+	{0, 0x04, 0, 4328},      // U+2F7D
+	{0, 0x04, 0, 4330},      // U+2F7E
+	{0, 0x04, 0, 4332},      // U+2F7F
+	{0, 0x04, 0, 4334},      // U+2F80
+	{0, 0x04, 0, 4336},      // U+2F81
+	{0, 0x04, 0, 4338},      // U+2F82
+	{0, 0x04, 0, 4340},      // U+2F83
+	{0, 0x04, 0, 4342},      // U+2F84
+	{0, 0x04, 0, 4344},      // U+2F85
+	{0, 0x04, 0, 4346},      // U+2F86
+	{0, 0x04, 0, 4348},      // U+2F87
+	{0, 0x04, 0, 4350},      // U+2F88
+	{0, 0x04, 0, 4352},      // U+2F89
+	{0, 0x04, 0, 4354},      // U+2F8A
+	{0, 0x04, 0, 4356},      // U+2F8B
+	{0, 0x04, 0, 4358},      // U+2F8C
+	{0, 0x04, 0, 4360},      // U+2F8D
+	{0, 0x04, 0, 4362},      // U+2F8E
+	{0, 0x04, 0, 4364},      // U+2F8F
+	{0, 0x04, 0, 4366},      // U+2F90
+	{0, 0x04, 0, 4368},      // U+2F91
+	{0, 0x04, 0, 4370},      // U+2F92
+	{0, 0x04, 0, 4372},      // U+2F93
+	{0, 0x04, 0, 4374},      // U+2F94
+	{0, 0x04, 0, 4376},      // U+2F95
+	{0, 0x04, 0, 4378},      // U+2F96
+	{0, 0x04, 0, 4380},      // U+2F97
+	{0, 0x04, 0, 4382},      // U+2F98
+	{0, 0x04, 0, 4384},      // U+2F99
+	{0, 0x04, 0, 4386},      // U+2F9A
+	{0, 0x04, 0, 4388},      // U+2F9B
+	{0, 0x04, 0, 4390},      // U+2F9C
+	{0, 0x04, 0, 4392},      // U+2F9D
+	{0, 0x04, 0, 4394},      // U+2F9E
+	{0, 0x04, 0, 4396},      // U+2F9F
+	{0, 0x04, 0, 4398},      // U+2FA0
+	{0, 0x04, 0, 4400},      // U+2FA1
+	{0, 0x04, 0, 4402},      // U+2FA2
+	{0, 0x04, 0, 4404},      // U+2FA3
+	{0, 0x04, 0, 4406},      // U+2FA4
+	{0, 0x04, 0, 4408},      // U+2FA5
+	{0, 0x04, 0, 4410},      // U+2FA6
+	{0, 0x04, 0, 4412},      // U+2FA7
+	{0, 0x04, 0, 4414},      // U+2FA8
+	{0, 0x04, 0, 4416},      // U+2FA9
+	{0, 0x04, 0, 4418},      // U+2FAA
+	{0, 0x04, 0, 4420},      // U+2FAB
+	{0, 0x04, 0, 4422},      // U+2FAC
+	{0, 0x04, 0, 4424},      // U+2FAD
+	{0, 0x04, 0, 4426},      // U+2FAE
+	{0, 0x04, 0, 4428},      // U+2FAF
+	{0, 0x04, 0, 4430},      // U+2FB0
+	{0, 0x04, 0, 4432},      // U+2FB1
+	{0, 0x04, 0, 4434},      // U+2FB2
+	{0, 0x04, 0, 4436},      // U+2FB3
+	{0, 0x04, 0, 4438},      // U+2FB4
+	{0, 0x04, 0, 4440},      // U+2FB5
+	{0, 0x04, 0, 4442},      // U+2FB6
+	{0, 0x04, 0, 4444},      // U+2FB7
+	{0, 0x04, 0, 4446},      // U+2FB8
+	{0, 0x04, 0, 4448},      // U+2FB9
+	{0, 0x04, 0, 4450},      // U+2FBA
+	{0, 0x04, 0, 4452},      // U+2FBB
+	{0, 0x04, 0, 4454},      // U+2FBC
+	{0, 0x04, 0, 4456},      // U+2FBD
+	{0, 0x04, 0, 4458},      // U+2FBE
+	{0, 0x04, 0, 4460},      // U+2FBF
+	{0, 0x04, 0, 4462},      // U+2FC0
+	{0, 0x04, 0, 4464},      // U+2FC1
+	{0, 0x04, 0, 4466},      // U+2FC2
+	{0, 0x04, 0, 4468},      // U+2FC3
+	{0, 0x04, 0, 4470},      // U+2FC4
+	{0, 0x04, 0, 4472},      // U+2FC5
+	{0, 0x04, 0, 4474},      // U+2FC6
+	{0, 0x04, 0, 4476},      // U+2FC7
+	{0, 0x04, 0, 4478},      // U+2FC8
+	{0, 0x04, 0, 4480},      // U+2FC9
+	{0, 0x04, 0, 4482},      // U+2FCA
+	{0, 0x04, 0, 4484},      // U+2FCB
+	{0, 0x04, 0, 4486},      // U+2FCC
+	{0, 0x04, 0, 4488},      // U+2FCD
+	{0, 0x04, 0, 4490},      // U+2FCE
+	{0, 0x04, 0, 4492},      // U+2FCF
+	{0, 0x04, 0, 4494},      // U+2FD0
+	{0, 0x04, 0, 4496},      // U+2FD1
+	{0, 0x04, 0, 4498},      // U+2FD2
+	{0, 0x04, 0, 4500},      // U+2FD3
+	{0, 0x04, 0, 4502},      // U+2FD4
+	{0, 0x04, 0, 4504},      // U+2FD5
+	{0, 0x04, 0, 1},         // U+3000
+	{218, 0x00, 0, 0},       // U+302A
+	{228, 0x00, 0, 0},       // U+302B
+	{232, 0x00, 0, 0},       // U+302C
+	{222, 0x00, 0, 0},       // U+302D
+	{224, 0x00, 0, 0},       // U+302E
+	{224, 0x00, 0, 0},       // U+302F
+	{0, 0x04, 0, 4506},      // U+3036
+	{0, 0x04, 0, 4124},      // U+3038
+	{0, 0x04, 0, 4508},      // U+3039
+	{0, 0x04, 0, 4510},      // U+303A
+	{0, 0x00, 4512, 4512},   // U+304C
+	{0, 0x00, 4515, 4515},   // U+304E
+	{0, 0x00, 4518, 4518},   // U+3050
+	{0, 0x00, 4521, 4521},   // U+3052
+	{0, 0x00, 4524, 4524},   // U+3054
+	{0, 0x00, 4527, 4527},   // U+3056
+	{0, 0x00, 4530, 4530},   // U+3058
+	{0, 0x00, 4533, 4533},   // U+305A
+	{0, 0x00, 4536, 4536},   // U+305C
+	{0, 0x00, 4539, 4539},   // U+305E
+	{0, 0x00, 4542, 4542},   // U+3060
+	{0, 0x00, 4545, 4545},   // U+3062
+	{0, 0x00, 4548, 4548},   // U+3065
+	{0, 0x00, 4551, 4551},   // U+3067
+	{0, 0x00, 4554, 4554},   // U+3069
+	{0, 0x00, 4557, 4557},   // U+3070
+	{0, 0x00, 4560, 4560},   // U+3071
+	{0, 0x00, 4563, 4563},   // U+3073
+	{0, 0x00, 4566, 4566},   // U+3074
+	{0, 0x00, 4569, 4569},   // U+3076
+	{0, 0x00, 4572, 4572},   // U+3077
+	{0, 0x00, 4575, 4575},   // U+3079
+	{0, 0x00, 4578, 4578},   // U+307A
+	{0, 0x00, 4581, 4581},   // U+307C
+	{0, 0x00, 4584, 4584},   // U+307D
+	{0, 0x00, 4587, 4587},   // U+3094
+	{8, 0x0A, 0, 0},         // U+3099
+	{8, 0x0A, 0, 0},         // U+309A
+	{0, 0x04, 0, 4590},      // U+309B
+	{0, 0x04, 0, 4593},      // U+309C
+	{0, 0x00, 4596, 4596},   // U+309E
+	{0, 0x04, 0, 4599},      // U+309F
+	{0, 0x00, 4602, 4602},   // U+30AC
+	{0, 0x00, 4605, 4605},   // U+30AE
+	{0, 0x00, 4608, 4608},   // U+30B0
+	{0, 0x00, 4611, 4611},   // U+30B2
+	{0, 0x00, 4614, 4614},   // U+30B4
+	{0, 0x00, 4617, 4617},   // U+30B6
+	{0, 0x00, 4620, 4620},   // U+30B8
+	{0, 0x00, 4623, 4623},   // U+30BA
+	{0, 0x00, 4626, 4626},   // U+30BC
+	{0, 0x00, 4629, 4629},   // U+30BE
+	{0, 0x00, 4632, 4632},   // U+30C0
+	{0, 0x00, 4635, 4635},   // U+30C2
+	{0, 0x00, 4638, 4638},   // U+30C5
+	{0, 0x00, 4641, 4641},   // U+30C7
+	{0, 0x00, 4644, 4644},   // U+30C9
+	{0, 0x00, 4647, 4647},   // U+30D0
+	{0, 0x00, 4650, 4650},   // U+30D1
+	{0, 0x00, 4653, 4653},   // U+30D3
+	{0, 0x00, 4656, 4656},   // U+30D4
+	{0, 0x00, 4659, 4659},   // U+30D6
+	{0, 0x00, 4662, 4662},   // U+30D7
+	{0, 0x00, 4665, 4665},   // U+30D9
+	{0, 0x00, 4668, 4668},   // U+30DA
+	{0, 0x00, 4671, 4671},   // U+30DC
+	{0, 0x00, 4674, 4674},   // U+30DD
+	{0, 0x00, 4677, 4677},   // U+30F4
+	{0, 0x00, 4680, 4680},   // U+30F7
+	{0, 0x00, 4683, 4683},   // U+30F8
+	{0, 0x00, 4686, 4686},   // U+30F9
+	{0, 0x00, 4689, 4689},   // U+30FA
+	{0, 0x00, 4692, 4692},   // U+30FE
+	{0, 0x04, 0, 4695},      // U+30FF
+	{0, 0x04, 0, 4698},      // U+3131
+	{0, 0x04, 0, 4700},      // U+3132
+	{0, 0x04, 0, 4702},      // U+3133
+	{0, 0x04, 0, 4704},      // U+3134
+	{0, 0x04, 0, 4706},      // U+3135
+	{0, 0x04, 0, 4708},      // U+3136
+	{0, 0x04, 0, 4710},      // U+3137
+	{0, 0x04, 0, 4712},      // U+3138
+	{0, 0x04, 0, 4714},      // U+3139
+	{0, 0x04, 0, 4716},      // U+313A
+	{0, 0x04, 0, 4718},      // U+313B
+	{0, 0x04, 0, 4720},      // U+313C
+	{0, 0x04, 0, 4722},      // U+313D
+	{0, 0x04, 0, 4724},      // U+313E
+	{0, 0x04, 0, 4726},      // U+313F
+	{0, 0x04, 0, 4728},      // U+3140
+	{0, 0x04, 0, 4730},      // U+3141
+	{0, 0x04, 0, 4732},      // U+3142
+	{0, 0x04, 0, 4734},      // U+3143
+	{0, 0x04, 0, 4736},      // U+3144
+	{0, 0x04, 0, 4738},      // U+3145
+	{0, 0x04, 0, 4740},      // U+3146
+	{0, 0x04, 0, 4742},      // U+3147
+	{0, 0x04, 0, 4744},      // U+3148
+	{0, 0x04, 0, 4746},      // U+3149
+	{0, 0x04, 0, 4748},      // U+314A
+	{0, 0x04, 0, 4750},      // U+314B
+	{0, 0x04, 0, 4752},      // U+314C
+	{0, 0x04, 0, 4754},      // U+314D
+	{0, 0x04, 0, 4756},      // U+314E
+	{0, 0x04, 0, 4758},      // U+314F
+	{0, 0x04, 0, 4760},      // U+3150
+	{0, 0x04, 0, 4762},      // U+3151
+	{0, 0x04, 0, 4764},      // U+3152
+	{0, 0x04, 0, 4766},      // U+3153
+	{0, 0x04, 0, 4768},      // U+3154
+	{0, 0x04, 0, 4770},      // U+3155
+	{0, 0x04, 0, 4772},      // U+3156
+	{0, 0x04, 0, 4774},      // U+3157
+	{0, 0x04, 0, 4776},      // U+3158
+	{0, 0x04, 0, 4778},      // U+3159
+	{0, 0x04, 0, 4780},      // U+315A
+	{0, 0x04, 0, 4782},      // U+315B
+	{0, 0x04, 0, 4784},      // U+315C
+	{0, 0x04, 0, 4786},      // U+315D
+	{0, 0x04, 0, 4788},      // U+315E
+	{0, 0x04, 0, 4790},      // U+315F
+	{0, 0x04, 0, 4792},      // U+3160
+	{0, 0x04, 0, 4794},      // U+3161
+	{0, 0x04, 0, 4796},      // U+3162
+	{0, 0x04, 0, 4798},      // U+3163
+	{0, 0x04, 0, 4800},      // U+3164
+	{0, 0x04, 0, 4802},      // U+3165
+	{0, 0x04, 0, 4804},      // U+3166
+	{0, 0x04, 0, 4806},      // U+3167
+	{0, 0x04, 0, 4808},      // U+3168
+	{0, 0x04, 0, 4810},      // U+3169
+	{0, 0x04, 0, 4812},      // U+316A
+	{0, 0x04, 0, 4814},      // U+316B
+	{0, 0x04, 0, 4816},      // U+316C
+	{0, 0x04, 0, 4818},      // U+316D
+	{0, 0x04, 0, 4820},      // U+316E
+	{0, 0x04, 0, 4822},      // U+316F
+	{0, 0x04, 0, 4824},      // U+3170
+	{0, 0x04, 0, 4826},      // U+3171
+	{0, 0x04, 0, 4828},      // U+3172
+	{0, 0x04, 0, 4830},      // U+3173
+	{0, 0x04, 0, 4832},      // U+3174
+	{0, 0x04, 0, 4834},      // U+3175
+	{0, 0x04, 0, 4836},      // U+3176
+	{0, 0x04, 0, 4838},      // U+3177
+	{0, 0x04, 0, 4840},      // U+3178
+	{0, 0x04, 0, 4842},      // U+3179
+	{0, 0x04, 0, 4844},      // U+317A
+	{0, 0x04, 0, 4846},      // U+317B
+	{0, 0x04, 0, 4848},      // U+317C
+	{0, 0x04, 0, 4850},      // U+317D
+	{0, 0x04, 0, 4852},      // U+317E
+	{0, 0x04, 0, 4854},      // U+317F
+	{0, 0x04, 0, 4856},      // U+3180
+	{0, 0x04, 0, 4858},      // U+3181
+	{0, 0x04, 0, 4860},      // U+3182
+	{0, 0x04, 0, 4862},      // U+3183
+	{0, 0x04, 0, 4864},      // U+3184
+	{0, 0x04, 0, 4866},      // U+3185
+	{0, 0x04, 0, 4868},      // U+3186
+	{0, 0x04, 0, 4870},      // U+3187
+	{0, 0x04, 0, 4872},      // U+3188
+	{0, 0x04, 0, 4874},      // U+3189
+	{0, 0x04, 0, 4876},      // U+318A
+	{0, 0x04, 0, 4878},      // U+318B
+	{0, 0x04, 0, 4880},      // U+318C
+	{0, 0x04, 0, 4882},      // U+318D
+	{0, 0x04, 0, 4884},      // U+318E
+	{0, 0x04, 0, 4078},      // U+3192
+	{0, 0x04, 0, 4090},      // U+3193
+	{0, 0x04, 0, 4886},      // U+3194
+	{0, 0x04, 0, 4888},      // U+3195
+	{0, 0x04, 0, 4890},      // U+3196
+	{0, 0x04, 0, 4892},      // U+3197
+	{0, 0x04, 0, 4894},      // U+3198
+	{0, 0x04, 0, 4896},      // U+3199
+	{0, 0x04, 0, 4086},      // U+319A
+	{0, 0x04, 0, 4898},      // U+319B
+	{0, 0x04, 0, 4900},      // U+319C
+	{0, 0x04, 0, 4902},      // U+319D
+	{0, 0x04, 0, 4904},      // U+319E
+	{0, 0x04, 0, 4094},      // U+319F
+	{0, 0x04, 0, 4906},      // U+3200
+	{0, 0x04, 0, 4910},      // U+3201
+	{0, 0x04, 0, 4914},      // U+3202
+	{0, 0x04, 0, 4918},      // U+3203
+	{0, 0x04, 0, 4922},      // U+3204
+	{0, 0x04, 0, 4926},      // U+3205
+	{0, 0x04, 0, 4930},      // U+3206
+	{0, 0x04, 0, 4934},      // U+3207
+	{0, 0x04, 0, 4938},      // U+3208
+	{0, 0x04, 0, 4942},      // U+3209
+	{0, 0x04, 0, 4946},      // U+320A
+	{0, 0x04, 0, 4950},      // U+320B
+	{0, 0x04, 0, 4954},      // U+320C
+	{0, 0x04, 0, 4958},      // U+320D
+	{0, 0x04, 0, 4962},      // U+320E
+	{0, 0x04, 0, 4967},      // U+320F
+	{0, 0x04, 0, 4972},      // U+3210
+	{0, 0x04, 0, 4977},      // U+3211
+	{0, 0x04, 0, 4982},      // U+3212
+	{0, 0x04, 0, 4987},      // U+3213
+	{0, 0x04, 0, 4992},      // U+3214
+	{0, 0x04, 0, 4997},      // U+3215
+	{0, 0x04, 0, 5002},      // U+3216
+	{0, 0x04, 0, 5007},      // U+3217
+	{0, 0x04, 0, 5012},      // U+3218
+	{0, 0x04, 0, 5017},      // U+3219
+	{0, 0x04, 0, 5022},      // U+321A
+	{0, 0x04, 0, 5027},      // U+321B
+	{0, 0x04, 0, 5032},      // U+321C
+	{0, 0x04, 0, 5037},      // U+321D
+	{0, 0x04, 0, 5045},      // U+321E
+	{0, 0x04, 0, 5052},      // U+3220
+	{0, 0x04, 0, 5056},      // U+3221
+	{0, 0x04, 0, 5060},      // U+3222
+	{0, 0x04, 0, 5064},      // U+3223
+	{0, 0x04, 0, 5068},      // U+3224
+	{0, 0x04, 0, 5072},      // U+3225
+	{0, 0x04, 0, 5076},      // U+3226
+	{0, 0x04, 0, 5080},      // U+3227
+	{0, 0x04, 0, 5084},      // U+3228
+	{0, 0x04, 0, 5088},      // U+3229
+	{0, 0x04, 0, 5092},      // U+322A
+	{0, 0x04, 0, 5096},      // U+322B
+	{0, 0x04, 0, 5100},      // U+322C
+	{0, 0x04, 0, 5104},      // U+322D
+	{0, 0x04, 0, 5108},      // U+322E
+	{0, 0x04, 0, 5112},      // U+322F
+	{0, 0x04, 0, 5116},      // U+3230
+	{0, 0x04, 0, 5120},      // U+3231
+	{0, 0x04, 0, 5124},      // U+3232
+	{0, 0x04, 0, 5128},      // U+3233
+	{0, 0x04, 0, 5132},      // U+3234
+	{0, 0x04, 0, 5136},      // U+3235
+	{0, 0x04, 0, 5140},      // U+3236
+	{0, 0x04, 0, 5144},      // U+3237
+	{0, 0x04, 0, 5148},      // U+3238
+	{0, 0x04, 0, 5152},      // U+3239
+	{0, 0x04, 0, 5156},      // U+323A
+	{0, 0x04, 0, 5160},      // U+323B
+	{0, 0x04, 0, 5164},      // U+323C
+	{0, 0x04, 0, 5168},      // U+323D
+	{0, 0x04, 0, 5172},      // U+323E
+	{0, 0x04, 0, 5176},      // U+323F
+	{0, 0x04, 0, 5180},      // U+3240
+	{0, 0x04, 0, 5184},      // U+3241
+	{0, 0x04, 0, 5188},      // U+3242
+	{0, 0x04, 0, 5192},      // U+3243
+	{0, 0x04, 0, 5196},      // U+3244
+	{0, 0x04, 0, 5198},      // U+3245
+	{0, 0x04, 0, 4210},      // U+3246
+	{0, 0x04, 0, 5200},      // U+3247
+	{0, 0x04, 0, 5202},      // U+3250
+	{0, 0x04, 0, 5206},      // U+3251
+	{0, 0x04, 0, 5209},      // U+3252
+	{0, 0x04, 0, 5212},      // U+3253
+	{0, 0x04, 0, 5215},      // U+3254
+	{0, 0x04, 0, 5218},      // U+3255
+	{0, 0x04, 0, 5221},      // U+3256
+	{0, 0x04, 0, 5224},      // U+3257
+	{0, 0x04, 0, 5227},      // U+3258
+	{0, 0x04, 0, 5230},      // U+3259
+	{0, 0x04, 0, 5233},      // U+325A
+	{0, 0x04, 0, 5236},      // U+325B
+	{0, 0x04, 0, 5239},      // U+325C
+	{0, 0x04, 0, 5242},      // U+325D
+	{0, 0x04, 0, 5245},      // U+325E
+	{0, 0x04, 0, 5248},      // U+325F
+	{0, 0x04, 0, 4698},      // U+3260
+	{0, 0x04, 0, 4704},      // U+3261
+	{0, 0x04, 0, 4710},      // U+3262
+	{0, 0x04, 0, 4714},      // U+3263
+	{0, 0x04, 0, 4730},      // U+3264
+	{0, 0x04, 0, 4732},      // U+3265
+	{0, 0x04, 0, 4738},      // U+3266
+	{0, 0x04, 0, 4742},      // U+3267
+	{0, 0x04, 0, 4744},      // U+3268
+	{0, 0x04, 0, 4748},      // U+3269
+	{0, 0x04, 0, 4750},      // U+326A
+	{0, 0x04, 0, 4752},      // U+326B
+	{0, 0x04, 0, 4754},      // U+326C
+	{0, 0x04, 0, 4756},      // U+326D
+	{0, 0x04, 0, 5251},      // U+326E
+	{0, 0x04, 0, 5254},      // U+326F
+	{0, 0x04, 0, 5257},      // U+3270
+	{0, 0x04, 0, 5260},      // U+3271
+	{0, 0x04, 0, 5263},      // U+3272
+	{0, 0x04, 0, 5266},      // U+3273
+	{0, 0x04, 0, 5269},      // U+3274
+	{0, 0x04, 0, 5272},      // U+3275
+	{0, 0x04, 0, 5275},      // U+3276
+	{0, 0x04, 0, 5278},      // U+3277
+	{0, 0x04, 0, 5281},      // U+3278
+	{0, 0x04, 0, 5284},      // U+3279
+	{0, 0x04, 0, 5287},      // U+327A
+	{0, 0x04, 0, 5290},      // U+327B
+	{0, 0x04, 0, 5293},      // U+327C
+	{0, 0x04, 0, 5299},      // U+327D
+	{0, 0x04, 0, 5304},      // U+327E
+	{0, 0x04, 0, 4078},      // U+3280
+	{0, 0x04, 0, 4090},      // U+3281
+	{0, 0x04, 0, 4886},      // U+3282
+	{0, 0x04, 0, 4888},      // U+3283
+	{0, 0x04, 0, 5307},      // U+3284
+	{0, 0x04, 0, 5309},      // U+3285
+	{0, 0x04, 0, 5311},      // U+3286
+	{0, 0x04, 0, 4100},      // U+3287
+	{0, 0x04, 0, 5313},      // U+3288
+	{0, 0x04, 0, 4124},      // U+3289
+	{0, 0x04, 0, 4224},      // U+328A
+	{0, 0x04, 0, 4248},      // U+328B
+	{0, 0x04, 0, 4246},      // U+328C
+	{0, 0x04, 0, 4226},      // U+328D
+	{0, 0x04, 0, 4410},      // U+328E
+	{0, 0x04, 0, 4140},      // U+328F
+	{0, 0x04, 0, 4220},      // U+3290
+	{0, 0x04, 0, 5315},      // U+3291
+	{0, 0x04, 0, 5317},      // U+3292
+	{0, 0x04, 0, 5319},      // U+3293
+	{0, 0x04, 0, 5321},      // U+3294
+	{0, 0x04, 0, 5323},      // U+3295
+	{0, 0x04, 0, 5325},      // U+3296
+	{0, 0x04, 0, 5327},      // U+3297
+	{0, 0x04, 0, 5329},      // U+3298
+	{0, 0x04, 0, 5331},      // U+3299
+	{0, 0x04, 0, 5333},      // U+329A
+	{0, 0x04, 0, 4152},      // U+329B
+	{0, 0x04, 0, 5335},      // U+329C
+	{0, 0x04, 0, 5337},      // U+329D
+	{0, 0x04, 0, 5339},      // U+329E
+	{0, 0x04, 0, 5341},      // U+329F
+	{0, 0x04, 0, 5343},      // U+32A0
+	{0, 0x04, 0, 5345},      // U+32A1
+	{0, 0x04, 0, 5347},      // U+32A2
+	{0, 0x04, 0, 5349},      // U+32A3
+	{0, 0x04, 0, 4890},      // U+32A4
+	{0, 0x04, 0, 4892},      // U+32A5
+	{0, 0x04, 0, 4894},      // U+32A6
+	{0, 0x04, 0, 5351},      // U+32A7
+	{0, 0x04, 0, 5353},      // U+32A8
+	{0, 0x04, 0, 5355},      // U+32A9
+	{0, 0x04, 0, 5357},      // U+32AA
+	{0, 0x04, 0, 5359},      // U+32AB
+	{0, 0x04, 0, 5361},      // U+32AC
+	{0, 0x04, 0, 5363},      // U+32AD
+	{0, 0x04, 0, 5365},      // U+32AE
+	{0, 0x04, 0, 5367},      // U+32AF
+	{0, 0x04, 0, 5369},      // U+32B0
+	{0, 0x04, 0, 5371},      // U+32B1
+	{0, 0x04, 0, 5374},      // U+32B2
+	{0, 0x04, 0, 5377},      // U+32B3
+	{0, 0x04, 0, 5380},      // U+32B4
+	{0, 0x04, 0, 5383},      // U+32B5
+	{0, 0x04, 0, 5386},      // U+32B6
+	{0, 0x04, 0, 5389},      // U+32B7
+	{0, 0x04, 0, 5392},      // U+32B8
+	{0, 0x04, 0, 5395},      // U+32B9
+	{0, 0x04, 0, 5398},      // U+32BA
+	{0, 0x04, 0, 5401},      // U+32BB
+	{0, 0x04, 0, 5404},      // U+32BC
+	{0, 0x04, 0, 5407},      // U+32BD
+	{0, 0x04, 0, 5410},      // U+32BE
+	{0, 0x04, 0, 5413},      // U+32BF
+	{0, 0x04, 0, 5416},      // U+32C0
+	{0, 0x04, 0, 5419},      // U+32C1
+	{0, 0x04, 0, 5422},      // U+32C2
+	{0, 0x04, 0, 5425},      // U+32C3
+	{0, 0x04, 0, 5428},      // U+32C4
+	{0, 0x04, 0, 5431},      // U+32C5
+	{0, 0x04, 0, 5434},      // U+32C6
+	{0, 0x04, 0, 5437},      // U+32C7
+	{0, 0x04, 0, 5440},      // U+32C8
+	{0, 0x04, 0, 5443},      // U+32C9
+	{0, 0x04, 0, 5447},      // U+32CA
+	{0, 0x04, 0, 5451},      // U+32CB
+	{0, 0x04, 0, 5455},      // U+32CC
+	{0, 0x04, 0, 5458},      // U+32CD
+	{0, 0x04, 0, 5462},      // U+32CE
+	{0, 0x04, 0, 5465},      // U+32CF
+	{0, 0x04, 0, 5469},      // U+32D0
+	{0, 0x04, 0, 5471},      // U+32D1
+	{0, 0x04, 0, 5473},      // U+32D2
+	{0, 0x04, 0, 5475},      // U+32D3
+	{0, 0x04, 0, 5477},      // U+32D4
+	{0, 0x04, 0, 5479},      // U+32D5
+	{0, 0x04, 0, 5481},      // U+32D6
+	{0, 0x04, 0, 5483},      // U+32D7
+	{0, 0x04, 0, 5485},      // U+32D8
+	{0, 0x04, 0, 5487},      // U+32D9
+	{0, 0x04, 0, 5489},      // U+32DA
+	{0, 0x04, 0, 5491},      // U+32DB
+	{0, 0x04, 0, 5493},      // U+32DC
+	{0, 0x04, 0, 5495},      // U+32DD
+	{0, 0x04, 0, 5497},      // U+32DE
+	{0, 0x04, 0, 5499},      // U+32DF
+	{0, 0x04, 0, 5501},      // U+32E0
+	{0, 0x04, 0, 5503},      // U+32E1
+	{0, 0x04, 0, 5505},      // U+32E2
+	{0, 0x04, 0, 5507},      // U+32E3
+	{0, 0x04, 0, 5509},      // U+32E4
+	{0, 0x04, 0, 5511},      // U+32E5
+	{0, 0x04, 0, 5513},      // U+32E6
+	{0, 0x04, 0, 5515},      // U+32E7
+	{0, 0x04, 0, 5517},      // U+32E8
+	{0, 0x04, 0, 5519},      // U+32E9
+	{0, 0x04, 0, 5521},      // U+32EA
+	{0, 0x04, 0, 5523},      // U+32EB
+	{0, 0x04, 0, 5525},      // U+32EC
+	{0, 0x04, 0, 5527},      // U+32ED
+	{0, 0x04, 0, 5529},      // U+32EE
+	{0, 0x04, 0, 5531},      // U+32EF
+	{0, 0x04, 0, 5533},      // U+32F0
+	{0, 0x04, 0, 5535},      // U+32F1
+	{0, 0x04, 0, 5537},      // U+32F2
+	{0, 0x04, 0, 5539},      // U+32F3
+	{0, 0x04, 0, 5541},      // U+32F4
+	{0, 0x04, 0, 5543},      // U+32F5
+	{0, 0x04, 0, 5545},      // U+32F6
+	{0, 0x04, 0, 5547},      // U+32F7
+	{0, 0x04, 0, 5549},      // U+32F8
+	{0, 0x04, 0, 5551},      // U+32F9
+	{0, 0x04, 0, 5553},      // U+32FA
+	{0, 0x04, 0, 5555},      // U+32FB
+	{0, 0x04, 0, 5557},      // U+32FC
+	{0, 0x04, 0, 5559},      // U+32FD
+	{0, 0x04, 0, 5561},      // U+32FE
+	{0, 0x04, 0, 5563},      // U+32FF
+	{0, 0x04, 0, 5566},      // U+3300
+	{0, 0x04, 0, 5572},      // U+3301
+	{0, 0x04, 0, 5577},      // U+3302
+	{0, 0x04, 0, 5583},      // U+3303
+	{0, 0x04, 0, 5587},      // U+3304
+	{0, 0x04, 0, 5593},      // U+3305
+	{0, 0x04, 0, 5597},      // U+3306
+	{0, 0x04, 0, 5601},      // U+3307
+	{0, 0x04, 0, 5608},      // U+3308
+	{0, 0x04, 0, 5613},      // U+3309
+	{0, 0x04, 0, 5617},      // U+330A
+	{0, 0x04, 0, 5621},      // U+330B
+	{0, 0x04, 0, 5625},      // U+330C
+	{0, 0x04, 0, 5630},      // U+330D
+	{0, 0x04, 0, 5635},      // U+330E
+	{0, 0x04, 0, 5640},      // U+330F
+	{0, 0x04, 0, 5645},      // U+3310
+	{0, 0x04, 0, 5650},      // U+3311
+	{0, 0x04, 0, 5655},      // U+3312
+	{0, 0x04, 0, 5660},      // U+3313
+	{0, 0x04, 0, 5667},      // U+3314
+	{0, 0x04, 0, 5670},      // U+3315
+	{0, 0x04, 0, 5677},      // U+3316
+	{0, 0x04, 0, 5684},      // U+3317
+	{0, 0x04, 0, 5690},      // U+3318
+	{0, 0x04, 0, 5695},      // U+3319
+	{0, 0x04, 0, 5702},      // U+331A
+	{0, 0x04, 0, 5709},      // U+331B
+	{0, 0x04, 0, 5714},      // U+331C
+	{0, 0x04, 0, 5718},      // U+331D
+	{0, 0x04, 0, 5722},      // U+331E
+	{0, 0x04, 0, 5727},      // U+331F
+	{0, 0x04, 0, 5732},      // U+3320
+	{0, 0x04, 0, 5738},      // U+3321
+	{0, 0x04, 0, 5744},      // U+3322
+	{0, 0x04, 0, 5748},      // U+3323
+	{0, 0x04, 0, 5752},      // U+3324
+	{0, 0x04, 0, 5757},      // U+3325
+	{0, 0x04, 0, 5761},      // U+3326
+	{0, 0x04, 0, 5765},      // U+3327
+	{0, 0x04, 0, 5768},      // U+3328
+	{0, 0x04, 0, 5771},      // U+3329
+	{0, 0x04, 0, 5775},      // U+332A
+	{0, 0x04, 0, 5779},      // U+332B
+	{0, 0x04, 0, 5786},      // U+332C
+	{0, 0x04, 0, 5791},      // U+332D
+	{0, 0x04, 0, 5797},      // U+332E
+	{0, 0x04, 0, 5804},      // U+332F
+	{0, 0x04, 0, 5809},      // U+3330
+	{0, 0x04, 0, 5813},      // U+3331
+	{0, 0x04, 0, 5817},      // U+3332
+	{0, 0x04, 0, 5824},      // U+3333
+	{0, 0x04, 0, 5829},      // U+3334
+	{0, 0x04, 0, 5836},      // U+3335
+	{0, 0x04, 0, 5840},      // U+3336
+	{0, 0x04, 0, 5846},      // U+3337
+	{0, 0x04, 0, 5850},      // U+3338
+	{0, 0x04, 0, 5855},      // U+3339
+	{0, 0x04, 0, 5859},      // U+333A
+	{0, 0x04, 0, 5864},      // U+333B
+	{0, 0x04, 0, 5870},      // U+333C
+	{0, 0x04, 0, 5875},      // U+333D
+	{0, 0x04, 0, 5881},      // U+333E
+	{0, 0x04, 0, 5886},      // U+333F
+	{0, 0x04, 0, 5889},      // U+3340
+	{0, 0x04, 0, 5895},      // U+3341
+	{0, 0x04, 0, 5899},      // U+3342
+	{0, 0x04, 0, 5903},      // U+3343
+	{0, 0x04, 0, 5908},      // U+3344
+	{0, 0x04, 0, 5912},      // U+3345
+	{0, 0x04, 0, 5916},      // U+3346
+	{0, 0x04, 0, 5920},      // U+3347
+	{0, 0x04, 0, 5926},      // U+3348
+	{0, 0x04, 0, 5931},      // U+3349
+	{0, 0x04, 0, 5934},      // U+334A
+	{0, 0x04, 0, 5941},      // U+334B
+	{0, 0x04, 0, 5945},      // U+334C
+	{0, 0x04, 0, 5951},      // U+334D
+	{0, 0x04, 0, 5956},      // U+334E
+	{0, 0x04, 0, 5961},      // U+334F
+	{0, 0x04, 0, 5965},      // U+3350
+	{0, 0x04, 0, 5969},      // U+3351
+	{0, 0x04, 0, 5974},      // U+3352
+	{0, 0x04, 0, 5977},      // U+3353
+	{0, 0x04, 0, 5982},      // U+3354
+	{0, 0x04, 0, 5988},      // U+3355
+	{0, 0x04, 0, 5991},      // U+3356
+	{0, 0x04, 0, 5998},      // U+3357
+	{0, 0x04, 0, 6002},      // U+3358
+	{0, 0x04, 0, 6005},      // U+3359
+	{0, 0x04, 0, 6008},      // U+335A
+	{0, 0x04, 0, 6011},      // U+335B
+	{0, 0x04, 0, 6014},      // U+335C
+	{0, 0x04, 0, 6017},      // U+335D
+	{0, 0x04, 0, 6020},      // U+335E
+	{0, 0x04, 0, 6023},      // U+335F
+	{0, 0x04, 0, 6026},      // U+3360
+	{0, 0x04, 0, 6029},      // U+3361
+	{0, 0x04, 0, 6032},      // U+3362
+	{0, 0x04, 0, 6036},      // U+3363
+	{0, 0x04, 0, 6040},      // U+3364
+	{0, 0x04, 0, 6044},      // U+3365
+	{0, 0x04, 0, 6048},      // U+3366
+	{0, 0x04, 0, 6052},      // U+3367
+	{0, 0x04, 0, 6056},      // U+3368
+	{0, 0x04, 0, 6060},      // U+3369
+	{0, 0x04, 0, 6064},      // U+336A
+	{0, 0x04, 0, 6068},      // U+336B
+	{0, 0x04, 0, 6072},      // U+336C
+	{0, 0x04, 0, 6076},      // U+336D
+	{0, 0x04, 0, 6080},      // U+336E
+	{0, 0x04, 0, 6084},      // U+336F
+	{0, 0x04, 0, 6088},      // U+3370
+	{0, 0x04, 0, 6092},      // U+3371
+	{0, 0x04, 0, 6096},      // U+3372
+	{0, 0x04, 0, 6099},      // U+3373
+	{0, 0x04, 0, 6102},      // U+3374
+	{0, 0x04, 0, 6106},      // U+3375
+	{0, 0x04, 0, 6109},      // U+3376
+	{0, 0x04, 0, 6112},      // U+3377
+	{0, 0x04, 0, 6115},      // U+3378
+	{0, 0x04, 0, 6119},      // U+3379
+	{0, 0x04, 0, 6123},      // U+337A
+	{0, 0x04, 0, 6126},      // U+337B
+	{0, 0x04, 0, 6129},      // U+337C
+	{0, 0x04, 0, 6132},      // U+337D
+	{0, 0x04, 0, 6135},      // U+337E
+	{0, 0x04, 0, 6138},      // U+337F
+	{0, 0x04, 0, 6143},      // U+3380
+	{0, 0x04, 0, 6146},      // U+3381
+	{0, 0x04, 0, 6149},      // U+3382
+	{0, 0x04, 0, 6152},      // U+3383
+	{0, 0x04, 0, 6155},      // U+3384
+	{0, 0x04, 0, 6158},      // U+3385
+	{0, 0x04, 0, 6161},      // U+3386
+	{0, 0x04, 0, 6164},      // U+3387
+	{0, 0x04, 0, 6167},      // U+3388
+	{0, 0x04, 0, 6171},      // U+3389
+	{0, 0x04, 0, 6176},      // U+338A
+	{0, 0x04, 0, 6179},      // U+338B
+	{0, 0x04, 0, 6182},      // U+338C
+	{0, 0x04, 0, 6185},      // U+338D
+	{0, 0x04, 0, 6188},      // U+338E
+	{0, 0x04, 0, 6191},      // U+338F
+	{0, 0x04, 0, 6194},      // U+3390
+	{0, 0x04, 0, 6197},      // U+3391
+	{0, 0x04, 0, 6201},      // U+3392
+	{0, 0x04, 0, 6205},      // U+3393
+	{0, 0x04, 0, 6209},      // U+3394
+	{0, 0x04, 0, 6213},      // U+3395
+	{0, 0x04, 0, 6216},      // U+3396
+	{0, 0x04, 0, 6219},      // U+3397
+	{0, 0x04, 0, 6222},      // U+3398
+	{0, 0x04, 0, 6225},      // U+3399
+	{0, 0x04, 0, 6228},      // U+339A
+	{0, 0x04, 0, 6231},      // U+339B
+	{0, 0x04, 0, 6234},      // U+339C
+	{0, 0x04, 0, 6237},      // U+339D
+	{0, 0x04, 0, 6240},      // U+339E
+	{0, 0x04, 0, 6243},      // U+339F
+	{0, 0x04, 0, 6247},      // U+33A0
+	{0, 0x04, 0, 6251},      // U+33A1
+	{0, 0x04, 0, 6254},      // U+33A2
+	{0, 0x04, 0, 6258},      // U+33A3
+	{0, 0x04, 0, 6262},      // U+33A4
+	{0, 0x04, 0, 6266},      // U+33A5
+	{0, 0x04, 0, 6269},      // U+33A6
+	{0, 0x04, 0, 6273},      // U+33A7
+	{0, 0x04, 0, 6277},      // U+33A8
+	{0, 0x04, 0, 6282},      // U+33A9
+	{0, 0x04, 0, 6285},      // U+33AA
+	{0, 0x04, 0, 6289},      // U+33AB
+	{0, 0x04, 0, 6293},      // U+33AC
+	{0, 0x04, 0, 6297},      // U+33AD
+	{0, 0x04, 0, 6301},      // U+33AE
+	{0, 0x04, 0, 6307},      // U+33AF
+	{0, 0x04, 0, 6314},      // U+33B0
+	{0, 0x04, 0, 6317},      // U+33B1
+	{0, 0x04, 0, 6320},      // U+33B2
+	{0, 0x04, 0, 6323},      // U+33B3
+	{0, 0x04, 0, 6326},      // U+33B4
+	{0, 0x04, 0, 6329},      // U+33B5
+	{0, 0x04, 0, 6332},      // U+33B6
+	{0, 0x04, 0, 6335},      // U+33B7
+	{0, 0x04, 0, 6338},      // U+33B8
+	{0, 0x04, 0, 6341},      // U+33B9
+	{0, 0x04, 0, 6344},      // U+33BA
+	{0, 0x04, 0, 6347},      // U+33BB
+	{0, 0x04, 0, 6350},      // U+33BC
+	{0, 0x04, 0, 6353},      // U+33BD
+	{0, 0x04, 0, 6356},      // U+33BE
+	{0, 0x04, 0, 6359},      // U+33BF
+	{0, 0x04, 0, 6362},      // U+33C0
+	{0, 0x04, 0, 6365},      // U+33C1
+	{0, 0x04, 0, 6368},      // U+33C2
+	{0, 0x04, 0, 6373},      // U+33C3
+	{0, 0x04, 0, 6376},      // U+33C4
+	{0, 0x04, 0, 6379},      // U+33C5
+	{0, 0x04, 0, 6382},      // U+33C6
+	{0, 0x04, 0, 6387},      // U+33C7
+	{0, 0x04, 0, 6391},      // U+33C8
+	{0, 0x04, 0, 6394},      // U+33C9
+	{0, 0x04, 0, 6397},      // U+33CA
+	{0, 0x04, 0, 6400},      // U+33CB
+	{0, 0x04, 0, 6403},      // U+33CC
+	{0, 0x04, 0, 6406},      // U+33CD
+	{0, 0x04, 0, 6409},      // U+33CE
+	{0, 0x04, 0, 6412},      // U+33CF
+	{0, 0x04, 0, 6415},      // U+33D0
+	{0, 0x04, 0, 6418},      // U+33D1
+	{0, 0x04, 0, 6421},      // U+33D2
+	{0, 0x04, 0, 6425},      // U+33D3
+	{0, 0x04, 0, 6428},      // U+33D4
+	{0, 0x04, 0, 6431},      // U+33D5
+	{0, 0x04, 0, 6435},      // U+33D6
+	{0, 0x04, 0, 6439},      // U+33D7
+	{0, 0x04, 0, 6442},      // U+33D8
+	{0, 0x04, 0, 6447},      // U+33D9
+	{0, 0x04, 0, 6451},      // U+33DA
+	{0, 0x04, 0, 6454},      // U+33DB
+	{0, 0x04, 0, 6457},      // U+33DC
+	{0, 0x04, 0, 6460},      // U+33DD
+	{0, 0x04, 0, 6463},      // U+33DE
+	{0, 0x04, 0, 6467},      // U+33DF
+	{0, 0x04, 0, 6471},      // U+33E0
+	{0, 0x04, 0, 6474},      // U+33E1
+	{0, 0x04, 0, 6477},      // U+33E2
+	{0, 0x04, 0, 6480},      // U+33E3
+	{0, 0x04, 0, 6483},      // U+33E4
+	{0, 0x04, 0, 6486},      // U+33E5
+	{0, 0x04, 0, 6489},      // U+33E6
+	{0, 0x04, 0, 6492},      // U+33E7
+	{0, 0x04, 0, 6495},      // U+33E8
+	{0, 0x04, 0, 6498},      // U+33E9
+	{0, 0x04, 0, 6502},      // U+33EA
+	{0, 0x04, 0, 6506},      // U+33EB
+	{0, 0x04, 0, 6510},      // U+33EC
+	{0, 0x04, 0, 6514},      // U+33ED
+	{0, 0x04, 0, 6518},      // U+33EE
+	{0, 0x04, 0, 6522},      // U+33EF
+	{0, 0x04, 0, 6526},      // U+33F0
+	{0, 0x04, 0, 6530},      // U+33F1
+	{0, 0x04, 0, 6534},      // U+33F2
+	{0, 0x04, 0, 6538},      // U+33F3
+	{0, 0x04, 0, 6542},      // U+33F4
+	{0, 0x04, 0, 6546},      // U+33F5
+	{0, 0x04, 0, 6550},      // U+33F6
+	{0, 0x04, 0, 6554},      // U+33F7
+	{0, 0x04, 0, 6558},      // U+33F8
+	{0, 0x04, 0, 6562},      // U+33F9
+	{0, 0x04, 0, 6566},      // U+33FA
+	{0, 0x04, 0, 6570},      // U+33FB
+	{0, 0x04, 0, 6574},      // U+33FC
+	{0, 0x04, 0, 6578},      // U+33FD
+	{0, 0x04, 0, 6582},      // U+33FE
+	{0, 0x04, 0, 6586},      // U+33FF
+	{230, 0x00, 0, 0},       // U+A66F
+	{230, 0x00, 0, 0},       // U+A674
+	{230, 0x00, 0, 0},       // U+A675
+	{230, 0x00, 0, 0},       // U+A676
+	{230, 0x00, 0, 0},       // U+A677
+	{230, 0x00, 0, 0},       // U+A678
+	{230, 0x00, 0, 0},       // U+A679
+	{230, 0x00, 0, 0},       // U+A67A
+	{230, 0x00, 0, 0},       // U+A67B
+	{230, 0x00, 0, 0},       // U+A67C
+	{230, 0x00, 0, 0},       // U+A67D
+	{0, 0x04, 0, 6590},      // U+A69C
+	{0, 0x04, 0, 6592},      // U+A69D
+	{230, 0x00, 0, 0},       // U+A69E
+	{230, 0x00, 0, 0},       // U+A69F
+	{230, 0x00, 0, 0},       // U+A6F0
+	{230, 0x00, 0, 0},       // U+A6F1
+	{0, 0x04, 0, 6594},      // U+A770
+	{0, 0x04, 0, 3403},      // U+A7F2
+	{0, 0x04, 0, 3442},      // U+A7F3
+	{0, 0x04, 0, 3426},      // U+A7F4
+	{0, 0x04, 0, 6596},      // U+A7F8
+	{0, 0x04, 0, 6598},      // U+A7F9
+	{9, 0x00, 0, 0},         // U+A806
+	{9, 0x00, 0, 0},         // U+A82C
+	{9, 0x00, 0, 0},         // U+A8C4
+	{230, 0x00, 0, 0},       // U+A8E0
+	{230, 0x00, 0, 0},       // U+A8E1
+	{230, 0x00, 0, 0},       // U+A8E2
+	{230, 0x00, 0, 0},       // U+A8E3
+	{230, 0x00, 0, 0},       // U+A8E4
+	{230, 0x00, 0, 0},       // U+A8E5
+	{230, 0x00, 0, 0},       // U+A8E6
+	{230, 0x00, 0, 0},       // U+A8E7
+	{230, 0x00, 0, 0},       // U+A8E8
+	{230, 0x00, 0, 0},       // U+A8E9
+	{230, 0x00, 0, 0},       // U+A8EA
+	{230, 0x00, 0, 0},       // U+A8EB
+	{230, 0x00, 0, 0},       // U+A8EC
+	{230, 0x00, 0, 0},       // U+A8ED
+	{230, 0x00, 0, 0},       // U+A8EE
+	{230, 0x00, 0, 0},       // U+A8EF
+	{230, 0x00, 0, 0},       // U+A8F0
+	{230, 0x00, 0, 0},       // U+A8F1
+	{220, 0x00, 0, 0},       // U+A92B
+	{220, 0x00, 0, 0},       // U+A92C
+	{220, 0x00, 0, 0},       // U+A92D
+	{9, 0x00, 0, 0},         // U+A953
+	{7, 0x00, 0, 0},         // U+A9B3
+	{9, 0x00, 0, 0},         // U+A9C0
+	{230, 0x00, 0, 0},       // U+AAB0
+	{230, 0x00, 0, 0},       // U+AAB2
+	{230, 0x00, 0, 0},       // U+AAB3
+	{220, 0x00, 0, 0},       // U+AAB4
+	{230, 0x00, 0, 0},       // U+AAB7
+	{230, 0x00, 0, 0},       // U+AAB8
+	{230, 0x00, 0, 0},       // U+AABE
+	{230, 0x00, 0, 0},       // U+AABF
+	{230, 0x00, 0, 0},       // U+AAC1
+	{9, 0x00, 0, 0},         // U+AAF6
+	{0, 0x04, 0, 6600},      // U+AB5C
+	{0, 0x04, 0, 6602},      // U+AB5D
+	{0, 0x04, 0, 6604},      // U+AB5E
+	{0, 0x04, 0, 6606},      // U+AB5F
+	{0, 0x04, 0, 6608},      // U+AB69
+	{9, 0x00, 0, 0},         // U+ABED
+	{0, 0x05, 6610, 6610},   // U+F900
+	{0, 0x05, 6612, 6612},   // U+F901
+	{0, 0x05, 4394, 4394},   // U+F902
+	{0, 0x05, 6614, 6614},   // U+F903
+	{0, 0x05, 6616, 6616},   // U+F904
+	{0, 0x05, 6618, 6618},   // U+F905
+	{0, 0x05, 6620, 6620},   // U+F906
+	{0, 0x05, 4502, 4502},   // U+F907
+	{0, 0x05, 4502, 4502},   // U+F908
+	{0, 0x05, 6622, 6622},   // U+F909
+	{0, 0x05, 4410, 4410},   // U+F90A
+	{0, 0x05, 6624, 6624},   // U+F90B
+	{0, 0x05, 6626, 6626},   // U+F90C
+	{0, 0x05, 6628, 6628},   // U+F90D
+	{0, 0x05, 6630, 6630},   // U+F90E
+	{0, 0x05, 6632, 6632},   // U+F90F
+	{0, 0x05, 6634, 6634},   // U+F910
+	{0, 0x05, 6636, 6636},   // U+F911
+	{0, 0x05, 6638, 6638},   // U+F912
+	{0, 0x05, 6640, 6640},   // U+F913
+	{0, 0x05, 6642, 6642},   // U+F914
+	{0, 0x05, 6644, 6644},   // U+F915
+	{0, 0x05, 6646, 6646},   // U+F916
+	{0, 0x05, 6648, 6648},   // U+F917
+	{0, 0x05, 6650, 6650},   // U+F918
+	{0, 0x05, 6652, 6652},   // U+F919
+	{0, 0x05, 6654, 6654},   // U+F91A
+	{0, 0x05, 6656, 6656},   // U+F91B
+	{0, 0x05, 6658, 6658},   // U+F91C
+	{0, 0x05, 6660, 6660},   // U+F91D
+	{0, 0x05, 6662, 6662},   // U+F91E
+	{0, 0x05, 6664, 6664},   // U+F91F
+	{0, 0x05, 6666, 6666},   // U+F920
+	{0, 0x05, 6668, 6668},   // U+F921
+	{0, 0x05, 6670, 6670},   // U+F922
+	{0, 0x05, 6672, 6672},   // U+F923
+	{0, 0x05, 6674, 6674},   // U+F924
+	{0, 0x05, 6676, 6676},   // U+F925
+	{0, 0x05, 6678, 6678},   // U+F926
+	{0, 0x05, 6680, 6680},   // U+F927
+	{0, 0x05, 6682, 6682},   // U+F928
+	{0, 0x05, 6684, 6684},   // U+F929
+	{0, 0x05, 6686, 6686},   // U+F92A
+	{0, 0x05, 6688, 6688},   // U+F92B
+	{0, 0x05, 6690, 6690},   // U+F92C
+	{0, 0x05, 6692, 6692},   // U+F92D
+	{0, 0x05, 6694, 6694},   // U+F92E
+	{0, 0x05, 6696, 6696},   // U+F92F
+	{0, 0x05, 6698, 6698},   // U+F930
+	{0, 0x05, 6700, 6700},   // U+F931
+	{0, 0x05, 6702, 6702},   // U+F932
+	{0, 0x05, 6704, 6704},   // U+F933
+	{0, 0x05, 4326, 4326},   // U+F934
+	{0, 0x05, 6706, 6706},   // U+F935
+	{0, 0x05, 6708, 6708},   // U+F936
+	{0, 0x05, 6710, 6710},   // U+F937
+	{0, 0x05, 6712, 6712},   // U+F938
+	{0, 0x05, 6714, 6714},   // U+F939
+	{0, 0x05, 6716, 6716},   // U+F93A
+	{0, 0x05, 6718, 6718},   // U+F93B
+	{0, 0x05, 6720, 6720},   // U+F93C
+	{0, 0x05, 6722, 6722},   // U+F93D
+	{0, 0x05, 6724, 6724},   // U+F93E
+	{0, 0x05, 6726, 6726},   // U+F93F
+	{0, 0x05, 4472, 4472},   // U+F940
+	{0, 0x05, 6728, 6728},   // U+F941
+	{0, 0x05, 6730, 6730},   // U+F942
+	{0, 0x05, 6732, 6732},   // U+F943
+	{0, 0x05, 6734, 6734},   // U+F944
+	{0, 0x05, 6736, 6736},   // U+F945
+	{0, 0x05, 6738, 6738},   // U+F946
+	{0, 0x05, 6740, 6740},   // U+F947
+	{0, 0x05, 6742, 6742},   // U+F948
+	{0, 0x05, 6744, 6744},   // U+F949
+	{0, 0x05, 6746, 6746},   // U+F94A
+	{0, 0x05, 6748, 6748},   // U+F94B
+	{0, 0x05, 6750, 6750},   // U+F94C
+	{0, 0x05, 6752, 6752},   // U+F94D
+	{0, 0x05, 6754, 6754},   // U+F94E
+	{0, 0x05, 6756, 6756},   // U+F94F
+	{0, 0x05, 6758, 6758},   // U+F950
+	{0, 0x05, 6760, 6760},   // U+F951
+	{0, 0x05, 6762, 6762},   // U+F952
+	{0, 0x05, 6764, 6764},   // U+F953
+	{0, 0x05, 6766, 6766},   // U+F954
+	{0, 0x05, 6768, 6768},   // U+F955
+	{0, 0x05, 6770, 6770},   // U+F956
+	{0, 0x05, 6772, 6772},   // U+F957
+	{0, 0x05, 6774, 6774},   // U+F958
+	{0, 0x05, 6776, 6776},   // U+F959
+	{0, 0x05, 6778, 6778},   // U+F95A
+	{0, 0x05, 6780, 6780},   // U+F95B
+	{0, 0x05, 6642, 6642},   // U+F95C
+	{0, 0x05, 6782, 6782},   // U+F95D
+	{0, 0x05, 6784, 6784},   // U+F95E
+	{0, 0x05, 6786, 6786},   // U+F95F
+	{0, 0x05, 6788, 6788},   // U+F960
+	{0, 0x05, 6790, 6790},   // U+F961
+	{0, 0x05, 6792, 6792},   // U+F962
+	{0, 0x05, 6794, 6794},   // U+F963
+	{0, 0x05, 6796, 6796},   // U+F964
+	{0, 0x05, 6798, 6798},   // U+F965
+	{0, 0x05, 6800, 6800},   // U+F966
+	{0, 0x05, 6802, 6802},   // U+F967
+	{0, 0x05, 6804, 6804},   // U+F968
+	{0, 0x05, 6806, 6806},   // U+F969
+	{0, 0x05, 6808, 6808},   // U+F96A
+	{0, 0x05, 6810, 6810},   // U+F96B
+	{0, 0x05, 6812, 6812},   // U+F96C
+	{0, 0x05, 6814, 6814},   // U+F96D
+	{0, 0x05, 6816, 6816},   // U+F96E
+	{0, 0x05, 6818, 6818},   // U+F96F
+	{0, 0x05, 6820, 6820},   // U+F970
+	{0, 0x05, 4398, 4398},   // U+F971
+	{0, 0x05, 6822, 6822},   // U+F972
+	{0, 0x05, 6824, 6824},   // U+F973
+	{0, 0x05, 6826, 6826},   // U+F974
+	{0, 0x05, 6828, 6828},   // U+F975
+	{0, 0x05, 6830, 6830},   // U+F976
+	{0, 0x05, 6832, 6832},   // U+F977
+	{0, 0x05, 6834, 6834},   // U+F978
+	{0, 0x05, 6836, 6836},   // U+F979
+	{0, 0x05, 6838, 6838},   // U+F97A
+	{0, 0x05, 6840, 6840},   // U+F97B
+	{0, 0x05, 6842, 6842},   // U+F97C
+	{0, 0x05, 6844, 6844},   // U+F97D
+	{0, 0x05, 6846, 6846},   // U+F97E
+	{0, 0x05, 6848, 6848},   // U+F97F
+	{0, 0x05, 6850, 6850},   // U+F980
+	{0, 0x05, 4152, 4152},   // U+F981
+	{0, 0x05, 6852, 6852},   // U+F982
+	{0, 0x05, 6854, 6854},   // U+F983
+	{0, 0x05, 6856, 6856},   // U+F984
+	{0, 0x05, 6858, 6858},   // U+F985
+	{0, 0x05, 6860, 6860},   // U+F986
+	{0, 0x05, 6862, 6862},   // U+F987
+	{0, 0x05, 6864, 6864},   // U+F988
+	{0, 0x05, 6866, 6866},   // U+F989
+	{0, 0x05, 4114, 4114},   // U+F98A
+	{0, 0x05, 6868, 6868},   // U+F98B
+	{0, 0x05, 6870, 6870},   // U+F98C
+	{0, 0x05, 6872, 6872},   // U+F98D
+	{0, 0x05, 6874, 6874},   // U+F98E
+	{0, 0x05, 6876, 6876},   // U+F98F
+	{0, 0x05, 6878, 6878},   // U+F990
+	{0, 0x05, 6880, 6880},   // U+F991
+	{0, 0x05, 6882, 6882},   // U+F992
+	{0, 0x05, 6884, 6884},   // U+F993
+	{0, 0x05, 6886, 6886},   // U+F994
+	{0, 0x05, 6888, 6888},   // U+F995
+	{0, 0x05, 6890, 6890},   // U+F996
+	{0, 0x05, 6892, 6892},   // U+F997
+	{0, 0x05, 6894, 6894},   // U+F998
+	{0, 0x05, 6896, 6896},   // U+F999
+	{0, 0x05, 6898, 6898},   // U+F99A
+	{0, 0x05, 6900, 6900},   // U+F99B
+	{0, 0x05, 6902, 6902},   // U+F99C
+	{0, 0x05, 6904, 6904},   // U+F99D
+	{0, 0x05, 6906, 6906},   // U+F99E
+	{0, 0x05, 6908, 6908},   // U+F99F
+	{0, 0x05, 6910, 6910},   // U+F9A0
+	{0, 0x05, 6818, 6818},   // U+F9A1
+	{0, 0x05, 6912, 6912},   // U+F9A2
+	{0, 0x05, 6914, 6914},   // U+F9A3
+	{0, 0x05, 6916, 6916},   // U+F9A4
+	{0, 0x05, 6918, 6918},   // U+F9A5
+	{0, 0x05, 6920, 6920},   // U+F9A6
+	{0, 0x05, 6922, 6922},   // U+F9A7
+	{0, 0x05, 6924, 6924},   // U+F9A8
+	{0, 0x05, 6926, 6926},   // U+F9A9
+	{0, 0x05, 6786, 6786},   // U+F9AA
+	{0, 0x05, 6928, 6928},   // U+F9AB
+	{0, 0x05, 6930, 6930},   // U+F9AC
+	{0, 0x05, 6932, 6932},   // U+F9AD
+	{0, 0x05, 6934, 6934},   // U+F9AE
+	{0, 0x05, 6936, 6936},   // U+F9AF
+	{0, 0x05, 6938, 6938},   // U+F9B0
+	{0, 0x05, 6940, 6940},   // U+F9B1
+	{0, 0x05, 6942, 6942},   // U+F9B2
+	{0, 0x05, 6944, 6944},   // U+F9B3
+	{0, 0x05, 6946, 6946},   // U+F9B4
+	{0, 0x05, 6948, 6948},   // U+F9B5
+	{0, 0x05, 6950, 6950},   // U+F9B6
+	{0, 0x05, 6952, 6952},   // U+F9B7
+	{0, 0x05, 6954, 6954},   // U+F9B8
+	{0, 0x05, 6956, 6956},   // U+F9B9
+	{0, 0x05, 6958, 6958},   // U+F9BA
+	{0, 0x05, 6960, 6960},   // U+F9BB
+	{0, 0x05, 6962, 6962},   // U+F9BC
+	{0, 0x05, 6964, 6964},   // U+F9BD
+	{0, 0x05, 6966, 6966},   // U+F9BE
+	{0, 0x05, 6642, 6642},   // U+F9BF
+	{0, 0x05, 6968, 6968},   // U+F9C0
+	{0, 0x05, 6970, 6970},   // U+F9C1
+	{0, 0x05, 6972, 6972},   // U+F9C2
+	{0, 0x05, 6974, 6974},   // U+F9C3
+	{0, 0x05, 4500, 4500},   // U+F9C4
+	{0, 0x05, 6976, 6976},   // U+F9C5
+	{0, 0x05, 6978, 6978},   // U+F9C6
+	{0, 0x05, 6980, 6980},   // U+F9C7
+	{0, 0x05, 6982, 6982},   // U+F9C8
+	{0, 0x05, 6984, 6984},   // U+F9C9
+	{0, 0x05, 6986, 6986},   // U+F9CA
+	{0, 0x05, 6988, 6988},   // U+F9CB
+	{0, 0x05, 6990, 6990},   // U+F9CC
+	{0, 0x05, 6992, 6992},   // U+F9CD
+	{0, 0x05, 6994, 6994},   // U+F9CE
+	{0, 0x05, 6996, 6996},   // U+F9CF
+	{0, 0x05, 6998, 6998},   // U+F9D0
+	{0, 0x05, 5309, 5309},   // U+F9D1
+	{0, 0x05, 7000, 7000},   // U+F9D2
+	{0, 0x05, 7002, 7002},   // U+F9D3
+	{0, 0x05, 7004, 7004},   // U+F9D4
+	{0, 0x05, 7006, 7006},   // U+F9D5
+	{0, 0x05, 7008, 7008},   // U+F9D6
+	{0, 0x05, 7010, 7010},   // U+F9D7
+	{0, 0x05, 7012, 7012},   // U+F9D8
+	{0, 0x05, 7014, 7014},   // U+F9D9
+	{0, 0x05, 7016, 7016},   // U+F9DA
+	{0, 0x05, 6790, 6790},   // U+F9DB
+	{0, 0x05, 7018, 7018},   // U+F9DC
+	{0, 0x05, 7020, 7020},   // U+F9DD
+	{0, 0x05, 7022, 7022},   // U+F9DE
+	{0, 0x05, 7024, 7024},   // U+F9DF
+	{0, 0x05, 7026, 7026},   // U+F9E0
+	{0, 0x05, 7028, 7028},   // U+F9E1
+	{0, 0x05, 7030, 7030},   // U+F9E2
+	{0, 0x05, 7032, 7032},   // U+F9E3
+	{0, 0x05, 7034, 7034},   // U+F9E4
+	{0, 0x05, 7036, 7036},   // U+F9E5
+	{0, 0x05, 7038, 7038},   // U+F9E6
+	{0, 0x05, 7040, 7040},   // U+F9E7
+	{0, 0x05, 7042, 7042},   // U+F9E8
+	{0, 0x05, 4408, 4408},   // U+F9E9
+	{0, 0x05, 7044, 7044},   // U+F9EA
+	{0, 0x05, 7046, 7046},   // U+F9EB
+	{0, 0x05, 7048, 7048},   // U+F9EC
+	{0, 0x05, 7050, 7050},   // U+F9ED
+	{0, 0x05, 7052, 7052},   // U+F9EE
+	{0, 0x05, 7054, 7054},   // U+F9EF
+	{0, 0x05, 7056, 7056},   // U+F9F0
+	{0, 0x05, 7058, 7058},   // U+F9F1
+	{0, 0x05, 7060, 7060},   // U+F9F2
+	{0, 0x05, 7062, 7062},   // U+F9F3
+	{0, 0x05, 7064, 7064},   // U+F9F4
+	{0, 0x05, 7066, 7066},   // U+F9F5
+	{0, 0x05, 7068, 7068},   // U+F9F6
+	{0, 0x05, 4310, 4310},   // U+F9F7
+	{0, 0x05, 7070, 7070},   // U+F9F8
+	{0, 0x05, 7072, 7072},   // U+F9F9
+	{0, 0x05, 7074, 7074},   // U+F9FA
+	{0, 0x05, 7076, 7076},   // U+F9FB
+	{0, 0x05, 7078, 7078},   // U+F9FC
+	{0, 0x05, 7080, 7080},   // U+F9FD
+	{0, 0x05, 7082, 7082},   // U+F9FE
+	{0, 0x05, 7084, 7084},   // U+F9FF
+	{0, 0x05, 7086, 7086},   // U+FA00
+	{0, 0x05, 7088, 7088},   // U+FA01
+	{0, 0x05, 7090, 7090},   // U+FA02
+	{0, 0x05, 7092, 7092},   // U+FA03
+	{0, 0x05, 7094, 7094},   // U+FA04
+	{0, 0x05, 7096, 7096},   // U+FA05
+	{0, 0x05, 7098, 7098},   // U+FA06
+	{0, 0x05, 7100, 7100},   // U+FA07
+	{0, 0x05, 4364, 4364},   // U+FA08
+	{0, 0x05, 7102, 7102},   // U+FA09
+	{0, 0x05, 4370, 4370},   // U+FA0A
+	{0, 0x05, 7104, 7104},   // U+FA0B
+	{0, 0x05, 7106, 7106},   // U+FA0C
+	{0, 0x05, 7108, 7108},   // U+FA0D
+	{0, 0x05, 7110, 7110},   // U+FA10
+	{0, 0x05, 7112, 7112},   // U+FA12
+	{0, 0x05, 7114, 7114},   // U+FA15
+	{0, 0x05, 7116, 7116},   // U+FA16
+	{0, 0x05, 7118, 7118},   // U+FA17
+	{0, 0x05, 7120, 7120},   // U+FA18
+	{0, 0x05, 7122, 7122},   // U+FA19
+	{0, 0x05, 7124, 7124},   // U+FA1A
+	{0, 0x05, 7126, 7126},   // U+FA1B
+	{0, 0x05, 7128, 7128},   // U+FA1C
+	{0, 0x05, 7130, 7130},   // U+FA1D
+	{0, 0x05, 4324, 4324},   // U+FA1E
+	{0, 0x05, 7132, 7132},   // U+FA20
+	{0, 0x05, 7134, 7134},   // U+FA22
+	{0, 0x05, 7136, 7136},   // U+FA25
+	{0, 0x05, 7138, 7138},   // U+FA26
+	{0, 0x05, 7140, 7140},   // U+FA2A
+	{0, 0x05, 7142, 7142},   // U+FA2B
+	{0, 0x05, 7144, 7144},   // U+FA2C
+	{0, 0x05, 7146, 7146},   // U+FA2D
+	{0, 0x05, 7148, 7148},   // U+FA2E
+	{0, 0x05, 7150, 7150},   // U+FA2F
+	{0, 0x05, 7152, 7152},   // U+FA30
+	{0, 0x05, 7154, 7154},   // U+FA31
+	{0, 0x05, 7156, 7156},   // U+FA32
+	{0, 0x05, 7158, 7158},   // U+FA33
+	{0, 0x05, 7160, 7160},   // U+FA34
+	{0, 0x05, 7162, 7162},   // U+FA35
+	{0, 0x05, 7164, 7164},   // U+FA36
+	{0, 0x05, 7166, 7166},   // U+FA37
+	{0, 0x05, 7168, 7168},   // U+FA38
+	{0, 0x05, 7170, 7170},   // U+FA39
+	{0, 0x05, 7172, 7172},   // U+FA3A
+	{0, 0x05, 7174, 7174},   // U+FA3B
+	{0, 0x05, 4166, 4166},   // U+FA3C
+	{0, 0x05, 7176, 7176},   // U+FA3D
+	{0, 0x05, 7178, 7178},   // U+FA3E
+	{0, 0x05, 7180, 7180},   // U+FA3F
+	{0, 0x05, 7182, 7182},   // U+FA40
+	{0, 0x05, 7184, 7184},   // U+FA41
+	{0, 0x05, 7186, 7186},   // U+FA42
+	{0, 0x05, 7188, 7188},   // U+FA43
+	{0, 0x05, 7190, 7190},   // U+FA44
+	{0, 0x05, 7192, 7192},   // U+FA45
+	{0, 0x05, 7194, 7194},   // U+FA46
+	{0, 0x05, 7196, 7196},   // U+FA47
+	{0, 0x05, 7198, 7198},   // U+FA48
+	{0, 0x05, 7200, 7200},   // U+FA49
+	{0, 0x05, 7202, 7202},   // U+FA4A
+	{0, 0x05, 7204, 7204},   // U+FA4B
+	{0, 0x05, 5319, 5319},   // U+FA4C
+	{0, 0x05, 7206, 7206},   // U+FA4D
+	{0, 0x05, 7208, 7208},   // U+FA4E
+	{0, 0x05, 7210, 7210},   // U+FA4F
+	{0, 0x05, 7212, 7212},   // U+FA50
+	{0, 0x05, 5327, 5327},   // U+FA51
+	{0, 0x05, 7214, 7214},   // U+FA52
+	{0, 0x05, 7216, 7216},   // U+FA53
+	{0, 0x05, 7218, 7218},   // U+FA54
+	{0, 0x05, 7220, 7220},   // U+FA55
+	{0, 0x05, 7222, 7222},   // U+FA56
+	{0, 0x05, 6890, 6890},   // U+FA57
+	{0, 0x05, 7224, 7224},   // U+FA58
+	{0, 0x05, 7226, 7226},   // U+FA59
+	{0, 0x05, 7228, 7228},   // U+FA5A
+	{0, 0x05, 7230, 7230},   // U+FA5B
+	{0, 0x05, 7232, 7232},   // U+FA5C
+	{0, 0x05, 7234, 7234},   // U+FA5D
+	{0, 0x05, 7234, 7234},   // U+FA5E
+	{0, 0x05, 7236, 7236},   // U+FA5F
+	{0, 0x05, 7238, 7238},   // U+FA60
+	{0, 0x05, 7240, 7240},   // U+FA61
+	{0, 0x05, 7242, 7242},   // U+FA62
+	{0, 0x05, 7244, 7244},   // U+FA63
+	{0, 0x05, 7246, 7246},   // U+FA64
+	{0, 0x05, 7248, 7248},   // U+FA65
+	{0, 0x05, 7250, 7250},   // U+FA66
+	{0, 0x05, 7136, 7136},   // U+FA67
+	{0, 0x05, 7252, 7252},   // U+FA68
+	{0, 0x05, 7254, 7254},   // U+FA69
+	{0, 0x05, 7256, 7256},   // U+FA6A
+	{0, 0x05, 7258, 7258},   // U+FA6B
+	{0, 0x05, 7260, 7260},   // U+FA6C
+	{0, 0x05, 7262, 7262},   // U+FA6D
+	{0, 0x05, 7264, 7264},   // U+FA70
+	{0, 0x05, 7266, 7266},   // U+FA71
+	{0, 0x05, 7268, 7268},   // U+FA72
+	{0, 0x05, 7270, 7270},   // U+FA73
+	{0, 0x05, 7272, 7272},   // U+FA74
+	{0, 0x05, 7274, 7274},   // U+FA75
+	{0, 0x05, 7276, 7276},   // U+FA76
+	{0, 0x05, 7278, 7278},   // U+FA77
+	{0, 0x05, 7164, 7164},   // U+FA78
+	{0, 0x05, 7280, 7280},   // U+FA79
+	{0, 0x05, 7282, 7282},   // U+FA7A
+	{0, 0x05, 7284, 7284},   // U+FA7B
+	{0, 0x05, 7110, 7110},   // U+FA7C
+	{0, 0x05, 7286, 7286},   // U+FA7D
+	{0, 0x05, 7288, 7288},   // U+FA7E
+	{0, 0x05, 7290, 7290},   // U+FA7F
+	{0, 0x05, 7292, 7292},   // U+FA80
+	{0, 0x05, 7294, 7294},   // U+FA81
+	{0, 0x05, 7296, 7296},   // U+FA82
+	{0, 0x05, 7298, 7298},   // U+FA83
+	{0, 0x05, 7300, 7300},   // U+FA84
+	{0, 0x05, 7302, 7302},   // U+FA85
+	{0, 0x05, 7304, 7304},   // U+FA86
+	{0, 0x05, 7306, 7306},   // U+FA87
+	{0, 0x05, 7308, 7308},   // U+FA88
+	{0, 0x05, 7180, 7180},   // U+FA89
+	{0, 0x05, 7310, 7310},   // U+FA8A
+	{0, 0x05, 7182, 7182},   // U+FA8B
+	{0, 0x05, 7312, 7312},   // U+FA8C
+	{0, 0x05, 7314, 7314},   // U+FA8D
+	{0, 0x05, 7316, 7316},   // U+FA8E
+	{0, 0x05, 7318, 7318},   // U+FA8F
+	{0, 0x05, 7320, 7320},   // U+FA90
+	{0, 0x05, 7112, 7112},   // U+FA91
+	{0, 0x05, 6684, 6684},   // U+FA92
+	{0, 0x05, 7322, 7322},   // U+FA93
+	{0, 0x05, 7324, 7324},   // U+FA94
+	{0, 0x05, 4232, 4232},   // U+FA95
+	{0, 0x05, 6820, 6820},   // U+FA96
+	{0, 0x05, 6986, 6986},   // U+FA97
+	{0, 0x05, 7326, 7326},   // U+FA98
+	{0, 0x05, 7328, 7328},   // U+FA99
+	{0, 0x05, 7196, 7196},   // U+FA9A
+	{0, 0x05, 7330, 7330},   // U+FA9B
+	{0, 0x05, 7198, 7198},   // U+FA9C
+	{0, 0x05, 7332, 7332},   // U+FA9D
+	{0, 0x05, 7334, 7334},   // U+FA9E
+	{0, 0x05, 7336, 7336},   // U+FA9F
+	{0, 0x05, 7116, 7116},   // U+FAA0
+	{0, 0x05, 7338, 7338},   // U+FAA1
+	{0, 0x05, 7340, 7340},   // U+FAA2
+	{0, 0x05, 7342, 7342},   // U+FAA3
+	{0, 0x05, 7344, 7344},   // U+FAA4
+	{0, 0x05, 7346, 7346},   // U+FAA5
+	{0, 0x05, 7118, 7118},   // U+FAA6
+	{0, 0x05, 7348, 7348},   // U+FAA7
+	{0, 0x05, 7350, 7350},   // U+FAA8
+	{0, 0x05, 7352, 7352},   // U+FAA9
+	{0, 0x05, 7354, 7354},   // U+FAAA
+	{0, 0x05, 7356, 7356},   // U+FAAB
+	{0, 0x05, 7358, 7358},   // U+FAAC
+	{0, 0x05, 7222, 7222},   // U+FAAD
+	{0, 0x05, 7360, 7360},   // U+FAAE
+	{0, 0x05, 7362, 7362},   // U+FAAF
+	{0, 0x05, 6890, 6890},   // U+FAB0
+	{0, 0x05, 7364, 7364},   // U+FAB1
+	{0, 0x05, 7230, 7230},   // U+FAB2
+	{0, 0x05, 7366, 7366},   // U+FAB3
+	{0, 0x05, 7368, 7368},   // U+FAB4
+	{0, 0x05, 7370, 7370},   // U+FAB5
+	{0, 0x05, 7372, 7372},   // U+FAB6
+	{0, 0x05, 7374, 7374},   // U+FAB7
+	{0, 0x05, 7240, 7240},   // U+FAB8
+	{0, 0x05, 7376, 7376},   // U+FAB9
+	{0, 0x05, 7134, 7134},   // U+FABA
+	{0, 0x05, 7378, 7378},   // U+FABB
+	{0, 0x05, 7242, 7242},   // U+FABC
+	{0, 0x05, 6782, 6782},   // U+FABD
+	{0, 0x05, 7380, 7380},   // U+FABE
+	{0, 0x05, 7244, 7244},   // U+FABF
+	{0, 0x05, 7382, 7382},   // U+FAC0
+	{0, 0x05, 7248, 7248},   // U+FAC1
+	{0, 0x05, 7384, 7384},   // U+FAC2
+	{0, 0x05, 7386, 7386},   // U+FAC3
+	{0, 0x05, 7388, 7388},   // U+FAC4
+	{0, 0x05, 7390, 7390},   // U+FAC5
+	{0, 0x05, 7392, 7392},   // U+FAC6
+	{0, 0x05, 7252, 7252},   // U+FAC7
+	{0, 0x05, 7128, 7128},   // U+FAC8
+	{0, 0x05, 7394, 7394},   // U+FAC9
+	{0, 0x05, 7254, 7254},   // U+FACA
+	{0, 0x05, 7396, 7396},   // U+FACB
+	{0, 0x05, 7256, 7256},   // U+FACC
+	{0, 0x05, 7398, 7398},   // U+FACD
+	{0, 0x05, 4502, 4502},   // U+FACE
+	{0, 0x05, 7400, 7400},   // U+FACF
+	{0, 0x05, 7402, 7402},   // U+FAD0
+	{0, 0x05, 7404, 7404},   // U+FAD1
+	{0, 0x05, 7406, 7406},   // U+FAD2
+	{0, 0x05, 7408, 7408},   // U+FAD3
+	{0, 0x05, 7410, 7410},   // U+FAD4
+	{0, 0x05, 7412, 7412},   // U+FAD5
+	{0, 0x05, 7414, 7414},   // U+FAD6
+	{0, 0x05, 7416, 7416},   // U+FAD7
+	{0, 0x05, 7418, 7418},   // U+FAD8
+	{0, 0x05, 7420, 7420},   // U+FAD9
+	{0, 0x04, 0, 7422},      // U+FB00
+	{0, 0x04, 0, 7425},      // U+FB01
+	{0, 0x04, 0, 7428},      // U+FB02
+	{0, 0x04, 0, 7431},      // U+FB03
+	{0, 0x04, 0, 7435},      // U+FB04
+	{0, 0x04, 0, 7439},      // U+FB05
+	{0, 0x04, 0, 7439},      // U+FB06
+	{0, 0x04, 0, 7442},      // U+FB13
+	{0, 0x04, 0, 7445},      // U+FB14
+	{0, 0x04, 0, 7448},      // U+FB15
+	{0, 0x04, 0, 7451},      // U+FB16
+	{0, 0x04, 0, 7454},      // U+FB17
+	{0, 0x05, 7457, 7457},   // U+FB1D
+	{26, 0x00, 0, 0},        // U+FB1E
+	{0, 0x05, 7460, 7460},   // U+FB1F
+	{0, 0x04, 0, 7463},      // U+FB20
+	{0, 0x04, 0, 3444},      // U+FB21
+	{0, 0x04, 0, 3450},      // U+FB22
+	{0, 0x04, 0, 7465},      // U+FB23
+	{0, 0x04, 0, 7467},      // U+FB24
+	{0, 0x04, 0, 7469},      // U+FB25
+	{0, 0x04, 0, 7471},      // U+FB26
+	{0, 0x04, 0, 7473},      // U+FB27
+	{0, 0x04, 0, 7475},      // U+FB28
+	{0, 0x04, 0, 3380},      // U+FB29
+	{0, 0x05, 7477, 7477},   // U+FB2A
+	{0, 0x05, 7480, 7480},   // U+FB2B
+	{0, 0x05, 7483, 7483},   // U+FB2C
+	{0, 0x05, 7487, 7487},   // U+FB2D
+	{0, 0x05, 7491, 7491},   // U+FB2E
+	{0, 0x05, 7494, 7494},   // U+FB2F
+	{0, 0x05, 7497, 7497},   // U+FB30
+	{0, 0x05, 7500, 7500},   // U+FB31
+	{0, 0x05, 7503, 7503},   // U+FB32
+	{0, 0x05, 7506, 7506},   // U+FB33
+	{0, 0x05, 7509, 7509},   // U+FB34
+	{0, 0x05, 7512, 7512},   // U+FB35
+	{0, 0x05, 7515, 7515},   // U+FB36
+	{0, 0x05, 7518, 7518},   // U+FB38
+	{0, 0x05, 7521, 7521},   // U+FB39
+	{0, 0x05, 7524, 7524},   // U+FB3A
+	{0, 0x05, 7527, 7527},   // U+FB3B
+	{0, 0x05, 7530, 7530},   // U+FB3C
+	{0, 0x05, 7533, 7533},   // U+FB3E
+	{0, 0x05, 7536, 7536},   // U+FB40
+	{0, 0x05, 7539, 7539},   // U+FB41
+	{0, 0x05, 7542, 7542},   // U+FB43
+	{0, 0x05, 7545, 7545},   // U+FB44
+	{0, 0x05, 7548, 7548},   // U+FB46
+	{0, 0x05, 7551, 7551},   // U+FB47
+	{0, 0x05, 7554, 7554},   // U+FB48
+	{0, 0x05, 7557, 7557},   // U+FB49
+	{0, 0x05, 7560, 7560},   // U+FB4A
+	{0, 0x05, 7563, 7563},   // U+FB4B
+	{0, 0x05, 7566, 7566},   // U+FB4C
+	{0, 0x05, 7569, 7569},   // U+FB4D
+	{0, 0x05, 7572, 7572},   // U+FB4E
+	{0, 0x04, 0, 7575},      // U+FB4F
+	{0, 0x04, 0, 7578},      // U+FB50
+	{0, 0x04, 0, 7578},      // U+FB51
+	{0, 0x04, 0, 7580},      // U+FB52
+	{0, 0x04, 0, 7580},      // U+FB53
+	{0, 0x04, 0, 7580},      // U+FB54
+	{0, 0x04, 0, 7580},      // U+FB55
+	{0, 0x04, 0, 7582},      // U+FB56
+	{0, 0x04, 0, 7582},      // U+FB57
+	{0, 0x04, 0, 7582},      // U+FB58
+	{0, 0x04, 0, 7582},      // U+FB59
+	{0, 0x04, 0, 7584},      // U+FB5A
+	{0, 0x04, 0, 7584},      // U+FB5B
+	{0, 0x04, 0, 7584},      // U+FB5C
+	{0, 0x04, 0, 7584},      // U+FB5D
+	{0, 0x04, 0, 7586},      // U+FB5E
+	{0, 0x04, 0, 7586},      // U+FB5F
+	{0, 0x04, 0, 7586},      // U+FB60
+	{0, 0x04, 0, 7586},      // U+FB61
+	{0, 0x04, 0, 7588},      // U+FB62
+	{0, 0x04, 0, 7588},      // U+FB63
+	{0, 0x04, 0, 7588},      // U+FB64
+	{0, 0x04, 0, 7588},      // U+FB65
+	{0, 0x04, 0, 7590},      // U+FB66
+	{0, 0x04, 0, 7590},      // U+FB67
+	{0, 0x04, 0, 7590},      // U+FB68
+	{0, 0x04, 0, 7590},      // U+FB69
+	{0, 0x04, 0, 7592},      // U+FB6A
+	{0, 0x04, 0, 7592},      // U+FB6B
+	{0, 0x04, 0, 7592},      // U+FB6C
+	{0, 0x04, 0, 7592},      // U+FB6D
+	{0, 0x04, 0, 7594},      // U+FB6E
+	{0, 0x04, 0, 7594},      // U+FB6F
+	{0, 0x04, 0, 7594},      // U+FB70
+	{0, 0x04, 0, 7594},      // U+FB71
+	{0, 0x04, 0, 7596},      // U+FB72
+	{0, 0x04, 0, 7596},      // U+FB73
+	{0, 0x04, 0, 7596},      // U+FB74
+	{0, 0x04, 0, 7596},      // U+FB75
+	{0, 0x04, 0, 7598},      // U+FB76
+	{0, 0x04, 0, 7598},      // U+FB77
+	{0, 0x04, 0, 7598},      // U+FB78
+	{0, 0x04, 0, 7598},      // U+FB79
+	{0, 0x04, 0, 7600},      // U+FB7A
+	{0, 0x04, 0, 7600},      // U+FB7B
+	{0, 0x04, 0, 7600},      // U+FB7C
+	{0, 0x04, 0, 7600},      // U+FB7D
+	{0, 0x04, 0, 7602},      // U+FB7E
+	{0, 0x04, 0, 7602},      // U+FB7F
+	{0, 0x04, 0, 7602},      // U+FB80
+	{0, 0x04, 0, 7602},      // U+FB81
+	{0, 0x04, 0, 7604},      // U+FB82
+	{0, 0x04, 0, 7604},      // U+FB83
+	{0, 0x04, 0, 7606},      // U+FB84
+	{0, 0x04, 0, 7606},      // U+FB85
+	{0, 0x04, 0, 7608},      // U+FB86
+	{0, 0x04, 0, 7608},      // U+FB87
+	{0, 0x04, 0, 7610},      // U+FB88
+	{0, 0x04, 0, 7610},      // U+FB89
+	{0, 0x04, 0, 7612},      // U+FB8A
+	{0, 0x04, 0, 7612},      // U+FB8B
+	{0, 0x04, 0, 7614},      // U+FB8C
+	{0, 0x04, 0, 7614},      // U+FB8D
+	{0, 0x04, 0, 7616},      // U+FB8E
+	{0, 0x04, 0, 7616},      // U+FB8F
+	{0, 0x04, 0, 7616},      // U+FB90
+	{0, 0x04, 0, 7616},      // U+FB91
+	{0, 0x04, 0, 7618},      // U+FB92
+	{0, 0x04, 0, 7618},      // U+FB93
+	{0, 0x04, 0, 7618},      // U+FB94
+	{0, 0x04, 0, 7618},      // U+FB95
+	{0, 0x04, 0, 7620},      // U+FB96
+	{0, 0x04, 0, 7620},      // U+FB97
+	{0, 0x04, 0, 7620},      // U+FB98
+	{0, 0x04, 0, 7620},      // U+FB99
+	{0, 0x04, 0, 7622},      // U+FB9A
+	{0, 0x04, 0, 7622},      // U+FB9B
+	{0, 0x04, 0, 7622},      // U+FB9C
+	{0, 0x04, 0, 7622},      // U+FB9D
+	{0, 0x04, 0, 7624},      // U+FB9E
+	{0, 0x04, 0, 7624},      // U+FB9F
+	{0, 0x04, 0, 7626},      // U+FBA0
+	{0, 0x04, 0, 7626},      // U+FBA1
+	{0, 0x04, 0, 7626},      // U+FBA2
+	{0, 0x04, 0, 7626},      // U+FBA3
+	{0, 0x04, 0, 1218},      // U+FBA4
+	{0, 0x04, 0, 1218},      // U+FBA5
+	{0, 0x04, 0, 7628},      // U+FBA6
+	{0, 0x04, 0, 7628},      // U+FBA7
+	{0, 0x04, 0, 7628},      // U+FBA8
+	{0, 0x04, 0, 7628},      // U+FBA9
+	{0, 0x04, 0, 7630},      // U+FBAA
+	{0, 0x04, 0, 7630},      // U+FBAB
+	{0, 0x04, 0, 7630},      // U+FBAC
+	{0, 0x04, 0, 7630},      // U+FBAD
+	{0, 0x04, 0, 7632},      // U+FBAE
+	{0, 0x04, 0, 7632},      // U+FBAF
+	{0, 0x04, 0, 1224},      // U+FBB0
+	{0, 0x04, 0, 1224},      // U+FBB1
+	{0, 0x04, 0, 7634},      // U+FBD3
+	{0, 0x04, 0, 7634},      // U+FBD4
+	{0, 0x04, 0, 7634},      // U+FBD5
+	{0, 0x04, 0, 7634},      // U+FBD6
+	{0, 0x04, 0, 7636},      // U+FBD7
+	{0, 0x04, 0, 7636},      // U+FBD8
+	{0, 0x04, 0, 7638},      // U+FBD9
+	{0, 0x04, 0, 7638},      // U+FBDA
+	{0, 0x04, 0, 7640},      // U+FBDB
+	{0, 0x04, 0, 7640},      // U+FBDC
+	{0, 0x04, 0, 1212},      // U+FBDD
+	{0, 0x04, 0, 7642},      // U+FBDE
+	{0, 0x04, 0, 7642},      // U+FBDF
+	{0, 0x04, 0, 7644},      // U+FBE0
+	{0, 0x04, 0, 7644},      // U+FBE1
+	{0, 0x04, 0, 7646},      // U+FBE2
+	{0, 0x04, 0, 7646},      // U+FBE3
+	{0, 0x04, 0, 7648},      // U+FBE4
+	{0, 0x04, 0, 7648},      // U+FBE5
+	{0, 0x04, 0, 7648},      // U+FBE6
+	{0, 0x04, 0, 7648},      // U+FBE7
+	{0, 0x04, 0, 7650},      // U+FBE8
+	{0, 0x04, 0, 7650},      // U+FBE9
+	{0, 0x04, 0, 7652},      // U+FBEA
+	{0, 0x04, 0, 7652},      // U+FBEB
+	{0, 0x04, 0, 7656},      // U+FBEC
+	{0, 0x04, 0, 7656},      // U+FBED
+	{0, 0x04, 0, 7660},      // U+FBEE
+	{0, 0x04, 0, 7660},      // U+FBEF
+	{0, 0x04, 0, 7664},      // U+FBF0
+	{0, 0x04, 0, 7664},      // U+FBF1
+	{0, 0x04, 0, 7668},      // U+FBF2
+	{0, 0x04, 0, 7668},      // U+FBF3
+	{0, 0x04, 0, 7672},      // U+FBF4
+	{0, 0x04, 0, 7672},      // U+FBF5
+	{0, 0x04, 0, 7676},      // U+FBF6
+	{0, 0x04, 0, 7676},      // U+FBF7
+	{0, 0x04, 0, 7676},      // U+FBF8
+	{0, 0x04, 0, 7680},      // U+FBF9
+	{0, 0x04, 0, 7680},      // U+FBFA
+	{0, 0x04, 0, 7680},      // U+FBFB
+	{0, 0x04, 0, 7684},      // U+FBFC
+	{0, 0x04, 0, 7684},      // U+FBFD
+	{0, 0x04, 0, 7684},      // U+FBFE
+	{0, 0x04, 0, 7684},      // U+FBFF
+	{0, 0x04, 0, 7686},      // U+FC00
+	{0, 0x04, 0, 7690},      // U+FC01
+	{0, 0x04, 0, 7694},      // U+FC02
+	{0, 0x04, 0, 7680},      // U+FC03
+	{0, 0x04, 0, 7698},      // U+FC04
+	{0, 0x04, 0, 7702},      // U+FC05
+	{0, 0x04, 0, 7705},      // U+FC06
+	{0, 0x04, 0, 7708},      // U+FC07
+	{0, 0x04, 0, 7711},      // U+FC08
+	{0, 0x04, 0, 7714},      // U+FC09
+	{0, 0x04, 0, 7717},      // U+FC0A
+	{0, 0x04, 0, 7720},      // U+FC0B
+	{0, 0x04, 0, 7723},      // U+FC0C
+	{0, 0x04, 0, 7726},      // U+FC0D
+	{0, 0x04, 0, 7729},      // U+FC0E
+	{0, 0x04, 0, 7732},      // U+FC0F
+	{0, 0x04, 0, 7735},      // U+FC10
+	{0, 0x04, 0, 7738},      // U+FC11
+	{0, 0x04, 0, 7741},      // U+FC12
+	{0, 0x04, 0, 7744},      // U+FC13
+	{0, 0x04, 0, 7747},      // U+FC14
+	{0, 0x04, 0, 7750},      // U+FC15
+	{0, 0x04, 0, 7753},      // U+FC16
+	{0, 0x04, 0, 7756},      // U+FC17
+	{0, 0x04, 0, 7759},      // U+FC18
+	{0, 0x04, 0, 7762},      // U+FC19
+	{0, 0x04, 0, 7765},      // U+FC1A
+	{0, 0x04, 0, 7768},      // U+FC1B
+	{0, 0x04, 0, 7771},      // U+FC1C
+	{0, 0x04, 0, 7774},      // U+FC1D
+	{0, 0x04, 0, 7777},      // U+FC1E
+	{0, 0x04, 0, 7780},      // U+FC1F
+	{0, 0x04, 0, 7783},      // U+FC20
+	{0, 0x04, 0, 7786},      // U+FC21
+	{0, 0x04, 0, 7789},      // U+FC22
+	{0, 0x04, 0, 7792},      // U+FC23
+	{0, 0x04, 0, 7795},      // U+FC24
+	{0, 0x04, 0, 7798},      // U+FC25
+	{0, 0x04, 0, 7801},      // U+FC26
+	{0, 0x04, 0, 7804},      // U+FC27
+	{0, 0x04, 0, 7807},      // U+FC28
+	{0, 0x04, 0, 7810},      // U+FC29
+	{0, 0x04, 0, 7813},      // U+FC2A
+	{0, 0x04, 0, 7816},      // U+FC2B
+	{0, 0x04, 0, 7819},      // U+FC2C
+	{0, 0x04, 0, 7822},      // U+FC2D
+	{0, 0x04, 0, 7825},      // U+FC2E
+	{0, 0x04, 0, 7828},      // U+FC2F
+	{0, 0x04, 0, 7831},      // U+FC30
+	{0, 0x04, 0, 7834},      // U+FC31
+	{0, 0x04, 0, 7837},      // U+FC32
+	{0, 0x04, 0, 7840},      // U+FC33
+	{0, 0x04, 0, 7843},      // U+FC34
+	{0, 0x04, 0, 7846},      // U+FC35
+	{0, 0x04, 0, 7849},      // U+FC36
+	{0, 0x04, 0, 7852},      // U+FC37
+	{0, 0x04, 0, 7855},      // U+FC38
+	{0, 0x04, 0, 7858},      // U+FC39
+	{0, 0x04, 0, 7861},      // U+FC3A
+	{0, 0x04, 0, 7864},      // U+FC3B
+	{0, 0x04, 0, 7867},      // U+FC3C
+	{0, 0x04, 0, 7870},      // U+FC3D
+	{0, 0x04, 0, 7873},      // U+FC3E
+	{0, 0x04, 0, 7876},      // U+FC3F
+	{0, 0x04, 0, 7879},      // U+FC40
+	{0, 0x04, 0, 7882},      // U+FC41
+	{0, 0x04, 0, 7885},      // U+FC42
+	{0, 0x04, 0, 7888},      // U+FC43
+	{0, 0x04, 0, 7891},      // U+FC44
+	{0, 0x04, 0, 7894},      // U+FC45
+	{0, 0x04, 0, 7897},      // U+FC46
+	{0, 0x04, 0, 7900},      // U+FC47
+	{0, 0x04, 0, 7903},      // U+FC48
+	{0, 0x04, 0, 7906},      // U+FC49
+	{0, 0x04, 0, 7909},      // U+FC4A
+	{0, 0x04, 0, 7912},      // U+FC4B
+	{0, 0x04, 0, 7915},      // U+FC4C
+	{0, 0x04, 0, 7918},      // U+FC4D
+	{0, 0x04, 0, 7921},      // U+FC4E
+	{0, 0x04, 0, 7924},      // U+FC4F
+	{0, 0x04, 0, 7927},      // U+FC50
+	{0, 0x04, 0, 7930},      // U+FC51
+	{0, 0x04, 0, 7933},      // U+FC52
+	{0, 0x04, 0, 7936},      // U+FC53
+	{0, 0x04, 0, 7939},      // U+FC54
+	{0, 0x04, 0, 7942},      // U+FC55
+	{0, 0x04, 0, 7945},      // U+FC56
+	{0, 0x04, 0, 7948},      // U+FC57
+	{0, 0x04, 0, 7951},      // U+FC58
+	{0, 0x04, 0, 7954},      // U+FC59
+	{0, 0x04, 0, 7957},      // U+FC5A
+	{0, 0x04, 0, 7960},      // U+FC5B
+	{0, 0x04, 0, 7963},      // U+FC5C
+	{0, 0x04, 0, 7966},      // U+FC5D
+	{0, 0x04, 0, 7969},      // U+FC5E
+	{0, 0x04, 0, 7973},      // U+FC5F
+	{0, 0x04, 0, 7977},      // U+FC60
+	{0, 0x04, 0, 7981},      // U+FC61
+	{0, 0x04, 0, 7985},      // U+FC62
+	{0, 0x04, 0, 7989},      // U+FC63
+	{0, 0x04, 0, 7993},      // U+FC64
+	{0, 0x04, 0, 7997},      // U+FC65
+	{0, 0x04, 0, 7694},      // U+FC66
+	{0, 0x04, 0, 8001},      // U+FC67
+	{0, 0x04, 0, 7680},      // U+FC68
+	{0, 0x04, 0, 7698},      // U+FC69
+	{0, 0x04, 0, 8005},      // U+FC6A
+	{0, 0x04, 0, 8008},      // U+FC6B
+	{0, 0x04, 0, 7711},      // U+FC6C
+	{0, 0x04, 0, 8011},      // U+FC6D
+	{0, 0x04, 0, 7714},      // U+FC6E
+	{0, 0x04, 0, 7717},      // U+FC6F
+	{0, 0x04, 0, 8014},      // U+FC70
+	{0, 0x04, 0, 8017},      // U+FC71
+	{0, 0x04, 0, 7729},      // U+FC72
+	{0, 0x04, 0, 8020},      // U+FC73
+	{0, 0x04, 0, 7732},      // U+FC74
+	{0, 0x04, 0, 7735},      // U+FC75
+	{0, 0x04, 0, 8023},      // U+FC76
+	{0, 0x04, 0, 8026},      // U+FC77
+	{0, 0x04, 0, 7741},      // U+FC78
+	{0, 0x04, 0, 8029},      // U+FC79
+	{0, 0x04, 0, 7744},      // U+FC7A
+	{0, 0x04, 0, 7747},      // U+FC7B
+	{0, 0x04, 0, 7834},      // U+FC7C
+	{0, 0x04, 0, 7837},      // U+FC7D
+	{0, 0x04, 0, 7846},      // U+FC7E
+	{0, 0x04, 0, 7849},      // U+FC7F
+	{0, 0x04, 0, 7852},      // U+FC80
+	{0, 0x04, 0, 7864},      // U+FC81
+	{0, 0x04, 0, 7867},      // U+FC82
+	{0, 0x04, 0, 7870},      // U+FC83
+	{0, 0x04, 0, 7873},      // U+FC84
+	{0, 0x04, 0, 7885},      // U+FC85
+	{0, 0x04, 0, 7888},      // U+FC86
+	{0, 0x04, 0, 7891},      // U+FC87
+	{0, 0x04, 0, 8032},      // U+FC88
+	{0, 0x04, 0, 7903},      // U+FC89
+	{0, 0x04, 0, 8035},      // U+FC8A
+	{0, 0x04, 0, 8038},      // U+FC8B
+	{0, 0x04, 0, 7921},      // U+FC8C
+	{0, 0x04, 0, 8041},      // U+FC8D
+	{0, 0x04, 0, 7924},      // U+FC8E
+	{0, 0x04, 0, 7927},      // U+FC8F
+	{0, 0x04, 0, 7966},      // U+FC90
+	{0, 0x04, 0, 8044},      // U+FC91
+	{0, 0x04, 0, 8047},      // U+FC92
+	{0, 0x04, 0, 7951},      // U+FC93
+	{0, 0x04, 0, 8050},      // U+FC94
+	{0, 0x04, 0, 7954},      // U+FC95
+	{0, 0x04, 0, 7957},      // U+FC96
+	{0, 0x04, 0, 7686},      // U+FC97
+	{0, 0x04, 0, 7690},      // U+FC98
+	{0, 0x04, 0, 8053},      // U+FC99
+	{0, 0x04, 0, 7694},      // U+FC9A
+	{0, 0x04, 0, 8057},      // U+FC9B
+	{0, 0x04, 0, 7702},      // U+FC9C
+	{0, 0x04, 0, 7705},      // U+FC9D
+	{0, 0x04, 0, 7708},      // U+FC9E
+	{0, 0x04, 0, 7711},      // U+FC9F
+	{0, 0x04, 0, 8061},      // U+FCA0
+	{0, 0x04, 0, 7720},      // U+FCA1
+	{0, 0x04, 0, 7723},      // U+FCA2
+	{0, 0x04, 0, 7726},      // U+FCA3
+	{0, 0x04, 0, 7729},      // U+FCA4
+	{0, 0x04, 0, 8064},      // U+FCA5
+	{0, 0x04, 0, 7741},      // U+FCA6
+	{0, 0x04, 0, 7750},      // U+FCA7
+	{0, 0x04, 0, 7753},      // U+FCA8
+	{0, 0x04, 0, 7756},      // U+FCA9
+	{0, 0x04, 0, 7759},      // U+FCAA
+	{0, 0x04, 0, 7762},      // U+FCAB
+	{0, 0x04, 0, 7768},      // U+FCAC
+	{0, 0x04, 0, 7771},      // U+FCAD
+	{0, 0x04, 0, 7774},      // U+FCAE
+	{0, 0x04, 0, 7777},      // U+FCAF
+	{0, 0x04, 0, 7780},      // U+FCB0
+	{0, 0x04, 0, 7783},      // U+FCB1
+	{0, 0x04, 0, 8067},      // U+FCB2
+	{0, 0x04, 0, 7786},      // U+FCB3
+	{0, 0x04, 0, 7789},      // U+FCB4
+	{0, 0x04, 0, 7792},      // U+FCB5
+	{0, 0x04, 0, 7795},      // U+FCB6
+	{0, 0x04, 0, 7798},      // U+FCB7
+	{0, 0x04, 0, 7801},      // U+FCB8
+	{0, 0x04, 0, 7807},      // U+FCB9
+	{0, 0x04, 0, 7810},      // U+FCBA
+	{0, 0x04, 0, 7813},      // U+FCBB
+	{0, 0x04, 0, 7816},      // U+FCBC
+	{0, 0x04, 0, 7819},      // U+FCBD
+	{0, 0x04, 0, 7822},      // U+FCBE
+	{0, 0x04, 0, 7825},      // U+FCBF
+	{0, 0x04, 0, 7828},      // U+FCC0
+	{0, 0x04, 0, 7831},      // U+FCC1
+	{0, 0x04, 0, 7840},      // U+FCC2
+	{0, 0x04, 0, 7843},      // U+FCC3
+	{0, 0x04, 0, 7855},      // U+FCC4
+	{0, 0x04, 0, 7858},      // U+FCC5
+	{0, 0x04, 0, 7861},      // U+FCC6
+	{0, 0x04, 0, 7864},      // U+FCC7
+	{0, 0x04, 0, 7867},      // U+FCC8
+	{0, 0x04, 0, 7876},      // U+FCC9
+	{0, 0x04, 0, 7879},      // U+FCCA
+	{0, 0x04, 0, 7882},      // U+FCCB
+	{0, 0x04, 0, 7885},      // U+FCCC
+	{0, 0x04, 0, 8070},      // U+FCCD
+	{0, 0x04, 0, 7894},      // U+FCCE
+	{0, 0x04, 0, 7897},      // U+FCCF
+	{0, 0x04, 0, 7900},      // U+FCD0
+	{0, 0x04, 0, 7903},      // U+FCD1
+	{0, 0x04, 0, 7912},      // U+FCD2
+	{0, 0x04, 0, 7915},      // U+FCD3
+	{0, 0x04, 0, 7918},      // U+FCD4
+	{0, 0x04, 0, 7921},      // U+FCD5
+	{0, 0x04, 0, 8073},      // U+FCD6
+	{0, 0x04, 0, 7930},      // U+FCD7
+	{0, 0x04, 0, 7933},      // U+FCD8
+	{0, 0x04, 0, 8076},      // U+FCD9
+	{0, 0x04, 0, 7942},      // U+FCDA
+	{0, 0x04, 0, 7945},      // U+FCDB
+	{0, 0x04, 0, 7948},      // U+FCDC
+	{0, 0x04, 0, 7951},      // U+FCDD
+	{0, 0x04, 0, 8079},      // U+FCDE
+	{0, 0x04, 0, 7694},      // U+FCDF
+	{0, 0x04, 0, 8057},      // U+FCE0
+	{0, 0x04, 0, 7711},      // U+FCE1
+	{0, 0x04, 0, 8061},      // U+FCE2
+	{0, 0x04, 0, 7729},      // U+FCE3
+	{0, 0x04, 0, 8064},      // U+FCE4
+	{0, 0x04, 0, 7741},      // U+FCE5
+	{0, 0x04, 0, 8082},      // U+FCE6
+	{0, 0x04, 0, 7780},      // U+FCE7
+	{0, 0x04, 0, 8085},      // U+FCE8
+	{0, 0x04, 0, 8088},      // U+FCE9
+	{0, 0x04, 0, 8091},      // U+FCEA
+	{0, 0x04, 0, 7864},      // U+FCEB
+	{0, 0x04, 0, 7867},      // U+FCEC
+	{0, 0x04, 0, 7885},      // U+FCED
+	{0, 0x04, 0, 7921},      // U+FCEE
+	{0, 0x04, 0, 8073},      // U+FCEF
+	{0, 0x04, 0, 7951},      // U+FCF0
+	{0, 0x04, 0, 8079},      // U+FCF1
+	{0, 0x04, 0, 8094},      // U+FCF2
+	{0, 0x04, 0, 8098},      // U+FCF3
+	{0, 0x04, 0, 8102},      // U+FCF4
+	{0, 0x04, 0, 8106},      // U+FCF5
+	{0, 0x04, 0, 8109},      // U+FCF6
+	{0, 0x04, 0, 8112},      // U+FCF7
+	{0, 0x04, 0, 8115},      // U+FCF8
+	{0, 0x04, 0, 8118},      // U+FCF9
+	{0, 0x04, 0, 8121},      // U+FCFA
+	{0, 0x04, 0, 8124},      // U+FCFB
+	{0, 0x04, 0, 8127},      // U+FCFC
+	{0, 0x04, 0, 8130},      // U+FCFD
+	{0, 0x04, 0, 8133},      // U+FCFE
+	{0, 0x04, 0, 8136},      // U+FCFF
+	{0, 0x04, 0, 8139},      // U+FD00
+	{0, 0x04, 0, 8142},      // U+FD01
+	{0, 0x04, 0, 8145},      // U+FD02
+	{0, 0x04, 0, 8148},      // U+FD03
+	{0, 0x04, 0, 8151},      // U+FD04
+	{0, 0x04, 0, 8154},      // U+FD05
+	{0, 0x04, 0, 8157},      // U+FD06
+	{0, 0x04, 0, 8160},      // U+FD07
+	{0, 0x04, 0, 8163},      // U+FD08
+	{0, 0x04, 0, 8166},      // U+FD09
+	{0, 0x04, 0, 8169},      // U+FD0A
+	{0, 0x04, 0, 8172},      // U+FD0B
+	{0, 0x04, 0, 8088},      // U+FD0C
+	{0, 0x04, 0, 8175},      // U+FD0D
+	{0, 0x04, 0, 8178},      // U+FD0E
+	{0, 0x04, 0, 8181},      // U+FD0F
+	{0, 0x04, 0, 8184},      // U+FD10
+	{0, 0x04, 0, 8106},      // U+FD11
+	{0, 0x04, 0, 8109},      // U+FD12
+	{0, 0x04, 0, 8112},      // U+FD13
+	{0, 0x04, 0, 8115},      // U+FD14
+	{0, 0x04, 0, 8118},      // U+FD15
+	{0, 0x04, 0, 8121},      // U+FD16
+	{0, 0x04, 0, 8124},      // U+FD17
+	{0, 0x04, 0, 8127},      // U+FD18
+	{0, 0x04, 0, 8130},      // U+FD19
+	{0, 0x04, 0, 8133},      // U+FD1A
+	{0, 0x04, 0, 8136},      // U+FD1B
+	{0, 0x04, 0, 8139},      // U+FD1C
+	{0, 0x04, 0, 8142},      // U+FD1D
+	{0, 0x04, 0, 8145},      // U+FD1E
+	{0, 0x04, 0, 8148},      // U+FD1F
+	{0, 0x04, 0, 8151},      // U+FD20
+	{0, 0x04, 0, 8154},      // U+FD21
+	{0, 0x04, 0, 8157},      // U+FD22
+	{0, 0x04, 0, 8160},      // U+FD23
+	{0, 0x04, 0, 8163},      // U+FD24
+	{0, 0x04, 0, 8166},      // U+FD25
+	{0, 0x04, 0, 8169},      // U+FD26
+	{0, 0x04, 0, 8172},      // U+FD27
+	{0, 0x04, 0, 8088},      // U+FD28
+	{0, 0x04, 0, 8175},      // U+FD29
+	{0, 0x04, 0, 8178},      // U+FD2A
+	{0, 0x04, 0, 8181},      // U+FD2B
+	{0, 0x04, 0, 8184},      // U+FD2C
+	{0, 0x04, 0, 8166},      // U+FD2D
+	{0, 0x04, 0, 8169},      // U+FD2E
+	{0, 0x04, 0, 8172},      // U+FD2F
+	{0, 0x04, 0, 8088},      // U+FD30
+	{0, 0x04, 0, 8085},      // U+FD31
+	{0, 0x04, 0, 8091},      // U+FD32
+	{0, 0x04, 0, 7804},      // U+FD33
+	{0, 0x04, 0, 7771},      // U+FD34
+	{0, 0x04, 0, 7774},      // U+FD35
+	{0, 0x04, 0, 7777},      // U+FD36
+	{0, 0x04, 0, 8166},      // U+FD37
+	{0, 0x04, 0, 8169},      // U+FD38
+	{0, 0x04, 0, 8172},      // U+FD39
+	{0, 0x04, 0, 7804},      // U+FD3A
+	{0, 0x04, 0, 7807},      // U+FD3B
+	{0, 0x04, 0, 8187},      // U+FD3C
+	{0, 0x04, 0, 8187},      // U+FD3D
+	{0, 0x04, 0, 8190},      // U+FD50
+	{0, 0x04, 0, 8194},      // U+FD51
+	{0, 0x04, 0, 8194},      // U+FD52
+	{0, 0x04, 0, 8198},      // U+FD53
+	{0, 0x04, 0, 8202},      // U+FD54
+	{0, 0x04, 0, 8206},      // U+FD55
+	{0, 0x04, 0, 8210},      // U+FD56
+	{0, 0x04, 0, 8214},      // U+FD57
+	{0, 0x04, 0, 8218},      // U+FD58
+	{0, 0x04, 0, 8218},      // U+FD59
+	{0, 0x04, 0, 8222},      // U+FD5A
+	{0, 0x04, 0, 8226},      // U+FD5B
+	{0, 0x04, 0, 8230},      // U+FD5C
+	{0, 0x04, 0, 8234},      // U+FD5D
+	{0, 0x04, 0, 8238},      // U+FD5E
+	{0, 0x04, 0, 8242},      // U+FD5F
+	{0, 0x04, 0, 8242},      // U+FD60
+	{0, 0x04, 0, 8246},      // U+FD61
+	{0, 0x04, 0, 8250},      // U+FD62
+	{0, 0x04, 0, 8250},      // U+FD63
+	{0, 0x04, 0, 8254},      // U+FD64
+	{0, 0x04, 0, 8254},      // U+FD65
+	{0, 0x04, 0, 8258},      // U+FD66
+	{0, 0x04, 0, 8262},      // U+FD67
+	{0, 0x04, 0, 8262},      // U+FD68
+	{0, 0x04, 0, 8266},      // U+FD69
+	{0, 0x04, 0, 8270},      // U+FD6A
+	{0, 0x04, 0, 8270},      // U+FD6B
+	{0, 0x04, 0, 8274},      // U+FD6C
+	{0, 0x04, 0, 8274},      // U+FD6D
+	{0, 0x04, 0, 8278},      // U+FD6E
+	{0, 0x04, 0, 8282},      // U+FD6F
+	{0, 0x04, 0, 8282},      // U+FD70
+	{0, 0x04, 0, 8286},      // U+FD71
+	{0, 0x04, 0, 8286},      // U+FD72
+	{0, 0x04, 0, 8290},      // U+FD73
+	{0, 0x04, 0, 8294},      // U+FD74
+	{0, 0x04, 0, 8298},      // U+FD75
+	{0, 0x04, 0, 8302},      // U+FD76
+	{0, 0x04, 0, 8302},      // U+FD77
+	{0, 0x04, 0, 8306},      // U+FD78
+	{0, 0x04, 0, 8310},      // U+FD79
+	{0, 0x04, 0, 8314},      // U+FD7A
+	{0, 0x04, 0, 8318},      // U+FD7B
+	{0, 0x04, 0, 8322},      // U+FD7C
+	{0, 0x04, 0, 8322},      // U+FD7D
+	{0, 0x04, 0, 8326},      // U+FD7E
+	{0, 0x04, 0, 8330},      // U+FD7F
+	{0, 0x04, 0, 8334},      // U+FD80
+	{0, 0x04, 0, 8338},      // U+FD81
+	{0, 0x04, 0, 8342},      // U+FD82
+	{0, 0x04, 0, 8346},      // U+FD83
+	{0, 0x04, 0, 8346},      // U+FD84
+	{0, 0x04, 0, 8350},      // U+FD85
+	{0, 0x04, 0, 8350},      // U+FD86
+	{0, 0x04, 0, 8354},      // U+FD87
+	{0, 0x04, 0, 8354},      // U+FD88
+	{0, 0x04, 0, 8358},      // U+FD89
+	{0, 0x04, 0, 8362},      // U+FD8A
+	{0, 0x04, 0, 8366},      // U+FD8B
+	{0, 0x04, 0, 8370},      // U+FD8C
+	{0, 0x04, 0, 8374},      // U+FD8D
+	{0, 0x04, 0, 8378},      // U+FD8E
+	{0, 0x04, 0, 8382},      // U+FD8F
+	{0, 0x04, 0, 8386},      // U+FD92
+	{0, 0x04, 0, 8390},      // U+FD93
+	{0, 0x04, 0, 8394},      // U+FD94
+	{0, 0x04, 0, 8398},      // U+FD95
+	{0, 0x04, 0, 8402},      // U+FD96
+	{0, 0x04, 0, 8406},      // U+FD97
+	{0, 0x04, 0, 8406},      // U+FD98
+	{0, 0x04, 0, 8410},      // U+FD99
+	{0, 0x04, 0, 8414},      // U+FD9A
+	{0, 0x04, 0, 8418},      // U+FD9B
+	{0, 0x04, 0, 8422},      // U+FD9C
+	{0, 0x04, 0, 8422},      // U+FD9D
+	{0, 0x04, 0, 8426},      // U+FD9E
+	{0, 0x04, 0, 8430},      // U+FD9F
+	{0, 0x04, 0, 8434},      // U+FDA0
+	{0, 0x04, 0, 8438},      // U+FDA1
+	{0, 0x04, 0, 8442},      // U+FDA2
+	{0, 0x04, 0, 8446},      // U+FDA3
+	{0, 0x04, 0, 8450},      // U+FDA4
+	{0, 0x04, 0, 8454},      // U+FDA5
+	{0, 0x04, 0, 8458},      // U+FDA6
+	{0, 0x04, 0, 8462},      // U+FDA7
+	{0, 0x04, 0, 8466},      // U+FDA8
+	{0, 0x04, 0, 8470},      // U+FDA9
+	{0, 0x04, 0, 8474},      // U+FDAA
+	{0, 0x04, 0, 8478},      // U+FDAB
+	{0, 0x04, 0, 8482},      // U+FDAC
+	{0, 0x04, 0, 8486},      // U+FDAD
+	{0, 0x04, 0, 8490},      // U+FDAE
+	{0, 0x04, 0, 8494},      // U+FDAF
+	{0, 0x04, 0, 8498},      // U+FDB0
+	{0, 0x04, 0, 8502},      // U+FDB1
+	{0, 0x04, 0, 8506},      // U+FDB2
+	{0, 0x04, 0, 8510},      // U+FDB3
+	{0, 0x04, 0, 8326},      // U+FDB4
+	{0, 0x04, 0, 8334},      // U+FDB5
+	{0, 0x04, 0, 8514},      // U+FDB6
+	{0, 0x04, 0, 8518},      // U+FDB7
+	{0, 0x04, 0, 8522},      // U+FDB8
+	{0, 0x04, 0, 8526},      // U+FDB9
+	{0, 0x04, 0, 8530},      // U+FDBA
+	{0, 0x04, 0, 8534},      // U+FDBB
+	{0, 0x04, 0, 8530},      // U+FDBC
+	{0, 0x04, 0, 8522},      // U+FDBD
+	{0, 0x04, 0, 8538},      // U+FDBE
+	{0, 0x04, 0, 8542},      // U+FDBF
+	{0, 0x04, 0, 8546},      // U+FDC0
+	{0, 0x04, 0, 8550},      // U+FDC1
+	{0, 0x04, 0, 8554},      // U+FDC2
+	{0, 0x04, 0, 8534},      // U+FDC3
+	{0, 0x04, 0, 8298},      // U+FDC4
+	{0, 0x04, 0, 8258},      // U+FDC5
+	{0, 0x04, 0, 8558},      // U+FDC6
+	{0, 0x04, 0, 8562},      // U+FDC7
+	{0, 0x04, 0, 8566},      // U+FDF0
+	{0, 0x04, 0, 8570},      // U+FDF1
+	{0, 0x04, 0, 8574},      // U+FDF2
+	{0, 0x04, 0, 8579},      // U+FDF3
+	{0, 0x04, 0, 8584},      // U+FDF4
+	{0, 0x04, 0, 8589},      // U+FDF5
+	{0, 0x04, 0, 8594},      // U+FDF6
+	{0, 0x04, 0, 8599},      // U+FDF7
+	{0, 0x04, 0, 8604},      // U+FDF8
+	{0, 0x04, 0, 8609},      // U+FDF9
+	{0, 0x04, 0, 8613},      // U+FDFA
+	{0, 0x04, 0, 8632},      // U+FDFB
+	{0, 0x04, 0, 8641},      // U+FDFC
+	{0, 0x04, 0, 8646},      // U+FE10
+	{0, 0x04, 0, 8648},      // U+FE11
+	{0, 0x04, 0, 8650},      // U+FE12
+	{0, 0x04, 0, 8652},      // U+FE13
+	{0, 0x04, 0, 931},       // U+FE14
+	{0, 0x04, 0, 8654},      // U+FE15
+	{0, 0x04, 0, 8656},      // U+FE16
+	{0, 0x04, 0, 8658},      // U+FE17
+	{0, 0x04, 0, 8660},      // U+FE18
+	{0, 0x04, 0, 3328},      // U+FE19
+	{230, 0x00, 0, 0},       // U+FE20
+	{230, 0x00, 0, 0},       // U+FE21
+	{230, 0x00, 0, 0},       // U+FE22
+	{230, 0x00, 0, 0},       // U+FE23
+	{230, 0x00, 0, 0},       // U+FE24
+	{230, 0x00, 0, 0},       // U+FE25
+	{230, 0x00, 0, 0},       // U+FE26
+	{220, 0x00, 0, 0},       // U+FE27
+	{220, 0x00, 0, 0},       // U+FE28
+	{220, 0x00, 0, 0},       // U+FE29
+	{220, 0x00, 0, 0},       // U+FE2A
+	{220, 0x00, 0, 0},       // U+FE2B
+	{220, 0x00, 0, 0},       // U+FE2C
+	{220, 0x00, 0, 0},       // U+FE2D
+	{230, 0x00, 0, 0},       // U+FE2E
+	{230, 0x00, 0, 0},       // U+FE2F
+	{0, 0x04, 0, 3325},      // U+FE30
+	{0, 0x04, 0, 8662},      // U+FE31
+	{0, 0x04, 0, 8664},      // U+FE32
+	{0, 0x04, 0, 8666},      // U+FE33
+	{0, 0x04, 0, 8666},      // U+FE34
+	{0, 0x04, 0, 3386},      // U+FE35
+	{0, 0x04, 0, 3388},      // U+FE36
+	{0, 0x04, 0, 8668},      // U+FE37
+	{0, 0x04, 0, 8670},      // U+FE38
+	{0, 0x04, 0, 8672},      // U+FE39
+	{0, 0x04, 0, 8674},      // U+FE3A
+	{0, 0x04, 0, 8676},      // U+FE3B
+	{0, 0x04, 0, 8678},      // U+FE3C
+	{0, 0x04, 0, 8680},      // U+FE3D
+	{0, 0x04, 0, 8682},      // U+FE3E
+	{0, 0x04, 0, 3744},      // U+FE3F
+	{0, 0x04, 0, 3746},      // U+FE40
+	{0, 0x04, 0, 8684},      // U+FE41
+	{0, 0x04, 0, 8686},      // U+FE42
+	{0, 0x04, 0, 8688},      // U+FE43
+	{0, 0x04, 0, 8690},      // U+FE44
+	{0, 0x04, 0, 8692},      // U+FE47
+	{0, 0x04, 0, 8694},      // U+FE48
+	{0, 0x04, 0, 3349},      // U+FE49
+	{0, 0x04, 0, 3349},      // U+FE4A
+	{0, 0x04, 0, 3349},      // U+FE4B
+	{0, 0x04, 0, 3349},      // U+FE4C
+	{0, 0x04, 0, 8666},      // U+FE4D
+	{0, 0x04, 0, 8666},      // U+FE4E
+	{0, 0x04, 0, 8666},      // U+FE4F
+	{0, 0x04, 0, 8646},      // U+FE50
+	{0, 0x04, 0, 8648},      // U+FE51
+	{0, 0x04, 0, 3323},      // U+FE52
+	{0, 0x04, 0, 931},       // U+FE54
+	{0, 0x04, 0, 8652},      // U+FE55
+	{0, 0x04, 0, 8656},      // U+FE56
+	{0, 0x04, 0, 8654},      // U+FE57
+	{0, 0x04, 0, 8662},      // U+FE58
+	{0, 0x04, 0, 3386},      // U+FE59
+	{0, 0x04, 0, 3388},      // U+FE5A
+	{0, 0x04, 0, 8668},      // U+FE5B
+	{0, 0x04, 0, 8670},      // U+FE5C
+	{0, 0x04, 0, 8672},      // U+FE5D
+	{0, 0x04, 0, 8674},      // U+FE5E
+	{0, 0x04, 0, 8696},      // U+FE5F
+	{0, 0x04, 0, 8698},      // U+FE60
+	{0, 0x04, 0, 8700},      // U+FE61
+	{0, 0x04, 0, 3380},      // U+FE62
+	{0, 0x04, 0, 8702},      // U+FE63
+	{0, 0x04, 0, 8704},      // U+FE64
+	{0, 0x04, 0, 8706},      // U+FE65
+	{0, 0x04, 0, 3384},      // U+FE66
+	{0, 0x04, 0, 8708},      // U+FE68
+	{0, 0x04, 0, 8710},      // U+FE69
+	{0, 0x04, 0, 8712},      // U+FE6A
+	{0, 0x04, 0, 8714},      // U+FE6B
+	{0, 0x04, 0, 8716},      // U+FE70
+	{0, 0x04, 0, 8719},      // U+FE71
+	{0, 0x04, 0, 8722},      // U+FE72
+	{0, 0x04, 0, 8725},      // U+FE74
+	{0, 0x04, 0, 8728},      // U+FE76
+	{0, 0x04, 0, 8731},      // U+FE77
+	{0, 0x04, 0, 8734},      // U+FE78
+	{0, 0x04, 0, 8737},      // U+FE79
+	{0, 0x04, 0, 8740},      // U+FE7A
+	{0, 0x04, 0, 8743},      // U+FE7B
+	{0, 0x04, 0, 8746},      // U+FE7C
+	{0, 0x04, 0, 8749},      // U+FE7D
+	{0, 0x04, 0, 8752},      // U+FE7E
+	{0, 0x04, 0, 8755},      // U+FE7F
+	{0, 0x04, 0, 8758},      // U+FE80
+	{0, 0x04, 0, 1191},      // U+FE81
+	{0, 0x04, 0, 1191},      // U+FE82
+	{0, 0x04, 0, 1194},      // U+FE83
+	{0, 0x04, 0, 1194},      // U+FE84
+	{0, 0x04, 0, 1197},      // U+FE85
+	{0, 0x04, 0, 1197},      // U+FE86
+	{0, 0x04, 0, 1200},      // U+FE87
+	{0, 0x04, 0, 1200},      // U+FE88
+	{0, 0x04, 0, 1203},      // U+FE89
+	{0, 0x04, 0, 1203},      // U+FE8A
+	{0, 0x04, 0, 1203},      // U+FE8B
+	{0, 0x04, 0, 1203},      // U+FE8C
+	{0, 0x04, 0, 8760},      // U+FE8D
+	{0, 0x04, 0, 8760},      // U+FE8E
+	{0, 0x04, 0, 8762},      // U+FE8F
+	{0, 0x04, 0, 8762},      // U+FE90
+	{0, 0x04, 0, 8762},      // U+FE91
+	{0, 0x04, 0, 8762},      // U+FE92
+	{0, 0x04, 0, 8764},      // U+FE93
+	{0, 0x04, 0, 8764},      // U+FE94
+	{0, 0x04, 0, 8766},      // U+FE95
+	{0, 0x04, 0, 8766},      // U+FE96
+	{0, 0x04, 0, 8766},      // U+FE97
+	{0, 0x04, 0, 8766},      // U+FE98
+	{0, 0x04, 0, 8768},      // U+FE99
+	{0, 0x04, 0, 8768},      // U+FE9A
+	{0, 0x04, 0, 8768},      // U+FE9B
+	{0, 0x04, 0, 8768},      // U+FE9C
+	{0, 0x04, 0, 8770},      // U+FE9D
+	{0, 0x04, 0, 8770},      // U+FE9E
+	{0, 0x04, 0, 8770},      // U+FE9F
+	{0, 0x04, 0, 8770},      // U+FEA0
+	{0, 0x04, 0, 8772},      // U+FEA1
+	{0, 0x04, 0, 8772},      // U+FEA2
+	{0, 0x04, 0, 8772},      // U+FEA3
+	{0, 0x04, 0, 8772},      // U+FEA4
+	{0, 0x04, 0, 8774},      // U+FEA5
+	{0, 0x04, 0, 8774},      // U+FEA6
+	{0, 0x04, 0, 8774},      // U+FEA7
+	{0, 0x04, 0, 8774},      // U+FEA8
+	{0, 0x04, 0, 8776},      // U+FEA9
+	{0, 0x04, 0, 8776},      // U+FEAA
+	{0, 0x04, 0, 8778},      // U+FEAB
+	{0, 0x04, 0, 8778},      // U+FEAC
+	{0, 0x04, 0, 8780},      // U+FEAD
+	{0, 0x04, 0, 8780},      // U+FEAE
+	{0, 0x04, 0, 8782},      // U+FEAF
+	{0, 0x04, 0, 8782},      // U+FEB0
+	{0, 0x04, 0, 8784},      // U+FEB1
+	{0, 0x04, 0, 8784},      // U+FEB2
+	{0, 0x04, 0, 8784},      // U+FEB3
+	{0, 0x04, 0, 8784},      // U+FEB4
+	{0, 0x04, 0, 8786},      // U+FEB5
+	{0, 0x04, 0, 8786},      // U+FEB6
+	{0, 0x04, 0, 8786},      // U+FEB7
+	{0, 0x04, 0, 8786},      // U+FEB8
+	{0, 0x04, 0, 8788},      // U+FEB9
+	{0, 0x04, 0, 8788},      // U+FEBA
+	{0, 0x04, 0, 8788},      // U+FEBB
+	{0, 0x04, 0, 8788},      // U+FEBC
+	{0, 0x04, 0, 8790},      // U+FEBD
+	{0, 0x04, 0, 8790},      // U+FEBE
+	{0, 0x04, 0, 8790},      // U+FEBF
+	{0, 0x04, 0, 8790},      // U+FEC0
+	{0, 0x04, 0, 8792},      // U+FEC1
+	{0, 0x04, 0, 8792},      // U+FEC2
+	{0, 0x04, 0, 8792},      // U+FEC3
+	{0, 0x04, 0, 8792},      // U+FEC4
+	{0, 0x04, 0, 8794},      // U+FEC5
+	{0, 0x04, 0, 8794},      // U+FEC6
+	{0, 0x04, 0, 8794},      // U+FEC7
+	{0, 0x04, 0, 8794},      // U+FEC8
+	{0, 0x04, 0, 8796},      // U+FEC9
+	{0, 0x04, 0, 8796},      // U+FECA
+	{0, 0x04, 0, 8796},      // U+FECB
+	{0, 0x04, 0, 8796},      // U+FECC
+	{0, 0x04, 0, 8798},      // U+FECD
+	{0, 0x04, 0, 8798},      // U+FECE
+	{0, 0x04, 0, 8798},      // U+FECF
+	{0, 0x04, 0, 8798},      // U+FED0
+	{0, 0x04, 0, 8800},      // U+FED1
+	{0, 0x04, 0, 8800},      // U+FED2
+	{0, 0x04, 0, 8800},      // U+FED3
+	{0, 0x04, 0, 8800},      // U+FED4
+	{0, 0x04, 0, 8802},      // U+FED5
+	{0, 0x04, 0, 8802},      // U+FED6
+	{0, 0x04, 0, 8802},      // U+FED7
+	{0, 0x04, 0, 8802},      // U+FED8
+	{0, 0x04, 0, 8804},      // U+FED9
+	{0, 0x04, 0, 8804},      // U+FEDA
+	{0, 0x04, 0, 8804},      // U+FEDB
+	{0, 0x04, 0, 8804},      // U+FEDC
+	{0, 0x04, 0, 8806},      // U+FEDD
+	{0, 0x04, 0, 8806},      // U+FEDE
+	{0, 0x04, 0, 8806},      // U+FEDF
+	{0, 0x04, 0, 8806},      // U+FEE0
+	{0, 0x04, 0, 8808},      // U+FEE1
+	{0, 0x04, 0, 8808},      // U+FEE2
+	{0, 0x04, 0, 8808},      // U+FEE3
+	{0, 0x04, 0, 8808},      // U+FEE4
+	{0, 0x04, 0, 8810},      // U+FEE5
+	{0, 0x04, 0, 8810},      // U+FEE6
+	{0, 0x04, 0, 8810},      // U+FEE7
+	{0, 0x04, 0, 8810},      // U+FEE8
+	{0, 0x04, 0, 8812},      // U+FEE9
+	{0, 0x04, 0, 8812},      // U+FEEA
+	{0, 0x04, 0, 8812},      // U+FEEB
+	{0, 0x04, 0, 8812},      // U+FEEC
+	{0, 0x04, 0, 8814},      // U+FEED
+	{0, 0x04, 0, 8814},      // U+FEEE
+	{0, 0x04, 0, 7650},      // U+FEEF
+	{0, 0x04, 0, 7650},      // U+FEF0
+	{0, 0x04, 0, 8816},      // U+FEF1
+	{0, 0x04, 0, 8816},      // U+FEF2
+	{0, 0x04, 0, 8816},      // U+FEF3
+	{0, 0x04, 0, 8816},      // U+FEF4
+	{0, 0x04, 0, 8818},      // U+FEF5
+	{0, 0x04, 0, 8818},      // U+FEF6
+	{0, 0x04, 0, 8822},      // U+FEF7
+	{0, 0x04, 0, 8822},      // U+FEF8
+	{0, 0x04, 0, 8826},      // U+FEF9
+	{0, 0x04, 0, 8826},      // U+FEFA
+	{0, 0x04, 0, 8830},      // U+FEFB
+	{0, 0x04, 0, 8830},      // U+FEFC
+	{0, 0x04, 0, 8654},      // U+FF01
+	{0, 0x04, 0, 8833},      // U+FF02
+	{0, 0x04, 0, 8696},      // U+FF03
+	{0, 0x04, 0, 8710},      // U+FF04
+	{0, 0x04, 0, 8712},      // U+FF05
+	{0, 0x04, 0, 8698},      // U+FF06
+	{0, 0x04, 0, 8835},      // U+FF07
+	{0, 0x04, 0, 3386},      // U+FF08
+	{0, 0x04, 0, 3388},      // U+FF09
+	{0, 0x04, 0, 8700},      // U+FF0A
+	{0, 0x04, 0, 3380},      // U+FF0B
+	{0, 0x04, 0, 8646},      // U+FF0C
+	{0, 0x04, 0, 8702},      // U+FF0D
+	{0, 0x04, 0, 3323},      // U+FF0E
+	{0, 0x04, 0, 8837},      // U+FF0F
+	{0, 0x04, 0, 3366},      // U+FF10
+	{0, 0x04, 0, 23},        // U+FF11
+	{0, 0x04, 0, 11},        // U+FF12
+	{0, 0x04, 0, 13},        // U+FF13
+	{0, 0x04, 0, 3368},      // U+FF14
+	{0, 0x04, 0, 3370},      // U+FF15
+	{0, 0x04, 0, 3372},      // U+FF16
+	{0, 0x04, 0, 3374},      // U+FF17
+	{0, 0x04, 0, 3376},      // U+FF18
+	{0, 0x04, 0, 3378},      // U+FF19
+	{0, 0x04, 0, 8652},      // U+FF1A
+	{0, 0x04, 0, 931},       // U+FF1B
+	{0, 0x04, 0, 8704},      // U+FF1C
+	{0, 0x04, 0, 3384},      // U+FF1D
+	{0, 0x04, 0, 8706},      // U+FF1E
+	{0, 0x04, 0, 8656},      // U+FF1F
+	{0, 0x04, 0, 8714},      // U+FF20
+	{0, 0x04, 0, 1472},      // U+FF21
+	{0, 0x04, 0, 1476},      // U+FF22
+	{0, 0x04, 0, 3403},      // U+FF23
+	{0, 0x04, 0, 1478},      // U+FF24
+	{0, 0x04, 0, 1480},      // U+FF25
+	{0, 0x04, 0, 3442},      // U+FF26
+	{0, 0x04, 0, 1484},      // U+FF27
+	{0, 0x04, 0, 1486},      // U+FF28
+	{0, 0x04, 0, 1488},      // U+FF29
+	{0, 0x04, 0, 1490},      // U+FF2A
+	{0, 0x04, 0, 1492},      // U+FF2B
+	{0, 0x04, 0, 1494},      // U+FF2C
+	{0, 0x04, 0, 1496},      // U+FF2D
+	{0, 0x04, 0, 1498},      // U+FF2E
+	{0, 0x04, 0, 1500},      // U+FF2F
+	{0, 0x04, 0, 1504},      // U+FF30
+	{0, 0x04, 0, 3426},      // U+FF31
+	{0, 0x04, 0, 1506},      // U+FF32
+	{0, 0x04, 0, 4047},      // U+FF33
+	{0, 0x04, 0, 1508},      // U+FF34
+	{0, 0x04, 0, 1510},      // U+FF35
+	{0, 0x04, 0, 3536},      // U+FF36
+	{0, 0x04, 0, 1512},      // U+FF37
+	{0, 0x04, 0, 3553},      // U+FF38
+	{0, 0x04, 0, 4049},      // U+FF39
+	{0, 0x04, 0, 3438},      // U+FF3A
+	{0, 0x04, 0, 8692},      // U+FF3B
+	{0, 0x04, 0, 8708},      // U+FF3C
+	{0, 0x04, 0, 8694},      // U+FF3D
+	{0, 0x04, 0, 8839},      // U+FF3E
+	{0, 0x04, 0, 8666},      // U+FF3F
+	{0, 0x04, 0, 3280},      // U+FF40
+	{0, 0x04, 0, 6},         // U+FF41
+	{0, 0x04, 0, 1520},      // U+FF42
+	{0, 0x04, 0, 1572},      // U+FF43
+	{0, 0x04, 0, 1522},      // U+FF44
+	{0, 0x04, 0, 1524},      // U+FF45
+	{0, 0x04, 0, 1578},      // U+FF46
+	{0, 0x04, 0, 1532},      // U+FF47
+	{0, 0x04, 0, 873},       // U+FF48
+	{0, 0x04, 0, 1566},      // U+FF49
+	{0, 0x04, 0, 877},       // U+FF4A
+	{0, 0x04, 0, 1534},      // U+FF4B
+	{0, 0x04, 0, 911},       // U+FF4C
+	{0, 0x04, 0, 1536},      // U+FF4D
+	{0, 0x04, 0, 3390},      // U+FF4E
+	{0, 0x04, 0, 25},        // U+FF4F
+	{0, 0x04, 0, 1546},      // U+FF50
+	{0, 0x04, 0, 4051},      // U+FF51
+	{0, 0x04, 0, 879},       // U+FF52
+	{0, 0x04, 0, 537},       // U+FF53
+	{0, 0x04, 0, 1548},      // U+FF54
+	{0, 0x04, 0, 1550},      // U+FF55
+	{0, 0x04, 0, 1556},      // U+FF56
+	{0, 0x04, 0, 887},       // U+FF57
+	{0, 0x04, 0, 913},       // U+FF58
+	{0, 0x04, 0, 889},       // U+FF59
+	{0, 0x04, 0, 1632},      // U+FF5A
+	{0, 0x04, 0, 8668},      // U+FF5B
+	{0, 0x04, 0, 8841},      // U+FF5C
+	{0, 0x04, 0, 8670},      // U+FF5D
+	{0, 0x04, 0, 8843},      // U+FF5E
+	{0, 0x04, 0, 8845},      // U+FF5F
+	{0, 0x04, 0, 8847},      // U+FF60
+	{0, 0x04, 0, 8650},      // U+FF61
+	{0, 0x04, 0, 8684},      // U+FF62
+	{0, 0x04, 0, 8686},      // U+FF63
+	{0, 0x04, 0, 8648},      // U+FF64
+	{0, 0x04, 0, 8849},      // U+FF65
+	{0, 0x04, 0, 5561},      // U+FF66
+	{0, 0x04, 0, 8851},      // U+FF67
+	{0, 0x04, 0, 8853},      // U+FF68
+	{0, 0x04, 0, 8855},      // U+FF69
+	{0, 0x04, 0, 8857},      // U+FF6A
+	{0, 0x04, 0, 8859},      // U+FF6B
+	{0, 0x04, 0, 8861},      // U+FF6C
+	{0, 0x04, 0, 8863},      // U+FF6D
+	{0, 0x04, 0, 8865},      // U+FF6E
+	{0, 0x04, 0, 8867},      // U+FF6F
+	{0, 0x04, 0, 8869},      // U+FF70
+	{0, 0x04, 0, 5469},      // U+FF71
+	{0, 0x04, 0, 5471},      // U+FF72
+	{0, 0x04, 0, 5473},      // U+FF73
+	{0, 0x04, 0, 5475},      // U+FF74
+	{0, 0x04, 0, 5477},      // U+FF75
+	{0, 0x04, 0, 5479},      // U+FF76
+	{0, 0x04, 0, 5481},      // U+FF77
+	{0, 0x04, 0, 5483},      // U+FF78
+	{0, 0x04, 0, 5485},      // U+FF79
+	{0, 0x04, 0, 5487},      // U+FF7A
+	{0, 0x04, 0, 5489},      // U+FF7B
+	{0, 0x04, 0, 5491},      // U+FF7C
+	{0, 0x04, 0, 5493},      // U+FF7D
+	{0, 0x04, 0, 5495},      // U+FF7E
+	{0, 0x04, 0, 5497},      // U+FF7F
+	{0, 0x04, 0, 5499},      // U+FF80
+	{0, 0x04, 0, 5501},      // U+FF81
+	{0, 0x04, 0, 5503},      // U+FF82
+	{0, 0x04, 0, 5505},      // U+FF83
+	{0, 0x04, 0, 5507},      // U+FF84
+	{0, 0x04, 0, 5509},      // U+FF85
+	{0, 0x04, 0, 5511},      // U+FF86
+	{0, 0x04, 0, 5513},      // U+FF87
+	{0, 0x04, 0, 5515},      // U+FF88
+	{0, 0x04, 0, 5517},      // U+FF89
+	{0, 0x04, 0, 5519},      // U+FF8A
+	{0, 0x04, 0, 5521},      // U+FF8B
+	{0, 0x04, 0, 5523},      // U+FF8C
+	{0, 0x04, 0, 5525},      // U+FF8D
+	{0, 0x04, 0, 5527},      // U+FF8E
+	{0, 0x04, 0, 5529},      // U+FF8F
+	{0, 0x04, 0, 5531},      // U+FF90
+	{0, 0x04, 0, 5533},      // U+FF91
+	{0, 0x04, 0, 5535},      // U+FF92
+	{0, 0x04, 0, 5537},      // U+FF93
+	{0, 0x04, 0, 5539},      // U+FF94
+	{0, 0x04, 0, 5541},      // U+FF95
+	{0, 0x04, 0, 5543},      // U+FF96
+	{0, 0x04, 0, 5545},      // U+FF97
+	{0, 0x04, 0, 5547},      // U+FF98
+	{0, 0x04, 0, 5549},      // U+FF99
+	{0, 0x04, 0, 5551},      // U+FF9A
+	{0, 0x04, 0, 5553},      // U+FF9B
+	{0, 0x04, 0, 5555},      // U+FF9C
+	{0, 0x04, 0, 8871},      // U+FF9D
+	{0, 0x04, 0, 8873},      // U+FF9E
+	{0, 0x04, 0, 8875},      // U+FF9F
+	{0, 0x04, 0, 4800},      // U+FFA0
+	{0, 0x04, 0, 4698},      // U+FFA1
+	{0, 0x04, 0, 4700},      // U+FFA2
+	{0, 0x04, 0, 4702},      // U+FFA3
+	{0, 0x04, 0, 4704},      // U+FFA4
+	{0, 0x04, 0, 4706},      // U+FFA5
+	{0, 0x04, 0, 4708},      // U+FFA6
+	{0, 0x04, 0, 4710},      // U+FFA7
+	{0, 0x04, 0, 4712},      // U+FFA8
+	{0, 0x04, 0, 4714},      // U+FFA9
+	{0, 0x04, 0, 4716},      // U+FFAA
+	{0, 0x04, 0, 4718},      // U+FFAB
+	{0, 0x04, 0, 4720},      // U+FFAC
+	{0, 0x04, 0, 4722},      // U+FFAD
+	{0, 0x04, 0, 4724},      // U+FFAE
+	{0, 0x04, 0, 4726},      // U+FFAF
+	{0, 0x04, 0, 4728},      // U+FFB0
+	{0, 0x04, 0, 4730},      // U+FFB1
+	{0, 0x04, 0, 4732},      // U+FFB2
+	{0, 0x04, 0, 4734},      // U+FFB3
+	{0, 0x04, 0, 4736},      // U+FFB4
+	{0, 0x04, 0, 4738},      // U+FFB5
+	{0, 0x04, 0, 4740},      // U+FFB6
+	{0, 0x04, 0, 4742},      // U+FFB7
+	{0, 0x04, 0, 4744},      // U+FFB8
+	{0, 0x04, 0, 4746},      // U+FFB9
+	{0, 0x04, 0, 4748},      // U+FFBA
+	{0, 0x04, 0, 4750},      // U+FFBB
+	{0, 0x04, 0, 4752},      // U+FFBC
+	{0, 0x04, 0, 4754},      // U+FFBD
+	{0, 0x04, 0, 4756},      // U+FFBE
+	{0, 0x04, 0, 4758},      // U+FFC2
+	{0, 0x04, 0, 4760},      // U+FFC3
+	{0, 0x04, 0, 4762},      // U+FFC4
+	{0, 0x04, 0, 4764},      // U+FFC5
+	{0, 0x04, 0, 4766},      // U+FFC6
+	{0, 0x04, 0, 4768},      // U+FFC7
+	{0, 0x04, 0, 4770},      // U+FFCA
+	{0, 0x04, 0, 4772},      // U+FFCB
+	{0, 0x04, 0, 4774},      // U+FFCC
+	{0, 0x04, 0, 4776},      // U+FFCD
+	{0, 0x04, 0, 4778},      // U+FFCE
+	{0, 0x04, 0, 4780},      // U+FFCF
+	{0, 0x04, 0, 4782},      // U+FFD2
+	{0, 0x04, 0, 4784},      // U+FFD3
+	{0, 0x04, 0, 4786},      // U+FFD4
+	{0, 0x04, 0, 4788},      // U+FFD5
+	{0, 0x04, 0, 4790},      // U+FFD6
+	{0, 0x04, 0, 4792},      // U+FFD7
+	{0, 0x04, 0, 4794},      // U+FFDA
+	{0, 0x04, 0, 4796},      // U+FFDB
+	{0, 0x04, 0, 4798},      // U+FFDC
+	{0, 0x04, 0, 8877},      // U+FFE0
+	{0, 0x04, 0, 8879},      // U+FFE1
+	{0, 0x04, 0, 8881},      // U+FFE2
+	{0, 0x04, 0, 8},         // U+FFE3
+	{0, 0x04, 0, 8883},      // U+FFE4
+	{0, 0x04, 0, 8885},      // U+FFE5
+	{0, 0x04, 0, 8887},      // U+FFE6
+	{0, 0x04, 0, 8889},      // U+FFE8
+	{0, 0x04, 0, 8891},      // U+FFE9
+	{0, 0x04, 0, 8893},      // U+FFEA
+	{0, 0x04, 0, 8895},      // U+FFEB
+	{0, 0x04, 0, 8897},      // U+FFEC
+	{0, 0x04, 0, 8899},      // U+FFED
+	{0, 0x04, 0, 8901},      // U+FFEE
+	{220, 0x00, 0, 0},       // U+101FD
+	{220, 0x00, 0, 0},       // U+102E0
+	{230, 0x00, 0, 0},       // U+10376
+	{230, 0x00, 0, 0},       // U+10377
+	{230, 0x00, 0, 0},       // U+10378
+	{230, 0x00, 0, 0},       // U+10379
+	{230, 0x00, 0, 0},       // U+1037A
+	{0, 0x04, 0, 8903},      // U+10781
+	{0, 0x04, 0, 8905},      // U+10782
+	{0, 0x04, 0, 8907},      // U+10783
+	{0, 0x04, 0, 8909},      // U+10784
+	{0, 0x04, 0, 8911},      // U+10785
+	{0, 0x04, 0, 8913},      // U+10787
+	{0, 0x04, 0, 8915},      // U+10788
+	{0, 0x04, 0, 8917},      // U+10789
+	{0, 0x04, 0, 8919},      // U+1078A
+	{0, 0x04, 0, 8921},      // U+1078B
+	{0, 0x04, 0, 8923},      // U+1078C
+	{0, 0x04, 0, 8925},      // U+1078D
+	{0, 0x04, 0, 8927},      // U+1078E
+	{0, 0x04, 0, 8929},      // U+1078F
+	{0, 0x04, 0, 8931},      // U+10790
+	{0, 0x04, 0, 8933},      // U+10791
+	{0, 0x04, 0, 8935},      // U+10792
+	{0, 0x04, 0, 8937},      // U+10793
+	{0, 0x04, 0, 8939},      // U+10794
+	{0, 0x04, 0, 3421},      // U+10795
+	{0, 0x04, 0, 8941},      // U+10796
+	{0, 0x04, 0, 8943},      // U+10797
+	{0, 0x04, 0, 8945},      // U+10798
+	{0, 0x04, 0, 8947},      // U+10799
+	{0, 0x04, 0, 8949},      // U+1079A
+	{0, 0x04, 0, 8951},      // U+1079B
+	{0, 0x04, 0, 8953},      // U+1079C
+	{0, 0x04, 0, 8955},      // U+1079D
+	{0, 0x04, 0, 8957},      // U+1079E
+	{0, 0x04, 0, 8959},      // U+1079F
+	{0, 0x04, 0, 8961},      // U+107A0
+	{0, 0x04, 0, 8963},      // U+107A1
+	{0, 0x04, 0, 8965},      // U+107A2
+	{0, 0x04, 0, 8967},      // U+107A3
+	{0, 0x04, 0, 8969},      // U+107A4
+	{0, 0x04, 0, 4051},      // U+107A5
+	{0, 0x04, 0, 8971},      // U+107A6
+	{0, 0x04, 0, 8973},      // U+107A7
+	{0, 0x04, 0, 8975},      // U+107A8
+	{0, 0x04, 0, 8977},      // U+107A9
+	{0, 0x04, 0, 8979},      // U+107AA
+	{0, 0x04, 0, 8981},      // U+107AB
+	{0, 0x04, 0, 8983},      // U+107AC
+	{0, 0x04, 0, 8985},      // U+107AD
+	{0, 0x04, 0, 8987},      // U+107AE
+	{0, 0x04, 0, 8989},      // U+107AF
+	{0, 0x04, 0, 8991},      // U+107B0
+	{0, 0x04, 0, 8993},      // U+107B2
+	{0, 0x04, 0, 8995},      // U+107B3
+	{0, 0x04, 0, 8997},      // U+107B4
+	{0, 0x04, 0, 8999},      // U+107B5
+	{0, 0x04, 0, 9001},      // U+107B6
+	{0, 0x04, 0, 9003},      // U+107B7
+	{0, 0x04, 0, 9005},      // U+107B8
+	{0, 0x04, 0, 9007},      // U+107B9
+	{0, 0x04, 0, 9009},      // U+107BA
+	{220, 0x00, 0, 0},       // U+10A0D
+	{230, 0x00, 0, 0},       // U+10A0F
+	{230, 0x00, 0, 0},       // U+10A38
+	{1, 0x00, 0, 0},         // U+10A39
+	{220, 0x00, 0, 0},       // U+10A3A
+	{9, 0x00, 0, 0},         // U+10A3F
+	{230, 0x00, 0, 0},       // U+10AE5
+	{220, 0x00, 0, 0},       // U+10AE6
+	{230, 0x00, 0, 0},       // U+10D24
+	{230, 0x00, 0, 0},       // U+10D25
+	{230, 0x00, 0, 0},       // U+10D26
+	{230, 0x00, 0, 0},       // U+10D27
+	{230, 0x00, 0, 0},       // U+10EAB
+	{230, 0x00, 0, 0},       // U+10EAC
+	{220, 0x00, 0, 0},       // U+10EFD
+	{220, 0x00, 0, 0},       // U+10EFE
+	{220, 0x00, 0, 0},       // U+10EFF
+	{220, 0x00, 0, 0},       // U+10F46
+	{220, 0x00, 0, 0},       // U+10F47
+	{230, 0x00, 0, 0},       // U+10F48
+	{230, 0x00, 0, 0},       // U+10F49
+	{230, 0x00, 0, 0},       // U+10F4A
+	{220, 0x00, 0, 0},       // U+10F4B
+	{230, 0x00, 0, 0},       // U+10F4C
+	{220, 0x00, 0, 0},       // U+10F4D
+	{220, 0x00, 0, 0},       // U+10F4E
+	{220, 0x00, 0, 0},       // U+10F4F
+	{220, 0x00, 0, 0},       // U+10F50
+	{230, 0x00, 0, 0},       // U+10F82
+	{220, 0x00, 0, 0},       // U+10F83
+	{230, 0x00, 0, 0},       // U+10F84
+	{220, 0x00, 0, 0},       // U+10F85
+	{9, 0x00, 0, 0},         // U+11046
+	{9, 0x00, 0, 0},         // U+11070
+	{9, 0x00, 0, 0},         // U+1107F
+	{0, 0x00, 9011, 9011},   // U+1109A
+	{0, 0x00, 9014, 9014},   // U+1109C
+	{0, 0x00, 9017, 9017},   // U+110AB
+	{9, 0x00, 0, 0},         // U+110B9
+	{7, 0x0A, 0, 0},         // U+110BA
+	{230, 0x00, 0, 0},       // U+11100
+	{230, 0x00, 0, 0},       // U+11101
+	{230, 0x00, 0, 0},       // U+11102
+	{0, 0x0A, 0, 0},         // U+11127
+	{0, 0x00, 9020, 9020},   // U+1112E
+	{0, 0x00, 9023, 9023},   // U+1112F
+	{9, 0x00, 0, 0},         // U+11133
+	{9, 0x00, 0, 0},         // U+11134
+	{7, 0x00, 0, 0},         // U+11173
+	{9, 0x00, 0, 0},         // U+111C0
+	{7, 0x00, 0, 0},         // U+111CA
+	{9, 0x00, 0, 0},         // U+11235
+	{7, 0x00, 0, 0},         // U+11236
+	{7, 0x00, 0, 0},         // U+112E9
+	{9, 0x00, 0, 0},         // U+112EA
+	{7, 0x00, 0, 0},         // U+1133B
+	{7, 0x00, 0, 0},         // U+1133C
+	{0, 0x0A, 0, 0},         // U+1133E
+	{0, 0x00, 9026, 9026},   // U+1134B
+	{0, 0x00, 9029, 9029},   // U+1134C
+	{9, 0x00, 0, 0},         // U+1134D
+	{0, 0x0A, 0, 0},         // U+11357
+	{230, 0x00, 0, 0},       // U+11366
+	{230, 0x00, 0, 0},       // U+11367
+	{230, 0x00, 0, 0},       // U+11368
+	{230, 0x00, 0, 0},       // U+11369
+	{230, 0x00, 0, 0},       // U+1136A
+	{230, 0x00, 0, 0},       // U+1136B
+	{230, 0x00, 0, 0},       // U+1136C
+	{230, 0x00, 0, 0},       // U+11370
+	{230, 0x00, 0, 0},       // U+11371
+	{230, 0x00, 0, 0},       // U+11372
+	{230, 0x00, 0, 0},       // U+11373
+	{230, 0x00, 0, 0},       // U+11374
+	{9, 0x00, 0, 0},         // U+11442
+	{7, 0x00, 0, 0},         // U+11446
+	{230, 0x00, 0, 0},       // U+1145E
+	{0, 0x0A, 0, 0},         // U+114B0
+	{0, 0x0A, 0, 0},         // U+114BA
+	{0, 0x00, 9032, 9032},   // U+114BB
+	{0, 0x00, 9035, 9035},   // U+114BC
+	{0, 0x0A, 0, 0},         // U+114BD
+	{0, 0x00, 9038, 9038},   // U+114BE
+	{9, 0x00, 0, 0},         // U+114C2
+	{7, 0x00, 0, 0},         // U+114C3
+	{0, 0x0A, 0, 0},         // U+115AF
+	{0, 0x00, 9041, 9041},   // U+115BA
+	{0, 0x00, 9044, 9044},   // U+115BB
+	{9, 0x00, 0, 0},         // U+115BF
+	{7, 0x00, 0, 0},         // U+115C0
+	{9, 0x00, 0, 0},         // U+1163F
+	{9, 0x00, 0, 0},         // U+116B6
+	{7, 0x00, 0, 0},         // U+116B7
+	{9, 0x00, 0, 0},         // U+1172B
+	{9, 0x00, 0, 0},         // U+11839
+	{7, 0x00, 0, 0},         // U+1183A
+	{0, 0x0A, 0, 0},         // U+11930
+	{0, 0x00, 9047, 9047},   // U+11938
+	{9, 0x00, 0, 0},         // U+1193D
+	{9, 0x00, 0, 0},         // U+1193E
+	{7, 0x00, 0, 0},         // U+11943
+	{9, 0x00, 0, 0},         // U+119E0
+	{9, 0x00, 0, 0},         // U+11A34
+	{9, 0x00, 0, 0},         // U+11A47
+	{9, 0x00, 0, 0},         // U+11A99
+	{9, 0x00, 0, 0},         // U+11C3F
+	{7, 0x00, 0, 0},         // U+11D42
+	{9, 0x00, 0, 0},         // U+11D44
+	{9, 0x00, 0, 0},         // U+11D45
+	{9, 0x00, 0, 0},         // U+11D97
+	{9, 0x00, 0, 0},         // U+11F41
+	{9, 0x00, 0, 0},         // U+11F42
+	{1, 0x00, 0, 0},         // U+16AF0
+	{1, 0x00, 0, 0},         // U+16AF1
+	{1, 0x00, 0, 0},         // U+16AF2
+	{1, 0x00, 0, 0},         // U+16AF3
+	{1, 0x00, 0, 0},         // U+16AF4
+	{230, 0x00, 0, 0},       // U+16B30
+	{230, 0x00, 0, 0},       // U+16B31
+	{230, 0x00, 0, 0},       // U+16B32
+	{230, 0x00, 0, 0},       // U+16B33
+	{230, 0x00, 0, 0},       // U+16B34
+	{230, 0x00, 0, 0},       // U+16B35
+	{230, 0x00, 0, 0},       // U+16B36
+	{6, 0x00, 0, 0},         // U+16FF0
+	{6, 0x00, 0, 0},         // U+16FF1
+	{1, 0x00, 0, 0},         // U+1BC9E
+	{0, 0x05, 9050, 9050},   // U+1D15E
+	{0, 0x05, 9053, 9053},   // U+1D15F
+	{0, 0x05, 9056, 9056},   // U+1D160
+	{0, 0x05, 9060, 9060},   // U+1D161
+	{0, 0x05, 9064, 9064},   // U+1D162
+	{0, 0x05, 9068, 9068},   // U+1D163
+	{0, 0x05, 9072, 9072},   // U+1D164
+	{216, 0x00, 0, 0},       // U+1D165
+	{216, 0x00, 0, 0},       // U+1D166
+	{1, 0x00, 0, 0},         // U+1D167
+	{1, 0x00, 0, 0},         // U+1D168
+	{1, 0x00, 0, 0},         // U+1D169
+	{226, 0x00, 0, 0},       // U+1D16D
+	{216, 0x00, 0, 0},       // U+1D16E
+	{216, 0x00, 0, 0},       // U+1D16F
+	{216, 0x00, 0, 0},       // U+1D170
+	{216, 0x00, 0, 0},       // U+1D171
+	{216, 0x00, 0, 0},       // U+1D172
+	{220, 0x00, 0, 0},       // U+1D17B
+	{220, 0x00, 0, 0},       // U+1D17C
+	{220, 0x00, 0, 0},       // U+1D17D
+	{220, 0x00, 0, 0},       // U+1D17E
+	{220, 0x00, 0, 0},       // U+1D17F
+	{220, 0x00, 0, 0},       // U+1D180
+	{220, 0x00, 0, 0},       // U+1D181
+	{220, 0x00, 0, 0},       // U+1D182
+	{230, 0x00, 0, 0},       // U+1D185
+	{230, 0x00, 0, 0},       // U+1D186
+	{230, 0x00, 0, 0},       // U+1D187
+	{230, 0x00, 0, 0},       // U+1D188
+	{230, 0x00, 0, 0},       // U+1D189
+	{220, 0x00, 0, 0},       // U+1D18A
+	{220, 0x00, 0, 0},       // U+1D18B
+	{230, 0x00, 0, 0},       // U+1D1AA
+	{230, 0x00, 0, 0},       // U+1D1AB
+	{230, 0x00, 0, 0},       // U+1D1AC
+	{230, 0x00, 0, 0},       // U+1D1AD
+	{0, 0x05, 9076, 9076},   // U+1D1BB
+	{0, 0x05, 9079, 9079},   // U+1D1BC
+	{0, 0x05, 9082, 9082},   // U+1D1BD
+	{0, 0x05, 9086, 9086},   // U+1D1BE
+	{0, 0x05, 9090, 9090},   // U+1D1BF
+	{0, 0x05, 9094, 9094},   // U+1D1C0
+	{230, 0x00, 0, 0},       // U+1D242
+	{230, 0x00, 0, 0},       // U+1D243
+	{230, 0x00, 0, 0},       // U+1D244
+	{0, 0x04, 0, 1472},      // U+1D400
+	{0, 0x04, 0, 1476},      // U+1D401
+	{0, 0x04, 0, 3403},      // U+1D402
+	{0, 0x04, 0, 1478},      // U+1D403
+	{0, 0x04, 0, 1480},      // U+1D404
+	{0, 0x04, 0, 3442},      // U+1D405
+	{0, 0x04, 0, 1484},      // U+1D406
+	{0, 0x04, 0, 1486},      // U+1D407
+	{0, 0x04, 0, 1488},      // U+1D408
+	{0, 0x04, 0, 1490},      // U+1D409
+	{0, 0x04, 0, 1492},      // U+1D40A
+	{0, 0x04, 0, 1494},      // U+1D40B
+	{0, 0x04, 0, 1496},      // U+1D40C
+	{0, 0x04, 0, 1498},      // U+1D40D
+	{0, 0x04, 0, 1500},      // U+1D40E
+	{0, 0x04, 0, 1504},      // U+1D40F
+	{0, 0x04, 0, 3426},      // U+1D410
+	{0, 0x04, 0, 1506},      // U+1D411
+	{0, 0x04, 0, 4047},      // U+1D412
+	{0, 0x04, 0, 1508},      // U+1D413
+	{0, 0x04, 0, 1510},      // U+1D414
+	{0, 0x04, 0, 3536},      // U+1D415
+	{0, 0x04, 0, 1512},      // U+1D416
+	{0, 0x04, 0, 3553},      // U+1D417
+	{0, 0x04, 0, 4049},      // U+1D418
+	{0, 0x04, 0, 3438},      // U+1D419
+	{0, 0x04, 0, 6},         // U+1D41A
+	{0, 0x04, 0, 1520},      // U+1D41B
+	{0, 0x04, 0, 1572},      // U+1D41C
+	{0, 0x04, 0, 1522},      // U+1D41D
+	{0, 0x04, 0, 1524},      // U+1D41E
+	{0, 0x04, 0, 1578},      // U+1D41F
+	{0, 0x04, 0, 1532},      // U+1D420
+	{0, 0x04, 0, 873},       // U+1D421
+	{0, 0x04, 0, 1566},      // U+1D422
+	{0, 0x04, 0, 877},       // U+1D423
+	{0, 0x04, 0, 1534},      // U+1D424
+	{0, 0x04, 0, 911},       // U+1D425
+	{0, 0x04, 0, 1536},      // U+1D426
+	{0, 0x04, 0, 3390},      // U+1D427
+	{0, 0x04, 0, 25},        // U+1D428
+	{0, 0x04, 0, 1546},      // U+1D429
+	{0, 0x04, 0, 4051},      // U+1D42A
+	{0, 0x04, 0, 879},       // U+1D42B
+	{0, 0x04, 0, 537},       // U+1D42C
+	{0, 0x04, 0, 1548},      // U+1D42D
+	{0, 0x04, 0, 1550},      // U+1D42E
+	{0, 0x04, 0, 1556},      // U+1D42F
+	{0, 0x04, 0, 887},       // U+1D430
+	{0, 0x04, 0, 913},       // U+1D431
+	{0, 0x04, 0, 889},       // U+1D432
+	{0, 0x04, 0, 1632},      // U+1D433
+	{0, 0x04, 0, 1472},      // U+1D434
+	{0, 0x04, 0, 1476},      // U+1D435
+	{0, 0x04, 0, 3403},      // U+1D436
+	{0, 0x04, 0, 1478},      // U+1D437
+	{0, 0x04, 0, 1480},      // U+1D438
+	{0, 0x04, 0, 3442},      // U+1D439
+	{0, 0x04, 0, 1484},      // U+1D43A
+	{0, 0x04, 0, 1486},      // U+1D43B
+	{0, 0x04, 0, 1488},      // U+1D43C
+	{0, 0x04, 0, 1490},      // U+1D43D
+	{0, 0x04, 0, 1492},      // U+1D43E
+	{0, 0x04, 0, 1494},      // U+1D43F
+	{0, 0x04, 0, 1496},      // U+1D440
+	{0, 0x04, 0, 1498},      // U+1D441
+	{0, 0x04, 0, 1500},      // U+1D442
+	{0, 0x04, 0, 1504},      // U+1D443
+	{0, 0x04, 0, 3426},      // U+1D444
+	{0, 0x04, 0, 1506},      // U+1D445
+	{0, 0x04, 0, 4047},      // U+1D446
+	{0, 0x04, 0, 1508},      // U+1D447
+	{0, 0x04, 0, 1510},      // U+1D448
+	{0, 0x04, 0, 3536},      // U+1D449
+	{0, 0x04, 0, 1512},      // U+1D44A
+	{0, 0x04, 0, 3553},      // U+1D44B
+	{0, 0x04, 0, 4049},      // U+1D44C
+	{0, 0x04, 0, 3438},      // U+1D44D
+	{0, 0x04, 0, 6},         // U+1D44E
+	{0, 0x04, 0, 1520},      // U+1D44F
+	{0, 0x04, 0, 1572},      // U+1D450
+	{0, 0x04, 0, 1522},      // U+1D451
+	{0, 0x04, 0, 1524},      // U+1D452
+	{0, 0x04, 0, 1578},      // U+1D453
+	{0, 0x04, 0, 1532},      // U+1D454
+	{0, 0x04, 0, 1566},      // U+1D456
+	{0, 0x04, 0, 877},       // U+1D457
+	{0, 0x04, 0, 1534},      // U+1D458
+	{0, 0x04, 0, 911},       // U+1D459
+	{0, 0x04, 0, 1536},      // U+1D45A
+	{0, 0x04, 0, 3390},      // U+1D45B
+	{0, 0x04, 0, 25},        // U+1D45C
+	{0, 0x04, 0, 1546},      // U+1D45D
+	{0, 0x04, 0, 4051},      // U+1D45E
+	{0, 0x04, 0, 879},       // U+1D45F
+	{0, 0x04, 0, 537},       // U+1D460
+	{0, 0x04, 0, 1548},      // U+1D461
+	{0, 0x04, 0, 1550},      // U+1D462
+	{0, 0x04, 0, 1556},      // U+1D463
+	{0, 0x04, 0, 887},       // U+1D464
+	{0, 0x04, 0, 913},       // U+1D465
+	{0, 0x04, 0, 889},       // U+1D466
+	{0, 0x04, 0, 1632},      // U+1D467
+	{0, 0x04, 0, 1472},      // U+1D468
+	{0, 0x04, 0, 1476},      // U+1D469
+	{0, 0x04, 0, 3403},      // U+1D46A
+	{0, 0x04, 0, 1478},      // U+1D46B
+	{0, 0x04, 0, 1480},      // U+1D46C
+	{0, 0x04, 0, 3442},      // U+1D46D
+	{0, 0x04, 0, 1484},      // U+1D46E
+	{0, 0x04, 0, 1486},      // U+1D46F
+	{0, 0x04, 0, 1488},      // U+1D470
+	{0, 0x04, 0, 1490},      // U+1D471
+	{0, 0x04, 0, 1492},      // U+1D472
+	{0, 0x04, 0, 1494},      // U+1D473
+	{0, 0x04, 0, 1496},      // U+1D474
+	{0, 0x04, 0, 1498},      // U+1D475
+	{0, 0x04, 0, 1500},      // U+1D476
+	{0, 0x04, 0, 1504},      // U+1D477
+	{0, 0x04, 0, 3426},      // U+1D478
+	{0, 0x04, 0, 1506},      // U+1D479
+	{0, 0x04, 0, 4047},      // U+1D47A
+	{0, 0x04, 0, 1508},      // U+1D47B
+	{0, 0x04, 0, 1510},      // U+1D47C
+	{0, 0x04, 0, 3536},      // U+1D47D
+	{0, 0x04, 0, 1512},      // U+1D47E
+	{0, 0x04, 0, 3553},      // U+1D47F
+	{0, 0x04, 0, 4049},      // U+1D480
+	{0, 0x04, 0, 3438},      // U+1D481
+	{0, 0x04, 0, 6},         // U+1D482
+	{0, 0x04, 0, 1520},      // U+1D483
+	{0, 0x04, 0, 1572},      // U+1D484
+	{0, 0x04, 0, 1522},      // U+1D485
+	{0, 0x04, 0, 1524},      // U+1D486
+	{0, 0x04, 0, 1578},      // U+1D487
+	{0, 0x04, 0, 1532},      // U+1D488
+	{0, 0x04, 0, 873},       // U+1D489
+	{0, 0x04, 0, 1566},      // U+1D48A
+	{0, 0x04, 0, 877},       // U+1D48B
+	{0, 0x04, 0, 1534},      // U+1D48C
+	{0, 0x04, 0, 911},       // U+1D48D
+	{0, 0x04, 0, 1536},      // U+1D48E
+	{0, 0x04, 0, 3390},      // U+1D48F
+	{0, 0x04, 0, 25},        // U+1D490
+	{0, 0x04, 0, 1546},      // U+1D491
+	{0, 0x04, 0, 4051},      // U+1D492
+	{0, 0x04, 0, 879},       // U+1D493
+	{0, 0x04, 0, 537},       // U+1D494
+	{0, 0x04, 0, 1548},      // U+1D495
+	{0, 0x04, 0, 1550},      // U+1D496
+	{0, 0x04, 0, 1556},      // U+1D497
+	{0, 0x04, 0, 887},       // U+1D498
+	{0, 0x04, 0, 913},       // U+1D499
+	{0, 0x04, 0, 889},       // U+1D49A
+	{0, 0x04, 0, 1632},      // U+1D49B
+	{0, 0x04, 0, 1472},      // U+1D49C
+	{0, 0x04, 0, 3403},      // U+1D49E
+	{0, 0x04, 0, 1478},      // U+1D49F
+	{0, 0x04, 0, 1484},      // U+1D4A2
+	{0, 0x04, 0, 1490},      // U+1D4A5
+	{0, 0x04, 0, 1492},      // U+1D4A6
+	{0, 0x04, 0, 1498},      // U+1D4A9
+	{0, 0x04, 0, 1500},      // U+1D4AA
+	{0, 0x04, 0, 1504},      // U+1D4AB
+	{0, 0x04, 0, 3426},      // U+1D4AC
+	{0, 0x04, 0, 4047},      // U+1D4AE
+	{0, 0x04, 0, 1508},      // U+1D4AF
+	{0, 0x04, 0, 1510},      // U+1D4B0
+	{0, 0x04, 0, 3536},      // U+1D4B1
+	{0, 0x04, 0, 1512},      // U+1D4B2
+	{0, 0x04, 0, 3553},      // U+1D4B3
+	{0, 0x04, 0, 4049},      // U+1D4B4
+	{0, 0x04, 0, 3438},      // U+1D4B5
+	{0, 0x04, 0, 6},         // U+1D4B6
+	{0, 0x04, 0, 1520},      // U+1D4B7
+	{0, 0x04, 0, 1572},      // U+1D4B8
+	{0, 0x04, 0, 1522},      // U+1D4B9
+	{0, 0x04, 0, 1578},      // U+1D4BB
+	{0, 0x04, 0, 873},       // U+1D4BD
+	{0, 0x04, 0, 1566},      // U+1D4BE
+	{0, 0x04, 0, 877},       // U+1D4BF
+	{0, 0x04, 0, 1534},      // U+1D4C0
+	{0, 0x04, 0, 911},       // U+1D4C1
+	{0, 0x04, 0, 1536},      // U+1D4C2
+	{0, 0x04, 0, 3390},      // U+1D4C3
+	{0, 0x04, 0, 1546},      // U+1D4C5
+	{0, 0x04, 0, 4051},      // U+1D4C6
+	{0, 0x04, 0, 879},       // U+1D4C7
+	{0, 0x04, 0, 537},       // U+1D4C8
+	{0, 0x04, 0, 1548},      // U+1D4C9
+	{0, 0x04, 0, 1550},      // U+1D4CA
+	{0, 0x04, 0, 1556},      // U+1D4CB
+	{0, 0x04, 0, 887},       // U+1D4CC
+	{0, 0x04, 0, 913},       // U+1D4CD
+	{0, 0x04, 0, 889},       // U+1D4CE
+	{0, 0x04, 0, 1632},      // U+1D4CF
+	{0, 0x04, 0, 1472},      // U+1D4D0
+	{0, 0x04, 0, 1476},      // U+1D4D1
+	{0, 0x04, 0, 3403},      // U+1D4D2
+	{0, 0x04, 0, 1478},      // U+1D4D3
+	{0, 0x04, 0, 1480},      // U+1D4D4
+	{0, 0x04, 0, 3442},      // U+1D4D5
+	{0, 0x04, 0, 1484},      // U+1D4D6
+	{0, 0x04, 0, 1486},      // U+1D4D7
+	{0, 0x04, 0, 1488},      // U+1D4D8
+	{0, 0x04, 0, 1490},      // U+1D4D9
+	{0, 0x04, 0, 1492},      // U+1D4DA
+	{0, 0x04, 0, 1494},      // U+1D4DB
+	{0, 0x04, 0, 1496},      // U+1D4DC
+	{0, 0x04, 0, 1498},      // U+1D4DD
+	{0, 0x04, 0, 1500},      // U+1D4DE
+	{0, 0x04, 0, 1504},      // U+1D4DF
+	{0, 0x04, 0, 3426},      // U+1D4E0
+	{0, 0x04, 0, 1506},      // U+1D4E1
+	{0, 0x04, 0, 4047},      // U+1D4E2
+	{0, 0x04, 0, 1508},      // U+1D4E3
+	{0, 0x04, 0, 1510},      // U+1D4E4
+	{0, 0x04, 0, 3536},      // U+1D4E5
+	{0, 0x04, 0, 1512},      // U+1D4E6
+	{0, 0x04, 0, 3553},      // U+1D4E7
+	{0, 0x04, 0, 4049},      // U+1D4E8
+	{0, 0x04, 0, 3438},      // U+1D4E9
+	{0, 0x04, 0, 6},         // U+1D4EA
+	{0, 0x04, 0, 1520},      // U+1D4EB
+	{0, 0x04, 0, 1572},      // U+1D4EC
+	{0, 0x04, 0, 1522},      // U+1D4ED
+	{0, 0x04, 0, 1524},      // U+1D4EE
+	{0, 0x04, 0, 1578},      // U+1D4EF
+	{0, 0x04, 0, 1532},      // U+1D4F0
+	{0, 0x04, 0, 873},       // U+1D4F1
+	{0, 0x04, 0, 1566},      // U+1D4F2
+	{0, 0x04, 0, 877},       // U+1D4F3
+	{0, 0x04, 0, 1534},      // U+1D4F4
+	{0, 0x04, 0, 911},       // U+1D4F5
+	{0, 0x04, 0, 1536},      // U+1D4F6
+	{0, 0x04, 0, 3390},      // U+1D4F7
+	{0, 0x04, 0, 25},        // U+1D4F8
+	{0, 0x04, 0, 1546},      // U+1D4F9
+	{0, 0x04, 0, 4051},      // U+1D4FA
+	{0, 0x04, 0, 879},       // U+1D4FB
+	{0, 0x04, 0, 537},       // U+1D4FC
+	{0, 0x04, 0, 1548},      // U+1D4FD
+	{0, 0x04, 0, 1550},      // U+1D4FE
+	{0, 0x04, 0, 1556},      // U+1D4FF
+	{0, 0x04, 0, 887},       // U+1D500
+	{0, 0x04, 0, 913},       // U+1D501
+	{0, 0x04, 0, 889},       // U+1D502
+	{0, 0x04, 0, 1632},      // U+1D503
+	{0, 0x04, 0, 1472},      // U+1D504
+	{0, 0x04, 0, 1476},      // U+1D505
+	{0, 0x04, 0, 1478},      // U+1D507
+	{0, 0x04, 0, 1480},      // U+1D508
+	{0, 0x04, 0, 3442},      // U+1D509
+	{0, 0x04, 0, 1484},      // U+1D50A
+	{0, 0x04, 0, 1490},      // U+1D50D
+	{0, 0x04, 0, 1492},      // U+1D50E
+	{0, 0x04, 0, 1494},      // U+1D50F
+	{0, 0x04, 0, 1496},      // U+1D510
+	{0, 0x04, 0, 1498},      // U+1D511
+	{0, 0x04, 0, 1500},      // U+1D512
+	{0, 0x04, 0, 1504},      // U+1D513
+	{0, 0x04, 0, 3426},      // U+1D514
+	{0, 0x04, 0, 4047},      // U+1D516
+	{0, 0x04, 0, 1508},      // U+1D517
+	{0, 0x04, 0, 1510},      // U+1D518
+	{0, 0x04, 0, 3536},      // U+1D519
+	{0, 0x04, 0, 1512},      // U+1D51A
+	{0, 0x04, 0, 3553},      // U+1D51B
+	{0, 0x04, 0, 4049},      // U+1D51C
+	{0, 0x04, 0, 6},         // U+1D51E
+	{0, 0x04, 0, 1520},      // U+1D51F
+	{0, 0x04, 0, 1572},      // U+1D520
+	{0, 0x04, 0, 1522},      // U+1D521
+	{0, 0x04, 0, 1524},      // U+1D522
+	{0, 0x04, 0, 1578},      // U+1D523
+	{0, 0x04, 0, 1532},      // U+1D524
+	{0, 0x04, 0, 873},       // U+1D525
+	{0, 0x04, 0, 1566},      // U+1D526
+	{0, 0x04, 0, 877},       // U+1D527
+	{0, 0x04, 0, 1534},      // U+1D528
+	{0, 0x04, 0, 911},       // U+1D529
+	{0, 0x04, 0, 1536},      // U+1D52A
+	{0, 0x04, 0, 3390},      // U+1D52B
+	{0, 0x04, 0, 25},        // U+1D52C
+	{0, 0x04, 0, 1546},      // U+1D52D
+	{0, 0x04, 0, 4051},      // U+1D52E
+	{0, 0x04, 0, 879},       // U+1D52F
+	{0, 0x04, 0, 537},       // U+1D530
+	{0, 0x04, 0, 1548},      // U+1D531
+	{0, 0x04, 0, 1550},      // U+1D532
+	{0, 0x04, 0, 1556},      // U+1D533
+	{0, 0x04, 0, 887},       // U+1D534
+	{0, 0x04, 0, 913},       // U+1D535
+	{0, 0x04, 0, 889},       // U+1D536
+	{0, 0x04, 0, 1632},      // U+1D537
+	{0, 0x04, 0, 1472},      // U+1D538
+	{0, 0x04, 0, 1476},      // U+1D539
+	{0, 0x04, 0, 1478},      // U+1D53B
+	{0, 0x04, 0, 1480},      // U+1D53C
+	{0, 0x04, 0, 3442},      // U+1D53D
+	{0, 0x04, 0, 1484},      // U+1D53E
+	{0, 0x04, 0, 1488},      // U+1D540
+	{0, 0x04, 0, 1490},      // U+1D541
+	{0, 0x04, 0, 1492},      // U+1D542
+	{0, 0x04, 0, 1494},      // U+1D543
+	{0, 0x04, 0, 1496},      // U+1D544
+	{0, 0x04, 0, 1500},      // U+1D546
+	{0, 0x04, 0, 4047},      // U+1D54A
+	{0, 0x04, 0, 1508},      // U+1D54B
+	{0, 0x04, 0, 1510},      // U+1D54C
+	{0, 0x04, 0, 3536},      // U+1D54D
+	{0, 0x04, 0, 1512},      // U+1D54E
+	{0, 0x04, 0, 3553},      // U+1D54F
+	{0, 0x04, 0, 4049},      // U+1D550
+	{0, 0x04, 0, 6},         // U+1D552
+	{0, 0x04, 0, 1520},      // U+1D553
+	{0, 0x04, 0, 1572},      // U+1D554
+	{0, 0x04, 0, 1522},      // U+1D555
+	{0, 0x04, 0, 1524},      // U+1D556
+	{0, 0x04, 0, 1578},      // U+1D557
+	{0, 0x04, 0, 1532},      // U+1D558
+	{0, 0x04, 0, 873},       // U+1D559
+	{0, 0x04, 0, 1566},      // U+1D55A
+	{0, 0x04, 0, 877},       // U+1D55B
+	{0, 0x04, 0, 1534},      // U+1D55C
+	{0, 0x04, 0, 911},       // U+1D55D
+	{0, 0x04, 0, 1536},      // U+1D55E
+	{0, 0x04, 0, 3390},      // U+1D55F
+	{0, 0x04, 0, 25},        // U+1D560
+	{0, 0x04, 0, 1546},      // U+1D561
+	{0, 0x04, 0, 4051},      // U+1D562
+	{0, 0x04, 0, 879},       // U+1D563
+	{0, 0x04, 0, 537},       // U+1D564
+	{0, 0x04, 0, 1548},      // U+1D565
+	{0, 0x04, 0, 1550},      // U+1D566
+	{0, 0x04, 0, 1556},      // U+1D567
+	{0, 0x04, 0, 887},       // U+1D568
+	{0, 0x04, 0, 913},       // U+1D569
+	{0, 0x04, 0, 889},       // U+1D56A
+	{0, 0x04, 0, 1632},      // U+1D56B
+	{0, 0x04, 0, 1472},      // U+1D56C
+	{0, 0x04, 0, 1476},      // U+1D56D
+	{0, 0x04, 0, 3403},      // U+1D56E
+	{0, 0x04, 0, 1478},      // U+1D56F
+	{0, 0x04, 0, 1480},      // U+1D570
+	{0, 0x04, 0, 3442},      // U+1D571
+	{0, 0x04, 0, 1484},      // U+1D572
+	{0, 0x04, 0, 1486},      // U+1D573
+	{0, 0x04, 0, 1488},      // U+1D574
+	{0, 0x04, 0, 1490},      // U+1D575
+	{0, 0x04, 0, 1492},      // U+1D576
+	{0, 0x04, 0, 1494},      // U+1D577
+	{0, 0x04, 0, 1496},      // U+1D578
+	{0, 0x04, 0, 1498},      // U+1D579
+	{0, 0x04, 0, 1500},      // U+1D57A
+	{0, 0x04, 0, 1504},      // U+1D57B
+	{0, 0x04, 0, 3426},      // U+1D57C
+	{0, 0x04, 0, 1506},      // U+1D57D
+	{0, 0x04, 0, 4047},      // U+1D57E
+	{0, 0x04, 0, 1508},      // U+1D57F
+	{0, 0x04, 0, 1510},      // U+1D580
+	{0, 0x04, 0, 3536},      // U+1D581
+	{0, 0x04, 0, 1512},      // U+1D582
+	{0, 0x04, 0, 3553},      // U+1D583
+	{0, 0x04, 0, 4049},      // U+1D584
+	{0, 0x04, 0, 3438},      // U+1D585
+	{0, 0x04, 0, 6},         // U+1D586
+	{0, 0x04, 0, 1520},      // U+1D587
+	{0, 0x04, 0, 1572},      // U+1D588
+	{0, 0x04, 0, 1522},      // U+1D589
+	{0, 0x04, 0, 1524},      // U+1D58A
+	{0, 0x04, 0, 1578},      // U+1D58B
+	{0, 0x04, 0, 1532},      // U+1D58C
+	{0, 0x04, 0, 873},       // U+1D58D
+	{0, 0x04, 0, 1566},      // U+1D58E
+	{0, 0x04, 0, 877},       // U+1D58F
+	{0, 0x04, 0, 1534},      // U+1D590
+	{0, 0x04, 0, 911},       // U+1D591
+	{0, 0x04, 0, 1536},      // U+1D592
+	{0, 0x04, 0, 3390},      // U+1D593
+	{0, 0x04, 0, 25},        // U+1D594
+	{0, 0x04, 0, 1546},      // U+1D595
+	{0, 0x04, 0, 4051},      // U+1D596
+	{0, 0x04, 0, 879},       // U+1D597
+	{0, 0x04, 0, 537},       // U+1D598
+	{0, 0x04, 0, 1548},      // U+1D599
+	{0, 0x04, 0, 1550},      // U+1D59A
+	{0, 0x04, 0, 1556},      // U+1D59B
+	{0, 0x04, 0, 887},       // U+1D59C
+	{0, 0x04, 0, 913},       // U+1D59D
+	{0, 0x04, 0, 889},       // U+1D59E
+	{0, 0x04, 0, 1632},      // U+1D59F
+	{0, 0x04, 0, 1472},      // U+1D5A0
+	{0, 0x04, 0, 1476},      // U+1D5A1
+	{0, 0x04, 0, 3403},      // U+1D5A2
+	{0, 0x04, 0, 1478},      // U+1D5A3
+	{0, 0x04, 0, 1480},      // U+1D5A4
+	{0, 0x04, 0, 3442},      // U+1D5A5
+	{0, 0x04, 0, 1484},      // U+1D5A6
+	{0, 0x04, 0, 1486},      // U+1D5A7
+	{0, 0x04, 0, 1488},      // U+1D5A8
+	{0, 0x04, 0, 1490},      // U+1D5A9
+	{0, 0x04, 0, 1492},      // U+1D5AA
+	{0, 0x04, 0, 1494},      // U+1D5AB
+	{0, 0x04, 0, 1496},      // U+1D5AC
+	{0, 0x04, 0, 1498},      // U+1D5AD
+	{0, 0x04, 0, 1500},      // U+1D5AE
+	{0, 0x04, 0, 1504},      // U+1D5AF
+	{0, 0x04, 0, 3426},      // U+1D5B0
+	{0, 0x04, 0, 1506},      // U+1D5B1
+	{0, 0x04, 0, 4047},      // U+1D5B2
+	{0, 0x04, 0, 1508},      // U+1D5B3
+	{0, 0x04, 0, 1510},      // U+1D5B4
+	{0, 0x04, 0, 3536},      // U+1D5B5
+	{0, 0x04, 0, 1512},      // U+1D5B6
+	{0, 0x04, 0, 3553},      // U+1D5B7
+	{0, 0x04, 0, 4049},      // U+1D5B8
+	{0, 0x04, 0, 3438},      // U+1D5B9
+	{0, 0x04, 0, 6},         // U+1D5BA
+	{0, 0x04, 0, 1520},      // U+1D5BB
+	{0, 0x04, 0, 1572},      // U+1D5BC
+	{0, 0x04, 0, 1522},      // U+1D5BD
+	{0, 0x04, 0, 1524},      // U+1D5BE
+	{0, 0x04, 0, 1578},      // U+1D5BF
+	{0, 0x04, 0, 1532},      // U+1D5C0
+	{0, 0x04, 0, 873},       // U+1D5C1
+	{0, 0x04, 0, 1566},      // U+1D5C2
+	{0, 0x04, 0, 877},       // U+1D5C3
+	{0, 0x04, 0, 1534},      // U+1D5C4
+	{0, 0x04, 0, 911},       // U+1D5C5
+	{0, 0x04, 0, 1536},      // U+1D5C6
+	{0, 0x04, 0, 3390},      // U+1D5C7
+	{0, 0x04, 0, 25},        // U+1D5C8
+	{0, 0x04, 0, 1546},      // U+1D5C9
+	{0, 0x04, 0, 4051},      // U+1D5CA
+	{0, 0x04, 0, 879},       // U+1D5CB
+	{0, 0x04, 0, 537},       // U+1D5CC
+	{0, 0x04, 0, 1548},      // U+1D5CD
+	{0, 0x04, 0, 1550},      // U+1D5CE
+	{0, 0x04, 0, 1556},      // U+1D5CF
+	{0, 0x04, 0, 887},       // U+1D5D0
+	{0, 0x04, 0, 913},       // U+1D5D1
+	{0, 0x04, 0, 889},       // U+1D5D2
+	{0, 0x04, 0, 1632},      // U+1D5D3
+	{0, 0x04, 0, 1472},      // U+1D5D4
+	{0, 0x04, 0, 1476},      // U+1D5D5
+	{0, 0x04, 0, 3403},      // U+1D5D6
+	{0, 0x04, 0, 1478},      // U+1D5D7
+	{0, 0x04, 0, 1480},      // U+1D5D8
+	{0, 0x04, 0, 3442},      // U+1D5D9
+	{0, 0x04, 0, 1484},      // U+1D5DA
+	{0, 0x04, 0, 1486},      // U+1D5DB
+	{0, 0x04, 0, 1488},      // U+1D5DC
+	{0, 0x04, 0, 1490},      // U+1D5DD
+	{0, 0x04, 0, 1492},      // U+1D5DE
+	{0, 0x04, 0, 1494},      // U+1D5DF
+	{0, 0x04, 0, 1496},      // U+1D5E0
+	{0, 0x04, 0, 1498},      // U+1D5E1
+	{0, 0x04, 0, 1500},      // U+1D5E2
+	{0, 0x04, 0, 1504},      // U+1D5E3
+	{0, 0x04, 0, 3426},      // U+1D5E4
+	{0, 0x04, 0, 1506},      // U+1D5E5
+	{0, 0x04, 0, 4047},      // U+1D5E6
+	{0, 0x04, 0, 1508},      // U+1D5E7
+	{0, 0x04, 0, 1510},      // U+1D5E8
+	{0, 0x04, 0, 3536},      // U+1D5E9
+	{0, 0x04, 0, 1512},      // U+1D5EA
+	{0, 0x04, 0, 3553},      // U+1D5EB
+	{0, 0x04, 0, 4049},      // U+1D5EC
+	{0, 0x04, 0, 3438},      // U+1D5ED
+	{0, 0x04, 0, 6},         // U+1D5EE
+	{0, 0x04, 0, 1520},      // U+1D5EF
+	{0, 0x04, 0, 1572},      // U+1D5F0
+	{0, 0x04, 0, 1522},      // U+1D5F1
+	{0, 0x04, 0, 1524},      // U+1D5F2
+	{0, 0x04, 0, 1578},      // U+1D5F3
+	{0, 0x04, 0, 1532},      // U+1D5F4
+	{0, 0x04, 0, 873},       // U+1D5F5
+	{0, 0x04, 0, 1566},      // U+1D5F6
+	{0, 0x04, 0, 877},       // U+1D5F7
+	{0, 0x04, 0, 1534},      // U+1D5F8
+	{0, 0x04, 0, 911},       // U+1D5F9
+	{0, 0x04, 0, 1536},      // U+1D5FA
+	{0, 0x04, 0, 3390},      // U+1D5FB
+	{0, 0x04, 0, 25},        // U+1D5FC
+	{0, 0x04, 0, 1546},      // U+1D5FD
+	{0, 0x04, 0, 4051},      // U+1D5FE
+	{0, 0x04, 0, 879},       // U+1D5FF
+	{0, 0x04, 0, 537},       // U+1D600
+	{0, 0x04, 0, 1548},      // U+1D601
+	{0, 0x04, 0, 1550},      // U+1D602
+	{0, 0x04, 0, 1556},      // U+1D603
+	{0, 0x04, 0, 887},       // U+1D604
+	{0, 0x04, 0, 913},       // U+1D605
+	{0, 0x04, 0, 889},       // U+1D606
+	{0, 0x04, 0, 1632},      // U+1D607
+	{0, 0x04, 0, 1472},      // U+1D608
+	{0, 0x04, 0, 1476},      // U+1D609
+	{0, 0x04, 0, 3403},      // U+1D60A
+	{0, 0x04, 0, 1478},      // U+1D60B
+	{0, 0x04, 0, 1480},      // U+1D60C
+	{0, 0x04, 0, 3442},      // U+1D60D
+	{0, 0x04, 0, 1484},      // U+1D60E
+	{0, 0x04, 0, 1486},      // U+1D60F
+	{0, 0x04, 0, 1488},      // U+1D610
+	{0, 0x04, 0, 1490},      // U+1D611
+	{0, 0x04, 0, 1492},      // U+1D612
+	{0, 0x04, 0, 1494},      // U+1D613
+	{0, 0x04, 0, 1496},      // U+1D614
+	{0, 0x04, 0, 1498},      // U+1D615
+	{0, 0x04, 0, 1500},      // U+1D616
+	{0, 0x04, 0, 1504},      // U+1D617
+	{0, 0x04, 0, 3426},      // U+1D618
+	{0, 0x04, 0, 1506},      // U+1D619
+	{0, 0x04, 0, 4047},      // U+1D61A
+	{0, 0x04, 0, 1508},      // U+1D61B
+	{0, 0x04, 0, 1510},      // U+1D61C
+	{0, 0x04, 0, 3536},      // U+1D61D
+	{0, 0x04, 0, 1512},      // U+1D61E
+	{0, 0x04, 0, 3553},      // U+1D61F
+	{0, 0x04, 0, 4049},      // U+1D620
+	{0, 0x04, 0, 3438},      // U+1D621
+	{0, 0x04, 0, 6},         // U+1D622
+	{0, 0x04, 0, 1520},      // U+1D623
+	{0, 0x04, 0, 1572},      // U+1D624
+	{0, 0x04, 0, 1522},      // U+1D625
+	{0, 0x04, 0, 1524},      // U+1D626
+	{0, 0x04, 0, 1578},      // U+1D627
+	{0, 0x04, 0, 1532},      // U+1D628
+	{0, 0x04, 0, 873},       // U+1D629
+	{0, 0x04, 0, 1566},      // U+1D62A
+	{0, 0x04, 0, 877},       // U+1D62B
+	{0, 0x04, 0, 1534},      // U+1D62C
+	{0, 0x04, 0, 911},       // U+1D62D
+	{0, 0x04, 0, 1536},      // U+1D62E
+	{0, 0x04, 0, 3390},      // U+1D62F
+	{0, 0x04, 0, 25},        // U+1D630
+	{0, 0x04, 0, 1546},      // U+1D631
+	{0, 0x04, 0, 4051},      // U+1D632
+	{0, 0x04, 0, 879},       // U+1D633
+	{0, 0x04, 0, 537},       // U+1D634
+	{0, 0x04, 0, 1548},      // U+1D635
+	{0, 0x04, 0, 1550},      // U+1D636
+	{0, 0x04, 0, 1556},      // U+1D637
+	{0, 0x04, 0, 887},       // U+1D638
+	{0, 0x04, 0, 913},       // U+1D639
+	{0, 0x04, 0, 889},       // U+1D63A
+	{0, 0x04, 0, 1632},      // U+1D63B
+	{0, 0x04, 0, 1472},      // U+1D63C
+	{0, 0x04, 0, 1476},      // U+1D63D
+	{0, 0x04, 0, 3403},      // U+1D63E
+	{0, 0x04, 0, 1478},      // U+1D63F
+	{0, 0x04, 0, 1480},      // U+1D640
+	{0, 0x04, 0, 3442},      // U+1D641
+	{0, 0x04, 0, 1484},      // U+1D642
+	{0, 0x04, 0, 1486},      // U+1D643
+	{0, 0x04, 0, 1488},      // U+1D644
+	{0, 0x04, 0, 1490},      // U+1D645
+	{0, 0x04, 0, 1492},      // U+1D646
+	{0, 0x04, 0, 1494},      // U+1D647
+	{0, 0x04, 0, 1496},      // U+1D648
+	{0, 0x04, 0, 1498},      // U+1D649
+	{0, 0x04, 0, 1500},      // U+1D64A
+	{0, 0x04, 0, 1504},      // U+1D64B
+	{0, 0x04, 0, 3426},      // U+1D64C
+	{0, 0x04, 0, 1506},      // U+1D64D
+	{0, 0x04, 0, 4047},      // U+1D64E
+	{0, 0x04, 0, 1508},      // U+1D64F
+	{0, 0x04, 0, 1510},      // U+1D650
+	{0, 0x04, 0, 3536},      // U+1D651
+	{0, 0x04, 0, 1512},      // U+1D652
+	{0, 0x04, 0, 3553},      // U+1D653
+	{0, 0x04, 0, 4049},      // U+1D654
+	{0, 0x04, 0, 3438},      // U+1D655
+	{0, 0x04, 0, 6},         // U+1D656
+	{0, 0x04, 0, 1520},      // U+1D657
+	{0, 0x04, 0, 1572},      // U+1D658
+	{0, 0x04, 0, 1522},      // U+1D659
+	{0, 0x04, 0, 1524},      // U+1D65A
+	{0, 0x04, 0, 1578},      // U+1D65B
+	{0, 0x04, 0, 1532},      // U+1D65C
+	{0, 0x04, 0, 873},       // U+1D65D
+	{0, 0x04, 0, 1566},      // U+1D65E
+	{0, 0x04, 0, 877},       // U+1D65F
+	{0, 0x04, 0, 1534},      // U+1D660
+	{0, 0x04, 0, 911},       // U+1D661
+	{0, 0x04, 0, 1536},      // U+1D662
+	{0, 0x04, 0, 3390},      // U+1D663
+	{0, 0x04, 0, 25},        // U+1D664
+	{0, 0x04, 0, 1546},      // U+1D665
+	{0, 0x04, 0, 4051},      // U+1D666
+	{0, 0x04, 0, 879},       // U+1D667
+	{0, 0x04, 0, 537},       // U+1D668
+	{0, 0x04, 0, 1548},      // U+1D669
+	{0, 0x04, 0, 1550},      // U+1D66A
+	{0, 0x04, 0, 1556},      // U+1D66B
+	{0, 0x04, 0, 887},       // U+1D66C
+	{0, 0x04, 0, 913},       // U+1D66D
+	{0, 0x04, 0, 889},       // U+1D66E
+	{0, 0x04, 0, 1632},      // U+1D66F
+	{0, 0x04, 0, 1472},      // U+1D670
+	{0, 0x04, 0, 1476},      // U+1D671
+	{0, 0x04, 0, 3403},      // U+1D672
+	{0, 0x04, 0, 1478},      // U+1D673
+	{0, 0x04, 0, 1480},      // U+1D674
+	{0, 0x04, 0, 3442},      // U+1D675
+	{0, 0x04, 0, 1484},      // U+1D676
+	{0, 0x04, 0, 1486},      // U+1D677
+	{0, 0x04, 0, 1488},      // U+1D678
+	{0, 0x04, 0, 1490},      // U+1D679
+	{0, 0x04, 0, 1492},      // U+1D67A
+	{0, 0x04, 0, 1494},      // U+1D67B
+	{0, 0x04, 0, 1496},      // U+1D67C
+	{0, 0x04, 0, 1498},      // U+1D67D
+	{0, 0x04, 0, 1500},      // U+1D67E
+	{0, 0x04, 0, 1504},      // U+1D67F
+	{0, 0x04, 0, 3426},      // U+1D680
+	{0, 0x04, 0, 1506},      // U+1D681
+	{0, 0x04, 0, 4047},      // U+1D682
+	{0, 0x04, 0, 1508},      // U+1D683
+	{0, 0x04, 0, 1510},      // U+1D684
+	{0, 0x04, 0, 3536},      // U+1D685
+	{0, 0x04, 0, 1512},      // U+1D686
+	{0, 0x04, 0, 3553},      // U+1D687
+	{0, 0x04, 0, 4049},      // U+1D688
+	{0, 0x04, 0, 3438},      // U+1D689
+	{0, 0x04, 0, 6},         // U+1D68A
+	{0, 0x04, 0, 1520},      // U+1D68B
+	{0, 0x04, 0, 1572},      // U+1D68C
+	{0, 0x04, 0, 1522},      // U+1D68D
+	{0, 0x04, 0, 1524},      // U+1D68E
+	{0, 0x04, 0, 1578},      // U+1D68F
+	{0, 0x04, 0, 1532},      // U+1D690
+	{0, 0x04, 0, 873},       // U+1D691
+	{0, 0x04, 0, 1566},      // U+1D692
+	{0, 0x04, 0, 877},       // U+1D693
+	{0, 0x04, 0, 1534},      // U+1D694
+	{0, 0x04, 0, 911},       // U+1D695
+	{0, 0x04, 0, 1536},      // U+1D696
+	{0, 0x04, 0, 3390},      // U+1D697
+	{0, 0x04, 0, 25},        // U+1D698
+	{0, 0x04, 0, 1546},      // U+1D699
+	{0, 0x04, 0, 4051},      // U+1D69A
+	{0, 0x04, 0, 879},       // U+1D69B
+	{0, 0x04, 0, 537},       // U+1D69C
+	{0, 0x04, 0, 1548},      // U+1D69D
+	{0, 0x04, 0, 1550},      // U+1D69E
+	{0, 0x04, 0, 1556},      // U+1D69F
+	{0, 0x04, 0, 887},       // U+1D6A0
+	{0, 0x04, 0, 913},       // U+1D6A1
+	{0, 0x04, 0, 889},       // U+1D6A2
+	{0, 0x04, 0, 1632},      // U+1D6A3
+	{0, 0x04, 0, 9098},      // U+1D6A4
+	{0, 0x04, 0, 9100},      // U+1D6A5
+	{0, 0x04, 0, 9102},      // U+1D6A8
+	{0, 0x04, 0, 9104},      // U+1D6A9
+	{0, 0x04, 0, 3456},      // U+1D6AA
+	{0, 0x04, 0, 9106},      // U+1D6AB
+	{0, 0x04, 0, 9108},      // U+1D6AC
+	{0, 0x04, 0, 9110},      // U+1D6AD
+	{0, 0x04, 0, 9112},      // U+1D6AE
+	{0, 0x04, 0, 1026},      // U+1D6AF
+	{0, 0x04, 0, 9114},      // U+1D6B0
+	{0, 0x04, 0, 9116},      // U+1D6B1
+	{0, 0x04, 0, 9118},      // U+1D6B2
+	{0, 0x04, 0, 9120},      // U+1D6B3
+	{0, 0x04, 0, 9122},      // U+1D6B4
+	{0, 0x04, 0, 9124},      // U+1D6B5
+	{0, 0x04, 0, 9126},      // U+1D6B6
+	{0, 0x04, 0, 3458},      // U+1D6B7
+	{0, 0x04, 0, 9128},      // U+1D6B8
+	{0, 0x04, 0, 1026},      // U+1D6B9
+	{0, 0x04, 0, 1030},      // U+1D6BA
+	{0, 0x04, 0, 9130},      // U+1D6BB
+	{0, 0x04, 0, 1008},      // U+1D6BC
+	{0, 0x04, 0, 9132},      // U+1D6BD
+	{0, 0x04, 0, 9134},      // U+1D6BE
+	{0, 0x04, 0, 9136},      // U+1D6BF
+	{0, 0x04, 0, 3440},      // U+1D6C0
+	{0, 0x04, 0, 9138},      // U+1D6C1
+	{0, 0x04, 0, 9140},      // U+1D6C2
+	{0, 0x04, 0, 1004},      // U+1D6C3
+	{0, 0x04, 0, 1560},      // U+1D6C4
+	{0, 0x04, 0, 1562},      // U+1D6C5
+	{0, 0x04, 0, 1028},      // U+1D6C6
+	{0, 0x04, 0, 9142},      // U+1D6C7
+	{0, 0x04, 0, 9144},      // U+1D6C8
+	{0, 0x04, 0, 1006},      // U+1D6C9
+	{0, 0x04, 0, 3131},      // U+1D6CA
+	{0, 0x04, 0, 1020},      // U+1D6CB
+	{0, 0x04, 0, 9146},      // U+1D6CC
+	{0, 0x04, 0, 18},        // U+1D6CD
+	{0, 0x04, 0, 9148},      // U+1D6CE
+	{0, 0x04, 0, 9150},      // U+1D6CF
+	{0, 0x04, 0, 9152},      // U+1D6D0
+	{0, 0x04, 0, 1018},      // U+1D6D1
+	{0, 0x04, 0, 1022},      // U+1D6D2
+	{0, 0x04, 0, 1024},      // U+1D6D3
+	{0, 0x04, 0, 9154},      // U+1D6D4
+	{0, 0x04, 0, 9156},      // U+1D6D5
+	{0, 0x04, 0, 9158},      // U+1D6D6
+	{0, 0x04, 0, 1016},      // U+1D6D7
+	{0, 0x04, 0, 1564},      // U+1D6D8
+	{0, 0x04, 0, 9160},      // U+1D6D9
+	{0, 0x04, 0, 9162},      // U+1D6DA
+	{0, 0x04, 0, 9164},      // U+1D6DB
+	{0, 0x04, 0, 1028},      // U+1D6DC
+	{0, 0x04, 0, 1006},      // U+1D6DD
+	{0, 0x04, 0, 1020},      // U+1D6DE
+	{0, 0x04, 0, 1016},      // U+1D6DF
+	{0, 0x04, 0, 1022},      // U+1D6E0
+	{0, 0x04, 0, 1018},      // U+1D6E1
+	{0, 0x04, 0, 9102},      // U+1D6E2
+	{0, 0x04, 0, 9104},      // U+1D6E3
+	{0, 0x04, 0, 3456},      // U+1D6E4
+	{0, 0x04, 0, 9106},      // U+1D6E5
+	{0, 0x04, 0, 9108},      // U+1D6E6
+	{0, 0x04, 0, 9110},      // U+1D6E7
+	{0, 0x04, 0, 9112},      // U+1D6E8
+	{0, 0x04, 0, 1026},      // U+1D6E9
+	{0, 0x04, 0, 9114},      // U+1D6EA
+	{0, 0x04, 0, 9116},      // U+1D6EB
+	{0, 0x04, 0, 9118},      // U+1D6EC
+	{0, 0x04, 0, 9120},      // U+1D6ED
+	{0, 0x04, 0, 9122},      // U+1D6EE
+	{0, 0x04, 0, 9124},      // U+1D6EF
+	{0, 0x04, 0, 9126},      // U+1D6F0
+	{0, 0x04, 0, 3458},      // U+1D6F1
+	{0, 0x04, 0, 9128},      // U+1D6F2
+	{0, 0x04, 0, 1026},      // U+1D6F3
+	{0, 0x04, 0, 1030},      // U+1D6F4
+	{0, 0x04, 0, 9130},      // U+1D6F5
+	{0, 0x04, 0, 1008},      // U+1D6F6
+	{0, 0x04, 0, 9132},      // U+1D6F7
+	{0, 0x04, 0, 9134},      // U+1D6F8
+	{0, 0x04, 0, 9136},      // U+1D6F9
+	{0, 0x04, 0, 3440},      // U+1D6FA
+	{0, 0x04, 0, 9138},      // U+1D6FB
+	{0, 0x04, 0, 9140},      // U+1D6FC
+	{0, 0x04, 0, 1004},      // U+1D6FD
+	{0, 0x04, 0, 1560},      // U+1D6FE
+	{0, 0x04, 0, 1562},      // U+1D6FF
+	{0, 0x04, 0, 1028},      // U+1D700
+	{0, 0x04, 0, 9142},      // U+1D701
+	{0, 0x04, 0, 9144},      // U+1D702
+	{0, 0x04, 0, 1006},      // U+1D703
+	{0, 0x04, 0, 3131},      // U+1D704
+	{0, 0x04, 0, 1020},      // U+1D705
+	{0, 0x04, 0, 9146},      // U+1D706
+	{0, 0x04, 0, 18},        // U+1D707
+	{0, 0x04, 0, 9148},      // U+1D708
+	{0, 0x04, 0, 9150},      // U+1D709
+	{0, 0x04, 0, 9152},      // U+1D70A
+	{0, 0x04, 0, 1018},      // U+1D70B
+	{0, 0x04, 0, 1022},      // U+1D70C
+	{0, 0x04, 0, 1024},      // U+1D70D
+	{0, 0x04, 0, 9154},      // U+1D70E
+	{0, 0x04, 0, 9156},      // U+1D70F
+	{0, 0x04, 0, 9158},      // U+1D710
+	{0, 0x04, 0, 1016},      // U+1D711
+	{0, 0x04, 0, 1564},      // U+1D712
+	{0, 0x04, 0, 9160},      // U+1D713
+	{0, 0x04, 0, 9162},      // U+1D714
+	{0, 0x04, 0, 9164},      // U+1D715
+	{0, 0x04, 0, 1028},      // U+1D716
+	{0, 0x04, 0, 1006},      // U+1D717
+	{0, 0x04, 0, 1020},      // U+1D718
+	{0, 0x04, 0, 1016},      // U+1D719
+	{0, 0x04, 0, 1022},      // U+1D71A
+	{0, 0x04, 0, 1018},      // U+1D71B
+	{0, 0x04, 0, 9102},      // U+1D71C
+	{0, 0x04, 0, 9104},      // U+1D71D
+	{0, 0x04, 0, 3456},      // U+1D71E
+	{0, 0x04, 0, 9106},      // U+1D71F
+	{0, 0x04, 0, 9108},      // U+1D720
+	{0, 0x04, 0, 9110},      // U+1D721
+	{0, 0x04, 0, 9112},      // U+1D722
+	{0, 0x04, 0, 1026},      // U+1D723
+	{0, 0x04, 0, 9114},      // U+1D724
+	{0, 0x04, 0, 9116},      // U+1D725
+	{0, 0x04, 0, 9118},      // U+1D726
+	{0, 0x04, 0, 9120},      // U+1D727
+	{0, 0x04, 0, 9122},      // U+1D728
+	{0, 0x04, 0, 9124},      // U+1D729
+	{0, 0x04, 0, 9126},      // U+1D72A
+	{0, 0x04, 0, 3458},      // U+1D72B
+	{0, 0x04, 0, 9128},      // U+1D72C
+	{0, 0x04, 0, 1026},      // U+1D72D
+	{0, 0x04, 0, 1030},      // U+1D72E
+	{0, 0x04, 0, 9130},      // U+1D72F
+	{0, 0x04, 0, 1008},      // U+1D730
+	{0, 0x04, 0, 9132},      // U+1D731
+	{0, 0x04, 0, 9134},      // U+1D732
+	{0, 0x04, 0, 9136},      // U+1D733
+	{0, 0x04, 0, 3440},      // U+1D734
+	{0, 0x04, 0, 9138},      // U+1D735
+	{0, 0x04, 0, 9140},      // U+1D736
+	{0, 0x04, 0, 1004},      // U+1D737
+	{0, 0x04, 0, 1560},      // U+1D738
+	{0, 0x04, 0, 1562},      // U+1D739
+	{0, 0x04, 0, 1028},      // U+1D73A
+	{0, 0x04, 0, 9142},      // U+1D73B
+	{0, 0x04, 0, 9144},      // U+1D73C
+	{0, 0x04, 0, 1006},      // U+1D73D
+	{0, 0x04, 0, 3131},      // U+1D73E
+	{0, 0x04, 0, 1020},      // U+1D73F
+	{0, 0x04, 0, 9146},      // U+1D740
+	{0, 0x04, 0, 18},        // U+1D741
+	{0, 0x04, 0, 9148},      // U+1D742
+	{0, 0x04, 0, 9150},      // U+1D743
+	{0, 0x04, 0, 9152},      // U+1D744
+	{0, 0x04, 0, 1018},      // U+1D745
+	{0, 0x04, 0, 1022},      // U+1D746
+	{0, 0x04, 0, 1024},      // U+1D747
+	{0, 0x04, 0, 9154},      // U+1D748
+	{0, 0x04, 0, 9156},      // U+1D749
+	{0, 0x04, 0, 9158},      // U+1D74A
+	{0, 0x04, 0, 1016},      // U+1D74B
+	{0, 0x04, 0, 1564},      // U+1D74C
+	{0, 0x04, 0, 9160},      // U+1D74D
+	{0, 0x04, 0, 9162},      // U+1D74E
+	{0, 0x04, 0, 9164},      // U+1D74F
+	{0, 0x04, 0, 1028},      // U+1D750
+	{0, 0x04, 0, 1006},      // U+1D751
+	{0, 0x04, 0, 1020},      // U+1D752
+	{0, 0x04, 0, 1016},      // U+1D753
+	{0, 0x04, 0, 1022},      // U+1D754
+	{0, 0x04, 0, 1018},      // U+1D755
+	{0, 0x04, 0, 9102},      // U+1D756
+	{0, 0x04, 0, 9104},      // U+1D757
+	{0, 0x04, 0, 3456},      // U+1D758
+	{0, 0x04, 0, 9106},      // U+1D759
+	{0, 0x04, 0, 9108},      // U+1D75A
+	{0, 0x04, 0, 9110},      // U+1D75B
+	{0, 0x04, 0, 9112},      // U+1D75C
+	{0, 0x04, 0, 1026},      // U+1D75D
+	{0, 0x04, 0, 9114},      // U+1D75E
+	{0, 0x04, 0, 9116},      // U+1D75F
+	{0, 0x04, 0, 9118},      // U+1D760
+	{0, 0x04, 0, 9120},      // U+1D761
+	{0, 0x04, 0, 9122},      // U+1D762
+	{0, 0x04, 0, 9124},      // U+1D763
+	{0, 0x04, 0, 9126},      // U+1D764
+	{0, 0x04, 0, 3458},      // U+1D765
+	{0, 0x04, 0, 9128},      // U+1D766
+	{0, 0x04, 0, 1026},      // U+1D767
+	{0, 0x04, 0, 1030},      // U+1D768
+	{0, 0x04, 0, 9130},      // U+1D769
+	{0, 0x04, 0, 1008},      // U+1D76A
+	{0, 0x04, 0, 9132},      // U+1D76B
+	{0, 0x04, 0, 9134},      // U+1D76C
+	{0, 0x04, 0, 9136},      // U+1D76D
+	{0, 0x04, 0, 3440},      // U+1D76E
+	{0, 0x04, 0, 9138},      // U+1D76F
+	{0, 0x04, 0, 9140},      // U+1D770
+	{0, 0x04, 0, 1004},      // U+1D771
+	{0, 0x04, 0, 1560},      // U+1D772
+	{0, 0x04, 0, 1562},      // U+1D773
+	{0, 0x04, 0, 1028},      // U+1D774
+	{0, 0x04, 0, 9142},      // U+1D775
+	{0, 0x04, 0, 9144},      // U+1D776
+	{0, 0x04, 0, 1006},      // U+1D777
+	{0, 0x04, 0, 3131},      // U+1D778
+	{0, 0x04, 0, 1020},      // U+1D779
+	{0, 0x04, 0, 9146},      // U+1D77A
+	{0, 0x04, 0, 18},        // U+1D77B
+	{0, 0x04, 0, 9148},      // U+1D77C
+	{0, 0x04, 0, 9150},      // U+1D77D
+	{0, 0x04, 0, 9152},      // U+1D77E
+	{0, 0x04, 0, 1018},      // U+1D77F
+	{0, 0x04, 0, 1022},      // U+1D780
+	{0, 0x04, 0, 1024},      // U+1D781
+	{0, 0x04, 0, 9154},      // U+1D782
+	{0, 0x04, 0, 9156},      // U+1D783
+	{0, 0x04, 0, 9158},      // U+1D784
+	{0, 0x04, 0, 1016},      // U+1D785
+	{0, 0x04, 0, 1564},      // U+1D786
+	{0, 0x04, 0, 9160},      // U+1D787
+	{0, 0x04, 0, 9162},      // U+1D788
+	{0, 0x04, 0, 9164},      // U+1D789
+	{0, 0x04, 0, 1028},      // U+1D78A
+	{0, 0x04, 0, 1006},      // U+1D78B
+	{0, 0x04, 0, 1020},      // U+1D78C
+	{0, 0x04, 0, 1016},      // U+1D78D
+	{0, 0x04, 0, 1022},      // U+1D78E
+	{0, 0x04, 0, 1018},      // U+1D78F
+	{0, 0x04, 0, 9102},      // U+1D790
+	{0, 0x04, 0, 9104},      // U+1D791
+	{0, 0x04, 0, 3456},      // U+1D792
+	{0, 0x04, 0, 9106},      // U+1D793
+	{0, 0x04, 0, 9108},      // U+1D794
+	{0, 0x04, 0, 9110},      // U+1D795
+	{0, 0x04, 0, 9112},      // U+1D796
+	{0, 0x04, 0, 1026},      // U+1D797
+	{0, 0x04, 0, 9114},      // U+1D798
+	{0, 0x04, 0, 9116},      // U+1D799
+	{0, 0x04, 0, 9118},      // U+1D79A
+	{0, 0x04, 0, 9120},      // U+1D79B
+	{0, 0x04, 0, 9122},      // U+1D79C
+	{0, 0x04, 0, 9124},      // U+1D79D
+	{0, 0x04, 0, 9126},      // U+1D79E
+	{0, 0x04, 0, 3458},      // U+1D79F
+	{0, 0x04, 0, 9128},      // U+1D7A0
+	{0, 0x04, 0, 1026},      // U+1D7A1
+	{0, 0x04, 0, 1030},      // U+1D7A2
+	{0, 0x04, 0, 9130},      // U+1D7A3
+	{0, 0x04, 0, 1008},      // U+1D7A4
+	{0, 0x04, 0, 9132},      // U+1D7A5
+	{0, 0x04, 0, 9134},      // U+1D7A6
+	{0, 0x04, 0, 9136},      // U+1D7A7
+	{0, 0x04, 0, 3440},      // U+1D7A8
+	{0, 0x04, 0, 9138},      // U+1D7A9
+	{0, 0x04, 0, 9140},      // U+1D7AA
+	{0, 0x04, 0, 1004},      // U+1D7AB
+	{0, 0x04, 0, 1560},      // U+1D7AC
+	{0, 0x04, 0, 1562},      // U+1D7AD
+	{0, 0x04, 0, 1028},      // U+1D7AE
+	{0, 0x04, 0, 9142},      // U+1D7AF
+	{0, 0x04, 0, 9144},      // U+1D7B0
+	{0, 0x04, 0, 1006},      // U+1D7B1
+	{0, 0x04, 0, 3131},      // U+1D7B2
+	{0, 0x04, 0, 1020},      // U+1D7B3
+	{0, 0x04, 0, 9146},      // U+1D7B4
+	{0, 0x04, 0, 18},        // U+1D7B5
+	{0, 0x04, 0, 9148},      // U+1D7B6
+	{0, 0x04, 0, 9150},      // U+1D7B7
+	{0, 0x04, 0, 9152},      // U+1D7B8
+	{0, 0x04, 0, 1018},      // U+1D7B9
+	{0, 0x04, 0, 1022},      // U+1D7BA
+	{0, 0x04, 0, 1024},      // U+1D7BB
+	{0, 0x04, 0, 9154},      // U+1D7BC
+	{0, 0x04, 0, 9156},      // U+1D7BD
+	{0, 0x04, 0, 9158},      // U+1D7BE
+	{0, 0x04, 0, 1016},      // U+1D7BF
+	{0, 0x04, 0, 1564},      // U+1D7C0
+	{0, 0x04, 0, 9160},      // U+1D7C1
+	{0, 0x04, 0, 9162},      // U+1D7C2
+	{0, 0x04, 0, 9164},      // U+1D7C3
+	{0, 0x04, 0, 1028},      // U+1D7C4
+	{0, 0x04, 0, 1006},      // U+1D7C5
+	{0, 0x04, 0, 1020},      // U+1D7C6
+	{0, 0x04, 0, 1016},      // U+1D7C7
+	{0, 0x04, 0, 1022},      // U+1D7C8
+	{0, 0x04, 0, 1018},      // U+1D7C9
+	{0, 0x04, 0, 9166},      // U+1D7CA
+	{0, 0x04, 0, 9168},      // U+1D7CB
+	{0, 0x04, 0, 3366},      // U+1D7CE
+	{0, 0x04, 0, 23},        // U+1D7CF
+	{0, 0x04, 0, 11},        // U+1D7D0
+	{0, 0x04, 0, 13},        // U+1D7D1
+	{0, 0x04, 0, 3368},      // U+1D7D2
+	{0, 0x04, 0, 3370},      // U+1D7D3
+	{0, 0x04, 0, 3372},      // U+1D7D4
+	{0, 0x04, 0, 3374},      // U+1D7D5
+	{0, 0x04, 0, 3376},      // U+1D7D6
+	{0, 0x04, 0, 3378},      // U+1D7D7
+	{0, 0x04, 0, 3366},      // U+1D7D8
+	{0, 0x04, 0, 23},        // U+1D7D9
+	{0, 0x04, 0, 11},        // U+1D7DA
+	{0, 0x04, 0, 13},        // U+1D7DB
+	{0, 0x04, 0, 3368},      // U+1D7DC
+	{0, 0x04, 0, 3370},      // U+1D7DD
+	{0, 0x04, 0, 3372},      // U+1D7DE
+	{0, 0x04, 0, 3374},      // U+1D7DF
+	{0, 0x04, 0, 3376},      // U+1D7E0
+	{0, 0x04, 0, 3378},      // U+1D7E1
+	{0, 0x04, 0, 3366},      // U+1D7E2
+	{0, 0x04, 0, 23},        // U+1D7E3
+	{0, 0x04, 0, 11},        // U+1D7E4
+	{0, 0x04, 0, 13},        // U+1D7E5
+	{0, 0x04, 0, 3368},      // U+1D7E6
+	{0, 0x04, 0, 3370},      // U+1D7E7
+	{0, 0x04, 0, 3372},      // U+1D7E8
+	{0, 0x04, 0, 3374},      // U+1D7E9
+	{0, 0x04, 0, 3376},      // U+1D7EA
+	{0, 0x04, 0, 3378},      // U+1D7EB
+	{0, 0x04, 0, 3366},      // U+1D7EC
+	{0, 0x04, 0, 23},        // U+1D7ED
+	{0, 0x04, 0, 11},        // U+1D7EE
+	{0, 0x04, 0, 13},        // U+1D7EF
+	{0, 0x04, 0, 3368},      // U+1D7F0
+	{0, 0x04, 0, 3370},      // U+1D7F1
+	{0, 0x04, 0, 3372},      // U+1D7F2
+	{0, 0x04, 0, 3374},      // U+1D7F3
+	{0, 0x04, 0, 3376},      // U+1D7F4
+	{0, 0x04, 0, 3378},      // U+1D7F5
+	{0, 0x04, 0, 3366},      // U+1D7F6
+	{0, 0x04, 0, 23},        // U+1D7F7
+	{0, 0x04, 0, 11},        // U+1D7F8
+	{0, 0x04, 0, 13},        // U+1D7F9
+	{0, 0x04, 0, 3368},      // U+1D7FA
+	{0, 0x04, 0, 3370},      // U+1D7FB
+	{0, 0x04, 0, 3372},      // U+1D7FC
+	{0, 0x04, 0, 3374},      // U+1D7FD
+	{0, 0x04, 0, 3376},      // U+1D7FE
+	{0, 0x04, 0, 3378},      // U+1D7FF
+	{230, 0x00, 0, 0},       // U+1E000
+	{230, 0x00, 0, 0},       // U+1E001
+	{230, 0x00, 0, 0},       // U+1E002
+	{230, 0x00, 0, 0},       // U+1E003
+	{230, 0x00, 0, 0},       // U+1E004
+	{230, 0x00, 0, 0},       // U+1E005
+	{230, 0x00, 0, 0},       // U+1E006
+	{230, 0x00, 0, 0},       // U+1E008
+	{230, 0x00, 0, 0},       // U+1E009
+	{230, 0x00, 0, 0},       // U+1E00A
+	{230, 0x00, 0, 0},       // U+1E00B
+	{230, 0x00, 0, 0},       // U+1E00C
+	{230, 0x00, 0, 0},       // U+1E00D
+	{230, 0x00, 0, 0},       // U+1E00E
+	{230, 0x00, 0, 0},       // U+1E00F
+	{230, 0x00, 0, 0},       // U+1E010
+	{230, 0x00, 0, 0},       // U+1E011
+	{230, 0x00, 0, 0},       // U+1E012
+	{230, 0x00, 0, 0},       // U+1E013
+	{230, 0x00, 0, 0},       // U+1E014
+	{230, 0x00, 0, 0},       // U+1E015
+	{230, 0x00, 0, 0},       // U+1E016
+	{230, 0x00, 0, 0},       // U+1E017
+	{230, 0x00, 0, 0},       // U+1E018
+	{230, 0x00, 0, 0},       // U+1E01B
+	{230, 0x00, 0, 0},       // U+1E01C
+	{230, 0x00, 0, 0},       // U+1E01D
+	{230, 0x00, 0, 0},       // U+1E01E
+	{230, 0x00, 0, 0},       // U+1E01F
+	{230, 0x00, 0, 0},       // U+1E020
+	{230, 0x00, 0, 0},       // U+1E021
+	{230, 0x00, 0, 0},       // U+1E023
+	{230, 0x00, 0, 0},       // U+1E024
+	{230, 0x00, 0, 0},       // U+1E026
+	{230, 0x00, 0, 0},       // U+1E027
+	{230, 0x00, 0, 0},       // U+1E028
+	{230, 0x00, 0, 0},       // U+1E029
+	{230, 0x00, 0, 0},       // U+1E02A
+	{0, 0x04, 0, 9170},      // U+1E030
+	{0, 0x04, 0, 9172},      // U+1E031
+	{0, 0x04, 0, 9174},      // U+1E032
+	{0, 0x04, 0, 9176},      // U+1E033
+	{0, 0x04, 0, 9178},      // U+1E034
+	{0, 0x04, 0, 9180},      // U+1E035
+	{0, 0x04, 0, 9182},      // U+1E036
+	{0, 0x04, 0, 9184},      // U+1E037
+	{0, 0x04, 0, 9186},      // U+1E038
+	{0, 0x04, 0, 9188},      // U+1E039
+	{0, 0x04, 0, 9190},      // U+1E03A
+	{0, 0x04, 0, 9192},      // U+1E03B
+	{0, 0x04, 0, 9194},      // U+1E03C
+	{0, 0x04, 0, 9196},      // U+1E03D
+	{0, 0x04, 0, 9198},      // U+1E03E
+	{0, 0x04, 0, 9200},      // U+1E03F
+	{0, 0x04, 0, 9202},      // U+1E040
+	{0, 0x04, 0, 9204},      // U+1E041
+	{0, 0x04, 0, 9206},      // U+1E042
+	{0, 0x04, 0, 9208},      // U+1E043
+	{0, 0x04, 0, 9210},      // U+1E044
+	{0, 0x04, 0, 9212},      // U+1E045
+	{0, 0x04, 0, 9214},      // U+1E046
+	{0, 0x04, 0, 9216},      // U+1E047
+	{0, 0x04, 0, 9218},      // U+1E048
+	{0, 0x04, 0, 9220},      // U+1E049
+	{0, 0x04, 0, 9222},      // U+1E04A
+	{0, 0x04, 0, 9224},      // U+1E04B
+	{0, 0x04, 0, 9226},      // U+1E04C
+	{0, 0x04, 0, 9228},      // U+1E04D
+	{0, 0x04, 0, 9230},      // U+1E04E
+	{0, 0x04, 0, 9232},      // U+1E04F
+	{0, 0x04, 0, 9234},      // U+1E050
+	{0, 0x04, 0, 9170},      // U+1E051
+	{0, 0x04, 0, 9172},      // U+1E052
+	{0, 0x04, 0, 9174},      // U+1E053
+	{0, 0x04, 0, 9176},      // U+1E054
+	{0, 0x04, 0, 9178},      // U+1E055
+	{0, 0x04, 0, 9180},      // U+1E056
+	{0, 0x04, 0, 9182},      // U+1E057
+	{0, 0x04, 0, 9184},      // U+1E058
+	{0, 0x04, 0, 9186},      // U+1E059
+	{0, 0x04, 0, 9188},      // U+1E05A
+	{0, 0x04, 0, 9190},      // U+1E05B
+	{0, 0x04, 0, 9194},      // U+1E05C
+	{0, 0x04, 0, 9196},      // U+1E05D
+	{0, 0x04, 0, 9200},      // U+1E05E
+	{0, 0x04, 0, 9204},      // U+1E05F
+	{0, 0x04, 0, 9206},      // U+1E060
+	{0, 0x04, 0, 9208},      // U+1E061
+	{0, 0x04, 0, 9210},      // U+1E062
+	{0, 0x04, 0, 9212},      // U+1E063
+	{0, 0x04, 0, 9214},      // U+1E064
+	{0, 0x04, 0, 6590},      // U+1E065
+	{0, 0x04, 0, 9216},      // U+1E066
+	{0, 0x04, 0, 9236},      // U+1E067
+	{0, 0x04, 0, 9226},      // U+1E068
+	{0, 0x04, 0, 9238},      // U+1E069
+	{0, 0x04, 0, 9240},      // U+1E06A
+	{0, 0x04, 0, 9242},      // U+1E06B
+	{0, 0x04, 0, 9244},      // U+1E06C
+	{0, 0x04, 0, 9246},      // U+1E06D
+	{230, 0x00, 0, 0},       // U+1E08F
+	{230, 0x00, 0, 0},       // U+1E130
+	{230, 0x00, 0, 0},       // U+1E131
+	{230, 0x00, 0, 0},       // U+1E132
+	{230, 0x00, 0, 0},       // U+1E133
+	{230, 0x00, 0, 0},       // U+1E134
+	{230, 0x00, 0, 0},       // U+1E135
+	{230, 0x00, 0, 0},       // U+1E136
+	{230, 0x00, 0, 0},       // U+1E2AE
+	{230, 0x00, 0, 0},       // U+1E2EC
+	{230, 0x00, 0, 0},       // U+1E2ED
+	{230, 0x00, 0, 0},       // U+1E2EE
+	{230, 0x00, 0, 0},       // U+1E2EF
+	{232, 0x00, 0, 0},       // U+1E4EC
+	{232, 0x00, 0, 0},       // U+1E4ED
+	{220, 0x00, 0, 0},       // U+1E4EE
+	{230, 0x00, 0, 0},       // U+1E4EF
+	{220, 0x00, 0, 0},       // U+1E8D0
+	{220, 0x00, 0, 0},       // U+1E8D1
+	{220, 0x00, 0, 0},       // U+1E8D2
+	{220, 0x00, 0, 0},       // U+1E8D3
+	{220, 0x00, 0, 0},       // U+1E8D4
+	{220, 0x00, 0, 0},       // U+1E8D5
+	{220, 0x00, 0, 0},       // U+1E8D6
+	{230, 0x00, 0, 0},       // U+1E944
+	{230, 0x00, 0, 0},       // U+1E945
+	{230, 0x00, 0, 0},       // U+1E946
+	{230, 0x00, 0, 0},       // U+1E947
+	{230, 0x00, 0, 0},       // U+1E948
+	{230, 0x00, 0, 0},       // U+1E949
+	{7, 0x00, 0, 0},         // U+1E94A
+	{0, 0x04, 0, 8760},      // U+1EE00
+	{0, 0x04, 0, 8762},      // U+1EE01
+	{0, 0x04, 0, 8770},      // U+1EE02
+	{0, 0x04, 0, 8776},      // U+1EE03
+	{0, 0x04, 0, 8814},      // U+1EE05
+	{0, 0x04, 0, 8782},      // U+1EE06
+	{0, 0x04, 0, 8772},      // U+1EE07
+	{0, 0x04, 0, 8792},      // U+1EE08
+	{0, 0x04, 0, 8816},      // U+1EE09
+	{0, 0x04, 0, 8804},      // U+1EE0A
+	{0, 0x04, 0, 8806},      // U+1EE0B
+	{0, 0x04, 0, 8808},      // U+1EE0C
+	{0, 0x04, 0, 8810},      // U+1EE0D
+	{0, 0x04, 0, 8784},      // U+1EE0E
+	{0, 0x04, 0, 8796},      // U+1EE0F
+	{0, 0x04, 0, 8800},      // U+1EE10
+	{0, 0x04, 0, 8788},      // U+1EE11
+	{0, 0x04, 0, 8802},      // U+1EE12
+	{0, 0x04, 0, 8780},      // U+1EE13
+	{0, 0x04, 0, 8786},      // U+1EE14
+	{0, 0x04, 0, 8766},      // U+1EE15
+	{0, 0x04, 0, 8768},      // U+1EE16
+	{0, 0x04, 0, 8774},      // U+1EE17
+	{0, 0x04, 0, 8778},      // U+1EE18
+	{0, 0x04, 0, 8790},      // U+1EE19
+	{0, 0x04, 0, 8794},      // U+1EE1A
+	{0, 0x04, 0, 8798},      // U+1EE1B
+	{0, 0x04, 0, 9248},      // U+1EE1C
+	{0, 0x04, 0, 7624},      // U+1EE1D
+	{0, 0x04, 0, 9250},      // U+1EE1E
+	{0, 0x04, 0, 9252},      // U+1EE1F
+	{0, 0x04, 0, 8762},      // U+1EE21
+	{0, 0x04, 0, 8770},      // U+1EE22
+	{0, 0x04, 0, 8812},      // U+1EE24
+	{0, 0x04, 0, 8772},      // U+1EE27
+	{0, 0x04, 0, 8816},      // U+1EE29
+	{0, 0x04, 0, 8804},      // U+1EE2A
+	{0, 0x04, 0, 8806},      // U+1EE2B
+	{0, 0x04, 0, 8808},      // U+1EE2C
+	{0, 0x04, 0, 8810},      // U+1EE2D
+	{0, 0x04, 0, 8784},      // U+1EE2E
+	{0, 0x04, 0, 8796},      // U+1EE2F
+	{0, 0x04, 0, 8800},      // U+1EE30
+	{0, 0x04, 0, 8788},      // U+1EE31
+	{0, 0x04, 0, 8802},      // U+1EE32
+	{0, 0x04, 0, 8786},      // U+1EE34
+	{0, 0x04, 0, 8766},      // U+1EE35
+	{0, 0x04, 0, 8768},      // U+1EE36
+	{0, 0x04, 0, 8774},      // U+1EE37
+	{0, 0x04, 0, 8790},      // U+1EE39
+	{0, 0x04, 0, 8798},      // U+1EE3B
+	{0, 0x04, 0, 8770},      // U+1EE42
+	{0, 0x04, 0, 8772},      // U+1EE47
+	{0, 0x04, 0, 8816},      // U+1EE49
+	{0, 0x04, 0, 8806},      // U+1EE4B
+	{0, 0x04, 0, 8810},      // U+1EE4D
+	{0, 0x04, 0, 8784},      // U+1EE4E
+	{0, 0x04, 0, 8796},      // U+1EE4F
+	{0, 0x04, 0, 8788},      // U+1EE51
+	{0, 0x04, 0, 8802},      // U+1EE52
+	{0, 0x04, 0, 8786},      // U+1EE54
+	{0, 0x04, 0, 8774},      // U+1EE57
+	{0, 0x04, 0, 8790},      // U+1EE59
+	{0, 0x04, 0, 8798},      // U+1EE5B
+	{0, 0x04, 0, 7624},      // U+1EE5D
+	{0, 0x04, 0, 9252},      // U+1EE5F
+	{0, 0x04, 0, 8762},      // U+1EE61
+	{0, 0x04, 0, 8770},      // U+1EE62
+	{0, 0x04, 0, 8812},      // U+1EE64
+	{0, 0x04, 0, 8772},      // U+1EE67
+	{0, 0x04, 0, 8792},      // U+1EE68
+	{0, 0x04, 0, 8816},      // U+1EE69
+	{0, 0x04, 0, 8804},      // U+1EE6A
+	{0, 0x04, 0, 8808},      // U+1EE6C
+	{0, 0x04, 0, 8810},      // U+1EE6D
+	{0, 0x04, 0, 8784},      // U+1EE6E
+	{0, 0x04, 0, 8796},      // U+1EE6F
+	{0, 0x04, 0, 8800},      // U+1EE70
+	{0, 0x04, 0, 8788},      // U+1EE71
+	{0, 0x04, 0, 8802},      // U+1EE72
+	{0, 0x04, 0, 8786},      // U+1EE74
+	{0, 0x04, 0, 8766},      // U+1EE75
+	{0, 0x04, 0, 8768},      // U+1EE76
+	{0, 0x04, 0, 8774},      // U+1EE77
+	{0, 0x04, 0, 8790},      // U+1EE79
+	{0, 0x04, 0, 8794},      // U+1EE7A
+	{0, 0x04, 0, 8798},      // U+1EE7B
+	{0, 0x04, 0, 9248},      // U+1EE7C
+	{0, 0x04, 0, 9250},      // U+1EE7E
+	{0, 0x04, 0, 8760},      // U+1EE80
+	{0, 0x04, 0, 8762},      // U+1EE81
+	{0, 0x04, 0, 8770},      // U+1EE82
+	{0, 0x04, 0, 8776},      // U+1EE83
+	{0, 0x04, 0, 8812},      // U+1EE84
+	{0, 0x04, 0, 8814},      // U+1EE85
+	{0, 0x04, 0, 8782},      // U+1EE86
+	{0, 0x04, 0, 8772},      // U+1EE87
+	{0, 0x04, 0, 8792},      // U+1EE88
+	{0, 0x04, 0, 8816},      // U+1EE89
+	{0, 0x04, 0, 8806},      // U+1EE8B
+	{0, 0x04, 0, 8808},      // U+1EE8C
+	{0, 0x04, 0, 8810},      // U+1EE8D
+	{0, 0x04, 0, 8784},      // U+1EE8E
+	{0, 0x04, 0, 8796},      // U+1EE8F
+	{0, 0x04, 0, 8800},      // U+1EE90
+	{0, 0x04, 0, 8788},      // U+1EE91
+	{0, 0x04, 0, 8802},      // U+1EE92
+	{0, 0x04, 0, 8780},      // U+1EE93
+	{0, 0x04, 0, 8786},      // U+1EE94
+	{0, 0x04, 0, 8766},      // U+1EE95
+	{0, 0x04, 0, 8768},      // U+1EE96
+	{0, 0x04, 0, 8774},      // U+1EE97
+	{0, 0x04, 0, 8778},      // U+1EE98
+	{0, 0x04, 0, 8790},      // U+1EE99
+	{0, 0x04, 0, 8794},      // U+1EE9A
+	{0, 0x04, 0, 8798},      // U+1EE9B
+	{0, 0x04, 0, 8762},      // U+1EEA1
+	{0, 0x04, 0, 8770},      // U+1EEA2
+	{0, 0x04, 0, 8776},      // U+1EEA3
+	{0, 0x04, 0, 8814},      // U+1EEA5
+	{0, 0x04, 0, 8782},      // U+1EEA6
+	{0, 0x04, 0, 8772},      // U+1EEA7
+	{0, 0x04, 0, 8792},      // U+1EEA8
+	{0, 0x04, 0, 8816},      // U+1EEA9
+	{0, 0x04, 0, 8806},      // U+1EEAB
+	{0, 0x04, 0, 8808},      // U+1EEAC
+	{0, 0x04, 0, 8810},      // U+1EEAD
+	{0, 0x04, 0, 8784},      // U+1EEAE
+	{0, 0x04, 0, 8796},      // U+1EEAF
+	{0, 0x04, 0, 8800},      // U+1EEB0
+	{0, 0x04, 0, 8788},      // U+1EEB1
+	{0, 0x04, 0, 8802},      // U+1EEB2
+	{0, 0x04, 0, 8780},      // U+1EEB3
+	{0, 0x04, 0, 8786},      // U+1EEB4
+	{0, 0x04, 0, 8766},      // U+1EEB5
+	{0, 0x04, 0, 8768},      // U+1EEB6
+	{0, 0x04, 0, 8774},      // U+1EEB7
+	{0, 0x04, 0, 8778},      // U+1EEB8
+	{0, 0x04, 0, 8790},      // U+1EEB9
+	{0, 0x04, 0, 8794},      // U+1EEBA
+	{0, 0x04, 0, 8798},      // U+1EEBB
+	{0, 0x04, 0, 9254},      // U+1F100
+	{0, 0x04, 0, 9257},      // U+1F101
+	{0, 0x04, 0, 9260},      // U+1F102
+	{0, 0x04, 0, 9263},      // U+1F103
+	{0, 0x04, 0, 9266},      // U+1F104
+	{0, 0x04, 0, 9269},      // U+1F105
+	{0, 0x04, 0, 9272},      // U+1F106
+	{0, 0x04, 0, 9275},      // U+1F107
+	{0, 0x04, 0, 9278},      // U+1F108
+	{0, 0x04, 0, 9281},      // U+1F109
+	{0, 0x04, 0, 9284},      // U+1F10A
+	{0, 0x04, 0, 9287},      // U+1F110
+	{0, 0x04, 0, 9291},      // U+1F111
+	{0, 0x04, 0, 9295},      // U+1F112
+	{0, 0x04, 0, 9299},      // U+1F113
+	{0, 0x04, 0, 9303},      // U+1F114
+	{0, 0x04, 0, 9307},      // U+1F115
+	{0, 0x04, 0, 9311},      // U+1F116
+	{0, 0x04, 0, 9315},      // U+1F117
+	{0, 0x04, 0, 9319},      // U+1F118
+	{0, 0x04, 0, 9323},      // U+1F119
+	{0, 0x04, 0, 9327},      // U+1F11A
+	{0, 0x04, 0, 9331},      // U+1F11B
+	{0, 0x04, 0, 9335},      // U+1F11C
+	{0, 0x04, 0, 9339},      // U+1F11D
+	{0, 0x04, 0, 9343},      // U+1F11E
+	{0, 0x04, 0, 9347},      // U+1F11F
+	{0, 0x04, 0, 9351},      // U+1F120
+	{0, 0x04, 0, 9355},      // U+1F121
+	{0, 0x04, 0, 9359},      // U+1F122
+	{0, 0x04, 0, 9363},      // U+1F123
+	{0, 0x04, 0, 9367},      // U+1F124
+	{0, 0x04, 0, 9371},      // U+1F125
+	{0, 0x04, 0, 9375},      // U+1F126
+	{0, 0x04, 0, 9379},      // U+1F127
+	{0, 0x04, 0, 9383},      // U+1F128
+	{0, 0x04, 0, 9387},      // U+1F129
+	{0, 0x04, 0, 9391},      // U+1F12A
+	{0, 0x04, 0, 3403},      // U+1F12B
+	{0, 0x04, 0, 1506},      // U+1F12C
+	{0, 0x04, 0, 9395},      // U+1F12D
+	{0, 0x04, 0, 9398},      // U+1F12E
+	{0, 0x04, 0, 1472},      // U+1F130
+	{0, 0x04, 0, 1476},      // U+1F131
+	{0, 0x04, 0, 3403},      // U+1F132
+	{0, 0x04, 0, 1478},      // U+1F133
+	{0, 0x04, 0, 1480},      // U+1F134
+	{0, 0x04, 0, 3442},      // U+1F135
+	{0, 0x04, 0, 1484},      // U+1F136
+	{0, 0x04, 0, 1486},      // U+1F137
+	{0, 0x04, 0, 1488},      // U+1F138
+	{0, 0x04, 0, 1490},      // U+1F139
+	{0, 0x04, 0, 1492},      // U+1F13A
+	{0, 0x04, 0, 1494},      // U+1F13B
+	{0, 0x04, 0, 1496},      // U+1F13C
+	{0, 0x04, 0, 1498},      // U+1F13D
+	{0, 0x04, 0, 1500},      // U+1F13E
+	{0, 0x04, 0, 1504},      // U+1F13F
+	{0, 0x04, 0, 3426},      // U+1F140
+	{0, 0x04, 0, 1506},      // U+1F141
+	{0, 0x04, 0, 4047},      // U+1F142
+	{0, 0x04, 0, 1508},      // U+1F143
+	{0, 0x04, 0, 1510},      // U+1F144
+	{0, 0x04, 0, 3536},      // U+1F145
+	{0, 0x04, 0, 1512},      // U+1F146
+	{0, 0x04, 0, 3553},      // U+1F147
+	{0, 0x04, 0, 4049},      // U+1F148
+	{0, 0x04, 0, 3438},      // U+1F149
+	{0, 0x04, 0, 9401},      // U+1F14A
+	{0, 0x04, 0, 6341},      // U+1F14B
+	{0, 0x04, 0, 9404},      // U+1F14C
+	{0, 0x04, 0, 9407},      // U+1F14D
+	{0, 0x04, 0, 9410},      // U+1F14E
+	{0, 0x04, 0, 9414},      // U+1F14F
+	{0, 0x04, 0, 9417},      // U+1F16A
+	{0, 0x04, 0, 9420},      // U+1F16B
+	{0, 0x04, 0, 9423},      // U+1F16C
+	{0, 0x04, 0, 9426},      // U+1F190
+	{0, 0x04, 0, 9429},      // U+1F200
+	{0, 0x04, 0, 9432},      // U+1F201
+	{0, 0x04, 0, 5489},      // U+1F202
+	{0, 0x04, 0, 4204},      // U+1F210
+	{0, 0x04, 0, 9435},      // U+1F211
+	{0, 0x04, 0, 9437},      // U+1F212
+	{0, 0x04, 0, 4641},      // U+1F213
+	{0, 0x04, 0, 4090},      // U+1F214
+	{0, 0x04, 0, 9439},      // U+1F215
+	{0, 0x04, 0, 9441},      // U+1F216
+	{0, 0x04, 0, 4902},      // U+1F217
+	{0, 0x04, 0, 9443},      // U+1F218
+	{0, 0x04, 0, 9445},      // U+1F219
+	{0, 0x04, 0, 9447},      // U+1F21A
+	{0, 0x04, 0, 6966},      // U+1F21B
+	{0, 0x04, 0, 9449},      // U+1F21C
+	{0, 0x04, 0, 9451},      // U+1F21D
+	{0, 0x04, 0, 9453},      // U+1F21E
+	{0, 0x04, 0, 9455},      // U+1F21F
+	{0, 0x04, 0, 9457},      // U+1F220
+	{0, 0x04, 0, 9459},      // U+1F221
+	{0, 0x04, 0, 4276},      // U+1F222
+	{0, 0x04, 0, 9461},      // U+1F223
+	{0, 0x04, 0, 9463},      // U+1F224
+	{0, 0x04, 0, 9465},      // U+1F225
+	{0, 0x04, 0, 9467},      // U+1F226
+	{0, 0x04, 0, 9469},      // U+1F227
+	{0, 0x04, 0, 9471},      // U+1F228
+	{0, 0x04, 0, 4078},      // U+1F229
+	{0, 0x04, 0, 4886},      // U+1F22A
+	{0, 0x04, 0, 9473},      // U+1F22B
+	{0, 0x04, 0, 5351},      // U+1F22C
+	{0, 0x04, 0, 4892},      // U+1F22D
+	{0, 0x04, 0, 5353},      // U+1F22E
+	{0, 0x04, 0, 9475},      // U+1F22F
+	{0, 0x04, 0, 4388},      // U+1F230
+	{0, 0x04, 0, 9477},      // U+1F231
+	{0, 0x04, 0, 9479},      // U+1F232
+	{0, 0x04, 0, 9481},      // U+1F233
+	{0, 0x04, 0, 9483},      // U+1F234
+	{0, 0x04, 0, 9485},      // U+1F235
+	{0, 0x04, 0, 5317},      // U+1F236
+	{0, 0x04, 0, 4224},      // U+1F237
+	{0, 0x04, 0, 9487},      // U+1F238
+	{0, 0x04, 0, 9489},      // U+1F239
+	{0, 0x04, 0, 9491},      // U+1F23A
+	{0, 0x04, 0, 9493},      // U+1F23B
+	{0, 0x04, 0, 9495},      // U+1F240
+	{0, 0x04, 0, 9499},      // U+1F241
+	{0, 0x04, 0, 9503},      // U+1F242
+	{0, 0x04, 0, 9507},      // U+1F243
+	{0, 0x04, 0, 9511},      // U+1F244
+	{0, 0x04, 0, 9515},      // U+1F245
+	{0, 0x04, 0, 9519},      // U+1F246
+	{0, 0x04, 0, 9523},      // U+1F247
+	{0, 0x04, 0, 9527},      // U+1F248
+	{0, 0x04, 0, 9531},      // U+1F250
+	{0, 0x04, 0, 9533},      // U+1F251
+	{0, 0x04, 0, 3366},      // U+1FBF0
+	{0, 0x04, 0, 23},        // U+1FBF1
+	{0, 0x04, 0, 11},        // U+1FBF2
+	{0, 0x04, 0, 13},        // U+1FBF3
+	{0, 0x04, 0, 3368},      // U+1FBF4
+	{0, 0x04, 0, 3370},      // U+1FBF5
+	{0, 0x04, 0, 3372},      // U+1FBF6
+	{0, 0x04, 0, 3374},      // U+1FBF7
+	{0, 0x04, 0, 3376},      // U+1FBF8
+	{0, 0x04, 0, 3378},      // U+1FBF9
+	{0, 0x05, 9535, 9535},   // U+2F800
+	{0, 0x05, 9537, 9537},   // U+2F801
+	{0, 0x05, 9539, 9539},   // U+2F802
+	{0, 0x05, 9541, 9541},   // U+2F803
+	{0, 0x05, 9543, 9543},   // U+2F804
+	{0, 0x05, 7152, 7152},   // U+2F805
+	{0, 0x05, 9545, 9545},   // U+2F806
+	{0, 0x05, 9547, 9547},   // U+2F807
+	{0, 0x05, 9549, 9549},   // U+2F808
+	{0, 0x05, 9551, 9551},   // U+2F809
+	{0, 0x05, 7154, 7154},   // U+2F80A
+	{0, 0x05, 9553, 9553},   // U+2F80B
+	{0, 0x05, 9555, 9555},   // U+2F80C
+	{0, 0x05, 9557, 9557},   // U+2F80D
+	{0, 0x05, 7156, 7156},   // U+2F80E
+	{0, 0x05, 9559, 9559},   // U+2F80F
+	{0, 0x05, 9561, 9561},   // U+2F810
+	{0, 0x05, 9563, 9563},   // U+2F811
+	{0, 0x05, 9565, 9565},   // U+2F812
+	{0, 0x05, 9567, 9567},   // U+2F813
+	{0, 0x05, 9569, 9569},   // U+2F814
+	{0, 0x05, 9453, 9453},   // U+2F815
+	{0, 0x05, 9571, 9571},   // U+2F816
+	{0, 0x05, 9573, 9573},   // U+2F817
+	{0, 0x05, 9575, 9575},   // U+2F818
+	{0, 0x05, 9577, 9577},   // U+2F819
+	{0, 0x05, 9579, 9579},   // U+2F81A
+	{0, 0x05, 7266, 7266},   // U+2F81B
+	{0, 0x05, 9581, 9581},   // U+2F81C
+	{0, 0x05, 4110, 4110},   // U+2F81D
+	{0, 0x05, 9583, 9583},   // U+2F81E
+	{0, 0x05, 9585, 9585},   // U+2F81F
+	{0, 0x05, 9587, 9587},   // U+2F820
+	{0, 0x05, 9589, 9589},   // U+2F821
+	{0, 0x05, 9489, 9489},   // U+2F822
+	{0, 0x05, 9591, 9591},   // U+2F823
+	{0, 0x05, 9593, 9593},   // U+2F824
+	{0, 0x05, 7276, 7276},   // U+2F825
+	{0, 0x05, 7158, 7158},   // U+2F826
+	{0, 0x05, 7160, 7160},   // U+2F827
+	{0, 0x05, 7278, 7278},   // U+2F828
+	{0, 0x05, 9595, 9595},   // U+2F829
+	{0, 0x05, 9597, 9597},   // U+2F82A
+	{0, 0x05, 6794, 6794},   // U+2F82B
+	{0, 0x05, 9599, 9599},   // U+2F82C
+	{0, 0x05, 7162, 7162},   // U+2F82D
+	{0, 0x05, 9601, 9601},   // U+2F82E
+	{0, 0x05, 9603, 9603},   // U+2F82F
+	{0, 0x05, 9605, 9605},   // U+2F830
+	{0, 0x05, 9607, 9607},   // U+2F831
+	{0, 0x05, 9607, 9607},   // U+2F832
+	{0, 0x05, 9607, 9607},   // U+2F833
+	{0, 0x05, 9609, 9609},   // U+2F834
+	{0, 0x05, 9611, 9611},   // U+2F835
+	{0, 0x05, 9613, 9613},   // U+2F836
+	{0, 0x05, 9615, 9615},   // U+2F837
+	{0, 0x05, 9617, 9617},   // U+2F838
+	{0, 0x05, 9619, 9619},   // U+2F839
+	{0, 0x05, 9621, 9621},   // U+2F83A
+	{0, 0x05, 9623, 9623},   // U+2F83B
+	{0, 0x05, 9625, 9625},   // U+2F83C
+	{0, 0x05, 9627, 9627},   // U+2F83D
+	{0, 0x05, 9629, 9629},   // U+2F83E
+	{0, 0x05, 9631, 9631},   // U+2F83F
+	{0, 0x05, 9633, 9633},   // U+2F840
+	{0, 0x05, 9635, 9635},   // U+2F841
+	{0, 0x05, 9637, 9637},   // U+2F842
+	{0, 0x05, 9639, 9639},   // U+2F843
+	{0, 0x05, 9641, 9641},   // U+2F844
+	{0, 0x05, 9643, 9643},   // U+2F845
+	{0, 0x05, 9643, 9643},   // U+2F846
+	{0, 0x05, 7282, 7282},   // U+2F847
+	{0, 0x05, 9645, 9645},   // U+2F848
+	{0, 0x05, 9647, 9647},   // U+2F849
+	{0, 0x05, 9649, 9649},   // U+2F84A
+	{0, 0x05, 9651, 9651},   // U+2F84B
+	{0, 0x05, 7166, 7166},   // U+2F84C
+	{0, 0x05, 9653, 9653},   // U+2F84D
+	{0, 0x05, 9655, 9655},   // U+2F84E
+	{0, 0x05, 9657, 9657},   // U+2F84F
+	{0, 0x05, 7086, 7086},   // U+2F850
+	{0, 0x05, 9659, 9659},   // U+2F851
+	{0, 0x05, 9661, 9661},   // U+2F852
+	{0, 0x05, 9663, 9663},   // U+2F853
+	{0, 0x05, 9665, 9665},   // U+2F854
+	{0, 0x05, 9667, 9667},   // U+2F855
+	{0, 0x05, 9669, 9669},   // U+2F856
+	{0, 0x05, 9671, 9671},   // U+2F857
+	{0, 0x05, 9673, 9673},   // U+2F858
+	{0, 0x05, 9675, 9675},   // U+2F859
+	{0, 0x05, 9677, 9677},   // U+2F85A
+	{0, 0x05, 9679, 9679},   // U+2F85B
+	{0, 0x05, 9681, 9681},   // U+2F85C
+	{0, 0x05, 9439, 9439},   // U+2F85D
+	{0, 0x05, 9683, 9683},   // U+2F85E
+	{0, 0x05, 9685, 9685},   // U+2F85F
+	{0, 0x05, 9687, 9687},   // U+2F860
+	{0, 0x05, 9689, 9689},   // U+2F861
+	{0, 0x05, 9691, 9691},   // U+2F862
+	{0, 0x05, 9693, 9693},   // U+2F863
+	{0, 0x05, 9695, 9695},   // U+2F864
+	{0, 0x05, 9697, 9697},   // U+2F865
+	{0, 0x05, 9699, 9699},   // U+2F866
+	{0, 0x05, 9701, 9701},   // U+2F867
+	{0, 0x05, 9703, 9703},   // U+2F868
+	{0, 0x05, 9705, 9705},   // U+2F869
+	{0, 0x05, 9707, 9707},   // U+2F86A
+	{0, 0x05, 9707, 9707},   // U+2F86B
+	{0, 0x05, 9709, 9709},   // U+2F86C
+	{0, 0x05, 9711, 9711},   // U+2F86D
+	{0, 0x05, 9713, 9713},   // U+2F86E
+	{0, 0x05, 6786, 6786},   // U+2F86F
+	{0, 0x05, 9715, 9715},   // U+2F870
+	{0, 0x05, 9717, 9717},   // U+2F871
+	{0, 0x05, 9719, 9719},   // U+2F872
+	{0, 0x05, 9721, 9721},   // U+2F873
+	{0, 0x05, 9723, 9723},   // U+2F874
+	{0, 0x05, 4162, 4162},   // U+2F875
+	{0, 0x05, 9725, 9725},   // U+2F876
+	{0, 0x05, 9727, 9727},   // U+2F877
+	{0, 0x05, 4166, 4166},   // U+2F878
+	{0, 0x05, 9729, 9729},   // U+2F879
+	{0, 0x05, 9731, 9731},   // U+2F87A
+	{0, 0x05, 9733, 9733},   // U+2F87B
+	{0, 0x05, 9735, 9735},   // U+2F87C
+	{0, 0x05, 9737, 9737},   // U+2F87D
+	{0, 0x05, 9739, 9739},   // U+2F87E
+	{0, 0x05, 9741, 9741},   // U+2F87F
+	{0, 0x05, 9743, 9743},   // U+2F880
+	{0, 0x05, 9745, 9745},   // U+2F881
+	{0, 0x05, 9747, 9747},   // U+2F882
+	{0, 0x05, 9749, 9749},   // U+2F883
+	{0, 0x05, 9751, 9751},   // U+2F884
+	{0, 0x05, 9753, 9753},   // U+2F885
+	{0, 0x05, 9755, 9755},   // U+2F886
+	{0, 0x05, 9757, 9757},   // U+2F887
+	{0, 0x05, 9759, 9759},   // U+2F888
+	{0, 0x05, 9761, 9761},   // U+2F889
+	{0, 0x05, 9763, 9763},   // U+2F88A
+	{0, 0x05, 9765, 9765},   // U+2F88B
+	{0, 0x05, 9767, 9767},   // U+2F88C
+	{0, 0x05, 9769, 9769},   // U+2F88D
+	{0, 0x05, 6682, 6682},   // U+2F88E
+	{0, 0x05, 9771, 9771},   // U+2F88F
+	{0, 0x05, 4186, 4186},   // U+2F890
+	{0, 0x05, 9773, 9773},   // U+2F891
+	{0, 0x05, 9773, 9773},   // U+2F892
+	{0, 0x05, 9775, 9775},   // U+2F893
+	{0, 0x05, 9777, 9777},   // U+2F894
+	{0, 0x05, 9777, 9777},   // U+2F895
+	{0, 0x05, 9779, 9779},   // U+2F896
+	{0, 0x05, 9781, 9781},   // U+2F897
+	{0, 0x05, 9783, 9783},   // U+2F898
+	{0, 0x05, 9785, 9785},   // U+2F899
+	{0, 0x05, 9787, 9787},   // U+2F89A
+	{0, 0x05, 9789, 9789},   // U+2F89B
+	{0, 0x05, 9791, 9791},   // U+2F89C
+	{0, 0x05, 9793, 9793},   // U+2F89D
+	{0, 0x05, 9795, 9795},   // U+2F89E
+	{0, 0x05, 9797, 9797},   // U+2F89F
+	{0, 0x05, 9799, 9799},   // U+2F8A0
+	{0, 0x05, 9801, 9801},   // U+2F8A1
+	{0, 0x05, 9803, 9803},   // U+2F8A2
+	{0, 0x05, 7176, 7176},   // U+2F8A3
+	{0, 0x05, 9805, 9805},   // U+2F8A4
+	{0, 0x05, 9807, 9807},   // U+2F8A5
+	{0, 0x05, 9809, 9809},   // U+2F8A6
+	{0, 0x05, 9811, 9811},   // U+2F8A7
+	{0, 0x05, 7306, 7306},   // U+2F8A8
+	{0, 0x05, 9811, 9811},   // U+2F8A9
+	{0, 0x05, 9813, 9813},   // U+2F8AA
+	{0, 0x05, 7180, 7180},   // U+2F8AB
+	{0, 0x05, 9815, 9815},   // U+2F8AC
+	{0, 0x05, 9817, 9817},   // U+2F8AD
+	{0, 0x05, 9819, 9819},   // U+2F8AE
+	{0, 0x05, 9821, 9821},   // U+2F8AF
+	{0, 0x05, 7182, 7182},   // U+2F8B0
+	{0, 0x05, 6628, 6628},   // U+2F8B1
+	{0, 0x05, 9823, 9823},   // U+2F8B2
+	{0, 0x05, 9825, 9825},   // U+2F8B3
+	{0, 0x05, 9827, 9827},   // U+2F8B4
+	{0, 0x05, 9829, 9829},   // U+2F8B5
+	{0, 0x05, 9831, 9831},   // U+2F8B6
+	{0, 0x05, 9833, 9833},   // U+2F8B7
+	{0, 0x05, 9835, 9835},   // U+2F8B8
+	{0, 0x05, 9837, 9837},   // U+2F8B9
+	{0, 0x05, 9839, 9839},   // U+2F8BA
+	{0, 0x05, 9841, 9841},   // U+2F8BB
+	{0, 0x05, 9843, 9843},   // U+2F8BC
+	{0, 0x05, 9845, 9845},   // U+2F8BD
+	{0, 0x05, 9847, 9847},   // U+2F8BE
+	{0, 0x05, 9849, 9849},   // U+2F8BF
+	{0, 0x05, 9851, 9851},   // U+2F8C0
+	{0, 0x05, 9853, 9853},   // U+2F8C1
+	{0, 0x05, 9855, 9855},   // U+2F8C2
+	{0, 0x05, 9857, 9857},   // U+2F8C3
+	{0, 0x05, 9859, 9859},   // U+2F8C4
+	{0, 0x05, 9861, 9861},   // U+2F8C5
+	{0, 0x05, 9863, 9863},   // U+2F8C6
+	{0, 0x05, 9865, 9865},   // U+2F8C7
+	{0, 0x05, 7184, 7184},   // U+2F8C8
+	{0, 0x05, 9867, 9867},   // U+2F8C9
+	{0, 0x05, 9869, 9869},   // U+2F8CA
+	{0, 0x05, 9871, 9871},   // U+2F8CB
+	{0, 0x05, 9873, 9873},   // U+2F8CC
+	{0, 0x05, 9875, 9875},   // U+2F8CD
+	{0, 0x05, 9877, 9877},   // U+2F8CE
+	{0, 0x05, 7188, 7188},   // U+2F8CF
+	{0, 0x05, 9879, 9879},   // U+2F8D0
+	{0, 0x05, 9881, 9881},   // U+2F8D1
+	{0, 0x05, 9883, 9883},   // U+2F8D2
+	{0, 0x05, 9885, 9885},   // U+2F8D3
+	{0, 0x05, 9887, 9887},   // U+2F8D4
+	{0, 0x05, 9889, 9889},   // U+2F8D5
+	{0, 0x05, 9891, 9891},   // U+2F8D6
+	{0, 0x05, 9893, 9893},   // U+2F8D7
+	{0, 0x05, 6684, 6684},   // U+2F8D8
+	{0, 0x05, 7322, 7322},   // U+2F8D9
+	{0, 0x05, 9895, 9895},   // U+2F8DA
+	{0, 0x05, 9897, 9897},   // U+2F8DB
+	{0, 0x05, 9899, 9899},   // U+2F8DC
+	{0, 0x05, 9901, 9901},   // U+2F8DD
+	{0, 0x05, 9903, 9903},   // U+2F8DE
+	{0, 0x05, 9905, 9905},   // U+2F8DF
+	{0, 0x05, 9907, 9907},   // U+2F8E0
+	{0, 0x05, 9909, 9909},   // U+2F8E1
+	{0, 0x05, 7190, 7190},   // U+2F8E2
+	{0, 0x05, 9911, 9911},   // U+2F8E3
+	{0, 0x05, 9913, 9913},   // U+2F8E4
+	{0, 0x05, 9915, 9915},   // U+2F8E5
+	{0, 0x05, 9917, 9917},   // U+2F8E6
+	{0, 0x05, 7406, 7406},   // U+2F8E7
+	{0, 0x05, 9919, 9919},   // U+2F8E8
+	{0, 0x05, 9921, 9921},   // U+2F8E9
+	{0, 0x05, 9923, 9923},   // U+2F8EA
+	{0, 0x05, 9925, 9925},   // U+2F8EB
+	{0, 0x05, 9927, 9927},   // U+2F8EC
+	{0, 0x05, 9929, 9929},   // U+2F8ED
+	{0, 0x05, 9931, 9931},   // U+2F8EE
+	{0, 0x05, 9933, 9933},   // U+2F8EF
+	{0, 0x05, 9935, 9935},   // U+2F8F0
+	{0, 0x05, 9937, 9937},   // U+2F8F1
+	{0, 0x05, 9939, 9939},   // U+2F8F2
+	{0, 0x05, 9941, 9941},   // U+2F8F3
+	{0, 0x05, 9943, 9943},   // U+2F8F4
+	{0, 0x05, 6820, 6820},   // U+2F8F5
+	{0, 0x05, 9945, 9945},   // U+2F8F6
+	{0, 0x05, 9947, 9947},   // U+2F8F7
+	{0, 0x05, 9949, 9949},   // U+2F8F8
+	{0, 0x05, 9951, 9951},   // U+2F8F9
+	{0, 0x05, 9953, 9953},   // U+2F8FA
+	{0, 0x05, 9955, 9955},   // U+2F8FB
+	{0, 0x05, 9957, 9957},   // U+2F8FC
+	{0, 0x05, 9959, 9959},   // U+2F8FD
+	{0, 0x05, 9961, 9961},   // U+2F8FE
+	{0, 0x05, 9963, 9963},   // U+2F8FF
+	{0, 0x05, 9965, 9965},   // U+2F900
+	{0, 0x05, 7192, 7192},   // U+2F901
+	{0, 0x05, 6986, 6986},   // U+2F902
+	{0, 0x05, 9967, 9967},   // U+2F903
+	{0, 0x05, 9969, 9969},   // U+2F904
+	{0, 0x05, 9971, 9971},   // U+2F905
+	{0, 0x05, 9973, 9973},   // U+2F906
+	{0, 0x05, 9975, 9975},   // U+2F907
+	{0, 0x05, 9977, 9977},   // U+2F908
+	{0, 0x05, 9979, 9979},   // U+2F909
+	{0, 0x05, 9981, 9981},   // U+2F90A
+	{0, 0x05, 7328, 7328},   // U+2F90B
+	{0, 0x05, 9983, 9983},   // U+2F90C
+	{0, 0x05, 9985, 9985},   // U+2F90D
+	{0, 0x05, 9987, 9987},   // U+2F90E
+	{0, 0x05, 9989, 9989},   // U+2F90F
+	{0, 0x05, 9991, 9991},   // U+2F910
+	{0, 0x05, 9993, 9993},   // U+2F911
+	{0, 0x05, 9995, 9995},   // U+2F912
+	{0, 0x05, 9997, 9997},   // U+2F913
+	{0, 0x05, 7330, 7330},   // U+2F914
+	{0, 0x05, 9999, 9999},   // U+2F915
+	{0, 0x05, 10001, 10001}, // U+2F916
+	{0, 0x05, 10003, 10003}, // U+2F917
+	{0, 0x05, 10005, 10005}, // U+2F918
+	{0, 0x05, 10007, 10007}, // U+2F919
+	{0, 0x05, 10009, 10009}, // U+2F91A
+	{0, 0x05, 10011, 10011}, // U+2F91B
+	{0, 0x05, 10013, 10013}, // U+2F91C
+	{0, 0x05, 10015, 10015}, // U+2F91D
+	{0, 0x05, 10017, 10017}, // U+2F91E
+	{0, 0x05, 10019, 10019}, // U+2F91F
+	{0, 0x05, 10021, 10021}, // U+2F920
+	{0, 0x05, 7334, 7334},   // U+2F921
+	{0, 0x05, 10023, 10023}, // U+2F922
+	{0, 0x05, 10025, 10025}, // U+2F923
+	{0, 0x05, 10027, 10027}, // U+2F924
+	{0, 0x05, 10029, 10029}, // U+2F925
+	{0, 0x05, 10031, 10031}, // U+2F926
+	{0, 0x05, 10033, 10033}, // U+2F927
+	{0, 0x05, 10035, 10035}, // U+2F928
+	{0, 0x05, 10037, 10037}, // U+2F929
+	{0, 0x05, 10039, 10039}, // U+2F92A
+	{0, 0x05, 10041, 10041}, // U+2F92B
+	{0, 0x05, 10043, 10043}, // U+2F92C
+	{0, 0x05, 10043, 10043}, // U+2F92D
+	{0, 0x05, 10045, 10045}, // U+2F92E
+	{0, 0x05, 10047, 10047}, // U+2F92F
+	{0, 0x05, 7338, 7338},   // U+2F930
+	{0, 0x05, 10049, 10049}, // U+2F931
+	{0, 0x05, 10051, 10051}, // U+2F932
+	{0, 0x05, 10053, 10053}, // U+2F933
+	{0, 0x05, 10055, 10055}, // U+2F934
+	{0, 0x05, 10057, 10057}, // U+2F935
+	{0, 0x05, 10059, 10059}, // U+2F936
+	{0, 0x05, 10061, 10061}, // U+2F937
+	{0, 0x05, 6792, 6792},   // U+2F938
+	{0, 0x05, 10063, 10063}, // U+2F939
+	{0, 0x05, 10065, 10065}, // U+2F93A
+	{0, 0x05, 10067, 10067}, // U+2F93B
+	{0, 0x05, 10069, 10069}, // U+2F93C
+	{0, 0x05, 10071, 10071}, // U+2F93D
+	{0, 0x05, 10073, 10073}, // U+2F93E
+	{0, 0x05, 10075, 10075}, // U+2F93F
+	{0, 0x05, 7350, 7350},   // U+2F940
+	{0, 0x05, 10077, 10077}, // U+2F941
+	{0, 0x05, 10079, 10079}, // U+2F942
+	{0, 0x05, 10081, 10081}, // U+2F943
+	{0, 0x05, 10083, 10083}, // U+2F944
+	{0, 0x05, 10085, 10085}, // U+2F945
+	{0, 0x05, 10087, 10087}, // U+2F946
+	{0, 0x05, 10087, 10087}, // U+2F947
+	{0, 0x05, 7352, 7352},   // U+2F948
+	{0, 0x05, 7410, 7410},   // U+2F949
+	{0, 0x05, 10089, 10089}, // U+2F94A
+	{0, 0x05, 10091, 10091}, // U+2F94B
+	{0, 0x05, 10093, 10093}, // U+2F94C
+	{0, 0x05, 10095, 10095}, // U+2F94D
+	{0, 0x05, 10097, 10097}, // U+2F94E
+	{0, 0x05, 6718, 6718},   // U+2F94F
+	{0, 0x05, 7356, 7356},   // U+2F950
+	{0, 0x05, 10099, 10099}, // U+2F951
+	{0, 0x05, 10101, 10101}, // U+2F952
+	{0, 0x05, 7212, 7212},   // U+2F953
+	{0, 0x05, 10103, 10103}, // U+2F954
+	{0, 0x05, 10105, 10105}, // U+2F955
+	{0, 0x05, 7126, 7126},   // U+2F956
+	{0, 0x05, 10107, 10107}, // U+2F957
+	{0, 0x05, 10109, 10109}, // U+2F958
+	{0, 0x05, 7218, 7218},   // U+2F959
+	{0, 0x05, 10111, 10111}, // U+2F95A
+	{0, 0x05, 10113, 10113}, // U+2F95B
+	{0, 0x05, 10115, 10115}, // U+2F95C
+	{0, 0x05, 10117, 10117}, // U+2F95D
+	{0, 0x05, 10117, 10117}, // U+2F95E
+	{0, 0x05, 10119, 10119}, // U+2F95F
+	{0, 0x05, 10121, 10121}, // U+2F960
+	{0, 0x05, 10123, 10123}, // U+2F961
+	{0, 0x05, 10125, 10125}, // U+2F962
+	{0, 0x05, 10127, 10127}, // U+2F963
+	{0, 0x05, 10129, 10129}, // U+2F964
+	{0, 0x05, 10131, 10131}, // U+2F965
+	{0, 0x05, 10133, 10133}, // U+2F966
+	{0, 0x05, 10135, 10135}, // U+2F967
+	{0, 0x05, 10137, 10137}, // U+2F968
+	{0, 0x05, 10139, 10139}, // U+2F969
+	{0, 0x05, 10141, 10141}, // U+2F96A
+	{0, 0x05, 10143, 10143}, // U+2F96B
+	{0, 0x05, 10145, 10145}, // U+2F96C
+	{0, 0x05, 10147, 10147}, // U+2F96D
+	{0, 0x05, 10149, 10149}, // U+2F96E
+	{0, 0x05, 10151, 10151}, // U+2F96F
+	{0, 0x05, 10153, 10153}, // U+2F970
+	{0, 0x05, 10155, 10155}, // U+2F971
+	{0, 0x05, 10157, 10157}, // U+2F972
+	{0, 0x05, 10159, 10159}, // U+2F973
+	{0, 0x05, 10161, 10161}, // U+2F974
+	{0, 0x05, 10163, 10163}, // U+2F975
+	{0, 0x05, 10165, 10165}, // U+2F976
+	{0, 0x05, 10167, 10167}, // U+2F977
+	{0, 0x05, 10169, 10169}, // U+2F978
+	{0, 0x05, 10171, 10171}, // U+2F979
+	{0, 0x05, 7230, 7230},   // U+2F97A
+	{0, 0x05, 10173, 10173}, // U+2F97B
+	{0, 0x05, 10175, 10175}, // U+2F97C
+	{0, 0x05, 10177, 10177}, // U+2F97D
+	{0, 0x05, 10179, 10179}, // U+2F97E
+	{0, 0x05, 10181, 10181}, // U+2F97F
+	{0, 0x05, 10183, 10183}, // U+2F980
+	{0, 0x05, 10185, 10185}, // U+2F981
+	{0, 0x05, 10187, 10187}, // U+2F982
+	{0, 0x05, 10189, 10189}, // U+2F983
+	{0, 0x05, 10191, 10191}, // U+2F984
+	{0, 0x05, 10193, 10193}, // U+2F985
+	{0, 0x05, 10195, 10195}, // U+2F986
+	{0, 0x05, 10197, 10197}, // U+2F987
+	{0, 0x05, 10199, 10199}, // U+2F988
+	{0, 0x05, 10201, 10201}, // U+2F989
+	{0, 0x05, 10203, 10203}, // U+2F98A
+	{0, 0x05, 9775, 9775},   // U+2F98B
+	{0, 0x05, 10205, 10205}, // U+2F98C
+	{0, 0x05, 10207, 10207}, // U+2F98D
+	{0, 0x05, 10209, 10209}, // U+2F98E
+	{0, 0x05, 10211, 10211}, // U+2F98F
+	{0, 0x05, 10213, 10213}, // U+2F990
+	{0, 0x05, 10215, 10215}, // U+2F991
+	{0, 0x05, 10217, 10217}, // U+2F992
+	{0, 0x05, 10219, 10219}, // U+2F993
+	{0, 0x05, 10221, 10221}, // U+2F994
+	{0, 0x05, 10223, 10223}, // U+2F995
+	{0, 0x05, 10225, 10225}, // U+2F996
+	{0, 0x05, 10227, 10227}, // U+2F997
+	{0, 0x05, 6826, 6826},   // U+2F998
+	{0, 0x05, 10229, 10229}, // U+2F999
+	{0, 0x05, 10231, 10231}, // U+2F99A
+	{0, 0x05, 10233, 10233}, // U+2F99B
+	{0, 0x05, 10235, 10235}, // U+2F99C
+	{0, 0x05, 10237, 10237}, // U+2F99D
+	{0, 0x05, 10239, 10239}, // U+2F99E
+	{0, 0x05, 7236, 7236},   // U+2F99F
+	{0, 0x05, 10241, 10241}, // U+2F9A0
+	{0, 0x05, 10243, 10243}, // U+2F9A1
+	{0, 0x05, 10245, 10245}, // U+2F9A2
+	{0, 0x05, 10247, 10247}, // U+2F9A3
+	{0, 0x05, 10249, 10249}, // U+2F9A4
+	{0, 0x05, 10251, 10251}, // U+2F9A5
+	{0, 0x05, 10253, 10253}, // U+2F9A6
+	{0, 0x05, 10255, 10255}, // U+2F9A7
+	{0, 0x05, 10257, 10257}, // U+2F9A8
+	{0, 0x05, 10259, 10259}, // U+2F9A9
+	{0, 0x05, 10261, 10261}, // U+2F9AA
+	{0, 0x05, 10263, 10263}, // U+2F9AB
+	{0, 0x05, 10265, 10265}, // U+2F9AC
+	{0, 0x05, 10267, 10267}, // U+2F9AD
+	{0, 0x05, 10269, 10269}, // U+2F9AE
+	{0, 0x05, 10271, 10271}, // U+2F9AF
+	{0, 0x05, 10273, 10273}, // U+2F9B0
+	{0, 0x05, 10275, 10275}, // U+2F9B1
+	{0, 0x05, 10277, 10277}, // U+2F9B2
+	{0, 0x05, 10279, 10279}, // U+2F9B3
+	{0, 0x05, 6708, 6708},   // U+2F9B4
+	{0, 0x05, 10281, 10281}, // U+2F9B5
+	{0, 0x05, 10283, 10283}, // U+2F9B6
+	{0, 0x05, 10285, 10285}, // U+2F9B7
+	{0, 0x05, 10287, 10287}, // U+2F9B8
+	{0, 0x05, 10289, 10289}, // U+2F9B9
+	{0, 0x05, 10291, 10291}, // U+2F9BA
+	{0, 0x05, 7370, 7370},   // U+2F9BB
+	{0, 0x05, 10293, 10293}, // U+2F9BC
+	{0, 0x05, 10295, 10295}, // U+2F9BD
+	{0, 0x05, 10297, 10297}, // U+2F9BE
+	{0, 0x05, 10299, 10299}, // U+2F9BF
+	{0, 0x05, 10301, 10301}, // U+2F9C0
+	{0, 0x05, 10303, 10303}, // U+2F9C1
+	{0, 0x05, 10305, 10305}, // U+2F9C2
+	{0, 0x05, 10307, 10307}, // U+2F9C3
+	{0, 0x05, 4366, 4366},   // U+2F9C4
+	{0, 0x05, 10309, 10309}, // U+2F9C5
+	{0, 0x05, 10311, 10311}, // U+2F9C6
+	{0, 0x05, 10313, 10313}, // U+2F9C7
+	{0, 0x05, 10315, 10315}, // U+2F9C8
+	{0, 0x05, 10317, 10317}, // U+2F9C9
+	{0, 0x05, 10319, 10319}, // U+2F9CA
+	{0, 0x05, 10321, 10321}, // U+2F9CB
+	{0, 0x05, 10323, 10323}, // U+2F9CC
+	{0, 0x05, 10325, 10325}, // U+2F9CD
+	{0, 0x05, 10327, 10327}, // U+2F9CE
+	{0, 0x05, 10329, 10329}, // U+2F9CF
+	{0, 0x05, 7380, 7380},   // U+2F9D0
+	{0, 0x05, 7382, 7382},   // U+2F9D1
+	{0, 0x05, 4380, 4380},   // U+2F9D2
+	{0, 0x05, 10331, 10331}, // U+2F9D3
+	{0, 0x05, 10333, 10333}, // U+2F9D4
+	{0, 0x05, 10335, 10335}, // U+2F9D5
+	{0, 0x05, 10337, 10337}, // U+2F9D6
+	{0, 0x05, 10339, 10339}, // U+2F9D7
+	{0, 0x05, 10341, 10341}, // U+2F9D8
+	{0, 0x05, 10343, 10343}, // U+2F9D9
+	{0, 0x05, 10345, 10345}, // U+2F9DA
+	{0, 0x05, 10347, 10347}, // U+2F9DB
+	{0, 0x05, 10349, 10349}, // U+2F9DC
+	{0, 0x05, 10351, 10351}, // U+2F9DD
+	{0, 0x05, 10353, 10353}, // U+2F9DE
+	{0, 0x05, 7384, 7384},   // U+2F9DF
+	{0, 0x05, 10355, 10355}, // U+2F9E0
+	{0, 0x05, 10357, 10357}, // U+2F9E1
+	{0, 0x05, 10359, 10359}, // U+2F9E2
+	{0, 0x05, 10361, 10361}, // U+2F9E3
+	{0, 0x05, 10363, 10363}, // U+2F9E4
+	{0, 0x05, 10365, 10365}, // U+2F9E5
+	{0, 0x05, 10367, 10367}, // U+2F9E6
+	{0, 0x05, 10369, 10369}, // U+2F9E7
+	{0, 0x05, 10371, 10371}, // U+2F9E8
+	{0, 0x05, 10373, 10373}, // U+2F9E9
+	{0, 0x05, 10375, 10375}, // U+2F9EA
+	{0, 0x05, 10377, 10377}, // U+2F9EB
+	{0, 0x05, 10379, 10379}, // U+2F9EC
+	{0, 0x05, 10381, 10381}, // U+2F9ED
+	{0, 0x05, 10383, 10383}, // U+2F9EE
+	{0, 0x05, 10385, 10385}, // U+2F9EF
+	{0, 0x05, 10387, 10387}, // U+2F9F0
+	{0, 0x05, 10389, 10389}, // U+2F9F1
+	{0, 0x05, 10391, 10391}, // U+2F9F2
+	{0, 0x05, 10393, 10393}, // U+2F9F3
+	{0, 0x05, 10395, 10395}, // U+2F9F4
+	{0, 0x05, 10397, 10397}, // U+2F9F5
+	{0, 0x05, 10399, 10399}, // U+2F9F6
+	{0, 0x05, 10401, 10401}, // U+2F9F7
+	{0, 0x05, 10403, 10403}, // U+2F9F8
+	{0, 0x05, 10405, 10405}, // U+2F9F9
+	{0, 0x05, 10407, 10407}, // U+2F9FA
+	{0, 0x05, 10409, 10409}, // U+2F9FB
+	{0, 0x05, 10411, 10411}, // U+2F9FC
+	{0, 0x05, 10413, 10413}, // U+2F9FD
+	{0, 0x05, 7396, 7396},   // U+2F9FE
+	{0, 0x05, 7396, 7396},   // U+2F9FF
+	{0, 0x05, 10415, 10415}, // U+2FA00
+	{0, 0x05, 10417, 10417}, // U+2FA01
+	{0, 0x05, 10419, 10419}, // U+2FA02
+	{0, 0x05, 10421, 10421}, // U+2FA03
+	{0, 0x05, 10423, 10423}, // U+2FA04
+	{0, 0x05, 10425, 10425}, // U+2FA05
+	{0, 0x05, 10427, 10427}, // U+2FA06
+	{0, 0x05, 10429, 10429}, // U+2FA07
+	{0, 0x05, 10431, 10431}, // U+2FA08
+	{0, 0x05, 10433, 10433}, // U+2FA09
+	{0, 0x05, 7398, 7398},   // U+2FA0A
+	{0, 0x05, 10435, 10435}, // U+2FA0B
+	{0, 0x05, 10437, 10437}, // U+2FA0C
+	{0, 0x05, 10439, 10439}, // U+2FA0D
+	{0, 0x05, 10441, 10441}, // U+2FA0E
+	{0, 0x05, 10443, 10443}, // U+2FA0F
+	{0, 0x05, 10445, 10445}, // U+2FA10
+	{0, 0x05, 10447, 10447}, // U+2FA11
+	{0, 0x05, 10449, 10449}, // U+2FA12
+	{0, 0x05, 10451, 10451}, // U+2FA13
+	{0, 0x05, 10453, 10453}, // U+2FA14
+	{0, 0x05, 4476, 4476},   // U+2FA15
+	{0, 0x05, 10455, 10455}, // U+2FA16
+	{0, 0x05, 4484, 4484},   // U+2FA17
+	{0, 0x05, 10457, 10457}, // U+2FA18
+	{0, 0x05, 10459, 10459}, // U+2FA19
+	{0, 0x05, 10461, 10461}, // U+2FA1A
+	{0, 0x05, 10463, 10463}, // U+2FA1B
+	{0, 0x05, 4494, 4494},   // U+2FA1C
+	{0, 0x05, 10465, 10465}, // U+2FA1D
+}
+
+// decompData holds the full decompositions referenced by infoTable. Each
+// decomposition is preceded by its length.
+var decompData = [...]rune{
+	0x0000, 0x0001, 0x0020, 0x0002, 0x0020, 0x0308, 0x0001, 0x0061,
+	0x0002, 0x0020, 0x0304, 0x0001, 0x0032, 0x0001, 0x0033, 0x0002,
+	0x0020, 0x0301, 0x0001, 0x03BC, 0x0002, 0x0020, 0x0327, 0x0001,
+	0x0031, 0x0001, 0x006F, 0x0003, 0x0031, 0x2044, 0x0034, 0x0003,
+	0x0031, 0x2044, 0x0032, 0x0003, 0x0033, 0x2044, 0x0034, 0x0002,
+	0x0041, 0x0300, 0x0002, 0x0041, 0x0301, 0x0002, 0x0041, 0x0302,
+	0x0002, 0x0041, 0x0303, 0x0002, 0x0041, 0x0308, 0x0002, 0x0041,
+	0x030A, 0x0002, 0x0043, 0x0327, 0x0002, 0x0045, 0x0300, 0x0002,
+	0x0045, 0x0301, 0x0002, 0x0045, 0x0302, 0x0002, 0x0045, 0x0308,
+	0x0002, 0x0049, 0x0300, 0x0002, 0x0049, 0x0301, 0x0002, 0x0049,
+	0x0302, 0x0002, 0x0049, 0x0308, 0x0002, 0x004E, 0x0303, 0x0002,
+	0x004F, 0x0300, 0x0002, 0x004F, 0x0301, 0x0002, 0x004F, 0x0302,
+	0x0002, 0x004F, 0x0303, 0x0002, 0x004F, 0x0308, 0x0002, 0x0055,
+	0x0300, 0x0002, 0x0055, 0x0301, 0x0002, 0x0055, 0x0302, 0x0002,
+	0x0055, 0x0308, 0x0002, 0x0059, 0x0301, 0x0002, 0x0061, 0x0300,
+	0x0002, 0x0061, 0x0301, 0x0002, 0x0061, 0x0302, 0x0002, 0x0061,
+	0x0303, 0x0002, 0x0061, 0x0308, 0x0002, 0x0061, 0x030A, 0x0002,
+	0x0063, 0x0327, 0x0002, 0x0065, 0x0300, 0x0002, 0x0065, 0x0301,
+	0x0002, 0x0065, 0x0302, 0x0002, 0x0065, 0x0308, 0x0002, 0x0069,
+	0x0300, 0x0002, 0x0069, 0x0301, 0x0002, 0x0069, 0x0302, 0x0002,
+	0x0069, 0x0308, 0x0002, 0x006E, 0x0303, 0x0002, 0x006F, 0x0300,
+	0x0002, 0x006F, 0x0301, 0x0002, 0x006F, 0x0302, 0x0002, 0x006F,
+	0x0303, 0x0002, 0x006F, 0x0308, 0x0002, 0x0075, 0x0300, 0x0002,
+	0x0075, 0x0301, 0x0002, 0x0075, 0x0302, 0x0002, 0x0075, 0x0308,
+	0x0002, 0x0079, 0x0301, 0x0002, 0x0079, 0x0308, 0x0002, 0x0041,
+	0x0304, 0x0002, 0x0061, 0x0304, 0x0002, 0x0041, 0x0306, 0x0002,
+	0x0061, 0x0306, 0x0002, 0x0041, 0x0328, 0x0002, 0x0061, 0x0328,
+	0x0002, 0x0043, 0x0301, 0x0002, 0x0063, 0x0301, 0x0002, 0x0043,
+	0x0302, 0x0002, 0x0063, 0x0302, 0x0002, 0x0043, 0x0307, 0x0002,
+	0x0063, 0x0307, 0x0002, 0x0043, 0x030C, 0x0002, 0x0063, 0x030C,
+	0x0002, 0x0044, 0x030C, 0x0002, 0x0064, 0x030C, 0x0002, 0x0045,
+	0x0304, 0x0002, 0x0065, 0x0304, 0x0002, 0x0045, 0x0306, 0x0002,
+	0x0065, 0x0306, 0x0002, 0x0045, 0x0307, 0x0002, 0x0065, 0x0307,
+	0x0002, 0x0045, 0x0328, 0x0002, 0x0065, 0x0328, 0x0002, 0x0045,
+	0x030C, 0x0002, 0x0065, 0x030C, 0x0002, 0x0047, 0x0302, 0x0002,
+	0x0067, 0x0302, 0x0002, 0x0047, 0x0306, 0x0002, 0x0067, 0x0306,
+	0x0002, 0x0047, 0x0307, 0x0002, 0x0067, 0x0307, 0x0002, 0x0047,
+	0x0327, 0x0002, 0x0067, 0x0327, 0x0002, 0x0048, 0x0302, 0x0002,
+	0x0068, 0x0302, 0x0002, 0x0049, 0x0303, 0x0002, 0x0069, 0x0303,
+	0x0002, 0x0049, 0x0304, 0x0002, 0x0069, 0x0304, 0x0002, 0x0049,
+	0x0306, 0x0002, 0x0069, 0x0306, 0x0002, 0x0049, 0x0328, 0x0002,
+	0x0069, 0x0328, 0x0002, 0x0049, 0x0307, 0x0002, 0x0049, 0x004A,
+	0x0002, 0x0069, 0x006A, 0x0002, 0x004A, 0x0302, 0x0002, 0x006A,
+	0x0302, 0x0002, 0x004B, 0x0327, 0x0002, 0x006B, 0x0327, 0x0002,
+	0x004C, 0x0301, 0x0002, 0x006C, 0x0301, 0x0002, 0x004C, 0x0327,
+	0x0002, 0x006C, 0x0327, 0x0002, 0x004C, 0x030C, 0x0002, 0x006C,
+	0x030C, 0x0002, 0x004C, 0x00B7, 0x0002, 0x006C, 0x00B7, 0x0002,
+	0x004E, 0x0301, 0x0002, 0x006E, 0x0301, 0x0002, 0x004E, 0x0327,
+	0x0002, 0x006E, 0x0327, 0x0002, 0x004E, 0x030C, 0x0002, 0x006E,
+	0x030C, 0x0002, 0x02BC, 0x006E, 0x0002, 0x004F, 0x0304, 0x0002,
+	0x006F, 0x0304, 0x0002, 0x004F, 0x0306, 0x0002, 0x006F, 0x0306,
+	0x0002, 0x004F, 0x030B, 0x0002, 0x006F, 0x030B, 0x0002, 0x0052,
+	0x0301, 0x0002, 0x0072, 0x0301, 0x0002, 0x0052, 0x0327, 0x0002,
+	0x0072, 0x0327, 0x0002, 0x0052, 0x030C, 0x0002, 0x0072, 0x030C,
+	0x0002, 0x0053, 0x0301, 0x0002, 0x0073, 0x0301, 0x0002, 0x0053,
+	0x0302, 0x0002, 0x0073, 0x0302, 0x0002, 0x0053, 0x0327, 0x0002,
+	0x0073, 0x0327, 0x0002, 0x0053, 0x030C, 0x0002, 0x0073, 0x030C,
+	0x0002, 0x0054, 0x0327, 0x0002, 0x0074, 0x0327, 0x0002, 0x0054,
+	0x030C, 0x0002, 0x0074, 0x030C, 0x0002, 0x0055, 0x0303, 0x0002,
+	0x0075, 0x0303, 0x0002, 0x0055, 0x0304, 0x0002, 0x0075, 0x0304,
+	0x0002, 0x0055, 0x0306, 0x0002, 0x0075, 0x0306, 0x0002, 0x0055,
+	0x030A, 0x0002, 0x0075, 0x030A, 0x0002, 0x0055, 0x030B, 0x0002,
+	0x0075, 0x030B, 0x0002, 0x0055, 0x0328, 0x0002, 0x0075, 0x0328,
+	0x0002, 0x0057, 0x0302, 0x0002, 0x0077, 0x0302, 0x0002, 0x0059,
+	0x0302, 0x0002, 0x0079, 0x0302, 0x0002, 0x0059, 0x0308, 0x0002,
+	0x005A, 0x0301, 0x0002, 0x007A, 0x0301, 0x0002, 0x005A, 0x0307,
+	0x0002, 0x007A, 0x0307, 0x0002, 0x005A, 0x030C, 0x0002, 0x007A,
+	0x030C, 0x0001, 0x0073, 0x0002, 0x004F, 0x031B, 0x0002, 0x006F,
+	0x031B, 0x0002, 0x0055, 0x031B, 0x0002, 0x0075, 0x031B, 0x0003,
+	0x0044, 0x005A, 0x030C, 0x0003, 0x0044, 0x007A, 0x030C, 0x0003,
+	0x0064, 0x007A, 0x030C, 0x0002, 0x004C, 0x004A, 0x0002, 0x004C,
+	0x006A, 0x0002, 0x006C, 0x006A, 0x0002, 0x004E, 0x004A, 0x0002,
+	0x004E, 0x006A, 0x0002, 0x006E, 0x006A, 0x0002, 0x0041, 0x030C,
+	0x0002, 0x0061, 0x030C, 0x0002, 0x0049, 0x030C, 0x0002, 0x0069,
+	0x030C, 0x0002, 0x004F, 0x030C, 0x0002, 0x006F, 0x030C, 0x0002,
+	0x0055, 0x030C, 0x0002, 0x0075, 0x030C, 0x0003, 0x0055, 0x0308,
+	0x0304, 0x0003, 0x0075, 0x0308, 0x0304, 0x0003, 0x0055, 0x0308,
+	0x0301, 0x0003, 0x0075, 0x0308, 0x0301, 0x0003, 0x0055, 0x0308,
+	0x030C, 0x0003, 0x0075, 0x0308, 0x030C, 0x0003, 0x0055, 0x0308,
+	0x0300, 0x0003, 0x0075, 0x0308, 0x0300, 0x0003, 0x0041, 0x0308,
+	0x0304, 0x0003, 0x0061, 0x0308, 0x0304, 0x0003, 0x0041, 0x0307,
+	0x0304, 0x0003, 0x0061, 0x0307, 0x0304, 0x0002, 0x00C6, 0x0304,
+	0x0002, 0x00E6, 0x0304, 0x0002, 0x0047, 0x030C, 0x0002, 0x0067,
+	0x030C, 0x0002, 0x004B, 0x030C, 0x0002, 0x006B, 0x030C, 0x0002,
+	0x004F, 0x0328, 0x0002, 0x006F, 0x0328, 0x0003, 0x004F, 0x0328,
+	0x0304, 0x0003, 0x006F, 0x0328, 0x0304, 0x0002, 0x01B7, 0x030C,
+	0x0002, 0x0292, 0x030C, 0x0002, 0x006A, 0x030C, 0x0002, 0x0044,
+	0x005A, 0x0002, 0x0044, 0x007A, 0x0002, 0x0064, 0x007A, 0x0002,
+	0x0047, 0x0301, 0x0002, 0x0067, 0x0301, 0x0002, 0x004E, 0x0300,
+	0x0002, 0x006E, 0x0300, 0x0003, 0x0041, 0x030A, 0x0301, 0x0003,
+	0x0061, 0x030A, 0x0301, 0x0002, 0x00C6, 0x0301, 0x0002, 0x00E6,
+	0x0301, 0x0002, 0x00D8, 0x0301, 0x0002, 0x00F8, 0x0301, 0x0002,
+	0x0041, 0x030F, 0x0002, 0x0061, 0x030F, 0x0002, 0x0041, 0x0311,
+	0x0002, 0x0061, 0x0311, 0x0002, 0x0045, 0x030F, 0x0002, 0x0065,
+	0x030F, 0x0002, 0x0045, 0x0311, 0x0002, 0x0065, 0x0311, 0x0002,
+	0x0049, 0x030F, 0x0002, 0x0069, 0x030F, 0x0002, 0x0049, 0x0311,
+	0x0002, 0x0069, 0x0311, 0x0002, 0x004F, 0x030F, 0x0002, 0x006F,
+	0x030F, 0x0002, 0x004F, 0x0311, 0x0002, 0x006F, 0x0311, 0x0002,
+	0x0052, 0x030F, 0x0002, 0x0072, 0x030F, 0x0002, 0x0052, 0x0311,
+	0x0002, 0x0072, 0x0311, 0x0002, 0x0055, 0x030F, 0x0002, 0x0075,
+	0x030F, 0x0002, 0x0055, 0x0311, 0x0002, 0x0075, 0x0311, 0x0002,
+	0x0053, 0x0326, 0x0002, 0x0073, 0x0326, 0x0002, 0x0054, 0x0326,
+	0x0002, 0x0074, 0x0326, 0x0002, 0x0048, 0x030C, 0x0002, 0x0068,
+	0x030C, 0x0002, 0x0041, 0x0307, 0x0002, 0x0061, 0x0307, 0x0002,
+	0x0045, 0x0327, 0x0002, 0x0065, 0x0327, 0x0003, 0x004F, 0x0308,
+	0x0304, 0x0003, 0x006F, 0x0308, 0x0304, 0x0003, 0x004F, 0x0303,
+	0x0304, 0x0003, 0x006F, 0x0303, 0x0304, 0x0002, 0x004F, 0x0307,
+	0x0002, 0x006F, 0x0307, 0x0003, 0x004F, 0x0307, 0x0304, 0x0003,
+	0x006F, 0x0307, 0x0304, 0x0002, 0x0059, 0x0304, 0x0002, 0x0079,
+	0x0304, 0x0001, 0x0068, 0x0001, 0x0266, 0x0001, 0x006A, 0x0001,
+	0x0072, 0x0001, 0x0279, 0x0001, 0x027B, 0x0001, 0x0281, 0x0001,
+	0x0077, 0x0001, 0x0079, 0x0002, 0x0020, 0x0306, 0x0002, 0x0020,
+	0x0307, 0x0002, 0x0020, 0x030A, 0x0002, 0x0020, 0x0328, 0x0002,
+	0x0020, 0x0303, 0x0002, 0x0020, 0x030B, 0x0001, 0x0263, 0x0001,
+	0x006C, 0x0001, 0x0078, 0x0001, 0x0295, 0x0001, 0x0300, 0x0001,
+	0x0301, 0x0001, 0x0313, 0x0002, 0x0308, 0x0301, 0x0001, 0x02B9,
+	0x0002, 0x0020, 0x0345, 0x0001, 0x003B, 0x0002, 0x00A8, 0x0301,
+	0x0003, 0x0020, 0x0308, 0x0301, 0x0002, 0x0391, 0x0301, 0x0001,
+	0x00B7, 0x0002, 0x0395, 0x0301, 0x0002, 0x0397, 0x0301, 0x0002,
+	0x0399, 0x0301, 0x0002, 0x039F, 0x0301, 0x0002, 0x03A5, 0x0301,
+	0x0002, 0x03A9, 0x0301, 0x0003, 0x03B9, 0x0308, 0x0301, 0x0002,
+	0x0399, 0x0308, 0x0002, 0x03A5, 0x0308, 0x0002, 0x03B1, 0x0301,
+	0x0002, 0x03B5, 0x0301, 0x0002, 0x03B7, 0x0301, 0x0002, 0x03B9,
+	0x0301, 0x0003, 0x03C5, 0x0308, 0x0301, 0x0002, 0x03B9, 0x0308,
+	0x0002, 0x03C5, 0x0308, 0x0002, 0x03BF, 0x0301, 0x0002, 0x03C5,
+	0x0301, 0x0002, 0x03C9, 0x0301, 0x0001, 0x03B2, 0x0001, 0x03B8,
+	0x0001, 0x03A5, 0x0002, 0x03D2, 0x0301, 0x0002, 0x03D2, 0x0308,
+	0x0001, 0x03C6, 0x0001, 0x03C0, 0x0001, 0x03BA, 0x0001, 0x03C1,
+	0x0001, 0x03C2, 0x0001, 0x0398, 0x0001, 0x03B5, 0x0001, 0x03A3,
+	0x0002, 0x0415, 0x0300, 0x0002, 0x0415, 0x0308, 0x0002, 0x0413,
+	0x0301, 0x0002, 0x0406, 0x0308, 0x0002, 0x041A, 0x0301, 0x0002,
+	0x0418, 0x0300, 0x0002, 0x0423, 0x0306, 0x0002, 0x0418, 0x0306,
+	0x0002, 0x0438, 0x0306, 0x0002, 0x0435, 0x0300, 0x0002, 0x0435,
+	0x0308, 0x0002, 0x0433, 0x0301, 0x0002, 0x0456, 0x0308, 0x0002,
+	0x043A, 0x0301, 0x0002, 0x0438, 0x0300, 0x0002, 0x0443, 0x0306,
+	0x0002, 0x0474, 0x030F, 0x0002, 0x0475, 0x030F, 0x0002, 0x0416,
+	0x0306, 0x0002, 0x0436, 0x0306, 0x0002, 0x0410, 0x0306, 0x0002,
+	0x0430, 0x0306, 0x0002, 0x0410, 0x0308, 0x0002, 0x0430, 0x0308,
+	0x0002, 0x0415, 0x0306, 0x0002, 0x0435, 0x0306, 0x0002, 0x04D8,
+	0x0308, 0x0002, 0x04D9, 0x0308, 0x0002, 0x0416, 0x0308, 0x0002,
+	0x0436, 0x0308, 0x0002, 0x0417, 0x0308, 0x0002, 0x0437, 0x0308,
+	0x0002, 0x0418, 0x0304, 0x0002, 0x0438, 0x0304, 0x0002, 0x0418,
+	0x0308, 0x0002, 0x0438, 0x0308, 0x0002, 0x041E, 0x0308, 0x0002,
+	0x043E, 0x0308, 0x0002, 0x04E8, 0x0308, 0x0002, 0x04E9, 0x0308,
+	0x0002, 0x042D, 0x0308, 0x0002, 0x044D, 0x0308, 0x0002, 0x0423,
+	0x0304, 0x0002, 0x0443, 0x0304, 0x0002, 0x0423, 0x0308, 0x0002,
+	0x0443, 0x0308, 0x0002, 0x0423, 0x030B, 0x0002, 0x0443, 0x030B,
+	0x0002, 0x0427, 0x0308, 0x0002, 0x0447, 0x0308, 0x0002, 0x042B,
+	0x0308, 0x0002, 0x044B, 0x0308, 0x0002, 0x0565, 0x0582, 0x0002,
+	0x0627, 0x0653, 0x0002, 0x0627, 0x0654, 0x0002, 0x0648, 0x0654,
+	0x0002, 0x0627, 0x0655, 0x0002, 0x064A, 0x0654, 0x0002, 0x0627,
+	0x0674, 0x0002, 0x0648, 0x0674, 0x0002, 0x06C7, 0x0674, 0x0002,
+	0x064A, 0x0674, 0x0002, 0x06D5, 0x0654, 0x0002, 0x06C1, 0x0654,
+	0x0002, 0x06D2, 0x0654, 0x0002, 0x0928, 0x093C, 0x0002, 0x0930,
+	0x093C, 0x0002, 0x0933, 0x093C, 0x0002, 0x0915, 0x093C, 0x0002,
+	0x0916, 0x093C, 0x0002, 0x0917, 0x093C, 0x0002, 0x091C, 0x093C,
+	0x0002, 0x0921, 0x093C, 0x0002, 0x0922, 0x093C, 0x0002, 0x092B,
+	0x093C, 0x0002, 0x092F, 0x093C, 0x0002, 0x09C7, 0x09BE, 0x0002,
+	0x09C7, 0x09D7, 0x0002, 0x09A1, 0x09BC, 0x0002, 0x09A2, 0x09BC,
+	0x0002, 0x09AF, 0x09BC, 0x0002, 0x0A32, 0x0A3C, 0x0002, 0x0A38,
+	0x0A3C, 0x0002, 0x0A16, 0x0A3C, 0x0002, 0x0A17, 0x0A3C, 0x0002,
+	0x0A1C, 0x0A3C, 0x0002, 0x0A2B, 0x0A3C, 0x0002, 0x0B47, 0x0B56,
+	0x0002, 0x0B47, 0x0B3E, 0x0002, 0x0B47, 0x0B57, 0x0002, 0x0B21,
+	0x0B3C, 0x0002, 0x0B22, 0x0B3C, 0x0002, 0x0B92, 0x0BD7, 0x0002,
+	0x0BC6, 0x0BBE, 0x0002, 0x0BC7, 0x0BBE, 0x0002, 0x0BC6, 0x0BD7,
+	0x0002, 0x0C46, 0x0C56, 0x0002, 0x0CBF, 0x0CD5, 0x0002, 0x0CC6,
+	0x0CD5, 0x0002, 0x0CC6, 0x0CD6, 0x0002, 0x0CC6, 0x0CC2, 0x0003,
+	0x0CC6, 0x0CC2, 0x0CD5, 0x0002, 0x0D46, 0x0D3E, 0x0002, 0x0D47,
+	0x0D3E, 0x0002, 0x0D46, 0x0D57, 0x0002, 0x0DD9, 0x0DCA, 0x0002,
+	0x0DD9, 0x0DCF, 0x0003, 0x0DD9, 0x0DCF, 0x0DCA, 0x0002, 0x0DD9,
+	0x0DDF, 0x0002, 0x0E4D, 0x0E32, 0x0002, 0x0ECD, 0x0EB2, 0x0002,
+	0x0EAB, 0x0E99, 0x0002, 0x0EAB, 0x0EA1, 0x0001, 0x0F0B, 0x0002,
+	0x0F42, 0x0FB7, 0x0002, 0x0F4C, 0x0FB7, 0x0002, 0x0F51, 0x0FB7,
+	0x0002, 0x0F56, 0x0FB7, 0x0002, 0x0F5B, 0x0FB7, 0x0002, 0x0F40,
+	0x0FB5, 0x0002, 0x0F71, 0x0F72, 0x0002, 0x0F71, 0x0F74, 0x0002,
+	0x0FB2, 0x0F80, 0x0003, 0x0FB2, 0x0F71, 0x0F80, 0x0002, 0x0FB3,
+	0x0F80, 0x0003, 0x0FB3, 0x0F71, 0x0F80, 0x0002, 0x0F71, 0x0F80,
+	0x0002, 0x0F92, 0x0FB7, 0x0002, 0x0F9C, 0x0FB7, 0x0002, 0x0FA1,
+	0x0FB7, 0x0002, 0x0FA6, 0x0FB7, 0x0002, 0x0FAB, 0x0FB7, 0x0002,
+	0x0F90, 0x0FB5, 0x0002, 0x1025, 0x102E, 0x0001, 0x10DC, 0x0002,
+	0x1B05, 0x1B35, 0x0002, 0x1B07, 0x1B35, 0x0002, 0x1B09, 0x1B35,
+	0x0002, 0x1B0B, 0x1B35, 0x0002, 0x1B0D, 0x1B35, 0x0002, 0x1B11,
+	0x1B35, 0x0002, 0x1B3A, 0x1B35, 0x0002, 0x1B3C, 0x1B35, 0x0002,
+	0x1B3E, 0x1B35, 0x0002, 0x1B3F, 0x1B35, 0x0002, 0x1B42, 0x1B35,
+	0x0001, 0x0041, 0x0001, 0x00C6, 0x0001, 0x0042, 0x0001, 0x0044,
+	0x0001, 0x0045, 0x0001, 0x018E, 0x0001, 0x0047, 0x0001, 0x0048,
+	0x0001, 0x0049, 0x0001, 0x004A, 0x0001, 0x004B, 0x0001, 0x004C,
+	0x0001, 0x004D, 0x0001, 0x004E, 0x0001, 0x004F, 0x0001, 0x0222,
+	0x0001, 0x0050, 0x0001, 0x0052, 0x0001, 0x0054, 0x0001, 0x0055,
+	0x0001, 0x0057, 0x0001, 0x0250, 0x0001, 0x0251, 0x0001, 0x1D02,
+	0x0001, 0x0062, 0x0001, 0x0064, 0x0001, 0x0065, 0x0001, 0x0259,
+	0x0001, 0x025B, 0x0001, 0x025C, 0x0001, 0x0067, 0x0001, 0x006B,
+	0x0001, 0x006D, 0x0001, 0x014B, 0x0001, 0x0254, 0x0001, 0x1D16,
+	0x0001, 0x1D17, 0x0001, 0x0070, 0x0001, 0x0074, 0x0001, 0x0075,
+	0x0001, 0x1D1D, 0x0001, 0x026F, 0x0001, 0x0076, 0x0001, 0x1D25,
+	0x0001, 0x03B3, 0x0001, 0x03B4, 0x0001, 0x03C7, 0x0001, 0x0069,
+	0x0001, 0x043D, 0x0001, 0x0252, 0x0001, 0x0063, 0x0001, 0x0255,
+	0x0001, 0x00F0, 0x0001, 0x0066, 0x0001, 0x025F, 0x0001, 0x0261,
+	0x0001, 0x0265, 0x0001, 0x0268, 0x0001, 0x0269, 0x0001, 0x026A,
+	0x0001, 0x1D7B, 0x0001, 0x029D, 0x0001, 0x026D, 0x0001, 0x1D85,
+	0x0001, 0x029F, 0x0001, 0x0271, 0x0001, 0x0270, 0x0001, 0x0272,
+	0x0001, 0x0273, 0x0001, 0x0274, 0x0001, 0x0275, 0x0001, 0x0278,
+	0x0001, 0x0282, 0x0001, 0x0283, 0x0001, 0x01AB, 0x0001, 0x0289,
+	0x0001, 0x028A, 0x0001, 0x1D1C, 0x0001, 0x028B, 0x0001, 0x028C,
+	0x0001, 0x007A, 0x0001, 0x0290, 0x0001, 0x0291, 0x0001, 0x0292,
+	0x0002, 0x0041, 0x0325, 0x0002, 0x0061, 0x0325, 0x0002, 0x0042,
+	0x0307, 0x0002, 0x0062, 0x0307, 0x0002, 0x0042, 0x0323, 0x0002,
+	0x0062, 0x0323, 0x0002, 0x0042, 0x0331, 0x0002, 0x0062, 0x0331,
+	0x0003, 0x0043, 0x0327, 0x0301, 0x0003, 0x0063, 0x0327, 0x0301,
+	0x0002, 0x0044, 0x0307, 0x0002, 0x0064, 0x0307, 0x0002, 0x0044,
+	0x0323, 0x0002, 0x0064, 0x0323, 0x0002, 0x0044, 0x0331, 0x0002,
+	0x0064, 0x0331, 0x0002, 0x0044, 0x0327, 0x0002, 0x0064, 0x0327,
+	0x0002, 0x0044, 0x032D, 0x0002, 0x0064, 0x032D, 0x0003, 0x0045,
+	0x0304, 0x0300, 0x0003, 0x0065, 0x0304, 0x0300, 0x0003, 0x0045,
+	0x0304, 0x0301, 0x0003, 0x0065, 0x0304, 0x0301, 0x0002, 0x0045,
+	0x032D, 0x0002, 0x0065, 0x032D, 0x0002, 0x0045, 0x0330, 0x0002,
+	0x0065, 0x0330, 0x0003, 0x0045, 0x0327, 0x0306, 0x0003, 0x0065,
+	0x0327, 0x0306, 0x0002, 0x0046, 0x0307, 0x0002, 0x0066, 0x0307,
+	0x0002, 0x0047, 0x0304, 0x0002, 0x0067, 0x0304, 0x0002, 0x0048,
+	0x0307, 0x0002, 0x0068, 0x0307, 0x0002, 0x0048, 0x0323, 0x0002,
+	0x0068, 0x0323, 0x0002, 0x0048, 0x0308, 0x0002, 0x0068, 0x0308,
+	0x0002, 0x0048, 0x0327, 0x0002, 0x0068, 0x0327, 0x0002, 0x0048,
+	0x032E, 0x0002, 0x0068, 0x032E, 0x0002, 0x0049, 0x0330, 0x0002,
+	0x0069, 0x0330, 0x0003, 0x0049, 0x0308, 0x0301, 0x0003, 0x0069,
+	0x0308, 0x0301, 0x0002, 0x004B, 0x0301, 0x0002, 0x006B, 0x0301,
+	0x0002, 0x004B, 0x0323, 0x0002, 0x006B, 0x0323, 0x0002, 0x004B,
+	0x0331, 0x0002, 0x006B, 0x0331, 0x0002, 0x004C, 0x0323, 0x0002,
+	0x006C, 0x0323, 0x0003, 0x004C, 0x0323, 0x0304, 0x0003, 0x006C,
+	0x0323, 0x0304, 0x0002, 0x004C, 0x0331, 0x0002, 0x006C, 0x0331,
+	0x0002, 0x004C, 0x032D, 0x0002, 0x006C, 0x032D, 0x0002, 0x004D,
+	0x0301, 0x0002, 0x006D, 0x0301, 0x0002, 0x004D, 0x0307, 0x0002,
+	0x006D, 0x0307, 0x0002, 0x004D, 0x0323, 0x0002, 0x006D, 0x0323,
+	0x0002, 0x004E, 0x0307, 0x0002, 0x006E, 0x0307, 0x0002, 0x004E,
+	0x0323, 0x0002, 0x006E, 0x0323, 0x0002, 0x004E, 0x0331, 0x0002,
+	0x006E, 0x0331, 0x0002, 0x004E, 0x032D, 0x0002, 0x006E, 0x032D,
+	0x0003, 0x004F, 0x0303, 0x0301, 0x0003, 0x006F, 0x0303, 0x0301,
+	0x0003, 0x004F, 0x0303, 0x0308, 0x0003, 0x006F, 0x0303, 0x0308,
+	0x0003, 0x004F, 0x0304, 0x0300, 0x0003, 0x006F, 0x0304, 0x0300,
+	0x0003, 0x004F, 0x0304, 0x0301, 0x0003, 0x006F, 0x0304, 0x0301,
+	0x0002, 0x0050, 0x0301, 0x0002, 0x0070, 0x0301, 0x0002, 0x0050,
+	0x0307, 0x0002, 0x0070, 0x0307, 0x0002, 0x0052, 0x0307, 0x0002,
+	0x0072, 0x0307, 0x0002, 0x0052, 0x0323, 0x0002, 0x0072, 0x0323,
+	0x0003, 0x0052, 0x0323, 0x0304, 0x0003, 0x0072, 0x0323, 0x0304,
+	0x0002, 0x0052, 0x0331, 0x0002, 0x0072, 0x0331, 0x0002, 0x0053,
+	0x0307, 0x0002, 0x0073, 0x0307, 0x0002, 0x0053, 0x0323, 0x0002,
+	0x0073, 0x0323, 0x0003, 0x0053, 0x0301, 0x0307, 0x0003, 0x0073,
+	0x0301, 0x0307, 0x0003, 0x0053, 0x030C, 0x0307, 0x0003, 0x0073,
+	0x030C, 0x0307, 0x0003, 0x0053, 0x0323, 0x0307, 0x0003, 0x0073,
+	0x0323, 0x0307, 0x0002, 0x0054, 0x0307, 0x0002, 0x0074, 0x0307,
+	0x0002, 0x0054, 0x0323, 0x0002, 0x0074, 0x0323, 0x0002, 0x0054,
+	0x0331, 0x0002, 0x0074, 0x0331, 0x0002, 0x0054, 0x032D, 0x0002,
+	0x0074, 0x032D, 0x0002, 0x0055, 0x0324, 0x0002, 0x0075, 0x0324,
+	0x0002, 0x0055, 0x0330, 0x0002, 0x0075, 0x0330, 0x0002, 0x0055,
+	0x032D, 0x0002, 0x0075, 0x032D, 0x0003, 0x0055, 0x0303, 0x0301,
+	0x0003, 0x0075, 0x0303, 0x0301, 0x0003, 0x0055, 0x0304, 0x0308,
+	0x0003, 0x0075, 0x0304, 0x0308, 0x0002, 0x0056, 0x0303, 0x0002,
+	0x0076, 0x0303, 0x0002, 0x0056, 0x0323, 0x0002, 0x0076, 0x0323,
+	0x0002, 0x0057, 0x0300, 0x0002, 0x0077, 0x0300, 0x0002, 0x0057,
+	0x0301, 0x0002, 0x0077, 0x0301, 0x0002, 0x0057, 0x0308, 0x0002,
+	0x0077, 0x0308, 0x0002, 0x0057, 0x0307, 0x0002, 0x0077, 0x0307,
+	0x0002, 0x0057, 0x0323, 0x0002, 0x0077, 0x0323, 0x0002, 0x0058,
+	0x0307, 0x0002, 0x0078, 0x0307, 0x0002, 0x0058, 0x0308, 0x0002,
+	0x0078, 0x0308, 0x0002, 0x0059, 0x0307, 0x0002, 0x0079, 0x0307,
+	0x0002, 0x005A, 0x0302, 0x0002, 0x007A, 0x0302, 0x0002, 0x005A,
+	0x0323, 0x0002, 0x007A, 0x0323, 0x0002, 0x005A, 0x0331, 0x0002,
+	0x007A, 0x0331, 0x0002, 0x0068, 0x0331, 0x0002, 0x0074, 0x0308,
+	0x0002, 0x0077, 0x030A, 0x0002, 0x0079, 0x030A, 0x0002, 0x0061,
+	0x02BE, 0x0002, 0x017F, 0x0307, 0x0002, 0x0041, 0x0323, 0x0002,
+	0x0061, 0x0323, 0x0002, 0x0041, 0x0309, 0x0002, 0x0061, 0x0309,
+	0x0003, 0x0041, 0x0302, 0x0301, 0x0003, 0x0061, 0x0302, 0x0301,
+	0x0003, 0x0041, 0x0302, 0x0300, 0x0003, 0x0061, 0x0302, 0x0300,
+	0x0003, 0x0041, 0x0302, 0x0309, 0x0003, 0x0061, 0x0302, 0x0309,
+	0x0003, 0x0041, 0x0302, 0x0303, 0x0003, 0x0061, 0x0302, 0x0303,
+	0x0003, 0x0041, 0x0323, 0x0302, 0x0003, 0x0061, 0x0323, 0x0302,
+	0x0003, 0x0041, 0x0306, 0x0301, 0x0003, 0x0061, 0x0306, 0x0301,
+	0x0003, 0x0041, 0x0306, 0x0300, 0x0003, 0x0061, 0x0306, 0x0300,
+	0x0003, 0x0041, 0x0306, 0x0309, 0x0003, 0x0061, 0x0306, 0x0309,
+	0x0003, 0x0041, 0x0306, 0x0303, 0x0003, 0x0061, 0x0306, 0x0303,
+	0x0003, 0x0041, 0x0323, 0x0306, 0x0003, 0x0061, 0x0323, 0x0306,
+	0x0002, 0x0045, 0x0323, 0x0002, 0x0065, 0x0323, 0x0002, 0x0045,
+	0x0309, 0x0002, 0x0065, 0x0309, 0x0002, 0x0045, 0x0303, 0x0002,
+	0x0065, 0x0303, 0x0003, 0x0045, 0x0302, 0x0301, 0x0003, 0x0065,
+	0x0302, 0x0301, 0x0003, 0x0045, 0x0302, 0x0300, 0x0003, 0x0065,
+	0x0302, 0x0300, 0x0003, 0x0045, 0x0302, 0x0309, 0x0003, 0x0065,
+	0x0302, 0x0309, 0x0003, 0x0045, 0x0302, 0x0303, 0x0003, 0x0065,
+	0x0302, 0x0303, 0x0003, 0x0045, 0x0323, 0x0302, 0x0003, 0x0065,
+	0x0323, 0x0302, 0x0002, 0x0049, 0x0309, 0x0002, 0x0069, 0x0309,
+	0x0002, 0x0049, 0x0323, 0x0002, 0x0069, 0x0323, 0x0002, 0x004F,
+	0x0323, 0x0002, 0x006F, 0x0323, 0x0002, 0x004F, 0x0309, 0x0002,
+	0x006F, 0x0309, 0x0003, 0x004F, 0x0302, 0x0301, 0x0003, 0x006F,
+	0x0302, 0x0301, 0x0003, 0x004F, 0x0302, 0x0300, 0x0003, 0x006F,
+	0x0302, 0x0300, 0x0003, 0x004F, 0x0302, 0x0309, 0x0003, 0x006F,
+	0x0302, 0x0309, 0x0003, 0x004F, 0x0302, 0x0303, 0x0003, 0x006F,
+	0x0302, 0x0303, 0x0003, 0x004F, 0x0323, 0x0302, 0x0003, 0x006F,
+	0x0323, 0x0302, 0x0003, 0x004F, 0x031B, 0x0301, 0x0003, 0x006F,
+	0x031B, 0x0301, 0x0003, 0x004F, 0x031B, 0x0300, 0x0003, 0x006F,
+	0x031B, 0x0300, 0x0003, 0x004F, 0x031B, 0x0309, 0x0003, 0x006F,
+	0x031B, 0x0309, 0x0003, 0x004F, 0x031B, 0x0303, 0x0003, 0x006F,
+	0x031B, 0x0303, 0x0003, 0x004F, 0x031B, 0x0323, 0x0003, 0x006F,
+	0x031B, 0x0323, 0x0002, 0x0055, 0x0323, 0x0002, 0x0075, 0x0323,
+	0x0002, 0x0055, 0x0309, 0x0002, 0x0075, 0x0309, 0x0003, 0x0055,
+	0x031B, 0x0301, 0x0003, 0x0075, 0x031B, 0x0301, 0x0003, 0x0055,
+	0x031B, 0x0300, 0x0003, 0x0075, 0x031B, 0x0300, 0x0003, 0x0055,
+	0x031B, 0x0309, 0x0003, 0x0075, 0x031B, 0x0309, 0x0003, 0x0055,
+	0x031B, 0x0303, 0x0003, 0x0075, 0x031B, 0x0303, 0x0003, 0x0055,
+	0x031B, 0x0323, 0x0003, 0x0075, 0x031B, 0x0323, 0x0002, 0x0059,
+	0x0300, 0x0002, 0x0079, 0x0300, 0x0002, 0x0059, 0x0323, 0x0002,
+	0x0079, 0x0323, 0x0002, 0x0059, 0x0309, 0x0002, 0x0079, 0x0309,
+	0x0002, 0x0059, 0x0303, 0x0002, 0x0079, 0x0303, 0x0002, 0x03B1,
+	0x0313, 0x0002, 0x03B1, 0x0314, 0x0003, 0x03B1, 0x0313, 0x0300,
+	0x0003, 0x03B1, 0x0314, 0x0300, 0x0003, 0x03B1, 0x0313, 0x0301,
+	0x0003, 0x03B1, 0x0314, 0x0301, 0x0003, 0x03B1, 0x0313, 0x0342,
+	0x0003, 0x03B1, 0x0314, 0x0342, 0x0002, 0x0391, 0x0313, 0x0002,
+	0x0391, 0x0314, 0x0003, 0x0391, 0x0313, 0x0300, 0x0003, 0x0391,
+	0x0314, 0x0300, 0x0003, 0x0391, 0x0313, 0x0301, 0x0003, 0x0391,
+	0x0314, 0x0301, 0x0003, 0x0391, 0x0313, 0x0342, 0x0003, 0x0391,
+	0x0314, 0x0342, 0x0002, 0x03B5, 0x0313, 0x0002, 0x03B5, 0x0314,
+	0x0003, 0x03B5, 0x0313, 0x0300, 0x0003, 0x03B5, 0x0314, 0x0300,
+	0x0003, 0x03B5, 0x0313, 0x0301, 0x0003, 0x03B5, 0x0314, 0x0301,
+	0x0002, 0x0395, 0x0313, 0x0002, 0x0395, 0x0314, 0x0003, 0x0395,
+	0x0313, 0x0300, 0x0003, 0x0395, 0x0314, 0x0300, 0x0003, 0x0395,
+	0x0313, 0x0301, 0x0003, 0x0395, 0x0314, 0x0301, 0x0002, 0x03B7,
+	0x0313, 0x0002, 0x03B7, 0x0314, 0x0003, 0x03B7, 0x0313, 0x0300,
+	0x0003, 0x03B7, 0x0314, 0x0300, 0x0003, 0x03B7, 0x0313, 0x0301,
+	0x0003, 0x03B7, 0x0314, 0x0301, 0x0003, 0x03B7, 0x0313, 0x0342,
+	0x0003, 0x03B7, 0x0314, 0x0342, 0x0002, 0x0397, 0x0313, 0x0002,
+	0x0397, 0x0314, 0x0003, 0x0397, 0x0313, 0x0300, 0x0003, 0x0397,
+	0x0314, 0x0300, 0x0003, 0x0397, 0x0313, 0x0301, 0x0003, 0x0397,
+	0x0314, 0x0301, 0x0003, 0x0397, 0x0313, 0x0342, 0x0003, 0x0397,
+	0x0314, 0x0342, 0x0002, 0x03B9, 0x0313, 0x0002, 0x03B9, 0x0314,
+	0x0003, 0x03B9, 0x0313, 0x0300, 0x0003, 0x03B9, 0x0314, 0x0300,
+	0x0003, 0x03B9, 0x0313, 0x0301, 0x0003, 0x03B9, 0x0314, 0x0301,
+	0x0003, 0x03B9, 0x0313, 0x0342, 0x0003, 0x03B9, 0x0314, 0x0342,
+	0x0002, 0x0399, 0x0313, 0x0002, 0x0399, 0x0314, 0x0003, 0x0399,
+	0x0313, 0x0300, 0x0003, 0x0399, 0x0314, 0x0300, 0x0003, 0x0399,
+	0x0313, 0x0301, 0x0003, 0x0399, 0x0314, 0x0301, 0x0003, 0x0399,
+	0x0313, 0x0342, 0x0003, 0x0399, 0x0314, 0x0342, 0x0002, 0x03BF,
+	0x0313, 0x0002, 0x03BF, 0x0314, 0x0003, 0x03BF, 0x0313, 0x0300,
+	0x0003, 0x03BF, 0x0314, 0x0300, 0x0003, 0x03BF, 0x0313, 0x0301,
+	0x0003, 0x03BF, 0x0314, 0x0301, 0x0002, 0x039F, 0x0313, 0x0002,
+	0x039F, 0x0314, 0x0003, 0x039F, 0x0313, 0x0300, 0x0003, 0x039F,
+	0x0314, 0x0300, 0x0003, 0x039F, 0x0313, 0x0301, 0x0003, 0x039F,
+	0x0314, 0x0301, 0x0002, 0x03C5, 0x0313, 0x0002, 0x03C5, 0x0314,
+	0x0003, 0x03C5, 0x0313, 0x0300, 0x0003, 0x03C5, 0x0314, 0x0300,
+	0x0003, 0x03C5, 0x0313, 0x0301, 0x0003, 0x03C5, 0x0314, 0x0301,
+	0x0003, 0x03C5, 0x0313, 0x0342, 0x0003, 0x03C5, 0x0314, 0x0342,
+	0x0002, 0x03A5, 0x0314, 0x0003, 0x03A5, 0x0314, 0x0300, 0x0003,
+	0x03A5, 0x0314, 0x0301, 0x0003, 0x03A5, 0x0314, 0x0342, 0x0002,
+	0x03C9, 0x0313, 0x0002, 0x03C9, 0x0314, 0x0003, 0x03C9, 0x0313,
+	0x0300, 0x0003, 0x03C9, 0x0314, 0x0300, 0x0003, 0x03C9, 0x0313,
+	0x0301, 0x0003, 0x03C9, 0x0314, 0x0301, 0x0003, 0x03C9, 0x0313,
+	0x0342, 0x0003, 0x03C9, 0x0314, 0x0342, 0x0002, 0x03A9, 0x0313,
+	0x0002, 0x03A9, 0x0314, 0x0003, 0x03A9, 0x0313, 0x0300, 0x0003,
+	0x03A9, 0x0314, 0x0300, 0x0003, 0x03A9, 0x0313, 0x0301, 0x0003,
+	0x03A9, 0x0314, 0x0301, 0x0003, 0x03A9, 0x0313, 0x0342, 0x0003,
+	0x03A9, 0x0314, 0x0342, 0x0002, 0x03B1, 0x0300, 0x0002, 0x03B5,
+	0x0300, 0x0002, 0x03B7, 0x0300, 0x0002, 0x03B9, 0x0300, 0x0002,
+	0x03BF, 0x0300, 0x0002, 0x03C5, 0x0300, 0x0002, 0x03C9, 0x0300,
+	0x0003, 0x03B1, 0x0313, 0x0345, 0x0003, 0x03B1, 0x0314, 0x0345,
+	0x0004, 0x03B1, 0x0313, 0x0300, 0x0345, 0x0004, 0x03B1, 0x0314,
+	0x0300, 0x0345, 0x0004, 0x03B1, 0x0313, 0x0301, 0x0345, 0x0004,
+	0x03B1, 0x0314, 0x0301, 0x0345, 0x0004, 0x03B1, 0x0313, 0x0342,
+	0x0345, 0x0004, 0x03B1, 0x0314, 0x0342, 0x0345, 0x0003, 0x0391,
+	0x0313, 0x0345, 0x0003, 0x0391, 0x0314, 0x0345, 0x0004, 0x0391,
+	0x0313, 0x0300, 0x0345, 0x0004, 0x0391, 0x0314, 0x0300, 0x0345,
+	0x0004, 0x0391, 0x0313, 0x0301, 0x0345, 0x0004, 0x0391, 0x0314,
+	0x0301, 0x0345, 0x0004, 0x0391, 0x0313, 0x0342, 0x0345, 0x0004,
+	0x0391, 0x0314, 0x0342, 0x0345, 0x0003, 0x03B7, 0x0313, 0x0345,
+	0x0003, 0x03B7, 0x0314, 0x0345, 0x0004, 0x03B7, 0x0313, 0x0300,
+	0x0345, 0x0004, 0x03B7, 0x0314, 0x0300, 0x0345, 0x0004, 0x03B7,
+	0x0313, 0x0301, 0x0345, 0x0004, 0x03B7, 0x0314, 0x0301, 0x0345,
+	0x0004, 0x03B7, 0x0313, 0x0342, 0x0345, 0x0004, 0x03B7, 0x0314,
+	0x0342, 0x0345, 0x0003, 0x0397, 0x0313, 0x0345, 0x0003, 0x0397,
+	0x0314, 0x0345, 0x0004, 0x0397, 0x0313, 0x0300, 0x0345, 0x0004,
+	0x0397, 0x0314, 0x0300, 0x0345, 0x0004, 0x0397, 0x0313, 0x0301,
+	0x0345, 0x0004, 0x0397, 0x0314, 0x0301, 0x0345, 0x0004, 0x0397,
+	0x0313, 0x0342, 0x0345, 0x0004, 0x0397, 0x0314, 0x0342, 0x0345,
+	0x0003, 0x03C9, 0x0313, 0x0345, 0x0003, 0x03C9, 0x0314, 0x0345,
+	0x0004, 0x03C9, 0x0313, 0x0300, 0x0345, 0x0004, 0x03C9, 0x0314,
+	0x0300, 0x0345, 0x0004, 0x03C9, 0x0313, 0x0301, 0x0345, 0x0004,
+	0x03C9, 0x0314, 0x0301, 0x0345, 0x0004, 0x03C9, 0x0313, 0x0342,
+	0x0345, 0x0004, 0x03C9, 0x0314, 0x0342, 0x0345, 0x0003, 0x03A9,
+	0x0313, 0x0345, 0x0003, 0x03A9, 0x0314, 0x0345, 0x0004, 0x03A9,
+	0x0313, 0x0300, 0x0345, 0x0004, 0x03A9, 0x0314, 0x0300, 0x0345,
+	0x0004, 0x03A9, 0x0313, 0x0301, 0x0345, 0x0004, 0x03A9, 0x0314,
+	0x0301, 0x0345, 0x0004, 0x03A9, 0x0313, 0x0342, 0x0345, 0x0004,
+	0x03A9, 0x0314, 0x0342, 0x0345, 0x0002, 0x03B1, 0x0306, 0x0002,
+	0x03B1, 0x0304, 0x0003, 0x03B1, 0x0300, 0x0345, 0x0002, 0x03B1,
+	0x0345, 0x0003, 0x03B1, 0x0301, 0x0345, 0x0002, 0x03B1, 0x0342,
+	0x0003, 0x03B1, 0x0342, 0x0345, 0x0002, 0x0391, 0x0306, 0x0002,
+	0x0391, 0x0304, 0x0002, 0x0391, 0x0300, 0x0002, 0x0391, 0x0345,
+	0x0002, 0x0020, 0x0313, 0x0001, 0x03B9, 0x0002, 0x0020, 0x0342,
+	0x0002, 0x00A8, 0x0342, 0x0003, 0x0020, 0x0308, 0x0342, 0x0003,
+	0x03B7, 0x0300, 0x0345, 0x0002, 0x03B7, 0x0345, 0x0003, 0x03B7,
+	0x0301, 0x0345, 0x0002, 0x03B7, 0x0342, 0x0003, 0x03B7, 0x0342,
+	0x0345, 0x0002, 0x0395, 0x0300, 0x0002, 0x0397, 0x0300, 0x0002,
+	0x0397, 0x0345, 0x0002, 0x1FBF, 0x0300, 0x0003, 0x0020, 0x0313,
+	0x0300, 0x0002, 0x1FBF, 0x0301, 0x0003, 0x0020, 0x0313, 0x0301,
+	0x0002, 0x1FBF, 0x0342, 0x0003, 0x0020, 0x0313, 0x0342, 0x0002,
+	0x03B9, 0x0306, 0x0002, 0x03B9, 0x0304, 0x0003, 0x03B9, 0x0308,
+	0x0300, 0x0002, 0x03B9, 0x0342, 0x0003, 0x03B9, 0x0308, 0x0342,
+	0x0002, 0x0399, 0x0306, 0x0002, 0x0399, 0x0304, 0x0002, 0x0399,
+	0x0300, 0x0002, 0x1FFE, 0x0300, 0x0003, 0x0020, 0x0314, 0x0300,
+	0x0002, 0x1FFE, 0x0301, 0x0003, 0x0020, 0x0314, 0x0301, 0x0002,
+	0x1FFE, 0x0342, 0x0003, 0x0020, 0x0314, 0x0342, 0x0002, 0x03C5,
+	0x0306, 0x0002, 0x03C5, 0x0304, 0x0003, 0x03C5, 0x0308, 0x0300,
+	0x0002, 0x03C1, 0x0313, 0x0002, 0x03C1, 0x0314, 0x0002, 0x03C5,
+	0x0342, 0x0003, 0x03C5, 0x0308, 0x0342, 0x0002, 0x03A5, 0x0306,
+	0x0002, 0x03A5, 0x0304, 0x0002, 0x03A5, 0x0300, 0x0002, 0x03A1,
+	0x0314, 0x0002, 0x00A8, 0x0300, 0x0003, 0x0020, 0x0308, 0x0300,
+	0x0001, 0x0060, 0x0003, 0x03C9, 0x0300, 0x0345, 0x0002, 0x03C9,
+	0x0345, 0x0003, 0x03C9, 0x0301, 0x0345, 0x0002, 0x03C9, 0x0342,
+	0x0003, 0x03C9, 0x0342, 0x0345, 0x0002, 0x039F, 0x0300, 0x0002,
+	0x03A9, 0x0300, 0x0002, 0x03A9, 0x0345, 0x0001, 0x00B4, 0x0002,
+	0x0020, 0x0314, 0x0001, 0x2002, 0x0001, 0x2003, 0x0001, 0x2010,
+	0x0002, 0x0020, 0x0333, 0x0001, 0x002E, 0x0002, 0x002E, 0x002E,
+	0x0003, 0x002E, 0x002E, 0x002E, 0x0002, 0x2032, 0x2032, 0x0003,
+	0x2032, 0x2032, 0x2032, 0x0002, 0x2035, 0x2035, 0x0003, 0x2035,
+	0x2035, 0x2035, 0x0002, 0x0021, 0x0021, 0x0002, 0x0020, 0x0305,
+	0x0002, 0x003F, 0x003F, 0x0002, 0x003F, 0x0021, 0x0002, 0x0021,
+	0x003F, 0x0004, 0x2032, 0x2032, 0x2032, 0x2032, 0x0001, 0x0030,
+	0x0001, 0x0034, 0x0001, 0x0035, 0x0001, 0x0036, 0x0001, 0x0037,
+	0x0001, 0x0038, 0x0001, 0x0039, 0x0001, 0x002B, 0x0001, 0x2212,
+	0x0001, 0x003D, 0x0001, 0x0028, 0x0001, 0x0029, 0x0001, 0x006E,
+	0x0002, 0x0052, 0x0073, 0x0003, 0x0061, 0x002F, 0x0063, 0x0003,
+	0x0061, 0x002F, 0x0073, 0x0001, 0x0043, 0x0002, 0x00B0, 0x0043,
+	0x0003, 0x0063, 0x002F, 0x006F, 0x0003, 0x0063, 0x002F, 0x0075,
+	0x0001, 0x0190, 0x0002, 0x00B0, 0x0046, 0x0001, 0x0127, 0x0002,
+	0x004E, 0x006F, 0x0001, 0x0051, 0x0002, 0x0053, 0x004D, 0x0003,
+	0x0054, 0x0045, 0x004C, 0x0002, 0x0054, 0x004D, 0x0001, 0x005A,
+	0x0001, 0x03A9, 0x0001, 0x0046, 0x0001, 0x05D0, 0x0001, 0x05D1,
+	0x0001, 0x05D2, 0x0001, 0x05D3, 0x0003, 0x0046, 0x0041, 0x0058,
+	0x0001, 0x0393, 0x0001, 0x03A0, 0x0001, 0x2211, 0x0003, 0x0031,
+	0x2044, 0x0037, 0x0003, 0x0031, 0x2044, 0x0039, 0x0004, 0x0031,
+	0x2044, 0x0031, 0x0030, 0x0003, 0x0031, 0x2044, 0x0033, 0x0003,
+	0x0032, 0x2044, 0x0033, 0x0003, 0x0031, 0x2044, 0x0035, 0x0003,
+	0x0032, 0x2044, 0x0035, 0x0003, 0x0033, 0x2044, 0x0035, 0x0003,
+	0x0034, 0x2044, 0x0035, 0x0003, 0x0031, 0x2044, 0x0036, 0x0003,
+	0x0035, 0x2044, 0x0036, 0x0003, 0x0031, 0x2044, 0x0038, 0x0003,
+	0x0033, 0x2044, 0x0038, 0x0003, 0x0035, 0x2044, 0x0038, 0x0003,
+	0x0037, 0x2044, 0x0038, 0x0002, 0x0031, 0x2044, 0x0002, 0x0049,
+	0x0049, 0x0003, 0x0049, 0x0049, 0x0049, 0x0002, 0x0049, 0x0056,
+	0x0001, 0x0056, 0x0002, 0x0056, 0x0049, 0x0003, 0x0056, 0x0049,
+	0x0049, 0x0004, 0x0056, 0x0049, 0x0049, 0x0049, 0x0002, 0x0049,
+	0x0058, 0x0001, 0x0058, 0x0002, 0x0058, 0x0049, 0x0003, 0x0058,
+	0x0049, 0x0049, 0x0002, 0x0069, 0x0069, 0x0003, 0x0069, 0x0069,
+	0x0069, 0x0002, 0x0069, 0x0076, 0x0002, 0x0076, 0x0069, 0x0003,
+	0x0076, 0x0069, 0x0069, 0x0004, 0x0076, 0x0069, 0x0069, 0x0069,
+	0x0002, 0x0069, 0x0078, 0x0002, 0x0078, 0x0069, 0x0003, 0x0078,
+	0x0069, 0x0069, 0x0003, 0x0030, 0x2044, 0x0033, 0x0002, 0x2190,
+	0x0338, 0x0002, 0x2192, 0x0338, 0x0002, 0x2194, 0x0338, 0x0002,
+	0x21D0, 0x0338, 0x0002, 0x21D4, 0x0338, 0x0002, 0x21D2, 0x0338,
+	0x0002, 0x2203, 0x0338, 0x0002, 0x2208, 0x0338, 0x0002, 0x220B,
+	0x0338, 0x0002, 0x2223, 0x0338, 0x0002, 0x2225, 0x0338, 0x0002,
+	0x222B, 0x222B, 0x0003, 0x222B, 0x222B, 0x222B, 0x0002, 0x222E,
+	0x222E, 0x0003, 0x222E, 0x222E, 0x222E, 0x0002, 0x223C, 0x0338,
+	0x0002, 0x2243, 0x0338, 0x0002, 0x2245, 0x0338, 0x0002, 0x2248,
+	0x0338, 0x0002, 0x003D, 0x0338, 0x0002, 0x2261, 0x0338, 0x0002,
+	0x224D, 0x0338, 0x0002, 0x003C, 0x0338, 0x0002, 0x003E, 0x0338,
+	0x0002, 0x2264, 0x0338, 0x0002, 0x2265, 0x0338, 0x0002, 0x2272,
+	0x0338, 0x0002, 0x2273, 0x0338, 0x0002, 0x2276, 0x0338, 0x0002,
+	0x2277, 0x0338, 0x0002, 0x227A, 0x0338, 0x0002, 0x227B, 0x0338,
+	0x0002, 0x2282, 0x0338, 0x0002, 0x2283, 0x0338, 0x0002, 0x2286,
+	0x0338, 0x0002, 0x2287, 0x0338, 0x0002, 0x22A2, 0x0338, 0x0002,
+	0x22A8, 0x0338, 0x0002, 0x22A9, 0x0338, 0x0002, 0x22AB, 0x0338,
+	0x0002, 0x227C, 0x0338, 0x0002, 0x227D, 0x0338, 0x0002, 0x2291,
+	0x0338, 0x0002, 0x2292, 0x0338, 0x0002, 0x22B2, 0x0338, 0x0002,
+	0x22B3, 0x0338, 0x0002, 0x22B4, 0x0338, 0x0002, 0x22B5, 0x0338,
+	0x0001, 0x3008, 0x0001, 0x3009, 0x0002, 0x0031, 0x0030, 0x0002,
+	0x0031, 0x0031, 0x0002, 0x0031, 0x0032, 0x0002, 0x0031, 0x0033,
+	0x0002, 0x0031, 0x0034, 0x0002, 0x0031, 0x0035, 0x0002, 0x0031,
+	0x0036, 0x0002, 0x0031, 0x0037, 0x0002, 0x0031, 0x0038, 0x0002,
+	0x0031, 0x0039, 0x0002, 0x0032, 0x0030, 0x0003, 0x0028, 0x0031,
+	0x0029, 0x0003, 0x0028, 0x0032, 0x0029, 0x0003, 0x0028, 0x0033,
+	0x0029, 0x0003, 0x0028, 0x0034, 0x0029, 0x0003, 0x0028, 0x0035,
+	0x0029, 0x0003, 0x0028, 0x0036, 0x0029, 0x0003, 0x0028, 0x0037,
+	0x0029, 0x0003, 0x0028, 0x0038, 0x0029, 0x0003, 0x0028, 0x0039,
+	0x0029, 0x0004, 0x0028, 0x0031, 0x0030, 0x0029, 0x0004, 0x0028,
+	0x0031, 0x0031, 0x0029, 0x0004, 0x0028, 0x0031, 0x0032, 0x0029,
+	0x0004, 0x0028, 0x0031, 0x0033, 0x0029, 0x0004, 0x0028, 0x0031,
+	0x0034, 0x0029, 0x0004, 0x0028, 0x0031, 0x0035, 0x0029, 0x0004,
+	0x0028, 0x0031, 0x0036, 0x0029, 0x0004, 0x0028, 0x0031, 0x0037,
+	0x0029, 0x0004, 0x0028, 0x0031, 0x0038, 0x0029, 0x0004, 0x0028,
+	0x0031, 0x0039, 0x0029, 0x0004, 0x0028, 0x0032, 0x0030, 0x0029,
+	0x0002, 0x0031, 0x002E, 0x0002, 0x0032, 0x002E, 0x0002, 0x0033,
+	0x002E, 0x0002, 0x0034, 0x002E, 0x0002, 0x0035, 0x002E, 0x0002,
+	0x0036, 0x002E, 0x0002, 0x0037, 0x002E, 0x0002, 0x0038, 0x002E,
+	0x0002, 0x0039, 0x002E, 0x0003, 0x0031, 0x0030, 0x002E, 0x0003,
+	0x0031, 0x0031, 0x002E, 0x0003, 0x0031, 0x0032, 0x002E, 0x0003,
+	0x0031, 0x0033, 0x002E, 0x0003, 0x0031, 0x0034, 0x002E, 0x0003,
+	0x0031, 0x0035, 0x002E, 0x0003, 0x0031, 0x0036, 0x002E, 0x0003,
+	0x0031, 0x0037, 0x002E, 0x0003, 0x0031, 0x0038, 0x002E, 0x0003,
+	0x0031, 0x0039, 0x002E, 0x0003, 0x0032, 0x0030, 0x002E, 0x0003,
+	0x0028, 0x0061, 0x0029, 0x0003, 0x0028, 0x0062, 0x0029, 0x0003,
+	0x0028, 0x0063, 0x0029, 0x0003, 0x0028, 0x0064, 0x0029, 0x0003,
+	0x0028, 0x0065, 0x0029, 0x0003, 0x0028, 0x0066, 0x0029, 0x0003,
+	0x0028, 0x0067, 0x0029, 0x0003, 0x0028, 0x0068, 0x0029, 0x0003,
+	0x0028, 0x0069, 0x0029, 0x0003, 0x0028, 0x006A, 0x0029, 0x0003,
+	0x0028, 0x006B, 0x0029, 0x0003, 0x0028, 0x006C, 0x0029, 0x0003,
+	0x0028, 0x006D, 0x0029, 0x0003, 0x0028, 0x006E, 0x0029, 0x0003,
+	0x0028, 0x006F, 0x0029, 0x0003, 0x0028, 0x0070, 0x0029, 0x0003,
+	0x0028, 0x0071, 0x0029, 0x0003, 0x0028, 0x0072, 0x0029, 0x0003,
+	0x0028, 0x0073, 0x0029, 0x0003, 0x0028, 0x0074, 0x0029, 0x0003,
+	0x0028, 0x0075, 0x0029, 0x0003, 0x0028, 0x0076, 0x0029, 0x0003,
+	0x0028, 0x0077, 0x0029, 0x0003, 0x0028, 0x0078, 0x0029, 0x0003,
+	0x0028, 0x0079, 0x0029, 0x0003, 0x0028, 0x007A, 0x0029, 0x0001,
+	0x0053, 0x0001, 0x0059, 0x0001, 0x0071, 0x0004, 0x222B, 0x222B,
+	0x222B, 0x222B, 0x0003, 0x003A, 0x003A, 0x003D, 0x0002, 0x003D,
+	0x003D, 0x0003, 0x003D, 0x003D, 0x003D, 0x0002, 0x2ADD, 0x0338,
+	0x0001, 0x2D61, 0x0001, 0x6BCD, 0x0001, 0x9F9F, 0x0001, 0x4E00,
+	0x0001, 0x4E28, 0x0001, 0x4E36, 0x0001, 0x4E3F, 0x0001, 0x4E59,
+	0x0001, 0x4E85, 0x0001, 0x4E8C, 0x0001, 0x4EA0, 0x0001, 0x4EBA,
+	0x0001, 0x513F, 0x0001, 0x5165, 0x0001, 0x516B, 0x0001, 0x5182,
+	0x0001, 0x5196, 0x0001, 0x51AB, 0x0001, 0x51E0, 0x0001, 0x51F5,
+	0x0001, 0x5200, 0x0001, 0x529B, 0x0001, 0x52F9, 0x0001, 0x5315,
+	0x0001, 0x531A, 0x0001, 0x5338, 0x0001, 0x5341, 0x0001, 0x535C,
+	0x0001, 0x5369, 0x0001, 0x5382, 0x0001, 0x53B6, 0x0001, 0x53C8,
+	0x0001, 0x53E3, 0x0001, 0x56D7, 0x0001, 0x571F, 0x0001, 0x58EB,
+	0x0001, 0x5902, 0x0001, 0x590A, 0x0001, 0x5915, 0x0001, 0x5927,
+	0x0001, 0x5973, 0x0001, 0x5B50, 0x0001, 0x5B80, 0x0001, 0x5BF8,
+	0x0001, 0x5C0F, 0x0001, 0x5C22, 0x0001, 0x5C38, 0x0001, 0x5C6E,
+	0x0001, 0x5C71, 0x0001, 0x5DDB, 0x0001, 0x5DE5, 0x0001, 0x5DF1,
+	0x0001, 0x5DFE, 0x0001, 0x5E72, 0x0001, 0x5E7A, 0x0001, 0x5E7F,
+	0x0001, 0x5EF4, 0x0001, 0x5EFE, 0x0001, 0x5F0B, 0x0001, 0x5F13,
+	0x0001, 0x5F50, 0x0001, 0x5F61, 0x0001, 0x5F73, 0x0001, 0x5FC3,
+	0x0001, 0x6208, 0x0001, 0x6236, 0x0001, 0x624B, 0x0001, 0x652F,
+	0x0001, 0x6534, 0x0001, 0x6587, 0x0001, 0x6597, 0x0001, 0x65A4,
+	0x0001, 0x65B9, 0x0001, 0x65E0, 0x0001, 0x65E5, 0x0001, 0x66F0,
+	0x0001, 0x6708, 0x0001, 0x6728, 0x0001, 0x6B20, 0x0001, 0x6B62,
+	0x0001, 0x6B79, 0x0001, 0x6BB3, 0x0001, 0x6BCB, 0x0001, 0x6BD4,
+	0x0001, 0x6BDB, 0x0001, 0x6C0F, 0x0001, 0x6C14, 0x0001, 0x6C34,
+	0x0001, 0x706B, 0x0001, 0x722A, 0x0001, 0x7236, 0x0001, 0x723B,
+	0x0001, 0x723F, 0x0001, 0x7247, 0x0001, 0x7259, 0x0001, 0x725B,
+	0x0001, 0x72AC, 0x0001, 0x7384, 0x0001, 0x7389, 0x0001, 0x74DC,
+	0x0001, 0x74E6, 0x0001, 0x7518, 0x0001, 0x751F, 0x0001, 0x7528,
+	0x0001, 0x7530, 0x0001, 0x758B, 0x0001, 0x7592, 0x0001, 0x7676,
+	0x0001, 0x767D, 0x0001, 0x76AE, 0x0001, 0x76BF, 0x0001, 0x76EE,
+	0x0001, 0x77DB, 0x0001, 0x77E2, 0x0001, 0x77F3, 0x0001, 0x793A,
+	0x0001, 0x79B8, 0x0001, 0x79BE, 0x0001, 0x7A74, 0x0001, 0x7ACB,
+	0x0001, 0x7AF9, 0x0001, 0x7C73, 0x0001, 0x7CF8, 0x0001, 0x7F36,
+	0x0001, 0x7F51, 0x0001, 0x7F8A, 0x0001, 0x7FBD, 0x0001, 0x8001,
+	0x0001, 0x800C, 0x0001, 0x8012, 0x0001, 0x8033, 0x0001, 0x807F,
+	0x0001, 0x8089, 0x0001, 0x81E3, 0x0001, 0x81EA, 0x0001, 0x81F3,
+	0x0001, 0x81FC, 0x0001, 0x820C, 0x0001, 0x821B, 0x0001, 0x821F,
+	0x0001, 0x826E, 0x0001, 0x8272, 0x0001, 0x8278, 0x0001, 0x864D,
+	0x0001, 0x866B, 0x0001, 0x8840, 0x0001, 0x884C, 0x0001, 0x8863,
+	0x0001, 0x897E, 0x0001, 0x898B, 0x0001, 0x89D2, 0x0001, 0x8A00,
+	0x0001, 0x8C37, 0x0001, 0x8C46, 0x0001, 0x8C55, 0x0001, 0x8C78,
+	0x0001, 0x8C9D, 0x0001, 0x8D64, 0x0001, 0x8D70, 0x0001, 0x8DB3,
+	0x0001, 0x8EAB, 0x0001, 0x8ECA, 0x0001, 0x8F9B, 0x0001, 0x8FB0,
+	0x0001, 0x8FB5, 0x0001, 0x9091, 0x0001, 0x9149, 0x0001, 0x91C6,
+	0x0001, 0x91CC, 0x0001, 0x91D1, 0x0001, 0x9577, 0x0001, 0x9580,
+	0x0001, 0x961C, 0x0001, 0x96B6, 0x0001, 0x96B9, 0x0001, 0x96E8,
+	0x0001, 0x9751, 0x0001, 0x975E, 0x0001, 0x9762, 0x0001, 0x9769,
+	0x0001, 0x97CB, 0x0001, 0x97ED, 0x0001, 0x97F3, 0x0001, 0x9801,
+	0x0001, 0x98A8, 0x0001, 0x98DB, 0x0001, 0x98DF, 0x0001, 0x9996,
+	0x0001, 0x9999, 0x0001, 0x99AC, 0x0001, 0x9AA8, 0x0001, 0x9AD8,
+	0x0001, 0x9ADF, 0x0001, 0x9B25, 0x0001, 0x9B2F, 0x0001, 0x9B32,
+	0x0001, 0x9B3C, 0x0001, 0x9B5A, 0x0001, 0x9CE5, 0x0001, 0x9E75,
+	0x0001, 0x9E7F, 0x0001, 0x9EA5, 0x0001, 0x9EBB, 0x0001, 0x9EC3,
+	0x0001, 0x9ECD, 0x0001, 0x9ED1, 0x0001, 0x9EF9, 0x0001, 0x9EFD,
+	0x0001, 0x9F0E, 0x0001, 0x9F13, 0x0001, 0x9F20, 0x0001, 0x9F3B,
+	0x0001, 0x9F4A, 0x0001, 0x9F52, 0x0001, 0x9F8D, 0x0001, 0x9F9C,
+	0x0001, 0x9FA0, 0x0001, 0x3012, 0x0001, 0x5344, 0x0001, 0x5345,
+	0x0002, 0x304B, 0x3099, 0x0002, 0x304D, 0x3099, 0x0002, 0x304F,
+	0x3099, 0x0002, 0x3051, 0x3099, 0x0002, 0x3053, 0x3099, 0x0002,
+	0x3055, 0x3099, 0x0002, 0x3057, 0x3099, 0x0002, 0x3059, 0x3099,
+	0x0002, 0x305B, 0x3099, 0x0002, 0x305D, 0x3099, 0x0002, 0x305F,
+	0x3099, 0x0002, 0x3061, 0x3099, 0x0002, 0x3064, 0x3099, 0x0002,
+	0x3066, 0x3099, 0x0002, 0x3068, 0x3099, 0x0002, 0x306F, 0x3099,
+	0x0002, 0x306F, 0x309A, 0x0002, 0x3072, 0x3099, 0x0002, 0x3072,
+	0x309A, 0x0002, 0x3075, 0x3099, 0x0002, 0x3075, 0x309A, 0x0002,
+	0x3078, 0x3099, 0x0002, 0x3078, 0x309A, 0x0002, 0x307B, 0x3099,
+	0x0002, 0x307B, 0x309A, 0x0002, 0x3046, 0x3099, 0x0002, 0x0020,
+	0x3099, 0x0002, 0x0020, 0x309A, 0x0002, 0x309D, 0x3099, 0x0002,
+	0x3088, 0x308A, 0x0002, 0x30AB, 0x3099, 0x0002, 0x30AD, 0x3099,
+	0x0002, 0x30AF, 0x3099, 0x0002, 0x30B1, 0x3099, 0x0002, 0x30B3,
+	0x3099, 0x0002, 0x30B5, 0x3099, 0x0002, 0x30B7, 0x3099, 0x0002,
+	0x30B9, 0x3099, 0x0002, 0x30BB, 0x3099, 0x0002, 0x30BD, 0x3099,
+	0x0002, 0x30BF, 0x3099, 0x0002, 0x30C1, 0x3099, 0x0002, 0x30C4,
+	0x3099, 0x0002, 0x30C6, 0x3099, 0x0002, 0x30C8, 0x3099, 0x0002,
+	0x30CF, 0x3099, 0x0002, 0x30CF, 0x309A, 0x0002, 0x30D2, 0x3099,
+	0x0002, 0x30D2, 0x309A, 0x0002, 0x30D5, 0x3099, 0x0002, 0x30D5,
+	0x309A, 0x0002, 0x30D8, 0x3099, 0x0002, 0x30D8, 0x309A, 0x0002,
+	0x30DB, 0x3099, 0x0002, 0x30DB, 0x309A, 0x0002, 0x30A6, 0x3099,
+	0x0002, 0x30EF, 0x3099, 0x0002, 0x30F0, 0x3099, 0x0002, 0x30F1,
+	0x3099, 0x0002, 0x30F2, 0x3099, 0x0002, 0x30FD, 0x3099, 0x0002,
+	0x30B3, 0x30C8, 0x0001, 0x1100, 0x0001, 0x1101, 0x0001, 0x11AA,
+	0x0001, 0x1102, 0x0001, 0x11AC, 0x0001, 0x11AD, 0x0001, 0x1103,
+	0x0001, 0x1104, 0x0001, 0x1105, 0x0001, 0x11B0, 0x0001, 0x11B1,
+	0x0001, 0x11B2, 0x0001, 0x11B3, 0x0001, 0x11B4, 0x0001, 0x11B5,
+	0x0001, 0x111A, 0x0001, 0x1106, 0x0001, 0x1107, 0x0001, 0x1108,
+	0x0001, 0x1121, 0x0001, 0x1109, 0x0001, 0x110A, 0x0001, 0x110B,
+	0x0001, 0x110C, 0x0001, 0x110D, 0x0001, 0x110E, 0x0001, 0x110F,
+	0x0001, 0x1110, 0x0001, 0x1111, 0x0001, 0x1112, 0x0001, 0x1161,
+	0x0001, 0x1162, 0x0001, 0x1163, 0x0001, 0x1164, 0x0001, 0x1165,
+	0x0001, 0x1166, 0x0001, 0x1167, 0x0001, 0x1168, 0x0001, 0x1169,
+	0x0001, 0x116A, 0x0001, 0x116B, 0x0001, 0x116C, 0x0001, 0x116D,
+	0x0001, 0x116E, 0x0001, 0x116F, 0x0001, 0x1170, 0x0001, 0x1171,
+	0x0001, 0x1172, 0x0001, 0x1173, 0x0001, 0x1174, 0x0001, 0x1175,
+	0x0001, 0x1160, 0x0001, 0x1114, 0x0001, 0x1115, 0x0001, 0x11C7,
+	0x0001, 0x11C8, 0x0001, 0x11CC, 0x0001, 0x11CE, 0x0001, 0x11D3,
+	0x0001, 0x11D7, 0x0001, 0x11D9, 0x0001, 0x111C, 0x0001, 0x11DD,
+	0x0001, 0x11DF, 0x0001, 0x111D, 0x0001, 0x111E, 0x0001, 0x1120,
+	0x0001, 0x1122, 0x0001, 0x1123, 0x0001, 0x1127, 0x0001, 0x1129,
+	0x0001, 0x112B, 0x0001, 0x112C, 0x0001, 0x112D, 0x0001, 0x112E,
+	0x0001, 0x112F, 0x0001, 0x1132, 0x0001, 0x1136, 0x0001, 0x1140,
+	0x0001, 0x1147, 0x0001, 0x114C, 0x0001, 0x11F1, 0x0001, 0x11F2,
+	0x0001, 0x1157, 0x0001, 0x1158, 0x0001, 0x1159, 0x0001, 0x1184,
+	0x0001, 0x1185, 0x0001, 0x1188, 0x0001, 0x1191, 0x0001, 0x1192,
+	0x0001, 0x1194, 0x0001, 0x119E, 0x0001, 0x11A1, 0x0001, 0x4E09,
+	0x0001, 0x56DB, 0x0001, 0x4E0A, 0x0001, 0x4E2D, 0x0001, 0x4E0B,
+	0x0001, 0x7532, 0x0001, 0x4E19, 0x0001, 0x4E01, 0x0001, 0x5929,
+	0x0001, 0x5730, 0x0003, 0x0028, 0x1100, 0x0029, 0x0003, 0x0028,
+	0x1102, 0x0029, 0x0003, 0x0028, 0x1103, 0x0029, 0x0003, 0x0028,
+	0x1105, 0x0029, 0x0003, 0x0028, 0x1106, 0x0029, 0x0003, 0x0028,
+	0x1107, 0x0029, 0x0003, 0x0028, 0x1109, 0x0029, 0x0003, 0x0028,
+	0x110B, 0x0029, 0x0003, 0x0028, 0x110C, 0x0029, 0x0003, 0x0028,
+	0x110E, 0x0029, 0x0003, 0x0028, 0x110F, 0x0029, 0x0003, 0x0028,
+	0x1110, 0x0029, 0x0003, 0x0028, 0x1111, 0x0029, 0x0003, 0x0028,
+	0x1112, 0x0029, 0x0004, 0x0028, 0x1100, 0x1161, 0x0029, 0x0004,
+	0x0028, 0x1102, 0x1161, 0x0029, 0x0004, 0x0028, 0x1103, 0x1161,
+	0x0029, 0x0004, 0x0028, 0x1105, 0x1161, 0x0029, 0x0004, 0x0028,
+	0x1106, 0x1161, 0x0029, 0x0004, 0x0028, 0x1107, 0x1161, 0x0029,
+	0x0004, 0x0028, 0x1109, 0x1161, 0x0029, 0x0004, 0x0028, 0x110B,
+	0x1161, 0x0029, 0x0004, 0x0028, 0x110C, 0x1161, 0x0029, 0x0004,
+	0x0028, 0x110E, 0x1161, 0x0029, 0x0004, 0x0028, 0x110F, 0x1161,
+	0x0029, 0x0004, 0x0028, 0x1110, 0x1161, 0x0029, 0x0004, 0x0028,
+	0x1111, 0x1161, 0x0029, 0x0004, 0x0028, 0x1112, 0x1161, 0x0029,
+	0x0004, 0x0028, 0x110C, 0x116E, 0x0029, 0x0007, 0x0028, 0x110B,
+	0x1169, 0x110C, 0x1165, 0x11AB, 0x0029, 0x0006, 0x0028, 0x110B,
+	0x1169, 0x1112, 0x116E, 0x0029, 0x0003, 0x0028, 0x4E00, 0x0029,
+	0x0003, 0x0028, 0x4E8C, 0x0029, 0x0003, 0x0028, 0x4E09, 0x0029,
+	0x0003, 0x0028, 0x56DB, 0x0029, 0x0003, 0x0028, 0x4E94, 0x0029,
+	0x0003, 0x0028, 0x516D, 0x0029, 0x0003, 0x0028, 0x4E03, 0x0029,
+	0x0003, 0x0028, 0x516B, 0x0029, 0x0003, 0x0028, 0x4E5D, 0x0029,
+	0x0003, 0x0028, 0x5341, 0x0029, 0x0003, 0x0028, 0x6708, 0x0029,
+	0x0003, 0x0028, 0x706B, 0x0029, 0x0003, 0x0028, 0x6C34, 0x0029,
+	0x0003, 0x0028, 0x6728, 0x0029, 0x0003, 0x0028, 0x91D1, 0x0029,
+	0x0003, 0x0028, 0x571F, 0x0029, 0x0003, 0x0028, 0x65E5, 0x0029,
+	0x0003, 0x0028, 0x682A, 0x0029, 0x0003, 0x0028, 0x6709, 0x0029,
+	0x0003, 0x0028, 0x793E, 0x0029, 0x0003, 0x0028, 0x540D, 0x0029,
+	0x0003, 0x0028, 0x7279, 0x0029, 0x0003, 0x0028, 0x8CA1, 0x0029,
+	0x0003, 0x0028, 0x795D, 0x0029, 0x0003, 0x0028, 0x52B4, 0x0029,
+	0x0003, 0x0028, 0x4EE3, 0x0029, 0x0003, 0x0028, 0x547C, 0x0029,
+	0x0003, 0x0028, 0x5B66, 0x0029, 0x0003, 0x0028, 0x76E3, 0x0029,
+	0x0003, 0x0028, 0x4F01, 0x0029, 0x0003, 0x0028, 0x8CC7, 0x0029,
+	0x0003, 0x0028, 0x5354, 0x0029, 0x0003, 0x0028, 0x796D, 0x0029,
+	0x0003, 0x0028, 0x4F11, 0x0029, 0x0003, 0x0028, 0x81EA, 0x0029,
+	0x0003, 0x0028, 0x81F3, 0x0029, 0x0001, 0x554F, 0x0001, 0x5E7C,
+	0x0001, 0x7B8F, 0x0003, 0x0050, 0x0054, 0x0045, 0x0002, 0x0032,
+	0x0031, 0x0002, 0x0032, 0x0032, 0x0002, 0x0032, 0x0033, 0x0002,
+	0x0032, 0x0034, 0x0002, 0x0032, 0x0035, 0x0002, 0x0032, 0x0036,
+	0x0002, 0x0032, 0x0037, 0x0002, 0x0032, 0x0038, 0x0002, 0x0032,
+	0x0039, 0x0002, 0x0033, 0x0030, 0x0002, 0x0033, 0x0031, 0x0002,
+	0x0033, 0x0032, 0x0002, 0x0033, 0x0033, 0x0002, 0x0033, 0x0034,
+	0x0002, 0x0033, 0x0035, 0x0002, 0x1100, 0x1161, 0x0002, 0x1102,
+	0x1161, 0x0002, 0x1103, 0x1161, 0x0002, 0x1105, 0x1161, 0x0002,
+	0x1106, 0x1161, 0x0002, 0x1107, 0x1161, 0x0002, 0x1109, 0x1161,
+	0x0002, 0x110B, 0x1161, 0x0002, 0x110C, 0x1161, 0x0002, 0x110E,
+	0x1161, 0x0002, 0x110F, 0x1161, 0x0002, 0x1110, 0x1161, 0x0002,
+	0x1111, 0x1161, 0x0002, 0x1112, 0x1161, 0x0005, 0x110E, 0x1161,
+	0x11B7, 0x1100, 0x1169, 0x0004, 0x110C, 0x116E, 0x110B, 0x1174,
+	0x0002, 0x110B, 0x116E, 0x0001, 0x4E94, 0x0001, 0x516D, 0x0001,
+	0x4E03, 0x0001, 0x4E5D, 0x0001, 0x682A, 0x0001, 0x6709, 0x0001,
+	0x793E, 0x0001, 0x540D, 0x0001, 0x7279, 0x0001, 0x8CA1, 0x0001,
+	0x795D, 0x0001, 0x52B4, 0x0001, 0x79D8, 0x0001, 0x7537, 0x0001,
+	0x9069, 0x0001, 0x512A, 0x0001, 0x5370, 0x0001, 0x6CE8, 0x0001,
+	0x9805, 0x0001, 0x4F11, 0x0001, 0x5199, 0x0001, 0x6B63, 0x0001,
+	0x5DE6, 0x0001, 0x53F3, 0x0001, 0x533B, 0x0001, 0x5B97, 0x0001,
+	0x5B66, 0x0001, 0x76E3, 0x0001, 0x4F01, 0x0001, 0x8CC7, 0x0001,
+	0x5354, 0x0001, 0x591C, 0x0002, 0x0033, 0x0036, 0x0002, 0x0033,
+	0x0037, 0x0002, 0x0033, 0x0038, 0x0002, 0x0033, 0x0039, 0x0002,
+	0x0034, 0x0030, 0x0002, 0x0034, 0x0031, 0x0002, 0x0034, 0x0032,
+	0x0002, 0x0034, 0x0033, 0x0002, 0x0034, 0x0034, 0x0002, 0x0034,
+	0x0035, 0x0002, 0x0034, 0x0036, 0x0002, 0x0034, 0x0037, 0x0002,
+	0x0034, 0x0038, 0x0002, 0x0034, 0x0039, 0x0002, 0x0035, 0x0030,
+	0x0002, 0x0031, 0x6708, 0x0002, 0x0032, 0x6708, 0x0002, 0x0033,
+	0x6708, 0x0002, 0x0034, 0x6708, 0x0002, 0x0035, 0x6708, 0x0002,
+	0x0036, 0x6708, 0x0002, 0x0037, 0x6708, 0x0002, 0x0038, 0x6708,
+	0x0002, 0x0039, 0x6708, 0x0003, 0x0031, 0x0030, 0x6708, 0x0003,
+	0x0031, 0x0031, 0x6708, 0x0003, 0x0031, 0x0032, 0x6708, 0x0002,
+	0x0048, 0x0067, 0x0003, 0x0065, 0x0072, 0x0067, 0x0002, 0x0065,
+	0x0056, 0x0003, 0x004C, 0x0054, 0x0044, 0x0001, 0x30A2, 0x0001,
+	0x30A4, 0x0001, 0x30A6, 0x0001, 0x30A8, 0x0001, 0x30AA, 0x0001,
+	0x30AB, 0x0001, 0x30AD, 0x0001, 0x30AF, 0x0001, 0x30B1, 0x0001,
+	0x30B3, 0x0001, 0x30B5, 0x0001, 0x30B7, 0x0001, 0x30B9, 0x0001,
+	0x30BB, 0x0001, 0x30BD, 0x0001, 0x30BF, 0x0001, 0x30C1, 0x0001,
+	0x30C4, 0x0001, 0x30C6, 0x0001, 0x30C8, 0x0001, 0x30CA, 0x0001,
+	0x30CB, 0x0001, 0x30CC, 0x0001, 0x30CD, 0x0001, 0x30CE, 0x0001,
+	0x30CF, 0x0001, 0x30D2, 0x0001, 0x30D5, 0x0001, 0x30D8, 0x0001,
+	0x30DB, 0x0001, 0x30DE, 0x0001, 0x30DF, 0x0001, 0x30E0, 0x0001,
+	0x30E1, 0x0001, 0x30E2, 0x0001, 0x30E4, 0x0001, 0x30E6, 0x0001,
+	0x30E8, 0x0001, 0x30E9, 0x0001, 0x30EA, 0x0001, 0x30EB, 0x0001,
+	0x30EC, 0x0001, 0x30ED, 0x0001, 0x30EF, 0x0001, 0x30F0, 0x0001,
+	0x30F1, 0x0001, 0x30F2, 0x0002, 0x4EE4, 0x548C, 0x0005, 0x30A2,
+	0x30CF, 0x309A, 0x30FC, 0x30C8, 0x0004, 0x30A2, 0x30EB, 0x30D5,
+	0x30A1, 0x0005, 0x30A2, 0x30F3, 0x30D8, 0x309A, 0x30A2, 0x0003,
+	0x30A2, 0x30FC, 0x30EB, 0x0005, 0x30A4, 0x30CB, 0x30F3, 0x30AF,
+	0x3099, 0x0003, 0x30A4, 0x30F3, 0x30C1, 0x0003, 0x30A6, 0x30A9,
+	0x30F3, 0x0006, 0x30A8, 0x30B9, 0x30AF, 0x30FC, 0x30C8, 0x3099,
+	0x0004, 0x30A8, 0x30FC, 0x30AB, 0x30FC, 0x0003, 0x30AA, 0x30F3,
+	0x30B9, 0x0003, 0x30AA, 0x30FC, 0x30E0, 0x0003, 0x30AB, 0x30A4,
+	0x30EA, 0x0004, 0x30AB, 0x30E9, 0x30C3, 0x30C8, 0x0004, 0x30AB,
+	0x30ED, 0x30EA, 0x30FC, 0x0004, 0x30AB, 0x3099, 0x30ED, 0x30F3,
+	0x0004, 0x30AB, 0x3099, 0x30F3, 0x30DE, 0x0004, 0x30AD, 0x3099,
+	0x30AB, 0x3099, 0x0004, 0x30AD, 0x3099, 0x30CB, 0x30FC, 0x0004,
+	0x30AD, 0x30E5, 0x30EA, 0x30FC, 0x0006, 0x30AD, 0x3099, 0x30EB,
+	0x30BF, 0x3099, 0x30FC, 0x0002, 0x30AD, 0x30ED, 0x0006, 0x30AD,
+	0x30ED, 0x30AF, 0x3099, 0x30E9, 0x30E0, 0x0006, 0x30AD, 0x30ED,
+	0x30E1, 0x30FC, 0x30C8, 0x30EB, 0x0005, 0x30AD, 0x30ED, 0x30EF,
+	0x30C3, 0x30C8, 0x0004, 0x30AF, 0x3099, 0x30E9, 0x30E0, 0x0006,
+	0x30AF, 0x3099, 0x30E9, 0x30E0, 0x30C8, 0x30F3, 0x0006, 0x30AF,
+	0x30EB, 0x30BB, 0x3099, 0x30A4, 0x30ED, 0x0004, 0x30AF, 0x30ED,
+	0x30FC, 0x30CD, 0x0003, 0x30B1, 0x30FC, 0x30B9, 0x0003, 0x30B3,
+	0x30EB, 0x30CA, 0x0004, 0x30B3, 0x30FC, 0x30DB, 0x309A, 0x0004,
+	0x30B5, 0x30A4, 0x30AF, 0x30EB, 0x0005, 0x30B5, 0x30F3, 0x30C1,
+	0x30FC, 0x30E0, 0x0005, 0x30B7, 0x30EA, 0x30F3, 0x30AF, 0x3099,
+	0x0003, 0x30BB, 0x30F3, 0x30C1, 0x0003, 0x30BB, 0x30F3, 0x30C8,
+	0x0004, 0x30BF, 0x3099, 0x30FC, 0x30B9, 0x0003, 0x30C6, 0x3099,
+	0x30B7, 0x0003, 0x30C8, 0x3099, 0x30EB, 0x0002, 0x30C8, 0x30F3,
+	0x0002, 0x30CA, 0x30CE, 0x0003, 0x30CE, 0x30C3, 0x30C8, 0x0003,
+	0x30CF, 0x30A4, 0x30C4, 0x0006, 0x30CF, 0x309A, 0x30FC, 0x30BB,
+	0x30F3, 0x30C8, 0x0004, 0x30CF, 0x309A, 0x30FC, 0x30C4, 0x0005,
+	0x30CF, 0x3099, 0x30FC, 0x30EC, 0x30EB, 0x0006, 0x30D2, 0x309A,
+	0x30A2, 0x30B9, 0x30C8, 0x30EB, 0x0004, 0x30D2, 0x309A, 0x30AF,
+	0x30EB, 0x0003, 0x30D2, 0x309A, 0x30B3, 0x0003, 0x30D2, 0x3099,
+	0x30EB, 0x0006, 0x30D5, 0x30A1, 0x30E9, 0x30C3, 0x30C8, 0x3099,
+	0x0004, 0x30D5, 0x30A3, 0x30FC, 0x30C8, 0x0006, 0x30D5, 0x3099,
+	0x30C3, 0x30B7, 0x30A7, 0x30EB, 0x0003, 0x30D5, 0x30E9, 0x30F3,
+	0x0005, 0x30D8, 0x30AF, 0x30BF, 0x30FC, 0x30EB, 0x0003, 0x30D8,
+	0x309A, 0x30BD, 0x0004, 0x30D8, 0x309A, 0x30CB, 0x30D2, 0x0003,
+	0x30D8, 0x30EB, 0x30C4, 0x0004, 0x30D8, 0x309A, 0x30F3, 0x30B9,
+	0x0005, 0x30D8, 0x309A, 0x30FC, 0x30B7, 0x3099, 0x0004, 0x30D8,
+	0x3099, 0x30FC, 0x30BF, 0x0005, 0x30DB, 0x309A, 0x30A4, 0x30F3,
+	0x30C8, 0x0004, 0x30DB, 0x3099, 0x30EB, 0x30C8, 0x0002, 0x30DB,
+	0x30F3, 0x0005, 0x30DB, 0x309A, 0x30F3, 0x30C8, 0x3099, 0x0003,
+	0x30DB, 0x30FC, 0x30EB, 0x0003, 0x30DB, 0x30FC, 0x30F3, 0x0004,
+	0x30DE, 0x30A4, 0x30AF, 0x30ED, 0x0003, 0x30DE, 0x30A4, 0x30EB,
+	0x0003, 0x30DE, 0x30C3, 0x30CF, 0x0003, 0x30DE, 0x30EB, 0x30AF,
+	0x0005, 0x30DE, 0x30F3, 0x30B7, 0x30E7, 0x30F3, 0x0004, 0x30DF,
+	0x30AF, 0x30ED, 0x30F3, 0x0002, 0x30DF, 0x30EA, 0x0006, 0x30DF,
+	0x30EA, 0x30CF, 0x3099, 0x30FC, 0x30EB, 0x0003, 0x30E1, 0x30AB,
+	0x3099, 0x0005, 0x30E1, 0x30AB, 0x3099, 0x30C8, 0x30F3, 0x0004,
+	0x30E1, 0x30FC, 0x30C8, 0x30EB, 0x0004, 0x30E4, 0x30FC, 0x30C8,
+	0x3099, 0x0003, 0x30E4, 0x30FC, 0x30EB, 0x0003, 0x30E6, 0x30A2,
+	0x30F3, 0x0004, 0x30EA, 0x30C3, 0x30C8, 0x30EB, 0x0002, 0x30EA,
+	0x30E9, 0x0004, 0x30EB, 0x30D2, 0x309A, 0x30FC, 0x0005, 0x30EB,
+	0x30FC, 0x30D5, 0x3099, 0x30EB, 0x0002, 0x30EC, 0x30E0, 0x0006,
+	0x30EC, 0x30F3, 0x30C8, 0x30B1, 0x3099, 0x30F3, 0x0003, 0x30EF,
+	0x30C3, 0x30C8, 0x0002, 0x0030, 0x70B9, 0x0002, 0x0031, 0x70B9,
+	0x0002, 0x0032, 0x70B9, 0x0002, 0x0033, 0x70B9, 0x0002, 0x0034,
+	0x70B9, 0x0002, 0x0035, 0x70B9, 0x0002, 0x0036, 0x70B9, 0x0002,
+	0x0037, 0x70B9, 0x0002, 0x0038, 0x70B9, 0x0002, 0x0039, 0x70B9,
+	0x0003, 0x0031, 0x0030, 0x70B9, 0x0003, 0x0031, 0x0031, 0x70B9,
+	0x0003, 0x0031, 0x0032, 0x70B9, 0x0003, 0x0031, 0x0033, 0x70B9,
+	0x0003, 0x0031, 0x0034, 0x70B9, 0x0003, 0x0031, 0x0035, 0x70B9,
+	0x0003, 0x0031, 0x0036, 0x70B9, 0x0003, 0x0031, 0x0037, 0x70B9,
+	0x0003, 0x0031, 0x0038, 0x70B9, 0x0003, 0x0031, 0x0039, 0x70B9,
+	0x0003, 0x0032, 0x0030, 0x70B9, 0x0003, 0x0032, 0x0031, 0x70B9,
+	0x0003, 0x0032, 0x0032, 0x70B9, 0x0003, 0x0032, 0x0033, 0x70B9,
+	0x0003, 0x0032, 0x0034, 0x70B9, 0x0003, 0x0068, 0x0050, 0x0061,
+	0x0002, 0x0064, 0x0061, 0x0002, 0x0041, 0x0055, 0x0003, 0x0062,
+	0x0061, 0x0072, 0x0002, 0x006F, 0x0056, 0x0002, 0x0070, 0x0063,
+	0x0002, 0x0064, 0x006D, 0x0003, 0x0064, 0x006D, 0x0032, 0x0003,
+	0x0064, 0x006D, 0x0033, 0x0002, 0x0049, 0x0055, 0x0002, 0x5E73,
+	0x6210, 0x0002, 0x662D, 0x548C, 0x0002, 0x5927, 0x6B63, 0x0002,
+	0x660E, 0x6CBB, 0x0004, 0x682A, 0x5F0F, 0x4F1A, 0x793E, 0x0002,
+	0x0070, 0x0041, 0x0002, 0x006E, 0x0041, 0x0002, 0x03BC, 0x0041,
+	0x0002, 0x006D, 0x0041, 0x0002, 0x006B, 0x0041, 0x0002, 0x004B,
+	0x0042, 0x0002, 0x004D, 0x0042, 0x0002, 0x0047, 0x0042, 0x0003,
+	0x0063, 0x0061, 0x006C, 0x0004, 0x006B, 0x0063, 0x0061, 0x006C,
+	0x0002, 0x0070, 0x0046, 0x0002, 0x006E, 0x0046, 0x0002, 0x03BC,
+	0x0046, 0x0002, 0x03BC, 0x0067, 0x0002, 0x006D, 0x0067, 0x0002,
+	0x006B, 0x0067, 0x0002, 0x0048, 0x007A, 0x0003, 0x006B, 0x0048,
+	0x007A, 0x0003, 0x004D, 0x0048, 0x007A, 0x0003, 0x0047, 0x0048,
+	0x007A, 0x0003, 0x0054, 0x0048, 0x007A, 0x0002, 0x03BC, 0x006C,
+	0x0002, 0x006D, 0x006C, 0x0002, 0x0064, 0x006C, 0x0002, 0x006B,
+	0x006C, 0x0002, 0x0066, 0x006D, 0x0002, 0x006E, 0x006D, 0x0002,
+	0x03BC, 0x006D, 0x0002, 0x006D, 0x006D, 0x0002, 0x0063, 0x006D,
+	0x0002, 0x006B, 0x006D, 0x0003, 0x006D, 0x006D, 0x0032, 0x0003,
+	0x0063, 0x006D, 0x0032, 0x0002, 0x006D, 0x0032, 0x0003, 0x006B,
+	0x006D, 0x0032, 0x0003, 0x006D, 0x006D, 0x0033, 0x0003, 0x0063,
+	0x006D, 0x0033, 0x0002, 0x006D, 0x0033, 0x0003, 0x006B, 0x006D,
+	0x0033, 0x0003, 0x006D, 0x2215, 0x0073, 0x0004, 0x006D, 0x2215,
+	0x0073, 0x0032, 0x0002, 0x0050, 0x0061, 0x0003, 0x006B, 0x0050,
+	0x0061, 0x0003, 0x004D, 0x0050, 0x0061, 0x0003, 0x0047, 0x0050,
+	0x0061, 0x0003, 0x0072, 0x0061, 0x0064, 0x0005, 0x0072, 0x0061,
+	0x0064, 0x2215, 0x0073, 0x0006, 0x0072, 0x0061, 0x0064, 0x2215,
+	0x0073, 0x0032, 0x0002, 0x0070, 0x0073, 0x0002, 0x006E, 0x0073,
+	0x0002, 0x03BC, 0x0073, 0x0002, 0x006D, 0x0073, 0x0002, 0x0070,
+	0x0056, 0x0002, 0x006E, 0x0056, 0x0002, 0x03BC, 0x0056, 0x0002,
+	0x006D, 0x0056, 0x0002, 0x006B, 0x0056, 0x0002, 0x004D, 0x0056,
+	0x0002, 0x0070, 0x0057, 0x0002, 0x006E, 0x0057, 0x0002, 0x03BC,
+	0x0057, 0x0002, 0x006D, 0x0057, 0x0002, 0x006B, 0x0057, 0x0002,
+	0x004D, 0x0057, 0x0002, 0x006B, 0x03A9, 0x0002, 0x004D, 0x03A9,
+	0x0004, 0x0061, 0x002E, 0x006D, 0x002E, 0x0002, 0x0042, 0x0071,
+	0x0002, 0x0063, 0x0063, 0x0002, 0x0063, 0x0064, 0x0004, 0x0043,
+	0x2215, 0x006B, 0x0067, 0x0003, 0x0043, 0x006F, 0x002E, 0x0002,
+	0x0064, 0x0042, 0x0002, 0x0047, 0x0079, 0x0002, 0x0068, 0x0061,
+	0x0002, 0x0048, 0x0050, 0x0002, 0x0069, 0x006E, 0x0002, 0x004B,
+	0x004B, 0x0002, 0x004B, 0x004D, 0x0002, 0x006B, 0x0074, 0x0002,
+	0x006C, 0x006D, 0x0002, 0x006C, 0x006E, 0x0003, 0x006C, 0x006F,
+	0x0067, 0x0002, 0x006C, 0x0078, 0x0002, 0x006D, 0x0062, 0x0003,
+	0x006D, 0x0069, 0x006C, 0x0003, 0x006D, 0x006F, 0x006C, 0x0002,
+	0x0050, 0x0048, 0x0004, 0x0070, 0x002E, 0x006D, 0x002E, 0x0003,
+	0x0050, 0x0050, 0x004D, 0x0002, 0x0050, 0x0052, 0x0002, 0x0073,
+	0x0072, 0x0002, 0x0053, 0x0076, 0x0002, 0x0057, 0x0062, 0x0003,
+	0x0056, 0x2215, 0x006D, 0x0003, 0x0041, 0x2215, 0x006D, 0x0002,
+	0x0031, 0x65E5, 0x0002, 0x0032, 0x65E5, 0x0002, 0x0033, 0x65E5,
+	0x0002, 0x0034, 0x65E5, 0x0002, 0x0035, 0x65E5, 0x0002, 0x0036,
+	0x65E5, 0x0002, 0x0037, 0x65E5, 0x0002, 0x0038, 0x65E5, 0x0002,
+	0x0039, 0x65E5, 0x0003, 0x0031, 0x0030, 0x65E5, 0x0003, 0x0031,
+	0x0031, 0x65E5, 0x0003, 0x0031, 0x0032, 0x65E5, 0x0003, 0x0031,
+	0x0033, 0x65E5, 0x0003, 0x0031, 0x0034, 0x65E5, 0x0003, 0x0031,
+	0x0035, 0x65E5, 0x0003, 0x0031, 0x0036, 0x65E5, 0x0003, 0x0031,
+	0x0037, 0x65E5, 0x0003, 0x0031, 0x0038, 0x65E5, 0x0003, 0x0031,
+	0x0039, 0x65E5, 0x0003, 0x0032, 0x0030, 0x65E5, 0x0003, 0x0032,
+	0x0031, 0x65E5, 0x0003, 0x0032, 0x0032, 0x65E5, 0x0003, 0x0032,
+	0x0033, 0x65E5, 0x0003, 0x0032, 0x0034, 0x65E5, 0x0003, 0x0032,
+	0x0035, 0x65E5, 0x0003, 0x0032, 0x0036, 0x65E5, 0x0003, 0x0032,
+	0x0037, 0x65E5, 0x0003, 0x0032, 0x0038, 0x65E5, 0x0003, 0x0032,
+	0x0039, 0x65E5, 0x0003, 0x0033, 0x0030, 0x65E5, 0x0003, 0x0033,
+	0x0031, 0x65E5, 0x0003, 0x0067, 0x0061, 0x006C, 0x0001, 0x044A,
+	0x0001, 0x044C, 0x0001, 0xA76F, 0x0001, 0x0126, 0x0001, 0x0153,
+	0x0001, 0xA727, 0x0001, 0xAB37, 0x0001, 0x026B, 0x0001, 0xAB52,
+	0x0001, 0x028D, 0x0001, 0x8C48, 0x0001, 0x66F4, 0x0001, 0x8CC8,
+	0x0001, 0x6ED1, 0x0001, 0x4E32, 0x0001, 0x53E5, 0x0001, 0x5951,
+	0x0001, 0x5587, 0x0001, 0x5948, 0x0001, 0x61F6, 0x0001, 0x7669,
+	0x0001, 0x7F85, 0x0001, 0x863F, 0x0001, 0x87BA, 0x0001, 0x88F8,
+	0x0001, 0x908F, 0x0001, 0x6A02, 0x0001, 0x6D1B, 0x0001, 0x70D9,
+	0x0001, 0x73DE, 0x0001, 0x843D, 0x0001, 0x916A, 0x0001, 0x99F1,
+	0x0001, 0x4E82, 0x0001, 0x5375, 0x0001, 0x6B04, 0x0001, 0x721B,
+	0x0001, 0x862D, 0x0001, 0x9E1E, 0x0001, 0x5D50, 0x0001, 0x6FEB,
+	0x0001, 0x85CD, 0x0001, 0x8964, 0x0001, 0x62C9, 0x0001, 0x81D8,
+	0x0001, 0x881F, 0x0001, 0x5ECA, 0x0001, 0x6717, 0x0001, 0x6D6A,
+	0x0001, 0x72FC, 0x0001, 0x90CE, 0x0001, 0x4F86, 0x0001, 0x51B7,
+	0x0001, 0x52DE, 0x0001, 0x64C4, 0x0001, 0x6AD3, 0x0001, 0x7210,
+	0x0001, 0x76E7, 0x0001, 0x8606, 0x0001, 0x865C, 0x0001, 0x8DEF,
+	0x0001, 0x9732, 0x0001, 0x9B6F, 0x0001, 0x9DFA, 0x0001, 0x788C,
+	0x0001, 0x797F, 0x0001, 0x7DA0, 0x0001, 0x83C9, 0x0001, 0x9304,
+	0x0001, 0x8AD6, 0x0001, 0x58DF, 0x0001, 0x5F04, 0x0001, 0x7C60,
+	0x0001, 0x807E, 0x0001, 0x7262, 0x0001, 0x78CA, 0x0001, 0x8CC2,
+	0x0001, 0x96F7, 0x0001, 0x58D8, 0x0001, 0x5C62, 0x0001, 0x6A13,
+	0x0001, 0x6DDA, 0x0001, 0x6F0F, 0x0001, 0x7D2F, 0x0001, 0x7E37,
+	0x0001, 0x964B, 0x0001, 0x52D2, 0x0001, 0x808B, 0x0001, 0x51DC,
+	0x0001, 0x51CC, 0x0001, 0x7A1C, 0x0001, 0x7DBE, 0x0001, 0x83F1,
+	0x0001, 0x9675, 0x0001, 0x8B80, 0x0001, 0x62CF, 0x0001, 0x8AFE,
+	0x0001, 0x4E39, 0x0001, 0x5BE7, 0x0001, 0x6012, 0x0001, 0x7387,
+	0x0001, 0x7570, 0x0001, 0x5317, 0x0001, 0x78FB, 0x0001, 0x4FBF,
+	0x0001, 0x5FA9, 0x0001, 0x4E0D, 0x0001, 0x6CCC, 0x0001, 0x6578,
+	0x0001, 0x7D22, 0x0001, 0x53C3, 0x0001, 0x585E, 0x0001, 0x7701,
+	0x0001, 0x8449, 0x0001, 0x8AAA, 0x0001, 0x6BBA, 0x0001, 0x6C88,
+	0x0001, 0x62FE, 0x0001, 0x82E5, 0x0001, 0x63A0, 0x0001, 0x7565,
+	0x0001, 0x4EAE, 0x0001, 0x5169, 0x0001, 0x51C9, 0x0001, 0x6881,
+	0x0001, 0x7CE7, 0x0001, 0x826F, 0x0001, 0x8AD2, 0x0001, 0x91CF,
+	0x0001, 0x52F5, 0x0001, 0x5442, 0x0001, 0x5EEC, 0x0001, 0x65C5,
+	0x0001, 0x6FFE, 0x0001, 0x792A, 0x0001, 0x95AD, 0x0001, 0x9A6A,
+	0x0001, 0x9E97, 0x0001, 0x9ECE, 0x0001, 0x66C6, 0x0001, 0x6B77,
+	0x0001, 0x8F62, 0x0001, 0x5E74, 0x0001, 0x6190, 0x0001, 0x6200,
+	0x0001, 0x649A, 0x0001, 0x6F23, 0x0001, 0x7149, 0x0001, 0x7489,
+	0x0001, 0x79CA, 0x0001, 0x7DF4, 0x0001, 0x806F, 0x0001, 0x8F26,
+	0x0001, 0x84EE, 0x0001, 0x9023, 0x0001, 0x934A, 0x0001, 0x5217,
+	0x0001, 0x52A3, 0x0001, 0x54BD, 0x0001, 0x70C8, 0x0001, 0x88C2,
+	0x0001, 0x5EC9, 0x0001, 0x5FF5, 0x0001, 0x637B, 0x0001, 0x6BAE,
+	0x0001, 0x7C3E, 0x0001, 0x7375, 0x0001, 0x4EE4, 0x0001, 0x56F9,
+	0x0001, 0x5DBA, 0x0001, 0x601C, 0x0001, 0x73B2, 0x0001, 0x7469,
+	0x0001, 0x7F9A, 0x0001, 0x8046, 0x0001, 0x9234, 0x0001, 0x96F6,
+	0x0001, 0x9748, 0x0001, 0x9818, 0x0001, 0x4F8B, 0x0001, 0x79AE,
+	0x0001, 0x91B4, 0x0001, 0x96B8, 0x0001, 0x60E1, 0x0001, 0x4E86,
+	0x0001, 0x50DA, 0x0001, 0x5BEE, 0x0001, 0x5C3F, 0x0001, 0x6599,
+	0x0001, 0x71CE, 0x0001, 0x7642, 0x0001, 0x84FC, 0x0001, 0x907C,
+	0x0001, 0x6688, 0x0001, 0x962E, 0x0001, 0x5289, 0x0001, 0x677B,
+	0x0001, 0x67F3, 0x0001, 0x6D41, 0x0001, 0x6E9C, 0x0001, 0x7409,
+	0x0001, 0x7559, 0x0001, 0x786B, 0x0001, 0x7D10, 0x0001, 0x985E,
+	0x0001, 0x622E, 0x0001, 0x9678, 0x0001, 0x502B, 0x0001, 0x5D19,
+	0x0001, 0x6DEA, 0x0001, 0x8F2A, 0x0001, 0x5F8B, 0x0001, 0x6144,
+	0x0001, 0x6817, 0x0001, 0x9686, 0x0001, 0x5229, 0x0001, 0x540F,
+	0x0001, 0x5C65, 0x0001, 0x6613, 0x0001, 0x674E, 0x0001, 0x68A8,
+	0x0001, 0x6CE5, 0x0001, 0x7406, 0x0001, 0x75E2, 0x0001, 0x7F79,
+	0x0001, 0x88CF, 0x0001, 0x88E1, 0x0001, 0x96E2, 0x0001, 0x533F,
+	0x0001, 0x6EBA, 0x0001, 0x541D, 0x0001, 0x71D0, 0x0001, 0x7498,
+	0x0001, 0x85FA, 0x0001, 0x96A3, 0x0001, 0x9C57, 0x0001, 0x9E9F,
+	0x0001, 0x6797, 0x0001, 0x6DCB, 0x0001, 0x81E8, 0x0001, 0x7B20,
+	0x0001, 0x7C92, 0x0001, 0x72C0, 0x0001, 0x7099, 0x0001, 0x8B58,
+	0x0001, 0x4EC0, 0x0001, 0x8336, 0x0001, 0x523A, 0x0001, 0x5207,
+	0x0001, 0x5EA6, 0x0001, 0x62D3, 0x0001, 0x7CD6, 0x0001, 0x5B85,
+	0x0001, 0x6D1E, 0x0001, 0x66B4, 0x0001, 0x8F3B, 0x0001, 0x964D,
+	0x0001, 0x5ED3, 0x0001, 0x5140, 0x0001, 0x55C0, 0x0001, 0x585A,
+	0x0001, 0x6674, 0x0001, 0x51DE, 0x0001, 0x732A, 0x0001, 0x76CA,
+	0x0001, 0x793C, 0x0001, 0x795E, 0x0001, 0x7965, 0x0001, 0x798F,
+	0x0001, 0x9756, 0x0001, 0x7CBE, 0x0001, 0x8612, 0x0001, 0x8AF8,
+	0x0001, 0x9038, 0x0001, 0x90FD, 0x0001, 0x98EF, 0x0001, 0x98FC,
+	0x0001, 0x9928, 0x0001, 0x9DB4, 0x0001, 0x90DE, 0x0001, 0x96B7,
+	0x0001, 0x4FAE, 0x0001, 0x50E7, 0x0001, 0x514D, 0x0001, 0x52C9,
+	0x0001, 0x52E4, 0x0001, 0x5351, 0x0001, 0x559D, 0x0001, 0x5606,
+	0x0001, 0x5668, 0x0001, 0x5840, 0x0001, 0x58A8, 0x0001, 0x5C64,
+	0x0001, 0x6094, 0x0001, 0x6168, 0x0001, 0x618E, 0x0001, 0x61F2,
+	0x0001, 0x654F, 0x0001, 0x65E2, 0x0001, 0x6691, 0x0001, 0x6885,
+	0x0001, 0x6D77, 0x0001, 0x6E1A, 0x0001, 0x6F22, 0x0001, 0x716E,
+	0x0001, 0x722B, 0x0001, 0x7422, 0x0001, 0x7891, 0x0001, 0x7949,
+	0x0001, 0x7948, 0x0001, 0x7950, 0x0001, 0x7956, 0x0001, 0x798D,
+	0x0001, 0x798E, 0x0001, 0x7A40, 0x0001, 0x7A81, 0x0001, 0x7BC0,
+	0x0001, 0x7E09, 0x0001, 0x7E41, 0x0001, 0x7F72, 0x0001, 0x8005,
+	0x0001, 0x81ED, 0x0001, 0x8279, 0x0001, 0x8457, 0x0001, 0x8910,
+	0x0001, 0x8996, 0x0001, 0x8B01, 0x0001, 0x8B39, 0x0001, 0x8CD3,
+	0x0001, 0x8D08, 0x0001, 0x8FB6, 0x0001, 0x96E3, 0x0001, 0x97FF,
+	0x0001, 0x983B, 0x0001, 0x6075, 0x0001, 0x242EE, 0x0001, 0x8218,
+	0x0001, 0x4E26, 0x0001, 0x51B5, 0x0001, 0x5168, 0x0001, 0x4F80,
+	0x0001, 0x5145, 0x0001, 0x5180, 0x0001, 0x52C7, 0x0001, 0x52FA,
+	0x0001, 0x5555, 0x0001, 0x5599, 0x0001, 0x55E2, 0x0001, 0x58B3,
+	0x0001, 0x5944, 0x0001, 0x5954, 0x0001, 0x5A62, 0x0001, 0x5B28,
+	0x0001, 0x5ED2, 0x0001, 0x5ED9, 0x0001, 0x5F69, 0x0001, 0x5FAD,
+	0x0001, 0x60D8, 0x0001, 0x614E, 0x0001, 0x6108, 0x0001, 0x6160,
+	0x0001, 0x6234, 0x0001, 0x63C4, 0x0001, 0x641C, 0x0001, 0x6452,
+	0x0001, 0x6556, 0x0001, 0x671B, 0x0001, 0x6756, 0x0001, 0x6EDB,
+	0x0001, 0x6ECB, 0x0001, 0x701E, 0x0001, 0x77A7, 0x0001, 0x7235,
+	0x0001, 0x72AF, 0x0001, 0x7471, 0x0001, 0x7506, 0x0001, 0x753B,
+	0x0001, 0x761D, 0x0001, 0x761F, 0x0001, 0x76DB, 0x0001, 0x76F4,
+	0x0001, 0x774A, 0x0001, 0x7740, 0x0001, 0x78CC, 0x0001, 0x7AB1,
+	0x0001, 0x7C7B, 0x0001, 0x7D5B, 0x0001, 0x7F3E, 0x0001, 0x8352,
+	0x0001, 0x83EF, 0x0001, 0x8779, 0x0001, 0x8941, 0x0001, 0x8986,
+	0x0001, 0x8ABF, 0x0001, 0x8ACB, 0x0001, 0x8AED, 0x0001, 0x8B8A,
+	0x0001, 0x8F38, 0x0001, 0x9072, 0x0001, 0x9199, 0x0001, 0x9276,
+	0x0001, 0x967C, 0x0001, 0x97DB, 0x0001, 0x980B, 0x0001, 0x9B12,
+	0x0001, 0x2284A, 0x0001, 0x22844, 0x0001, 0x233D5, 0x0001, 0x3B9D,
+	0x0001, 0x4018, 0x0001, 0x4039, 0x0001, 0x25249, 0x0001, 0x25CD0,
+	0x0001, 0x27ED3, 0x0001, 0x9F43, 0x0001, 0x9F8E, 0x0002, 0x0066,
+	0x0066, 0x0002, 0x0066, 0x0069, 0x0002, 0x0066, 0x006C, 0x0003,
+	0x0066, 0x0066, 0x0069, 0x0003, 0x0066, 0x0066, 0x006C, 0x0002,
+	0x0073, 0x0074, 0x0002, 0x0574, 0x0576, 0x0002, 0x0574, 0x0565,
+	0x0002, 0x0574, 0x056B, 0x0002, 0x057E, 0x0576, 0x0002, 0x0574,
+	0x056D, 0x0002, 0x05D9, 0x05B4, 0x0002, 0x05F2, 0x05B7, 0x0001,
+	0x05E2, 0x0001, 0x05D4, 0x0001, 0x05DB, 0x0001, 0x05DC, 0x0001,
+	0x05DD, 0x0001, 0x05E8, 0x0001, 0x05EA, 0x0002, 0x05E9, 0x05C1,
+	0x0002, 0x05E9, 0x05C2, 0x0003, 0x05E9, 0x05BC, 0x05C1, 0x0003,
+	0x05E9, 0x05BC, 0x05C2, 0x0002, 0x05D0, 0x05B7, 0x0002, 0x05D0,
+	0x05B8, 0x0002, 0x05D0, 0x05BC, 0x0002, 0x05D1, 0x05BC, 0x0002,
+	0x05D2, 0x05BC, 0x0002, 0x05D3, 0x05BC, 0x0002, 0x05D4, 0x05BC,
+	0x0002, 0x05D5, 0x05BC, 0x0002, 0x05D6, 0x05BC, 0x0002, 0x05D8,
+	0x05BC, 0x0002, 0x05D9, 0x05BC, 0x0002, 0x05DA, 0x05BC, 0x0002,
+	0x05DB, 0x05BC, 0x0002, 0x05DC, 0x05BC, 0x0002, 0x05DE, 0x05BC,
+	0x0002, 0x05E0, 0x05BC, 0x0002, 0x05E1, 0x05BC, 0x0002, 0x05E3,
+	0x05BC, 0x0002, 0x05E4, 0x05BC, 0x0002, 0x05E6, 0x05BC, 0x0002,
+	0x05E7, 0x05BC, 0x0002, 0x05E8, 0x05BC, 0x0002, 0x05E9, 0x05BC,
+	0x0002, 0x05EA, 0x05BC, 0x0002, 0x05D5, 0x05B9, 0x0002, 0x05D1,
+	0x05BF, 0x0002, 0x05DB, 0x05BF, 0x0002, 0x05E4, 0x05BF, 0x0002,
+	0x05D0, 0x05DC, 0x0001, 0x0671, 0x0001, 0x067B, 0x0001, 0x067E,
+	0x0001, 0x0680, 0x0001, 0x067A, 0x0001, 0x067F, 0x0001, 0x0679,
+	0x0001, 0x06A4, 0x0001, 0x06A6, 0x0001, 0x0684, 0x0001, 0x0683,
+	0x0001, 0x0686, 0x0001, 0x0687, 0x0001, 0x068D, 0x0001, 0x068C,
+	0x0001, 0x068E, 0x0001, 0x0688, 0x0001, 0x0698, 0x0001, 0x0691,
+	0x0001, 0x06A9, 0x0001, 0x06AF, 0x0001, 0x06B3, 0x0001, 0x06B1,
+	0x0001, 0x06BA, 0x0001, 0x06BB, 0x0001, 0x06C1, 0x0001, 0x06BE,
+	0x0001, 0x06D2, 0x0001, 0x06AD, 0x0001, 0x06C7, 0x0001, 0x06C6,
+	0x0001, 0x06C8, 0x0001, 0x06CB, 0x0001, 0x06C5, 0x0001, 0x06C9,
+	0x0001, 0x06D0, 0x0001, 0x0649, 0x0003, 0x064A, 0x0654, 0x0627,
+	0x0003, 0x064A, 0x0654, 0x06D5, 0x0003, 0x064A, 0x0654, 0x0648,
+	0x0003, 0x064A, 0x0654, 0x06C7, 0x0003, 0x064A, 0x0654, 0x06C6,
+	0x0003, 0x064A, 0x0654, 0x06C8, 0x0003, 0x064A, 0x0654, 0x06D0,
+	0x0003, 0x064A, 0x0654, 0x0649, 0x0001, 0x06CC, 0x0003, 0x064A,
+	0x0654, 0x062C, 0x0003, 0x064A, 0x0654, 0x062D, 0x0003, 0x064A,
+	0x0654, 0x0645, 0x0003, 0x064A, 0x0654, 0x064A, 0x0002, 0x0628,
+	0x062C, 0x0002, 0x0628, 0x062D, 0x0002, 0x0628, 0x062E, 0x0002,
+	0x0628, 0x0645, 0x0002, 0x0628, 0x0649, 0x0002, 0x0628, 0x064A,
+	0x0002, 0x062A, 0x062C, 0x0002, 0x062A, 0x062D, 0x0002, 0x062A,
+	0x062E, 0x0002, 0x062A, 0x0645, 0x0002, 0x062A, 0x0649, 0x0002,
+	0x062A, 0x064A, 0x0002, 0x062B, 0x062C, 0x0002, 0x062B, 0x0645,
+	0x0002, 0x062B, 0x0649, 0x0002, 0x062B, 0x064A, 0x0002, 0x062C,
+	0x062D, 0x0002, 0x062C, 0x0645, 0x0002, 0x062D, 0x062C, 0x0002,
+	0x062D, 0x0645, 0x0002, 0x062E, 0x062C, 0x0002, 0x062E, 0x062D,
+	0x0002, 0x062E, 0x0645, 0x0002, 0x0633, 0x062C, 0x0002, 0x0633,
+	0x062D, 0x0002, 0x0633, 0x062E, 0x0002, 0x0633, 0x0645, 0x0002,
+	0x0635, 0x062D, 0x0002, 0x0635, 0x0645, 0x0002, 0x0636, 0x062C,
+	0x0002, 0x0636, 0x062D, 0x0002, 0x0636, 0x062E, 0x0002, 0x0636,
+	0x0645, 0x0002, 0x0637, 0x062D, 0x0002, 0x0637, 0x0645, 0x0002,
+	0x0638, 0x0645, 0x0002, 0x0639, 0x062C, 0x0002, 0x0639, 0x0645,
+	0x0002, 0x063A, 0x062C, 0x0002, 0x063A, 0x0645, 0x0002, 0x0641,
+	0x062C, 0x0002, 0x0641, 0x062D, 0x0002, 0x0641, 0x062E, 0x0002,
+	0x0641, 0x0645, 0x0002, 0x0641, 0x0649, 0x0002, 0x0641, 0x064A,
+	0x0002, 0x0642, 0x062D, 0x0002, 0x0642, 0x0645, 0x0002, 0x0642,
+	0x0649, 0x0002, 0x0642, 0x064A, 0x0002, 0x0643, 0x0627, 0x0002,
+	0x0643, 0x062C, 0x0002, 0x0643, 0x062D, 0x0002, 0x0643, 0x062E,
+	0x0002, 0x0643, 0x0644, 0x0002, 0x0643, 0x0645, 0x0002, 0x0643,
+	0x0649, 0x0002, 0x0643, 0x064A, 0x0002, 0x0644, 0x062C, 0x0002,
+	0x0644, 0x062D, 0x0002, 0x0644, 0x062E, 0x0002, 0x0644, 0x0645,
+	0x0002, 0x0644, 0x0649, 0x0002, 0x0644, 0x064A, 0x0002, 0x0645,
+	0x062C, 0x0002, 0x0645, 0x062D, 0x0002, 0x0645, 0x062E, 0x0002,
+	0x0645, 0x0645, 0x0002, 0x0645, 0x0649, 0x0002, 0x0645, 0x064A,
+	0x0002, 0x0646, 0x062C, 0x0002, 0x0646, 0x062D, 0x0002, 0x0646,
+	0x062E, 0x0002, 0x0646, 0x0645, 0x0002, 0x0646, 0x0649, 0x0002,
+	0x0646, 0x064A, 0x0002, 0x0647, 0x062C, 0x0002, 0x0647, 0x0645,
+	0x0002, 0x0647, 0x0649, 0x0002, 0x0647, 0x064A, 0x0002, 0x064A,
+	0x062C, 0x0002, 0x064A, 0x062D, 0x0002, 0x064A, 0x062E, 0x0002,
+	0x064A, 0x0645, 0x0002, 0x064A, 0x0649, 0x0002, 0x064A, 0x064A,
+	0x0002, 0x0630, 0x0670, 0x0002, 0x0631, 0x0670, 0x0002, 0x0649,
+	0x0670, 0x0003, 0x0020, 0x064C, 0x0651, 0x0003, 0x0020, 0x064D,
+	0x0651, 0x0003, 0x0020, 0x064E, 0x0651, 0x0003, 0x0020, 0x064F,
+	0x0651, 0x0003, 0x0020, 0x0650, 0x0651, 0x0003, 0x0020, 0x0651,
+	0x0670, 0x0003, 0x064A, 0x0654, 0x0631, 0x0003, 0x064A, 0x0654,
+	0x0632, 0x0003, 0x064A, 0x0654, 0x0646, 0x0002, 0x0628, 0x0631,
+	0x0002, 0x0628, 0x0632, 0x0002, 0x0628, 0x0646, 0x0002, 0x062A,
+	0x0631, 0x0002, 0x062A, 0x0632, 0x0002, 0x062A, 0x0646, 0x0002,
+	0x062B, 0x0631, 0x0002, 0x062B, 0x0632, 0x0002, 0x062B, 0x0646,
+	0x0002, 0x0645, 0x0627, 0x0002, 0x0646, 0x0631, 0x0002, 0x0646,
+	0x0632, 0x0002, 0x0646, 0x0646, 0x0002, 0x064A, 0x0631, 0x0002,
+	0x064A, 0x0632, 0x0002, 0x064A, 0x0646, 0x0003, 0x064A, 0x0654,
+	0x062E, 0x0003, 0x064A, 0x0654, 0x0647, 0x0002, 0x0628, 0x0647,
+	0x0002, 0x062A, 0x0647, 0x0002, 0x0635, 0x062E, 0x0002, 0x0644,
+	0x0647, 0x0002, 0x0646, 0x0647, 0x0002, 0x0647, 0x0670, 0x0002,
+	0x064A, 0x0647, 0x0002, 0x062B, 0x0647, 0x0002, 0x0633, 0x0647,
+	0x0002, 0x0634, 0x0645, 0x0002, 0x0634, 0x0647, 0x0003, 0x0640,
+	0x064E, 0x0651, 0x0003, 0x0640, 0x064F, 0x0651, 0x0003, 0x0640,
+	0x0650, 0x0651, 0x0002, 0x0637, 0x0649, 0x0002, 0x0637, 0x064A,
+	0x0002, 0x0639, 0x0649, 0x0002, 0x0639, 0x064A, 0x0002, 0x063A,
+	0x0649, 0x0002, 0x063A, 0x064A, 0x0002, 0x0633, 0x0649, 0x0002,
+	0x0633, 0x064A, 0x0002, 0x0634, 0x0649, 0x0002, 0x0634, 0x064A,
+	0x0002, 0x062D, 0x0649, 0x0002, 0x062D, 0x064A, 0x0002, 0x062C,
+	0x0649, 0x0002, 0x062C, 0x064A, 0x0002, 0x062E, 0x0649, 0x0002,
+	0x062E, 0x064A, 0x0002, 0x0635, 0x0649, 0x0002, 0x0635, 0x064A,
+	0x0002, 0x0636, 0x0649, 0x0002, 0x0636, 0x064A, 0x0002, 0x0634,
+	0x062C, 0x0002, 0x0634, 0x062D, 0x0002, 0x0634, 0x062E, 0x0002,
+	0x0634, 0x0631, 0x0002, 0x0633, 0x0631, 0x0002, 0x0635, 0x0631,
+	0x0002, 0x0636, 0x0631, 0x0002, 0x0627, 0x064B, 0x0003, 0x062A,
+	0x062C, 0x0645, 0x0003, 0x062A, 0x062D, 0x062C, 0x0003, 0x062A,
+	0x062D, 0x0645, 0x0003, 0x062A, 0x062E, 0x0645, 0x0003, 0x062A,
+	0x0645, 0x062C, 0x0003, 0x062A, 0x0645, 0x062D, 0x0003, 0x062A,
+	0x0645, 0x062E, 0x0003, 0x062C, 0x0645, 0x062D, 0x0003, 0x062D,
+	0x0645, 0x064A, 0x0003, 0x062D, 0x0645, 0x0649, 0x0003, 0x0633,
+	0x062D, 0x062C, 0x0003, 0x0633, 0x062C, 0x062D, 0x0003, 0x0633,
+	0x062C, 0x0649, 0x0003, 0x0633, 0x0645, 0x062D, 0x0003, 0x0633,
+	0x0645, 0x062C, 0x0003, 0x0633, 0x0645, 0x0645, 0x0003, 0x0635,
+	0x062D, 0x062D, 0x0003, 0x0635, 0x0645, 0x0645, 0x0003, 0x0634,
+	0x062D, 0x0645, 0x0003, 0x0634, 0x062C, 0x064A, 0x0003, 0x0634,
+	0x0645, 0x062E, 0x0003, 0x0634, 0x0645, 0x0645, 0x0003, 0x0636,
+	0x062D, 0x0649, 0x0003, 0x0636, 0x062E, 0x0645, 0x0003, 0x0637,
+	0x0645, 0x062D, 0x0003, 0x0637, 0x0645, 0x0645, 0x0003, 0x0637,
+	0x0645, 0x064A, 0x0003, 0x0639, 0x062C, 0x0645, 0x0003, 0x0639,
+	0x0645, 0x0645, 0x0003, 0x0639, 0x0645, 0x0649, 0x0003, 0x063A,
+	0x0645, 0x0645, 0x0003, 0x063A, 0x0645, 0x064A, 0x0003, 0x063A,
+	0x0645, 0x0649, 0x0003, 0x0641, 0x062E, 0x0645, 0x0003, 0x0642,
+	0x0645, 0x062D, 0x0003, 0x0642, 0x0645, 0x0645, 0x0003, 0x0644,
+	0x062D, 0x0645, 0x0003, 0x0644, 0x062D, 0x064A, 0x0003, 0x0644,
+	0x062D, 0x0649, 0x0003, 0x0644, 0x062C, 0x062C, 0x0003, 0x0644,
+	0x062E, 0x0645, 0x0003, 0x0644, 0x0645, 0x062D, 0x0003, 0x0645,
+	0x062D, 0x062C, 0x0003, 0x0645, 0x062D, 0x0645, 0x0003, 0x0645,
+	0x062D, 0x064A, 0x0003, 0x0645, 0x062C, 0x062D, 0x0003, 0x0645,
+	0x062C, 0x0645, 0x0003, 0x0645, 0x062E, 0x062C, 0x0003, 0x0645,
+	0x062E, 0x0645, 0x0003, 0x0645, 0x062C, 0x062E, 0x0003, 0x0647,
+	0x0645, 0x062C, 0x0003, 0x0647, 0x0645, 0x0645, 0x0003, 0x0646,
+	0x062D, 0x0645, 0x0003, 0x0646, 0x062D, 0x0649, 0x0003, 0x0646,
+	0x062C, 0x0645, 0x0003, 0x0646, 0x062C, 0x0649, 0x0003, 0x0646,
+	0x0645, 0x064A, 0x0003, 0x0646, 0x0645, 0x0649, 0x0003, 0x064A,
+	0x0645, 0x0645, 0x0003, 0x0628, 0x062E, 0x064A, 0x0003, 0x062A,
+	0x062C, 0x064A, 0x0003, 0x062A, 0x062C, 0x0649, 0x0003, 0x062A,
+	0x062E, 0x064A, 0x0003, 0x062A, 0x062E, 0x0649, 0x0003, 0x062A,
+	0x0645, 0x064A, 0x0003, 0x062A, 0x0645, 0x0649, 0x0003, 0x062C,
+	0x0645, 0x064A, 0x0003, 0x062C, 0x062D, 0x0649, 0x0003, 0x062C,
+	0x0645, 0x0649, 0x0003, 0x0633, 0x062E, 0x0649, 0x0003, 0x0635,
+	0x062D, 0x064A, 0x0003, 0x0634, 0x062D, 0x064A, 0x0003, 0x0636,
+	0x062D, 0x064A, 0x0003, 0x0644, 0x062C, 0x064A, 0x0003, 0x0644,
+	0x0645, 0x064A, 0x0003, 0x064A, 0x062D, 0x064A, 0x0003, 0x064A,
+	0x062C, 0x064A, 0x0003, 0x064A, 0x0645, 0x064A, 0x0003, 0x0645,
+	0x0645, 0x064A, 0x0003, 0x0642, 0x0645, 0x064A, 0x0003, 0x0646,
+	0x062D, 0x064A, 0x0003, 0x0639, 0x0645, 0x064A, 0x0003, 0x0643,
+	0x0645, 0x064A, 0x0003, 0x0646, 0x062C, 0x062D, 0x0003, 0x0645,
+	0x062E, 0x064A, 0x0003, 0x0644, 0x062C, 0x0645, 0x0003, 0x0643,
+	0x0645, 0x0645, 0x0003, 0x062C, 0x062D, 0x064A, 0x0003, 0x062D,
+	0x062C, 0x064A, 0x0003, 0x0645, 0x062C, 0x064A, 0x0003, 0x0641,
+	0x0645, 0x064A, 0x0003, 0x0628, 0x062D, 0x064A, 0x0003, 0x0633,
+	0x062E, 0x064A, 0x0003, 0x0646, 0x062C, 0x064A, 0x0003, 0x0635,
+	0x0644, 0x06D2, 0x0003, 0x0642, 0x0644, 0x06D2, 0x0004, 0x0627,
+	0x0644, 0x0644, 0x0647, 0x0004, 0x0627, 0x0643, 0x0628, 0x0631,
+	0x0004, 0x0645, 0x062D, 0x0645, 0x062F, 0x0004, 0x0635, 0x0644,
+	0x0639, 0x0645, 0x0004, 0x0631, 0x0633, 0x0648, 0x0644, 0x0004,
+	0x0639, 0x0644, 0x064A, 0x0647, 0x0004, 0x0648, 0x0633, 0x0644,
+	0x0645, 0x0003, 0x0635, 0x0644, 0x0649, 0x0012, 0x0635, 0x0644,
+	0x0649, 0x0020, 0x0627, 0x0644, 0x0644, 0x0647, 0x0020, 0x0639,
+	0x0644, 0x064A, 0x0647, 0x0020, 0x0648, 0x0633, 0x0644, 0x0645,
+	0x0008, 0x062C, 0x0644, 0x0020, 0x062C, 0x0644, 0x0627, 0x0644,
+	0x0647, 0x0004, 0x0631, 0x06CC, 0x0627, 0x0644, 0x0001, 0x002C,
+	0x0001, 0x3001, 0x0001, 0x3002, 0x0001, 0x003A, 0x0001, 0x0021,
+	0x0001, 0x003F, 0x0001, 0x3016, 0x0001, 0x3017, 0x0001, 0x2014,
+	0x0001, 0x2013, 0x0001, 0x005F, 0x0001, 0x007B, 0x0001, 0x007D,
+	0x0001, 0x3014, 0x0001, 0x3015, 0x0001, 0x3010, 0x0001, 0x3011,
+	0x0001, 0x300A, 0x0001, 0x300B, 0x0001, 0x300C, 0x0001, 0x300D,
+	0x0001, 0x300E, 0x0001, 0x300F, 0x0001, 0x005B, 0x0001, 0x005D,
+	0x0001, 0x0023, 0x0001, 0x0026, 0x0001, 0x002A, 0x0001, 0x002D,
+	0x0001, 0x003C, 0x0001, 0x003E, 0x0001, 0x005C, 0x0001, 0x0024,
+	0x0001, 0x0025, 0x0001, 0x0040, 0x0002, 0x0020, 0x064B, 0x0002,
+	0x0640, 0x064B, 0x0002, 0x0020, 0x064C, 0x0002, 0x0020, 0x064D,
+	0x0002, 0x0020, 0x064E, 0x0002, 0x0640, 0x064E, 0x0002, 0x0020,
+	0x064F, 0x0002, 0x0640, 0x064F, 0x0002, 0x0020, 0x0650, 0x0002,
+	0x0640, 0x0650, 0x0002, 0x0020, 0x0651, 0x0002, 0x0640, 0x0651,
+	0x0002, 0x0020, 0x0652, 0x0002, 0x0640, 0x0652, 0x0001, 0x0621,
+	0x0001, 0x0627, 0x0001, 0x0628, 0x0001, 0x0629, 0x0001, 0x062A,
+	0x0001, 0x062B, 0x0001, 0x062C, 0x0001, 0x062D, 0x0001, 0x062E,
+	0x0001, 0x062F, 0x0001, 0x0630, 0x0001, 0x0631, 0x0001, 0x0632,
+	0x0001, 0x0633, 0x0001, 0x0634, 0x0001, 0x0635, 0x0001, 0x0636,
+	0x0001, 0x0637, 0x0001, 0x0638, 0x0001, 0x0639, 0x0001, 0x063A,
+	0x0001, 0x0641, 0x0001, 0x0642, 0x0001, 0x0643, 0x0001, 0x0644,
+	0x0001, 0x0645, 0x0001, 0x0646, 0x0001, 0x0647, 0x0001, 0x0648,
+	0x0001, 0x064A, 0x0003, 0x0644, 0x0627, 0x0653, 0x0003, 0x0644,
+	0x0627, 0x0654, 0x0003, 0x0644, 0x0627, 0x0655, 0x0002, 0x0644,
+	0x0627, 0x0001, 0x0022, 0x0001, 0x0027, 0x0001, 0x002F, 0x0001,
+	0x005E, 0x0001, 0x007C, 0x0001, 0x007E, 0x0001, 0x2985, 0x0001,
+	0x2986, 0x0001, 0x30FB, 0x0001, 0x30A1, 0x0001, 0x30A3, 0x0001,
+	0x30A5, 0x0001, 0x30A7, 0x0001, 0x30A9, 0x0001, 0x30E3, 0x0001,
+	0x30E5, 0x0001, 0x30E7, 0x0001, 0x30C3, 0x0001, 0x30FC, 0x0001,
+	0x30F3, 0x0001, 0x3099, 0x0001, 0x309A, 0x0001, 0x00A2, 0x0001,
+	0x00A3, 0x0001, 0x00AC, 0x0001, 0x00A6, 0x0001, 0x00A5, 0x0001,
+	0x20A9, 0x0001, 0x2502, 0x0001, 0x2190, 0x0001, 0x2191, 0x0001,
+	0x2192, 0x0001, 0x2193, 0x0001, 0x25A0, 0x0001, 0x25CB, 0x0001,
+	0x02D0, 0x0001, 0x02D1, 0x0001, 0x00E6, 0x0001, 0x0299, 0x0001,
+	0x0253, 0x0001, 0x02A3, 0x0001, 0xAB66, 0x0001, 0x02A5, 0x0001,
+	0x02A4, 0x0001, 0x0256, 0x0001, 0x0257, 0x0001, 0x1D91, 0x0001,
+	0x0258, 0x0001, 0x025E, 0x0001, 0x02A9, 0x0001, 0x0264, 0x0001,
+	0x0262, 0x0001, 0x0260, 0x0001, 0x029B, 0x0001, 0x029C, 0x0001,
+	0x0267, 0x0001, 0x0284, 0x0001, 0x02AA, 0x0001, 0x02AB, 0x0001,
+	0x026C, 0x0001, 0x1DF04, 0x0001, 0xA78E, 0x0001, 0x026E, 0x0001,
+	0x1DF05, 0x0001, 0x028E, 0x0001, 0x1DF06, 0x0001, 0x00F8, 0x0001,
+	0x0276, 0x0001, 0x0277, 0x0001, 0x027A, 0x0001, 0x1DF08, 0x0001,
+	0x027D, 0x0001, 0x027E, 0x0001, 0x0280, 0x0001, 0x02A8, 0x0001,
+	0x02A6, 0x0001, 0xAB67, 0x0001, 0x02A7, 0x0001, 0x0288, 0x0001,
+	0x2C71, 0x0001, 0x028F, 0x0001, 0x02A1, 0x0001, 0x02A2, 0x0001,
+	0x0298, 0x0001, 0x01C0, 0x0001, 0x01C1, 0x0001, 0x01C2, 0x0001,
+	0x1DF0A, 0x0001, 0x1DF1E, 0x0002, 0x11099, 0x110BA, 0x0002, 0x1109B,
+	0x110BA, 0x0002, 0x110A5, 0x110BA, 0x0002, 0x11131, 0x11127, 0x0002,
+	0x11132, 0x11127, 0x0002, 0x11347, 0x1133E, 0x0002, 0x11347, 0x11357,
+	0x0002, 0x114B9, 0x114BA, 0x0002, 0x114B9, 0x114B0, 0x0002, 0x114B9,
+	0x114BD, 0x0002, 0x115B8, 0x115AF, 0x0002, 0x115B9, 0x115AF, 0x0002,
+	0x11935, 0x11930, 0x0002, 0x1D157, 0x1D165, 0x0002, 0x1D158, 0x1D165,
+	0x0003, 0x1D158, 0x1D165, 0x1D16E, 0x0003, 0x1D158, 0x1D165, 0x1D16F,
+	0x0003, 0x1D158, 0x1D165, 0x1D170, 0x0003, 0x1D158, 0x1D165, 0x1D171,
+	0x0003, 0x1D158, 0x1D165, 0x1D172, 0x0002, 0x1D1B9, 0x1D165, 0x0002,
+	0x1D1BA, 0x1D165, 0x0003, 0x1D1B9, 0x1D165, 0x1D16E, 0x0003, 0x1D1BA,
+	0x1D165, 0x1D16E, 0x0003, 0x1D1B9, 0x1D165, 0x1D16F, 0x0003, 0x1D1BA,
+	0x1D165, 0x1D16F, 0x0001, 0x0131, 0x0001, 0x0237, 0x0001, 0x0391,
+	0x0001, 0x0392, 0x0001, 0x0394, 0x0001, 0x0395, 0x0001, 0x0396,
+	0x0001, 0x0397, 0x0001, 0x0399, 0x0001, 0x039A, 0x0001, 0x039B,
+	0x0001, 0x039C, 0x0001, 0x039D, 0x0001, 0x039E, 0x0001, 0x039F,
+	0x0001, 0x03A1, 0x0001, 0x03A4, 0x0001, 0x03A6, 0x0001, 0x03A7,
+	0x0001, 0x03A8, 0x0001, 0x2207, 0x0001, 0x03B1, 0x0001, 0x03B6,
+	0x0001, 0x03B7, 0x0001, 0x03BB, 0x0001, 0x03BD, 0x0001, 0x03BE,
+	0x0001, 0x03BF, 0x0001, 0x03C3, 0x0001, 0x03C4, 0x0001, 0x03C5,
+	0x0001, 0x03C8, 0x0001, 0x03C9, 0x0001, 0x2202, 0x0001, 0x03DC,
+	0x0001, 0x03DD, 0x0001, 0x0430, 0x0001, 0x0431, 0x0001, 0x0432,
+	0x0001, 0x0433, 0x0001, 0x0434, 0x0001, 0x0435, 0x0001, 0x0436,
+	0x0001, 0x0437, 0x0001, 0x0438, 0x0001, 0x043A, 0x0001, 0x043B,
+	0x0001, 0x043C, 0x0001, 0x043E, 0x0001, 0x043F, 0x0001, 0x0440,
+	0x0001, 0x0441, 0x0001, 0x0442, 0x0001, 0x0443, 0x0001, 0x0444,
+	0x0001, 0x0445, 0x0001, 0x0446, 0x0001, 0x0447, 0x0001, 0x0448,
+	0x0001, 0x044B, 0x0001, 0x044D, 0x0001, 0x044E, 0x0001, 0xA689,
+	0x0001, 0x04D9, 0x0001, 0x0456, 0x0001, 0x0458, 0x0001, 0x04E9,
+	0x0001, 0x04AF, 0x0001, 0x04CF, 0x0001, 0x0491, 0x0001, 0x0455,
+	0x0001, 0x045F, 0x0001, 0x04AB, 0x0001, 0xA651, 0x0001, 0x04B1,
+	0x0001, 0x066E, 0x0001, 0x06A1, 0x0001, 0x066F, 0x0002, 0x0030,
+	0x002E, 0x0002, 0x0030, 0x002C, 0x0002, 0x0031, 0x002C, 0x0002,
+	0x0032, 0x002C, 0x0002, 0x0033, 0x002C, 0x0002, 0x0034, 0x002C,
+	0x0002, 0x0035, 0x002C, 0x0002, 0x0036, 0x002C, 0x0002, 0x0037,
+	0x002C, 0x0002, 0x0038, 0x002C, 0x0002, 0x0039, 0x002C, 0x0003,
+	0x0028, 0x0041, 0x0029, 0x0003, 0x0028, 0x0042, 0x0029, 0x0003,
+	0x0028, 0x0043, 0x0029, 0x0003, 0x0028, 0x0044, 0x0029, 0x0003,
+	0x0028, 0x0045, 0x0029, 0x0003, 0x0028, 0x0046, 0x0029, 0x0003,
+	0x0028, 0x0047, 0x0029, 0x0003, 0x0028, 0x0048, 0x0029, 0x0003,
+	0x0028, 0x0049, 0x0029, 0x0003, 0x0028, 0x004A, 0x0029, 0x0003,
+	0x0028, 0x004B, 0x0029, 0x0003, 0x0028, 0x004C, 0x0029, 0x0003,
+	0x0028, 0x004D, 0x0029, 0x0003, 0x0028, 0x004E, 0x0029, 0x0003,
+	0x0028, 0x004F, 0x0029, 0x0003, 0x0028, 0x0050, 0x0029, 0x0003,
+	0x0028, 0x0051, 0x0029, 0x0003, 0x0028, 0x0052, 0x0029, 0x0003,
+	0x0028, 0x0053, 0x0029, 0x0003, 0x0028, 0x0054, 0x0029, 0x0003,
+	0x0028, 0x0055, 0x0029, 0x0003, 0x0028, 0x0056, 0x0029, 0x0003,
+	0x0028, 0x0057, 0x0029, 0x0003, 0x0028, 0x0058, 0x0029, 0x0003,
+	0x0028, 0x0059, 0x0029, 0x0003, 0x0028, 0x005A, 0x0029, 0x0003,
+	0x3014, 0x0053, 0x3015, 0x0002, 0x0043, 0x0044, 0x0002, 0x0057,
+	0x005A, 0x0002, 0x0048, 0x0056, 0x0002, 0x0053, 0x0044, 0x0002,
+	0x0053, 0x0053, 0x0003, 0x0050, 0x0050, 0x0056, 0x0002, 0x0057,
+	0x0043, 0x0002, 0x004D, 0x0043, 0x0002, 0x004D, 0x0044, 0x0002,
+	0x004D, 0x0052, 0x0002, 0x0044, 0x004A, 0x0002, 0x307B, 0x304B,
+	0x0002, 0x30B3, 0x30B3, 0x0001, 0x5B57, 0x0001, 0x53CC, 0x0001,
+	0x591A, 0x0001, 0x89E3, 0x0001, 0x4EA4, 0x0001, 0x6620, 0x0001,
+	0x7121, 0x0001, 0x524D, 0x0001, 0x5F8C, 0x0001, 0x518D, 0x0001,
+	0x65B0, 0x0001, 0x521D, 0x0001, 0x7D42, 0x0001, 0x8CA9, 0x0001,
+	0x58F0, 0x0001, 0x5439, 0x0001, 0x6F14, 0x0001, 0x6295, 0x0001,
+	0x6355, 0x0001, 0x904A, 0x0001, 0x6307, 0x0001, 0x6253, 0x0001,
+	0x7981, 0x0001, 0x7A7A, 0x0001, 0x5408, 0x0001, 0x6E80, 0x0001,
+	0x7533, 0x0001, 0x5272, 0x0001, 0x55B6, 0x0001, 0x914D, 0x0003,
+	0x3014, 0x672C, 0x3015, 0x0003, 0x3014, 0x4E09, 0x3015, 0x0003,
+	0x3014, 0x4E8C, 0x3015, 0x0003, 0x3014, 0x5B89, 0x3015, 0x0003,
+	0x3014, 0x70B9, 0x3015, 0x0003, 0x3014, 0x6253, 0x3015, 0x0003,
+	0x3014, 0x76D7, 0x3015, 0x0003, 0x3014, 0x52DD, 0x3015, 0x0003,
+	0x3014, 0x6557, 0x3015, 0x0001, 0x5F97, 0x0001, 0x53EF, 0x0001,
+	0x4E3D, 0x0001, 0x4E38, 0x0001, 0x4E41, 0x0001, 0x20122, 0x0001,
+	0x4F60, 0x0001, 0x4FBB, 0x0001, 0x5002, 0x0001, 0x507A, 0x0001,
+	0x5099, 0x0001, 0x50CF, 0x0001, 0x349E, 0x0001, 0x2063A, 0x0001,
+	0x5154, 0x0001, 0x5164, 0x0001, 0x5177, 0x0001, 0x2051C, 0x0001,
+	0x34B9, 0x0001, 0x5167, 0x0001, 0x2054B, 0x0001, 0x5197, 0x0001,
+	0x51A4, 0x0001, 0x4ECC, 0x0001, 0x51AC, 0x0001, 0x291DF, 0x0001,
+	0x5203, 0x0001, 0x34DF, 0x0001, 0x523B, 0x0001, 0x5246, 0x0001,
+	0x5277, 0x0001, 0x3515, 0x0001, 0x5305, 0x0001, 0x5306, 0x0001,
+	0x5349, 0x0001, 0x535A, 0x0001, 0x5373, 0x0001, 0x537D, 0x0001,
+	0x537F, 0x0001, 0x20A2C, 0x0001, 0x7070, 0x0001, 0x53CA, 0x0001,
+	0x53DF, 0x0001, 0x20B63, 0x0001, 0x53EB, 0x0001, 0x53F1, 0x0001,
+	0x5406, 0x0001, 0x549E, 0x0001, 0x5438, 0x0001, 0x5448, 0x0001,
+	0x5468, 0x0001, 0x54A2, 0x0001, 0x54F6, 0x0001, 0x5510, 0x0001,
+	0x5553, 0x0001, 0x5563, 0x0001, 0x5584, 0x0001, 0x55AB, 0x0001,
+	0x55B3, 0x0001, 0x55C2, 0x0001, 0x5716, 0x0001, 0x5717, 0x0001,
+	0x5651, 0x0001, 0x5674, 0x0001, 0x58EE, 0x0001, 0x57CE, 0x0001,
+	0x57F4, 0x0001, 0x580D, 0x0001, 0x578B, 0x0001, 0x5832, 0x0001,
+	0x5831, 0x0001, 0x58AC, 0x0001, 0x214E4, 0x0001, 0x58F2, 0x0001,
+	0x58F7, 0x0001, 0x5906, 0x0001, 0x5922, 0x0001, 0x5962, 0x0001,
+	0x216A8, 0x0001, 0x216EA, 0x0001, 0x59EC, 0x0001, 0x5A1B, 0x0001,
+	0x5A27, 0x0001, 0x59D8, 0x0001, 0x5A66, 0x0001, 0x36EE, 0x0001,
+	0x36FC, 0x0001, 0x5B08, 0x0001, 0x5B3E, 0x0001, 0x219C8, 0x0001,
+	0x5BC3, 0x0001, 0x5BD8, 0x0001, 0x5BF3, 0x0001, 0x21B18, 0x0001,
+	0x5BFF, 0x0001, 0x5C06, 0x0001, 0x5F53, 0x0001, 0x3781, 0x0001,
+	0x5C60, 0x0001, 0x5CC0, 0x0001, 0x5C8D, 0x0001, 0x21DE4, 0x0001,
+	0x5D43, 0x0001, 0x21DE6, 0x0001, 0x5D6E, 0x0001, 0x5D6B, 0x0001,
+	0x5D7C, 0x0001, 0x5DE1, 0x0001, 0x5DE2, 0x0001, 0x382F, 0x0001,
+	0x5DFD, 0x0001, 0x5E28, 0x0001, 0x5E3D, 0x0001, 0x5E69, 0x0001,
+	0x3862, 0x0001, 0x22183, 0x0001, 0x387C, 0x0001, 0x5EB0, 0x0001,
+	0x5EB3, 0x0001, 0x5EB6, 0x0001, 0x2A392, 0x0001, 0x22331, 0x0001,
+	0x8201, 0x0001, 0x5F22, 0x0001, 0x38C7, 0x0001, 0x232B8, 0x0001,
+	0x261DA, 0x0001, 0x5F62, 0x0001, 0x5F6B, 0x0001, 0x38E3, 0x0001,
+	0x5F9A, 0x0001, 0x5FCD, 0x0001, 0x5FD7, 0x0001, 0x5FF9, 0x0001,
+	0x6081, 0x0001, 0x393A, 0x0001, 0x391C, 0x0001, 0x226D4, 0x0001,
+	0x60C7, 0x0001, 0x6148, 0x0001, 0x614C, 0x0001, 0x617A, 0x0001,
+	0x61B2, 0x0001, 0x61A4, 0x0001, 0x61AF, 0x0001, 0x61DE, 0x0001,
+	0x6210, 0x0001, 0x621B, 0x0001, 0x625D, 0x0001, 0x62B1, 0x0001,
+	0x62D4, 0x0001, 0x6350, 0x0001, 0x22B0C, 0x0001, 0x633D, 0x0001,
+	0x62FC, 0x0001, 0x6368, 0x0001, 0x6383, 0x0001, 0x63E4, 0x0001,
+	0x22BF1, 0x0001, 0x6422, 0x0001, 0x63C5, 0x0001, 0x63A9, 0x0001,
+	0x3A2E, 0x0001, 0x6469, 0x0001, 0x647E, 0x0001, 0x649D, 0x0001,
+	0x6477, 0x0001, 0x3A6C, 0x0001, 0x656C, 0x0001, 0x2300A, 0x0001,
+	0x65E3, 0x0001, 0x66F8, 0x0001, 0x6649, 0x0001, 0x3B19, 0x0001,
+	0x3B08, 0x0001, 0x3AE4, 0x0001, 0x5192, 0x0001, 0x5195, 0x0001,
+	0x6700, 0x0001, 0x669C, 0x0001, 0x80AD, 0x0001, 0x43D9, 0x0001,
+	0x6721, 0x0001, 0x675E, 0x0001, 0x6753, 0x0001, 0x233C3, 0x0001,
+	0x3B49, 0x0001, 0x67FA, 0x0001, 0x6785, 0x0001, 0x6852, 0x0001,
+	0x2346D, 0x0001, 0x688E, 0x0001, 0x681F, 0x0001, 0x6914, 0x0001,
+	0x6942, 0x0001, 0x69A3, 0x0001, 0x69EA, 0x0001, 0x6AA8, 0x0001,
+	0x236A3, 0x0001, 0x6ADB, 0x0001, 0x3C18, 0x0001, 0x6B21, 0x0001,
+	0x238A7, 0x0001, 0x6B54, 0x0001, 0x3C4E, 0x0001, 0x6B72, 0x0001,
+	0x6B9F, 0x0001, 0x6BBB, 0x0001, 0x23A8D, 0x0001, 0x21D0B, 0x0001,
+	0x23AFA, 0x0001, 0x6C4E, 0x0001, 0x23CBC, 0x0001, 0x6CBF, 0x0001,
+	0x6CCD, 0x0001, 0x6C67, 0x0001, 0x6D16, 0x0001, 0x6D3E, 0x0001,
+	0x6D69, 0x0001, 0x6D78, 0x0001, 0x6D85, 0x0001, 0x23D1E, 0x0001,
+	0x6D34, 0x0001, 0x6E2F, 0x0001, 0x6E6E, 0x0001, 0x3D33, 0x0001,
+	0x6EC7, 0x0001, 0x23ED1, 0x0001, 0x6DF9, 0x0001, 0x6F6E, 0x0001,
+	0x23F5E, 0x0001, 0x23F8E, 0x0001, 0x6FC6, 0x0001, 0x7039, 0x0001,
+	0x701B, 0x0001, 0x3D96, 0x0001, 0x704A, 0x0001, 0x707D, 0x0001,
+	0x7077, 0x0001, 0x70AD, 0x0001, 0x20525, 0x0001, 0x7145, 0x0001,
+	0x24263, 0x0001, 0x719C, 0x0001, 0x243AB, 0x0001, 0x7228, 0x0001,
+	0x7250, 0x0001, 0x24608, 0x0001, 0x7280, 0x0001, 0x7295, 0x0001,
+	0x24735, 0x0001, 0x24814, 0x0001, 0x737A, 0x0001, 0x738B, 0x0001,
+	0x3EAC, 0x0001, 0x73A5, 0x0001, 0x3EB8, 0x0001, 0x7447, 0x0001,
+	0x745C, 0x0001, 0x7485, 0x0001, 0x74CA, 0x0001, 0x3F1B, 0x0001,
+	0x7524, 0x0001, 0x24C36, 0x0001, 0x753E, 0x0001, 0x24C92, 0x0001,
+	0x2219F, 0x0001, 0x7610, 0x0001, 0x24FA1, 0x0001, 0x24FB8, 0x0001,
+	0x25044, 0x0001, 0x3FFC, 0x0001, 0x4008, 0x0001, 0x250F3, 0x0001,
+	0x250F2, 0x0001, 0x25119, 0x0001, 0x25133, 0x0001, 0x771E, 0x0001,
+	0x771F, 0x0001, 0x778B, 0x0001, 0x4046, 0x0001, 0x4096, 0x0001,
+	0x2541D, 0x0001, 0x784E, 0x0001, 0x40E3, 0x0001, 0x25626, 0x0001,
+	0x2569A, 0x0001, 0x256C5, 0x0001, 0x79EB, 0x0001, 0x412F, 0x0001,
+	0x7A4A, 0x0001, 0x7A4F, 0x0001, 0x2597C, 0x0001, 0x25AA7, 0x0001,
+	0x7AEE, 0x0001, 0x4202, 0x0001, 0x25BAB, 0x0001, 0x7BC6, 0x0001,
+	0x7BC9, 0x0001, 0x4227, 0x0001, 0x25C80, 0x0001, 0x7CD2, 0x0001,
+	0x42A0, 0x0001, 0x7CE8, 0x0001, 0x7CE3, 0x0001, 0x7D00, 0x0001,
+	0x25F86, 0x0001, 0x7D63, 0x0001, 0x4301, 0x0001, 0x7DC7, 0x0001,
+	0x7E02, 0x0001, 0x7E45, 0x0001, 0x4334, 0x0001, 0x26228, 0x0001,
+	0x26247, 0x0001, 0x4359, 0x0001, 0x262D9, 0x0001, 0x7F7A, 0x0001,
+	0x2633E, 0x0001, 0x7F95, 0x0001, 0x7FFA, 0x0001, 0x264DA, 0x0001,
+	0x26523, 0x0001, 0x8060, 0x0001, 0x265A8, 0x0001, 0x8070, 0x0001,
+	0x2335F, 0x0001, 0x43D5, 0x0001, 0x80B2, 0x0001, 0x8103, 0x0001,
+	0x440B, 0x0001, 0x813E, 0x0001, 0x5AB5, 0x0001, 0x267A7, 0x0001,
+	0x267B5, 0x0001, 0x23393, 0x0001, 0x2339C, 0x0001, 0x8204, 0x0001,
+	0x8F9E, 0x0001, 0x446B, 0x0001, 0x8291, 0x0001, 0x828B, 0x0001,
+	0x829D, 0x0001, 0x52B3, 0x0001, 0x82B1, 0x0001, 0x82B3, 0x0001,
+	0x82BD, 0x0001, 0x82E6, 0x0001, 0x26B3C, 0x0001, 0x831D, 0x0001,
+	0x8363, 0x0001, 0x83AD, 0x0001, 0x8323, 0x0001, 0x83BD, 0x0001,
+	0x83E7, 0x0001, 0x8353, 0x0001, 0x83CA, 0x0001, 0x83CC, 0x0001,
+	0x83DC, 0x0001, 0x26C36, 0x0001, 0x26D6B, 0x0001, 0x26CD5, 0x0001,
+	0x452B, 0x0001, 0x84F1, 0x0001, 0x84F3, 0x0001, 0x8516, 0x0001,
+	0x273CA, 0x0001, 0x8564, 0x0001, 0x26F2C, 0x0001, 0x455D, 0x0001,
+	0x4561, 0x0001, 0x26FB1, 0x0001, 0x270D2, 0x0001, 0x456B, 0x0001,
+	0x8650, 0x0001, 0x8667, 0x0001, 0x8669, 0x0001, 0x86A9, 0x0001,
+	0x8688, 0x0001, 0x870E, 0x0001, 0x86E2, 0x0001, 0x8728, 0x0001,
+	0x876B, 0x0001, 0x8786, 0x0001, 0x45D7, 0x0001, 0x87E1, 0x0001,
+	0x8801, 0x0001, 0x45F9, 0x0001, 0x8860, 0x0001, 0x27667, 0x0001,
+	0x88D7, 0x0001, 0x88DE, 0x0001, 0x4635, 0x0001, 0x88FA, 0x0001,
+	0x34BB, 0x0001, 0x278AE, 0x0001, 0x27966, 0x0001, 0x46BE, 0x0001,
+	0x46C7, 0x0001, 0x8AA0, 0x0001, 0x27CA8, 0x0001, 0x8CAB, 0x0001,
+	0x8CC1, 0x0001, 0x8D1B, 0x0001, 0x8D77, 0x0001, 0x27F2F, 0x0001,
+	0x20804, 0x0001, 0x8DCB, 0x0001, 0x8DBC, 0x0001, 0x8DF0, 0x0001,
+	0x208DE, 0x0001, 0x8ED4, 0x0001, 0x285D2, 0x0001, 0x285ED, 0x0001,
+	0x9094, 0x0001, 0x90F1, 0x0001, 0x9111, 0x0001, 0x2872E, 0x0001,
+	0x911B, 0x0001, 0x9238, 0x0001, 0x92D7, 0x0001, 0x92D8, 0x0001,
+	0x927C, 0x0001, 0x93F9, 0x0001, 0x9415, 0x0001, 0x28BFA, 0x0001,
+	0x958B, 0x0001, 0x4995, 0x0001, 0x95B7, 0x0001, 0x28D77, 0x0001,
+	0x49E6, 0x0001, 0x96C3, 0x0001, 0x5DB2, 0x0001, 0x9723, 0x0001,
+	0x29145, 0x0001, 0x2921A, 0x0001, 0x4A6E, 0x0001, 0x4A76, 0x0001,
+	0x97E0, 0x0001, 0x2940A, 0x0001, 0x4AB2, 0x0001, 0x29496, 0x0001,
+	0x9829, 0x0001, 0x295B6, 0x0001, 0x98E2, 0x0001, 0x4B33, 0x0001,
+	0x9929, 0x0001, 0x99A7, 0x0001, 0x99C2, 0x0001, 0x99FE, 0x0001,
+	0x4BCE, 0x0001, 0x29B30, 0x0001, 0x9C40, 0x0001, 0x9CFD, 0x0001,
+	0x4CCE, 0x0001, 0x4CED, 0x0001, 0x9D67, 0x0001, 0x2A0CE, 0x0001,
+	0x4CF8, 0x0001, 0x2A105, 0x0001, 0x2A20E, 0x0001, 0x2A291, 0x0001,
+	0x4D56, 0x0001, 0x9EFE, 0x0001, 0x9F05, 0x0001, 0x9F0F, 0x0001,
+	0x9F16, 0x0001, 0x2A600,
+}
+
+// compositions maps pairs of runes to their primary composites. The key
+// for a pair (a, b) is a<<32 | b.
+var compositions = map[uint64]rune{
+	0x0000003C00000338: 0x226E,
+	0x0000003D00000338: 0x2260,
+	0x0000003E00000338: 0x226F,
+	0x0000004100000300: 0x00C0,
+	0x0000004100000301: 0x00C1,
+	0x0000004100000302: 0x00C2,
+	0x0000004100000303: 0x00C3,
+	0x0000004100000304: 0x0100,
+	0x0000004100000306: 0x0102,
+	0x0000004100000307: 0x0226,
+	0x0000004100000308: 0x00C4,
+	0x0000004100000309: 0x1EA2,
+	0x000000410000030A: 0x00C5,
+	0x000000410000030C: 0x01CD,
+	0x000000410000030F: 0x0200,
+	0x0000004100000311: 0x0202,
+	0x0000004100000323: 0x1EA0,
+	0x0000004100000325: 0x1E00,
+	0x0000004100000328: 0x0104,
+	0x0000004200000307: 0x1E02,
+	0x0000004200000323: 0x1E04,
+	0x0000004200000331: 0x1E06,
+	0x0000004300000301: 0x0106,
+	0x0000004300000302: 0x0108,
+	0x0000004300000307: 0x010A,
+	0x000000430000030C: 0x010C,
+	0x0000004300000327: 0x00C7,
+	0x0000004400000307: 0x1E0A,
+	0x000000440000030C: 0x010E,
+	0x0000004400000323: 0x1E0C,
+	0x0000004400000327: 0x1E10,
+	0x000000440000032D: 0x1E12,
+	0x0000004400000331: 0x1E0E,
+	0x0000004500000300: 0x00C8,
+	0x0000004500000301: 0x00C9,
+	0x0000004500000302: 0x00CA,
+	0x0000004500000303: 0x1EBC,
+	0x0000004500000304: 0x0112,
+	0x0000004500000306: 0x0114,
+	0x0000004500000307: 0x0116,
+	0x0000004500000308: 0x00CB,
+	0x0000004500000309: 0x1EBA,
+	0x000000450000030C: 0x011A,
+	0x000000450000030F: 0x0204,
+	0x0000004500000311: 0x0206,
+	0x0000004500000323: 0x1EB8,
+	0x0000004500000327: 0x0228,
+	0x0000004500000328: 0x0118,
+	0x000000450000032D: 0x1E18,
+	0x0000004500000330: 0x1E1A,
+	0x0000004600000307: 0x1E1E,
+	0x0000004700000301: 0x01F4,
+	0x0000004700000302: 0x011C,
+	0x0000004700000304: 0x1E20,
+	0x0000004700000306: 0x011E,
+	0x0000004700000307: 0x0120,
+	0x000000470000030C: 0x01E6,
+	0x0000004700000327: 0x0122,
+	0x0000004800000302: 0x0124,
+	0x0000004800000307: 0x1E22,
+	0x0000004800000308: 0x1E26,
+	0x000000480000030C: 0x021E,
+	0x0000004800000323: 0x1E24,
+	0x0000004800000327: 0x1E28,
+	0x000000480000032E: 0x1E2A,
+	0x0000004900000300: 0x00CC,
+	0x0000004900000301: 0x00CD,
+	0x0000004900000302: 0x00CE,
+	0x0000004900000303: 0x0128,
+	0x0000004900000304: 0x012A,
+	0x0000004900000306: 0x012C,
+	0x0000004900000307: 0x0130,
+	0x0000004900000308: 0x00CF,
+	0x0000004900000309: 0x1EC8,
+	0x000000490000030C: 0x01CF,
+	0x000000490000030F: 0x0208,
+	0x0000004900000311: 0x020A,
+	0x0000004900000323: 0x1ECA,
+	0x0000004900000328: 0x012E,
+	0x0000004900000330: 0x1E2C,
+	0x0000004A00000302: 0x0134,
+	0x0000004B00000301: 0x1E30,
+	0x0000004B0000030C: 0x01E8,
+	0x0000004B00000323: 0x1E32,
+	0x0000004B00000327: 0x0136,
+	0x0000004B00000331: 0x1E34,
+	0x0000004C00000301: 0x0139,
+	0x0000004C0000030C: 0x013D,
+	0x0000004C00000323: 0x1E36,
+	0x0000004C00000327: 0x013B,
+	0x0000004C0000032D: 0x1E3C,
+	0x0000004C00000331: 0x1E3A,
+	0x0000004D00000301: 0x1E3E,
+	0x0000004D00000307: 0x1E40,
+	0x0000004D00000323: 0x1E42,
+	0x0000004E00000300: 0x01F8,
+	0x0000004E00000301: 0x0143,
+	0x0000004E00000303: 0x00D1,
+	0x0000004E00000307: 0x1E44,
+	0x0000004E0000030C: 0x0147,
+	0x0000004E00000323: 0x1E46,
+	0x0000004E00000327: 0x0145,
+	0x0000004E0000032D: 0x1E4A,
+	0x0000004E00000331: 0x1E48,
+	0x0000004F00000300: 0x00D2,
+	0x0000004F00000301: 0x00D3,
+	0x0000004F00000302: 0x00D4,
+	0x0000004F00000303: 0x00D5,
+	0x0000004F00000304: 0x014C,
+	0x0000004F00000306: 0x014E,
+	0x0000004F00000307: 0x022E,
+	0x0000004F00000308: 0x00D6,
+	0x0000004F00000309: 0x1ECE,
+	0x0000004F0000030B: 0x0150,
+	0x0000004F0000030C: 0x01D1,
+	0x0000004F0000030F: 0x020C,
+	0x0000004F00000311: 0x020E,
+	0x0000004F0000031B: 0x01A0,
+	0x0000004F00000323: 0x1ECC,
+	0x0000004F00000328: 0x01EA,
+	0x0000005000000301: 0x1E54,
+	0x0000005000000307: 0x1E56,
+	0x0000005200000301: 0x0154,
+	0x0000005200000307: 0x1E58,
+	0x000000520000030C: 0x0158,
+	0x000000520000030F: 0x0210,
+	0x0000005200000311: 0x0212,
+	0x0000005200000323: 0x1E5A,
+	0x0000005200000327: 0x0156,
+	0x0000005200000331: 0x1E5E,
+	0x0000005300000301: 0x015A,
+	0x0000005300000302: 0x015C,
+	0x0000005300000307: 0x1E60,
+	0x000000530000030C: 0x0160,
+	0x0000005300000323: 0x1E62,
+	0x0000005300000326: 0x0218,
+	0x0000005300000327: 0x015E,
+	0x0000005400000307: 0x1E6A,
+	0x000000540000030C: 0x0164,
+	0x0000005400000323: 0x1E6C,
+	0x0000005400000326: 0x021A,
+	0x0000005400000327: 0x0162,
+	0x000000540000032D: 0x1E70,
+	0x0000005400000331: 0x1E6E,
+	0x0000005500000300: 0x00D9,
+	0x0000005500000301: 0x00DA,
+	0x0000005500000302: 0x00DB,
+	0x0000005500000303: 0x0168,
+	0x0000005500000304: 0x016A,
+	0x0000005500000306: 0x016C,
+	0x0000005500000308: 0x00DC,
+	0x0000005500000309: 0x1EE6,
+	0x000000550000030A: 0x016E,
+	0x000000550000030B: 0x0170,
+	0x000000550000030C: 0x01D3,
+	0x000000550000030F: 0x0214,
+	0x0000005500000311: 0x0216,
+	0x000000550000031B: 0x01AF,
+	0x0000005500000323: 0x1EE4,
+	0x0000005500000324: 0x1E72,
+	0x0000005500000328: 0x0172,
+	0x000000550000032D: 0x1E76,
+	0x0000005500000330: 0x1E74,
+	0x0000005600000303: 0x1E7C,
+	0x0000005600000323: 0x1E7E,
+	0x0000005700000300: 0x1E80,
+	0x0000005700000301: 0x1E82,
+	0x0000005700000302: 0x0174,
+	0x0000005700000307: 0x1E86,
+	0x0000005700000308: 0x1E84,
+	0x0000005700000323: 0x1E88,
+	0x0000005800000307: 0x1E8A,
+	0x0000005800000308: 0x1E8C,
+	0x0000005900000300: 0x1EF2,
+	0x0000005900000301: 0x00DD,
+	0x0000005900000302: 0x0176,
+	0x0000005900000303: 0x1EF8,
+	0x0000005900000304: 0x0232,
+	0x0000005900000307: 0x1E8E,
+	0x0000005900000308: 0x0178,
+	0x0000005900000309: 0x1EF6,
+	0x0000005900000323: 0x1EF4,
+	0x0000005A00000301: 0x0179,
+	0x0000005A00000302: 0x1E90,
+	0x0000005A00000307: 0x017B,
+	0x0000005A0000030C: 0x017D,
+	0x0000005A00000323: 0x1E92,
+	0x0000005A00000331: 0x1E94,
+	0x0000006100000300: 0x00E0,
+	0x0000006100000301: 0x00E1,
+	0x0000006100000302: 0x00E2,
+	0x0000006100000303: 0x00E3,
+	0x0000006100000304: 0x0101,
+	0x0000006100000306: 0x0103,
+	0x0000006100000307: 0x0227,
+	0x0000006100000308: 0x00E4,
+	0x0000006100000309: 0x1EA3,
+	0x000000610000030A: 0x00E5,
+	0x000000610000030C: 0x01CE,
+	0x000000610000030F: 0x0201,
+	0x0000006100000311: 0x0203,
+	0x0000006100000323: 0x1EA1,
+	0x0000006100000325: 0x1E01,
+	0x0000006100000328: 0x0105,
+	0x0000006200000307: 0x1E03,
+	0x0000006200000323: 0x1E05,
+	0x0000006200000331: 0x1E07,
+	0x0000006300000301: 0x0107,
+	0x0000006300000302: 0x0109,
+	0x0000006300000307: 0x010B,
+	0x000000630000030C: 0x010D,
+	0x0000006300000327: 0x00E7,
+	0x0000006400000307: 0x1E0B,
+	0x000000640000030C: 0x010F,
+	0x0000006400000323: 0x1E0D,
+	0x0000006400000327: 0x1E11,
+	0x000000640000032D: 0x1E13,
+	0x0000006400000331: 0x1E0F,
+	0x0000006500000300: 0x00E8,
+	0x0000006500000301: 0x00E9,
+	0x0000006500000302: 0x00EA,
+	0x0000006500000303: 0x1EBD,
+	0x0000006500000304: 0x0113,
+	0x0000006500000306: 0x0115,
+	0x0000006500000307: 0x0117,
+	0x0000006500000308: 0x00EB,
+	0x0000006500000309: 0x1EBB,
+	0x000000650000030C: 0x011B,
+	0x000000650000030F: 0x0205,
+	0x0000006500000311: 0x0207,
+	0x0000006500000323: 0x1EB9,
+	0x0000006500000327: 0x0229,
+	0x0000006500000328: 0x0119,
+	0x000000650000032D: 0x1E19,
+	0x0000006500000330: 0x1E1B,
+	0x0000006600000307: 0x1E1F,
+	0x0000006700000301: 0x01F5,
+	0x0000006700000302: 0x011D,
+	0x0000006700000304: 0x1E21,
+	0x0000006700000306: 0x011F,
+	0x0000006700000307: 0x0121,
+	0x000000670000030C: 0x01E7,
+	0x0000006700000327: 0x0123,
+	0x0000006800000302: 0x0125,
+	0x0000006800000307: 0x1E23,
+	0x0000006800000308: 0x1E27,
+	0x000000680000030C: 0x021F,
+	0x0000006800000323: 0x1E25,
+	0x0000006800000327: 0x1E29,
+	0x000000680000032E: 0x1E2B,
+	0x0000006800000331: 0x1E96,
+	0x0000006900000300: 0x00EC,
+	0x0000006900000301: 0x00ED,
+	0x0000006900000302: 0x00EE,
+	0x0000006900000303: 0x0129,
+	0x0000006900000304: 0x012B,
+	0x0000006900000306: 0x012D,
+	0x0000006900000308: 0x00EF,
+	0x0000006900000309: 0x1EC9,
+	0x000000690000030C: 0x01D0,
+	0x000000690000030F: 0x0209,
+	0x0000006900000311: 0x020B,
+	0x0000006900000323: 0x1ECB,
+	0x0000006900000328: 0x012F,
+	0x0000006900000330: 0x1E2D,
+	0x0000006A00000302: 0x0135,
+	0x0000006A0000030C: 0x01F0,
+	0x0000006B00000301: 0x1E31,
+	0x0000006B0000030C: 0x01E9,
+	0x0000006B00000323: 0x1E33,
+	0x0000006B00000327: 0x0137,
+	0x0000006B00000331: 0x1E35,
+	0x0000006C00000301: 0x013A,
+	0x0000006C0000030C: 0x013E,
+	0x0000006C00000323: 0x1E37,
+	0x0000006C00000327: 0x013C,
+	0x0000006C0000032D: 0x1E3D,
+	0x0000006C00000331: 0x1E3B,
+	0x0000006D00000301: 0x1E3F,
+	0x0000006D00000307: 0x1E41,
+	0x0000006D00000323: 0x1E43,
+	0x0000006E00000300: 0x01F9,
+	0x0000006E00000301: 0x0144,
+	0x0000006E00000303: 0x00F1,
+	0x0000006E00000307: 0x1E45,
+	0x0000006E0000030C: 0x0148,
+	0x0000006E00000323: 0x1E47,
+	0x0000006E00000327: 0x0146,
+	0x0000006E0000032D: 0x1E4B,
+	0x0000006E00000331: 0x1E49,
+	0x0000006F00000300: 0x00F2,
+	0x0000006F00000301: 0x00F3,
+	0x0000006F00000302: 0x00F4,
+	0x0000006F00000303: 0x00F5,
+	0x0000006F00000304: 0x014D,
+	0x0000006F00000306: 0x014F,
+	0x0000006F00000307: 0x022F,
+	0x0000006F00000308: 0x00F6,
+	0x0000006F00000309: 0x1ECF,
+	0x0000006F0000030B: 0x0151,
+	0x0000006F0000030C: 0x01D2,
+	0x0000006F0000030F: 0x020D,
+	0x0000006F00000311: 0x020F,
+	0x0000006F0000031B: 0x01A1,
+	0x0000006F00000323: 0x1ECD,
+	0x0000006F00000328: 0x01EB,
+	0x0000007000000301: 0x1E55,
+	0x0000007000000307: 0x1E57,
+	0x0000007200000301: 0x0155,
+	0x0000007200000307: 0x1E59,
+	0x000000720000030C: 0x0159,
+	0x000000720000030F: 0x0211,
+	0x0000007200000311: 0x0213,
+	0x0000007200000323: 0x1E5B,
+	0x0000007200000327: 0x0157,
+	0x0000007200000331: 0x1E5F,
+	0x0000007300000301: 0x015B,
+	0x0000007300000302: 0x015D,
+	0x0000007300000307: 0x1E61,
+	0x000000730000030C: 0x0161,
+	0x0000007300000323: 0x1E63,
+	0x0000007300000326: 0x0219,
+	0x0000007300000327: 0x015F,
+	0x0000007400000307: 0x1E6B,
+	0x0000007400000308: 0x1E97,
+	0x000000740000030C: 0x0165,
+	0x0000007400000323: 0x1E6D,
+	0x0000007400000326: 0x021B,
+	0x0000007400000327: 0x0163,
+	0x000000740000032D: 0x1E71,
+	0x0000007400000331: 0x1E6F,
+	0x0000007500000300: 0x00F9,
+	0x0000007500000301: 0x00FA,
+	0x0000007500000302: 0x00FB,
+	0x0000007500000303: 0x0169,
+	0x0000007500000304: 0x016B,
+	0x0000007500000306: 0x016D,
+	0x0000007500000308: 0x00FC,
+	0x0000007500000309: 0x1EE7,
+	0x000000750000030A: 0x016F,
+	0x000000750000030B: 0x0171,
+	0x000000750000030C: 0x01D4,
+	0x000000750000030F: 0x0215,
+	0x0000007500000311: 0x0217,
+	0x000000750000031B: 0x01B0,
+	0x0000007500000323: 0x1EE5,
+	0x0000007500000324: 0x1E73,
+	0x0000007500000328: 0x0173,
+	0x000000750000032D: 0x1E77,
+	0x0000007500000330: 0x1E75,
+	0x0000007600000303: 0x1E7D,
+	0x0000007600000323: 0x1E7F,
+	0x0000007700000300: 0x1E81,
+	0x0000007700000301: 0x1E83,
+	0x0000007700000302: 0x0175,
+	0x0000007700000307: 0x1E87,
+	0x0000007700000308: 0x1E85,
+	0x000000770000030A: 0x1E98,
+	0x0000007700000323: 0x1E89,
+	0x0000007800000307: 0x1E8B,
+	0x0000007800000308: 0x1E8D,
+	0x0000007900000300: 0x1EF3,
+	0x0000007900000301: 0x00FD,
+	0x0000007900000302: 0x0177,
+	0x0000007900000303: 0x1EF9,
+	0x0000007900000304: 0x0233,
+	0x0000007900000307: 0x1E8F,
+	0x0000007900000308: 0x00FF,
+	0x0000007900000309: 0x1EF7,
+	0x000000790000030A: 0x1E99,
+	0x0000007900000323: 0x1EF5,
+	0x0000007A00000301: 0x017A,
+	0x0000007A00000302: 0x1E91,
+	0x0000007A00000307: 0x017C,
+	0x0000007A0000030C: 0x017E,
+	0x0000007A00000323: 0x1E93,
+	0x0000007A00000331: 0x1E95,
+	0x000000A800000300: 0x1FED,
+	0x000000A800000301: 0x0385,
+	0x000000A800000342: 0x1FC1,
+	0x000000C200000300: 0x1EA6,
+	0x000000C200000301: 0x1EA4,
+	0x000000C200000303: 0x1EAA,
+	0x000000C200000309: 0x1EA8,
+	0x000000C400000304: 0x01DE,
+	0x000000C500000301: 0x01FA,
+	0x000000C600000301: 0x01FC,
+	0x000000C600000304: 0x01E2,
+	0x000000C700000301: 0x1E08,
+	0x000000CA00000300: 0x1EC0,
+	0x000000CA00000301: 0x1EBE,
+	0x000000CA00000303: 0x1EC4,
+	0x000000CA00000309: 0x1EC2,
+	0x000000CF00000301: 0x1E2E,
+	0x000000D400000300: 0x1ED2,
+	0x000000D400000301: 0x1ED0,
+	0x000000D400000303: 0x1ED6,
+	0x000000D400000309: 0x1ED4,
+	0x000000D500000301: 0x1E4C,
+	0x000000D500000304: 0x022C,
+	0x000000D500000308: 0x1E4E,
+	0x000000D600000304: 0x022A,
+	0x000000D800000301: 0x01FE,
+	0x000000DC00000300: 0x01DB,
+	0x000000DC00000301: 0x01D7,
+	0x000000DC00000304: 0x01D5,
+	0x000000DC0000030C: 0x01D9,
+	0x000000E200000300: 0x1EA7,
+	0x000000E200000301: 0x1EA5,
+	0x000000E200000303: 0x1EAB,
+	0x000000E200000309: 0x1EA9,
+	0x000000E400000304: 0x01DF,
+	0x000000E500000301: 0x01FB,
+	0x000000E600000301: 0x01FD,
+	0x000000E600000304: 0x01E3,
+	0x000000E700000301: 0x1E09,
+	0x000000EA00000300: 0x1EC1,
+	0x000000EA00000301: 0x1EBF,
+	0x000000EA00000303: 0x1EC5,
+	0x000000EA00000309: 0x1EC3,
+	0x000000EF00000301: 0x1E2F,
+	0x000000F400000300: 0x1ED3,
+	0x000000F400000301: 0x1ED1,
+	0x000000F400000303: 0x1ED7,
+	0x000000F400000309: 0x1ED5,
+	0x000000F500000301: 0x1E4D,
+	0x000000F500000304: 0x022D,
+	0x000000F500000308: 0x1E4F,
+	0x000000F600000304: 0x022B,
+	0x000000F800000301: 0x01FF,
+	0x000000FC00000300: 0x01DC,
+	0x000000FC00000301: 0x01D8,
+	0x000000FC00000304: 0x01D6,
+	0x000000FC0000030C: 0x01DA,
+	0x0000010200000300: 0x1EB0,
+	0x0000010200000301: 0x1EAE,
+	0x0000010200000303: 0x1EB4,
+	0x0000010200000309: 0x1EB2,
+	0x0000010300000300: 0x1EB1,
+	0x0000010300000301: 0x1EAF,
+	0x0000010300000303: 0x1EB5,
+	0x0000010300000309: 0x1EB3,
+	0x0000011200000300: 0x1E14,
+	0x0000011200000301: 0x1E16,
+	0x0000011300000300: 0x1E15,
+	0x0000011300000301: 0x1E17,
+	0x0000014C00000300: 0x1E50,
+	0x0000014C00000301: 0x1E52,
+	0x0000014D00000300: 0x1E51,
+	0x0000014D00000301: 0x1E53,
+	0x0000015A00000307: 0x1E64,
+	0x0000015B00000307: 0x1E65,
+	0x0000016000000307: 0x1E66,
+	0x0000016100000307: 0x1E67,
+	0x0000016800000301: 0x1E78,
+	0x0000016900000301: 0x1E79,
+	0x0000016A00000308: 0x1E7A,
+	0x0000016B00000308: 0x1E7B,
+	0x0000017F00000307: 0x1E9B,
+	0x000001A000000300: 0x1EDC,
+	0x000001A000000301: 0x1EDA,
+	0x000001A000000303: 0x1EE0,
+	0x000001A000000309: 0x1EDE,
+	0x000001A000000323: 0x1EE2,
+	0x000001A100000300: 0x1EDD,
+	0x000001A100000301: 0x1EDB,
+	0x000001A100000303: 0x1EE1,
+	0x000001A100000309: 0x1EDF,
+	0x000001A100000323: 0x1EE3,
+	0x000001AF00000300: 0x1EEA,
+	0x000001AF00000301: 0x1EE8,
+	0x000001AF00000303: 0x1EEE,
+	0x000001AF00000309: 0x1EEC,
+	0x000001AF00000323: 0x1EF0,
+	0x000001B000000300: 0x1EEB,
+	0x000001B000000301: 0x1EE9,
+	0x000001B000000303: 0x1EEF,
+	0x000001B000000309: 0x1EED,
+	0x000001B000000323: 0x1EF1,
+	0x000001B70000030C: 0x01EE,
+	0x000001EA00000304: 0x01EC,
+	0x000001EB00000304: 0x01ED,
+	0x0000022600000304: 0x01E0,
+	0x0000022700000304: 0x01E1,
+	0x0000022800000306: 0x1E1C,
+	0x0000022900000306: 0x1E1D,
+	0x0000022E00000304: 0x0230,
+	0x0000022F00000304: 0x0231,
+	0x000002920000030C: 0x01EF,
+	0x0000039100000300: 0x1FBA,
+	0x0000039100000301: 0x0386,
+	0x0000039100000304: 0x1FB9,
+	0x0000039100000306: 0x1FB8,
+	0x0000039100000313: 0x1F08,
+	0x0000039100000314: 0x1F09,
+	0x0000039100000345: 0x1FBC,
+	0x0000039500000300: 0x1FC8,
+	0x0000039500000301: 0x0388,
+	0x0000039500000313: 0x1F18,
+	0x0000039500000314: 0x1F19,
+	0x0000039700000300: 0x1FCA,
+	0x0000039700000301: 0x0389,
+	0x0000039700000313: 0x1F28,
+	0x0000039700000314: 0x1F29,
+	0x0000039700000345: 0x1FCC,
+	0x0000039900000300: 0x1FDA,
+	0x0000039900000301: 0x038A,
+	0x0000039900000304: 0x1FD9,
+	0x0000039900000306: 0x1FD8,
+	0x0000039900000308: 0x03AA,
+	0x0000039900000313: 0x1F38,
+	0x0000039900000314: 0x1F39,
+	0x0000039F00000300: 0x1FF8,
+	0x0000039F00000301: 0x038C,
+	0x0000039F00000313: 0x1F48,
+	0x0000039F00000314: 0x1F49,
+	0x000003A100000314: 0x1FEC,
+	0x000003A500000300: 0x1FEA,
+	0x000003A500000301: 0x038E,
+	0x000003A500000304: 0x1FE9,
+	0x000003A500000306: 0x1FE8,
+	0x000003A500000308: 0x03AB,
+	0x000003A500000314: 0x1F59,
+	0x000003A900000300: 0x1FFA,
+	0x000003A900000301: 0x038F,
+	0x000003A900000313: 0x1F68,
+	0x000003A900000314: 0x1F69,
+	0x000003A900000345: 0x1FFC,
+	0x000003AC00000345: 0x1FB4,
+	0x000003AE00000345: 0x1FC4,
+	0x000003B100000300: 0x1F70,
+	0x000003B100000301: 0x03AC,
+	0x000003B100000304: 0x1FB1,
+	0x000003B100000306: 0x1FB0,
+	0x000003B100000313: 0x1F00,
+	0x000003B100000314: 0x1F01,
+	0x000003B100000342: 0x1FB6,
+	0x000003B100000345: 0x1FB3,
+	0x000003B500000300: 0x1F72,
+	0x000003B500000301: 0x03AD,
+	0x000003B500000313: 0x1F10,
+	0x000003B500000314: 0x1F11,
+	0x000003B700000300: 0x1F74,
+	0x000003B700000301: 0x03AE,
+	0x000003B700000313: 0x1F20,
+	0x000003B700000314: 0x1F21,
+	0x000003B700000342: 0x1FC6,
+	0x000003B700000345: 0x1FC3,
+	0x000003B900000300: 0x1F76,
+	0x000003B900000301: 0x03AF,
+	0x000003B900000304: 0x1FD1,
+	0x000003B900000306: 0x1FD0,
+	0x000003B900000308: 0x03CA,
+	0x000003B900000313: 0x1F30,
+	0x000003B900000314: 0x1F31,
+	0x000003B900000342: 0x1FD6,
+	0x000003BF00000300: 0x1F78,
+	0x000003BF00000301: 0x03CC,
+	0x000003BF00000313: 0x1F40,
+	0x000003BF00000314: 0x1F41,
+	0x000003C100000313: 0x1FE4,
+	0x000003C100000314: 0x1FE5,
+	0x000003C500000300: 0x1F7A,
+	0x000003C500000301: 0x03CD,
+	0x000003C500000304: 0x1FE1,
+	0x000003C500000306: 0x1FE0,
+	0x000003C500000308: 0x03CB,
+	0x000003C500000313: 0x1F50,
+	0x000003C500000314: 0x1F51,
+	0x000003C500000342: 0x1FE6,
+	0x000003C900000300: 0x1F7C,
+	0x000003C900000301: 0x03CE,
+	0x000003C900000313: 0x1F60,
+	0x000003C900000314: 0x1F61,
+	0x000003C900000342: 0x1FF6,
+	0x000003C900000345: 0x1FF3,
+	0x000003CA00000300: 0x1FD2,
+	0x000003CA00000301: 0x0390,
+	0x000003CA00000342: 0x1FD7,
+	0x000003CB00000300: 0x1FE2,
+	0x000003CB00000301: 0x03B0,
+	0x000003CB00000342: 0x1FE7,
+	0x000003CE00000345: 0x1FF4,
+	0x000003D200000301: 0x03D3,
+	0x000003D200000308: 0x03D4,
+	0x0000040600000308: 0x0407,
+	0x0000041000000306: 0x04D0,
+	0x0000041000000308: 0x04D2,
+	0x0000041300000301: 0x0403,
+	0x0000041500000300: 0x0400,
+	0x0000041500000306: 0x04D6,
+	0x0000041500000308: 0x0401,
+	0x0000041600000306: 0x04C1,
+	0x0000041600000308: 0x04DC,
+	0x0000041700000308: 0x04DE,
+	0x0000041800000300: 0x040D,
+	0x0000041800000304: 0x04E2,
+	0x0000041800000306: 0x0419,
+	0x0000041800000308: 0x04E4,
+	0x0000041A00000301: 0x040C,
+	0x0000041E00000308: 0x04E6,
+	0x0000042300000304: 0x04EE,
+	0x0000042300000306: 0x040E,
+	0x0000042300000308: 0x04F0,
+	0x000004230000030B: 0x04F2,
+	0x0000042700000308: 0x04F4,
+	0x0000042B00000308: 0x04F8,
+	0x0000042D00000308: 0x04EC,
+	0x0000043000000306: 0x04D1,
+	0x0000043000000308: 0x04D3,
+	0x0000043300000301: 0x0453,
+	0x0000043500000300: 0x0450,
+	0x0000043500000306: 0x04D7,
+	0x0000043500000308: 0x0451,
+	0x0000043600000306: 0x04C2,
+	0x0000043600000308: 0x04DD,
+	0x0000043700000308: 0x04DF,
+	0x0000043800000300: 0x045D,
+	0x0000043800000304: 0x04E3,
+	0x0000043800000306: 0x0439,
+	0x0000043800000308: 0x04E5,
+	0x0000043A00000301: 0x045C,
+	0x0000043E00000308: 0x04E7,
+	0x0000044300000304: 0x04EF,
+	0x0000044300000306: 0x045E,
+	0x0000044300000308: 0x04F1,
+	0x000004430000030B: 0x04F3,
+	0x0000044700000308: 0x04F5,
+	0x0000044B00000308: 0x04F9,
+	0x0000044D00000308: 0x04ED,
+	0x0000045600000308: 0x0457,
+	0x000004740000030F: 0x0476,
+	0x000004750000030F: 0x0477,
+	0x000004D800000308: 0x04DA,
+	0x000004D900000308: 0x04DB,
+	0x000004E800000308: 0x04EA,
+	0x000004E900000308: 0x04EB,
+	0x0000062700000653: 0x0622,
+	0x0000062700000654: 0x0623,
+	0x0000062700000655: 0x0625,
+	0x0000064800000654: 0x0624,
+	0x0000064A00000654: 0x0626,
+	0x000006C100000654: 0x06C2,
+	0x000006D200000654: 0x06D3,
+	0x000006D500000654: 0x06C0,
+	0x000009280000093C: 0x0929,
+	0x000009300000093C: 0x0931,
+	0x000009330000093C: 0x0934,
+	0x000009C7000009BE: 0x09CB,
+	0x000009C7000009D7: 0x09CC,
+	0x00000B4700000B3E: 0x0B4B,
+	0x00000B4700000B56: 0x0B48,
+	0x00000B4700000B57: 0x0B4C,
+	0x00000B9200000BD7: 0x0B94,
+	0x00000BC600000BBE: 0x0BCA,
+	0x00000BC600000BD7: 0x0BCC,
+	0x00000BC700000BBE: 0x0BCB,
+	0x00000C4600000C56: 0x0C48,
+	0x00000CBF00000CD5: 0x0CC0,
+	0x00000CC600000CC2: 0x0CCA,
+	0x00000CC600000CD5: 0x0CC7,
+	0x00000CC600000CD6: 0x0CC8,
+	0x00000CCA00000CD5: 0x0CCB,
+	0x00000D4600000D3E: 0x0D4A,
+	0x00000D4600000D57: 0x0D4C,
+	0x00000D4700000D3E: 0x0D4B,
+	0x00000DD900000DCA: 0x0DDA,
+	0x00000DD900000DCF: 0x0DDC,
+	0x00000DD900000DDF: 0x0DDE,
+	0x00000DDC00000DCA: 0x0DDD,
+	0x000010250000102E: 0x1026,
+	0x00001B0500001B35: 0x1B06,
+	0x00001B0700001B35: 0x1B08,
+	0x00001B0900001B35: 0x1B0A,
+	0x00001B0B00001B35: 0x1B0C,
+	0x00001B0D00001B35: 0x1B0E,
+	0x00001B1100001B35: 0x1B12,
+	0x00001B3A00001B35: 0x1B3B,
+	0x00001B3C00001B35: 0x1B3D,
+	0x00001B3E00001B35: 0x1B40,
+	0x00001B3F00001B35: 0x1B41,
+	0x00001B4200001B35: 0x1B43,
+	0x00001E3600000304: 0x1E38,
+	0x00001E3700000304: 0x1E39,
+	0x00001E5A00000304: 0x1E5C,
+	0x00001E5B00000304: 0x1E5D,
+	0x00001E6200000307: 0x1E68,
+	0x00001E6300000307: 0x1E69,
+	0x00001EA000000302: 0x1EAC,
+	0x00001EA000000306: 0x1EB6,
+	0x00001EA100000302: 0x1EAD,
+	0x00001EA100000306: 0x1EB7,
+	0x00001EB800000302: 0x1EC6,
+	0x00001EB900000302: 0x1EC7,
+	0x00001ECC00000302: 0x1ED8,
+	0x00001ECD00000302: 0x1ED9,
+	0x00001F0000000300: 0x1F02,
+	0x00001F0000000301: 0x1F04,
+	0x00001F0000000342: 0x1F06,
+	0x00001F0000000345: 0x1F80,
+	0x00001F0100000300: 0x1F03,
+	0x00001F0100000301: 0x1F05,
+	0x00001F0100000342: 0x1F07,
+	0x00001F0100000345: 0x1F81,
+	0x00001F0200000345: 0x1F82,
+	0x00001F0300000345: 0x1F83,
+	0x00001F0400000345: 0x1F84,
+	0x00001F0500000345: 0x1F85,
+	0x00001F0600000345: 0x1F86,
+	0x00001F0700000345: 0x1F87,
+	0x00001F0800000300: 0x1F0A,
+	0x00001F0800000301: 0x1F0C,
+	0x00001F0800000342: 0x1F0E,
+	0x00001F0800000345: 0x1F88,
+	0x00001F0900000300: 0x1F0B,
+	0x00001F0900000301: 0x1F0D,
+	0x00001F0900000342: 0x1F0F,
+	0x00001F0900000345: 0x1F89,
+	0x00001F0A00000345: 0x1F8A,
+	0x00001F0B00000345: 0x1F8B,
+	0x00001F0C00000345: 0x1F8C,
+	0x00001F0D00000345: 0x1F8D,
+	0x00001F0E00000345: 0x1F8E,
+	0x00001F0F00000345: 0x1F8F,
+	0x00001F1000000300: 0x1F12,
+	0x00001F1000000301: 0x1F14,
+	0x00001F1100000300: 0x1F13,
+	0x00001F1100000301: 0x1F15,
+	0x00001F1800000300: 0x1F1A,
+	0x00001F1800000301: 0x1F1C,
+	0x00001F1900000300: 0x1F1B,
+	0x00001F1900000301: 0x1F1D,
+	0x00001F2000000300: 0x1F22,
+	0x00001F2000000301: 0x1F24,
+	0x00001F2000000342: 0x1F26,
+	0x00001F2000000345: 0x1F90,
+	0x00001F2100000300: 0x1F23,
+	0x00001F2100000301: 0x1F25,
+	0x00001F2100000342: 0x1F27,
+	0x00001F2100000345: 0x1F91,
+	0x00001F2200000345: 0x1F92,
+	0x00001F2300000345: 0x1F93,
+	0x00001F2400000345: 0x1F94,
+	0x00001F2500000345: 0x1F95,
+	0x00001F2600000345: 0x1F96,
+	0x00001F2700000345: 0x1F97,
+	0x00001F2800000300: 0x1F2A,
+	0x00001F2800000301: 0x1F2C,
+	0x00001F2800000342: 0x1F2E,
+	0x00001F2800000345: 0x1F98,
+	0x00001F2900000300: 0x1F2B,
+	0x00001F2900000301: 0x1F2D,
+	0x00001F2900000342: 0x1F2F,
+	0x00001F2900000345: 0x1F99,
+	0x00001F2A00000345: 0x1F9A,
+	0x00001F2B00000345: 0x1F9B,
+	0x00001F2C00000345: 0x1F9C,
+	0x00001F2D00000345: 0x1F9D,
+	0x00001F2E00000345: 0x1F9E,
+	0x00001F2F00000345: 0x1F9F,
+	0x00001F3000000300: 0x1F32,
+	0x00001F3000000301: 0x1F34,
+	0x00001F3000000342: 0x1F36,
+	0x00001F3100000300: 0x1F33,
+	0x00001F3100000301: 0x1F35,
+	0x00001F3100000342: 0x1F37,
+	0x00001F3800000300: 0x1F3A,
+	0x00001F3800000301: 0x1F3C,
+	0x00001F3800000342: 0x1F3E,
+	0x00001F3900000300: 0x1F3B,
+	0x00001F3900000301: 0x1F3D,
+	0x00001F3900000342: 0x1F3F,
+	0x00001F4000000300: 0x1F42,
+	0x00001F4000000301: 0x1F44,
+	0x00001F4100000300: 0x1F43,
+	0x00001F4100000301: 0x1F45,
+	0x00001F4800000300: 0x1F4A,
+	0x00001F4800000301: 0x1F4C,
+	0x00001F4900000300: 0x1F4B,
+	0x00001F4900000301: 0x1F4D,
+	0x00001F5000000300: 0x1F52,
+	0x00001F5000000301: 0x1F54,
+	0x00001F5000000342: 0x1F56,
+	0x00001F5100000300: 0x1F53,
+	0x00001F5100000301: 0x1F55,
+	0x00001F5100000342: 0x1F57,
+	0x00001F5900000300: 0x1F5B,
+	0x00001F5900000301: 0x1F5D,
+	0x00001F5900000342: 0x1F5F,
+	0x00001F6000000300: 0x1F62,
+	0x00001F6000000301: 0x1F64,
+	0x00001F6000000342: 0x1F66,
+	0x00001F6000000345: 0x1FA0,
+	0x00001F6100000300: 0x1F63,
+	0x00001F6100000301: 0x1F65,
+	0x00001F6100000342: 0x1F67,
+	0x00001F6100000345: 0x1FA1,
+	0x00001F6200000345: 0x1FA2,
+	0x00001F6300000345: 0x1FA3,
+	0x00001F6400000345: 0x1FA4,
+	0x00001F6500000345: 0x1FA5,
+	0x00001F6600000345: 0x1FA6,
+	0x00001F6700000345: 0x1FA7,
+	0x00001F6800000300: 0x1F6A,
+	0x00001F6800000301: 0x1F6C,
+	0x00001F6800000342: 0x1F6E,
+	0x00001F6800000345: 0x1FA8,
+	0x00001F6900000300: 0x1F6B,
+	0x00001F6900000301: 0x1F6D,
+	0x00001F6900000342: 0x1F6F,
+	0x00001F6900000345: 0x1FA9,
+	0x00001F6A00000345: 0x1FAA,
+	0x00001F6B00000345: 0x1FAB,
+	0x00001F6C00000345: 0x1FAC,
+	0x00001F6D00000345: 0x1FAD,
+	0x00001F6E00000345: 0x1FAE,
+	0x00001F6F00000345: 0x1FAF,
+	0x00001F7000000345: 0x1FB2,
+	0x00001F7400000345: 0x1FC2,
+	0x00001F7C00000345: 0x1FF2,
+	0x00001FB600000345: 0x1FB7,
+	0x00001FBF00000300: 0x1FCD,
+	0x00001FBF00000301: 0x1FCE,
+	0x00001FBF00000342: 0x1FCF,
+	0x00001FC600000345: 0x1FC7,
+	0x00001FF600000345: 0x1FF7,
+	0x00001FFE00000300: 0x1FDD,
+	0x00001FFE00000301: 0x1FDE,
+	0x00001FFE00000342: 0x1FDF,
+	0x0000219000000338: 0x219A,
+	0x0000219200000338: 0x219B,
+	0x0000219400000338: 0x21AE,
+	0x000021D000000338: 0x21CD,
+	0x000021D200000338: 0x21CF,
+	0x000021D400000338: 0x21CE,
+	0x0000220300000338: 0x2204,
+	0x0000220800000338: 0x2209,
+	0x0000220B00000338: 0x220C,
+	0x0000222300000338: 0x2224,
+	0x0000222500000338: 0x2226,
+	0x0000223C00000338: 0x2241,
+	0x0000224300000338: 0x2244,
+	0x0000224500000338: 0x2247,
+	0x0000224800000338: 0x2249,
+	0x0000224D00000338: 0x226D,
+	0x0000226100000338: 0x2262,
+	0x0000226400000338: 0x2270,
+	0x0000226500000338: 0x2271,
+	0x0000227200000338: 0x2274,
+	0x0000227300000338: 0x2275,
+	0x0000227600000338: 0x2278,
+	0x0000227700000338: 0x2279,
+	0x0000227A00000338: 0x2280,
+	0x0000227B00000338: 0x2281,
+	0x0000227C00000338: 0x22E0,
+	0x0000227D00000338: 0x22E1,
+	0x0000228200000338: 0x2284,
+	0x0000228300000338: 0x2285,
+	0x0000228600000338: 0x2288,
+	0x0000228700000338: 0x2289,
+	0x0000229100000338: 0x22E2,
+	0x0000229200000338: 0x22E3,
+	0x000022A200000338: 0x22AC,
+	0x000022A800000338: 0x22AD,
+	0x000022A900000338: 0x22AE,
+	0x000022AB00000338: 0x22AF,
+	0x000022B200000338: 0x22EA,
+	0x000022B300000338: 0x22EB,
+	0x000022B400000338: 0x22EC,
+	0x000022B500000338: 0x22ED,
+	0x0000304600003099: 0x3094,
+	0x0000304B00003099: 0x304C,
+	0x0000304D00003099: 0x304E,
+	0x0000304F00003099: 0x3050,
+	0x0000305100003099: 0x3052,
+	0x0000305300003099: 0x3054,
+	0x0000305500003099: 0x3056,
+	0x0000305700003099: 0x3058,
+	0x0000305900003099: 0x305A,
+	0x0000305B00003099: 0x305C,
+	0x0000305D00003099: 0x305E,
+	0x0000305F00003099: 0x3060,
+	0x0000306100003099: 0x3062,
+	0x0000306400003099: 0x3065,
+	0x0000306600003099: 0x3067,
+	0x0000306800003099: 0x3069,
+	0x0000306F00003099: 0x3070,
+	0x0000306F0000309A: 0x3071,
+	0x0000307200003099: 0x3073,
+	0x000030720000309A: 0x3074,
+	0x0000307500003099: 0x3076,
+	0x000030750000309A: 0x3077,
+	0x0000307800003099: 0x3079,
+	0x000030780000309A: 0x307A,
+	0x0000307B00003099: 0x307C,
+	0x0000307B0000309A: 0x307D,
+	0x0000309D00003099: 0x309E,
+	0x000030A600003099: 0x30F4,
+	0x000030AB00003099: 0x30AC,
+	0x000030AD00003099: 0x30AE,
+	0x000030AF00003099: 0x30B0,
+	0x000030B100003099: 0x30B2,
+	0x000030B300003099: 0x30B4,
+	0x000030B500003099: 0x30B6,
+	0x000030B700003099: 0x30B8,
+	0x000030B900003099: 0x30BA,
+	0x000030BB00003099: 0x30BC,
+	0x000030BD00003099: 0x30BE,
+	0x000030BF00003099: 0x30C0,
+	0x000030C100003099: 0x30C2,
+	0x000030C400003099: 0x30C5,
+	0x000030C600003099: 0x30C7,
+	0x000030C800003099: 0x30C9,
+	0x000030CF00003099: 0x30D0,
+	0x000030CF0000309A: 0x30D1,
+	0x000030D200003099: 0x30D3,
+	0x000030D20000309A: 0x30D4,
+	0x000030D500003099: 0x30D6,
+	0x000030D50000309A: 0x30D7,
+	0x000030D800003099: 0x30D9,
+	0x000030D80000309A: 0x30DA,
+	0x000030DB00003099: 0x30DC,
+	0x000030DB0000309A: 0x30DD,
+	0x000030EF00003099: 0x30F7,
+	0x000030F000003099: 0x30F8,
+	0x000030F100003099: 0x30F9,
+	0x000030F200003099: 0x30FA,
+	0x000030FD00003099: 0x30FE,
+	0x00011099000110BA: 0x1109A,
+	0x0001109B000110BA: 0x1109C,
+	0x000110A5000110BA: 0x110AB,
+	0x0001113100011127: 0x1112E,
+	0x0001113200011127: 0x1112F,
+	0x000113470001133E: 0x1134B,
+	0x0001134700011357: 0x1134C,
+	0x000114B9000114B0: 0x114BC,
+	0x000114B9000114BA: 0x114BB,
+	0x000114B9000114BD: 0x114BE,
+	0x000115B8000115AF: 0x115BA,
+	0x000115B9000115AF: 0x115BB,
+	0x0001193500011930: 0x11938,
+}
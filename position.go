@@ -0,0 +1,116 @@
+package text
+
+import (
+	"strconv"
+
+	"github.com/pgavlin/text/utf8"
+)
+
+// A Position describes a location in text. Lines are terminated by '\n'.
+type Position struct {
+	Offset int // byte offset, starting at 0
+	Line   int // line number, starting at 1
+	Column int // byte column, starting at 1
+
+	// RuneColumn is the column in runes, starting at 1. An offset inside a
+	// multibyte rune has the rune's column. If the TabWidth of the
+	// PositionReader that reported the position is positive, a tab advances
+	// RuneColumn to the next tab stop instead of by one.
+	RuneColumn int
+}
+
+// String returns the position in the form "line:column", where column is the
+// rune column.
+func (p Position) String() string {
+	return strconv.Itoa(p.Line) + ":" + strconv.Itoa(p.RuneColumn)
+}
+
+// A PositionReader is a Reader that tracks the line and column of its
+// reading position. Because the position is derived from the offset into the
+// underlying string, it stays correct across calls to every Reader method,
+// including Seek, UnreadByte and UnreadRune.
+//
+// The zero value for PositionReader operates like a PositionReader of an
+// empty string.
+type PositionReader[S String] struct {
+	Reader[S]
+
+	// TabWidth is the distance between tab stops for computing
+	// Position.RuneColumn. If TabWidth is zero or negative, a tab is one
+	// column wide.
+	TabWidth int
+
+	// The position of the most recent query, from which the next one is
+	// computed incrementally. off is the start of the rune containing the
+	// queried offset, and col is its column. line is zero if there has been
+	// no query, and tabWidth is the TabWidth with which col was computed.
+	off, line, lineStart, col, tabWidth int
+}
+
+// NewPositionReader returns a new PositionReader reading from s.
+func NewPositionReader[S String](s S) *PositionReader[S] {
+	return &PositionReader[S]{Reader: Reader[S]{s, 0, -1}}
+}
+
+// Reset resets the PositionReader to be reading from s.
+func (r *PositionReader[S]) Reset(s S) {
+	r.Reader.Reset(s)
+	r.line = 0
+}
+
+// Pos returns the current reading position.
+func (r *PositionReader[S]) Pos() Position {
+	return r.PositionAt(int(min(r.i, int64(len(r.s)))))
+}
+
+// PositionAt returns the position of the given byte offset in the string
+// being read, which is clamped to the string's bounds. PositionAt is
+// cheapest for offsets near the most recently queried one.
+func (r *PositionReader[S]) PositionAt(offset int) Position {
+	offset = max(min(offset, len(r.s)), 0)
+	if r.line == 0 || r.tabWidth != r.TabWidth {
+		r.off, r.line, r.lineStart, r.col, r.tabWidth = 0, 1, 0, 1, r.TabWidth
+	}
+
+	if offset >= r.off {
+		s := r.s[r.off:offset]
+		if i := LastIndexByte(s, '\n'); i >= 0 {
+			r.line += Count(s, "\n")
+			r.lineStart = r.off + i + 1
+			r.off, r.col = r.lineStart, 1
+		}
+		r.off, r.col = r.advance(r.off, r.col, offset)
+	} else {
+		r.line -= Count(r.s[offset:r.off], "\n")
+		r.lineStart = LastIndexByte(r.s[:offset], '\n') + 1
+		r.off, r.col = r.advance(r.lineStart, 1, offset)
+	}
+
+	return Position{
+		Offset:     offset,
+		Line:       r.line,
+		Column:     offset - r.lineStart + 1,
+		RuneColumn: r.col,
+	}
+}
+
+// advance decodes the runes that start at index i, which is at the start of a
+// rune in column col, and end at or before index end, which is on the same
+// line. It returns the start of the rune containing end and the rune's
+// column, so that an offset inside a rune has the rune's own column, as for
+// LineIndex.
+func (r *PositionReader[S]) advance(i, col, end int) (int, int) {
+	for i < end {
+		c, size := utf8.DecodeRune(r.s[i:])
+		if i+size > end {
+			break
+		}
+		if c == '\t' && r.TabWidth > 0 {
+			col += r.TabWidth - (col-1)%r.TabWidth
+		} else {
+			col++
+		}
+		i += size
+	}
+	return i, col
+}
@@ -0,0 +1,153 @@
+package text_test
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+func checkPos(t *testing.T, what string, got, want Position) {
+	t.Helper()
+	if got != want {
+		t.Errorf("%s: got %+v, want %+v", what, got, want)
+	}
+}
+
+func TestPositionReader(t *testing.T) {
+	r := NewPositionReader("ab\nçd\n\nx")
+	checkPos(t, "start", r.Pos(), Position{0, 1, 1, 1})
+
+	var want = []Position{
+		{1, 1, 2, 2},
+		{2, 1, 3, 3},
+		{3, 2, 1, 1},
+		{5, 2, 3, 2},
+		{6, 2, 4, 3},
+		{7, 3, 1, 1},
+		{8, 4, 1, 1},
+		{9, 4, 2, 2},
+	}
+	for i, w := range want {
+		if _, _, err := r.ReadRune(); err != nil {
+			t.Fatalf("ReadRune %d: %v", i, err)
+		}
+		checkPos(t, "ReadRune", r.Pos(), w)
+	}
+	if _, _, err := r.ReadRune(); err != io.EOF {
+		t.Fatalf("ReadRune at end: got %v, want EOF", err)
+	}
+	checkPos(t, "EOF", r.Pos(), Position{9, 4, 2, 2})
+
+	if _, err := r.Seek(4, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	checkPos(t, "Seek into rune", r.Pos(), Position{4, 2, 2, 1})
+	if _, err := r.ReadByte(); err != nil {
+		t.Fatal(err)
+	}
+	checkPos(t, "ReadByte", r.Pos(), Position{5, 2, 3, 2})
+
+	if _, err := r.Seek(3, io.SeekCurrent); err != nil {
+		t.Fatal(err)
+	}
+	checkPos(t, "Seek forward", r.Pos(), Position{8, 4, 1, 1})
+
+	if _, err := r.Seek(100, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	checkPos(t, "Seek past end", r.Pos(), Position{9, 4, 2, 2})
+}
+
+func TestPositionReaderUnread(t *testing.T) {
+	r := NewPositionReader([]byte("a\nb"))
+	for range 2 {
+		if _, _, err := r.ReadRune(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkPos(t, "after newline", r.Pos(), Position{2, 2, 1, 1})
+	if err := r.UnreadRune(); err != nil {
+		t.Fatal(err)
+	}
+	checkPos(t, "UnreadRune", r.Pos(), Position{1, 1, 2, 2})
+	if err := r.UnreadByte(); err != nil {
+		t.Fatal(err)
+	}
+	checkPos(t, "UnreadByte", r.Pos(), Position{0, 1, 1, 1})
+}
+
+func TestPositionReaderTabWidth(t *testing.T) {
+	r := NewPositionReader("\tab\tc\td")
+	r.TabWidth = 4
+	tests := []struct {
+		offset, col int
+	}{
+		{0, 1}, {1, 5}, {2, 6}, {3, 7}, {4, 9}, {5, 10}, {6, 13}, {7, 14},
+	}
+	for _, tt := range tests {
+		if got := r.PositionAt(tt.offset).RuneColumn; got != tt.col {
+			t.Errorf("PositionAt(%d).RuneColumn = %d, want %d", tt.offset, got, tt.col)
+		}
+	}
+
+	r.TabWidth = 0
+	if got := r.PositionAt(4).RuneColumn; got != 5 {
+		t.Errorf("RuneColumn without tab stops = %d, want 5", got)
+	}
+}
+
+func TestPositionReaderPositionAt(t *testing.T) {
+	const s = "héllo\nwörld\n\tend\n"
+	r := NewPositionReader(s)
+
+	// Every offset must give the same answer regardless of the order in
+	// which offsets are queried.
+	want := make([]Position, len(s)+1)
+	for i := range want {
+		want[i] = NewPositionReader(s).PositionAt(i)
+	}
+	for _, i := range []int{5, 19, 0, 8, 7, 2, 13, 12, 14, 1, 3, 19, 6} {
+		checkPos(t, "PositionAt", r.PositionAt(i), want[i])
+	}
+	checkPos(t, "negative", r.PositionAt(-1), want[0])
+	checkPos(t, "past end", r.PositionAt(100), want[len(s)])
+	checkPos(t, "inside rune", want[9], Position{9, 2, 3, 2})
+}
+
+func TestPositionReaderLineIndex(t *testing.T) {
+	// PositionReader and LineIndex agree on every offset, including those
+	// inside multibyte runes and around invalid UTF-8.
+	const s = "ab\nçd\n𝄞\xf0a\xe2\x82x\xff\n\xe2\n"
+	r, x := NewPositionReader(s), NewLineIndex(s)
+	for i := 0; i <= len(s); i++ {
+		checkPos(t, "PositionAt", r.PositionAt(i), x.Position(i))
+	}
+	for i := len(s); i >= 0; i-- {
+		checkPos(t, "PositionAt backwards", r.PositionAt(i), x.Position(i))
+	}
+	checkPos(t, "inside rune", r.PositionAt(4), Position{4, 2, 2, 1})
+}
+
+func TestPositionReaderReset(t *testing.T) {
+	var r PositionReader[string]
+	checkPos(t, "zero value", r.Pos(), Position{0, 1, 1, 1})
+
+	r.Reset("a\nb")
+	if _, err := r.Seek(0, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	checkPos(t, "end", r.Pos(), Position{3, 2, 2, 2})
+
+	r.Reset("xyz")
+	checkPos(t, "after Reset", r.Pos(), Position{0, 1, 1, 1})
+	r.ReadByte()
+	checkPos(t, "after Reset and read", r.Pos(), Position{1, 1, 2, 2})
+}
+
+func TestPositionString(t *testing.T) {
+	p := Position{Offset: 12, Line: 3, Column: 7, RuneColumn: 5}
+	if got, want := p.String(), "3:5"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -114,6 +114,77 @@ func (r *Reader[S]) UnreadRune() error {
 	return nil
 }
 
+// ReadText reads until the first occurrence of delim in the input,
+// returning an S containing the data up to and including the delimiter.
+// If ReadText reaches the end of the string before finding a delimiter,
+// it returns the remaining data and io.EOF.
+// ReadText returns err != nil if and only if the returned data does not end
+// in delim. The returned text is a slice of the underlying string.
+func (r *Reader[S]) ReadText(delim byte) (line S, err error) {
+	r.prevRune = -1
+	if r.i >= int64(len(r.s)) {
+		return r.s[:0], io.EOF
+	}
+	s := r.s[r.i:]
+	end := IndexByte(s, delim) + 1
+	if end == 0 {
+		end, err = len(s), io.EOF
+	}
+	r.i += int64(end)
+	return s[:end], err
+}
+
+// ReadLine reads the next line, returning it without its terminating "\n" or
+// "\r\n". The last line of the string need not be terminated. If no data
+// remains, ReadLine returns an empty S and io.EOF. The returned text is a
+// slice of the underlying string.
+func (r *Reader[S]) ReadLine() (line S, err error) {
+	line, err = r.ReadText('\n')
+	if err != nil {
+		if len(line) == 0 {
+			return line, err
+		}
+		return line, nil
+	}
+	line = line[:len(line)-1]
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		line = line[:len(line)-1]
+	}
+	return line, nil
+}
+
+// Peek returns the next n bytes without advancing the reader. If fewer than n
+// bytes remain, Peek returns all of them. Peek does not affect UnreadRune.
+// The returned text is a slice of the underlying string.
+// Peek panics if n is negative.
+func (r *Reader[S]) Peek(n int) S {
+	if n < 0 {
+		panic("text.Reader.Peek: negative count")
+	}
+	if r.i >= int64(len(r.s)) {
+		return r.s[:0]
+	}
+	s := r.s[r.i:]
+	return s[:min(n, len(s))]
+}
+
+var errNegativeDiscard = errors.New("text.Reader.Discard: negative count")
+
+// Discard skips the next n bytes, returning the number of bytes discarded.
+// If Discard skips fewer than n bytes, it also returns io.EOF.
+func (r *Reader[S]) Discard(n int) (discarded int, err error) {
+	if n < 0 {
+		return 0, errNegativeDiscard
+	}
+	r.prevRune = -1
+	discarded = min(n, r.Len())
+	r.i += int64(discarded)
+	if discarded < n {
+		err = io.EOF
+	}
+	return discarded, err
+}
+
 // Seek implements the io.Seeker interface.
 func (r *Reader[S]) Seek(offset int64, whence int) (int64, error) {
 	r.prevRune = -1
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"sync"
 	"testing"
+
+	. "github.com/pgavlin/text"
 )
 
 func TestReader(t *testing.T) {
@@ -231,3 +233,138 @@ func TestReaderZero(t *testing.T) {
 		t.Errorf("WriteTo: got %d, %v; want 0, nil", n, err)
 	}
 }
+
+func TestReaderReadText(t *testing.T) {
+	r := NewReader("a,bc,,d")
+	for _, want := range []string{"a,", "bc,", ","} {
+		got, err := r.ReadText(',')
+		if got != want || err != nil {
+			t.Errorf("ReadText: got %q, %v; want %q, nil", got, err, want)
+		}
+	}
+	if got, err := r.ReadText(','); got != "d" || err != io.EOF {
+		t.Errorf("ReadText: got %q, %v; want \"d\", io.EOF", got, err)
+	}
+	if got, err := r.ReadText(','); got != "" || err != io.EOF {
+		t.Errorf("ReadText at end: got %q, %v; want \"\", io.EOF", got, err)
+	}
+}
+
+func TestReaderReadLine(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"a", []string{"a"}},
+		{"a\n", []string{"a"}},
+		{"a\r\nb\n\nc", []string{"a", "b", "", "c"}},
+		{"\r\n\r\n", []string{"", ""}},
+		{"a\rb\r", []string{"a\rb\r"}},
+		{"a\r\r\n", []string{"a\r"}},
+	}
+	for _, tt := range tests {
+		r := NewReader([]byte(tt.in))
+		var got []string
+		for {
+			line, err := r.ReadLine()
+			if err != nil {
+				if err != io.EOF || len(line) != 0 {
+					t.Errorf("ReadLine(%q): got %q, %v at end", tt.in, line, err)
+				}
+				break
+			}
+			got = append(got, string(line))
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) || len(got) != len(tt.want) {
+			t.Errorf("ReadLine(%q): got %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestReaderPeekDiscard(t *testing.T) {
+	r := NewReader("héllo")
+	if got := r.Peek(0); got != "" {
+		t.Errorf("Peek(0) = %q", got)
+	}
+	if got := r.Peek(3); got != "hé" {
+		t.Errorf("Peek(3) = %q, want \"hé\"", got)
+	}
+	if got := r.Peek(100); got != "héllo" {
+		t.Errorf("Peek(100) = %q, want \"héllo\"", got)
+	}
+	if r.Len() != 6 {
+		t.Errorf("Peek advanced the reader: Len() = %d", r.Len())
+	}
+
+	if n, err := r.Discard(3); n != 3 || err != nil {
+		t.Errorf("Discard(3): got %d, %v; want 3, nil", n, err)
+	}
+	if got := r.Peek(2); got != "ll" {
+		t.Errorf("Peek(2) after Discard = %q, want \"ll\"", got)
+	}
+	if _, err := r.Discard(-1); err == nil {
+		t.Errorf("Discard(-1): got nil error")
+	}
+	if n, err := r.Discard(5); n != 3 || err != io.EOF {
+		t.Errorf("Discard(5): got %d, %v; want 3, io.EOF", n, err)
+	}
+	if got := r.Peek(1); got != "" {
+		t.Errorf("Peek(1) at end = %q", got)
+	}
+
+	r.Seek(100, io.SeekStart)
+	if got := r.Peek(1); got != "" {
+		t.Errorf("Peek(1) past end = %q", got)
+	}
+	if n, err := r.Discard(1); n != 0 || err != io.EOF {
+		t.Errorf("Discard(1) past end: got %d, %v; want 0, io.EOF", n, err)
+	}
+}
+
+func TestReaderPeekPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Peek(-1) did not panic")
+		}
+	}()
+	NewReader("abc").Peek(-1)
+}
+
+func TestReaderDelimUnreadRune(t *testing.T) {
+	r := NewReader("世界\nx")
+	if _, _, err := r.ReadRune(); err != nil {
+		t.Fatal(err)
+	}
+	// Peek does not disturb UnreadRune.
+	if got := r.Peek(3); got != "界" {
+		t.Errorf("Peek(3) = %q", got)
+	}
+	if err := r.UnreadRune(); err != nil {
+		t.Errorf("UnreadRune after Peek: %v", err)
+	}
+
+	if _, _, err := r.ReadRune(); err != nil {
+		t.Fatal(err)
+	}
+	if line, err := r.ReadLine(); line != "界" || err != nil {
+		t.Errorf("ReadLine: got %q, %v", line, err)
+	}
+	if r.UnreadRune() == nil {
+		t.Errorf("UnreadRune after ReadLine: got nil, want error")
+	}
+
+	if _, _, err := r.ReadRune(); err != nil {
+		t.Fatal(err)
+	}
+	r.Discard(0)
+	if r.UnreadRune() == nil {
+		t.Errorf("UnreadRune after Discard: got nil, want error")
+	}
+	if r.UnreadByte() != nil {
+		t.Errorf("UnreadByte after Discard: got error")
+	}
+	if got, _ := r.ReadText('\n'); got != "x" {
+		t.Errorf("ReadText after UnreadByte = %q, want \"x\"", got)
+	}
+}
@@ -5,8 +5,11 @@
 package text
 
 import (
+	"errors"
 	"io"
 	"sync"
+
+	"github.com/pgavlin/text/utf8"
 )
 
 // Replacer replaces a list of strings with replacements.
@@ -15,12 +18,26 @@ type Replacer[S String] struct {
 	once   sync.Once // guards buildOnce method
 	r      replacer[S]
 	oldnew []S
+	fn     func(match S, offset int) S // computes replacements if non-nil
+	opts   ReplacerOptions
+
+	reportOnce sync.Once // guards buildReporter method
+	reporter   reporter[S]
 }
 
 // replacer is the interface that a replacement algorithm needs to implement.
 type replacer[S String] interface {
 	Replace(s S) S
 	WriteString(w io.Writer, s S) (n int, err error)
+
+	// writePrefix writes the longest prefix of s[start:] whose replacement
+	// does not depend on the text that follows s to w with all replacements
+	// performed, and returns the index in s of the end of that prefix.
+	// s[:start] has already been written, and serves only as context for
+	// matching at word boundaries. offset is the offset of s in the text
+	// being replaced. If final is true, s is the end of the text, and all of
+	// it is written.
+	writePrefix(w io.Writer, s S, start, offset int, final bool) (consumed, n int, err error)
 }
 
 // NewReplacer returns a new Replacer from a list of old, new string
@@ -36,21 +53,50 @@ func NewReplacer[S String](oldnew ...S) *Replacer[S] {
 	return &Replacer[S]{oldnew: append([]S(nil), oldnew...)}
 }
 
+// NewReplacerFunc returns a new Replacer that replaces each of the old
+// strings with the result of calling fn with the matched text and its byte
+// offset in the input. Matching follows the same rules as for NewReplacer:
+// replacements are performed in the order they appear in the target string,
+// without overlapping matches, and the old string comparisons are done in
+// argument order.
+//
+// For text written to a writer returned by NewWriter, offsets are relative to
+// the start of the stream. The Replacer calls fn from the goroutine that performs the
+// replacement, so fn must be safe for concurrent use if the Replacer is used
+// by multiple goroutines.
+func NewReplacerFunc[S String](fn func(match S, offset int) S, old ...S) *Replacer[S] {
+	oldnew := make([]S, 0, 2*len(old))
+	for _, o := range old {
+		oldnew = append(oldnew, o, Empty[S]())
+	}
+	return &Replacer[S]{oldnew: oldnew, fn: fn}
+}
+
 func (r *Replacer[S]) buildOnce() {
 	r.r = r.build()
-	r.oldnew = nil
+	if _, ok := r.r.(reporter[S]); ok {
+		r.oldnew = nil
+	}
+	// Otherwise, keep oldnew so that buildReporter can use it. The faster
+	// algorithms are only used for short lists of short old strings.
 }
 
 func (b *Replacer[S]) build() replacer[S] {
 	oldnew := b.oldnew
-	if len(oldnew) == 2 && len(oldnew[0]) > 1 {
+	if b.opts.IgnoreCase {
+		return makeFoldReplacer(oldnew, b.fn, b.opts)
+	}
+	if b.fn != nil || b.opts.WholeWord {
+		return makeGenericReplacer(oldnew, b.fn, b.opts)
+	}
+	if len(oldnew) == 2 && len(oldnew[0]) > 1 && !b.opts.IgnoreASCIICase {
 		return makeSingleStringReplacer(oldnew[0], oldnew[1])
 	}
 
 	allNewBytes := true
 	for i := 0; i < len(oldnew); i += 2 {
 		if len(oldnew[i]) != 1 {
-			return makeGenericReplacer(oldnew)
+			return makeGenericReplacer(oldnew, nil, b.opts)
 		}
 		if len(oldnew[i+1]) != 1 {
 			allNewBytes = false
@@ -68,6 +114,9 @@ func (b *Replacer[S]) build() replacer[S] {
 			o := oldnew[i][0]
 			n := oldnew[i+1][0]
 			r.m[o] = n
+			if b.opts.IgnoreASCIICase && isASCIILetter(o) {
+				r.m[o^0x20] = n
+			}
 		}
 		return &r
 	}
@@ -86,7 +135,12 @@ func (b *Replacer[S]) build() replacer[S] {
 			r.toReplace = append(r.toReplace, S([]byte{o}))
 		}
 		r.replacements[o] = []byte(n)
-
+		if b.opts.IgnoreASCIICase && isASCIILetter(o) {
+			if r.replacements[o^0x20] == nil {
+				r.toReplace = append(r.toReplace, S([]byte{o ^ 0x20}))
+			}
+			r.replacements[o^0x20] = []byte(n)
+		}
 	}
 	return &r
 }
@@ -103,6 +157,57 @@ func (r *Replacer[S]) WriteString(w io.Writer, s S) (n int, err error) {
 	return r.r.WriteString(w, s)
 }
 
+// NewWriter returns a writer that writes the text written to it to w with all
+// replacements performed. The writer holds back just enough trailing text to
+// recognize old strings that are split across calls to Write. Callers must
+// call Close to flush that text; Close does not close w.
+func (r *Replacer[S]) NewWriter(w io.Writer) io.WriteCloser {
+	r.once.Do(r.buildOnce)
+	return &replaceWriter[S]{r: r.r, w: w}
+}
+
+// replaceWriter is the io.WriteCloser returned by Replacer.NewWriter.
+type replaceWriter[S String] struct {
+	r     replacer[S]
+	w     io.Writer
+	buf   []byte // text that has been written but not yet replaced
+	start int    // length of the context at the start of buf
+	off   int    // offset of buf in the stream
+	err   error
+}
+
+var errReplaceWriterClosed = errors.New("text.Replacer: write to closed writer")
+
+func (w *replaceWriter[S]) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.buf = append(w.buf, p...)
+	consumed, _, err := w.r.writePrefix(w.w, S(w.buf), w.start, w.off, false)
+	if err != nil {
+		w.err = err
+		return 0, err
+	}
+	// Keep the last rune of the replaced text as context for the next
+	// call.
+	drop := max(consumed-utf8.UTFMax, 0)
+	w.buf = append(w.buf[:0], w.buf[drop:]...)
+	w.start, w.off = consumed-drop, w.off+drop
+	return len(p), nil
+}
+
+func (w *replaceWriter[S]) Close() error {
+	if w.err != nil {
+		if w.err == errReplaceWriterClosed {
+			return nil
+		}
+		return w.err
+	}
+	_, _, err := w.r.writePrefix(w.w, S(w.buf), w.start, w.off, true)
+	w.buf, w.err = nil, errReplaceWriterClosed
+	return err
+}
+
 // trieNode is a node in a lookup trie for prioritized key/value pairs. Keys
 // and values may be empty. For example, the trie containing keys "ax", "ay",
 // "bcbc", "x" and "xy" could have eight nodes:
@@ -218,18 +323,18 @@ func (t *trieNode[S]) add(key, val S, priority int, r *genericReplacer[S]) {
 	}
 }
 
-func (r *genericReplacer[S]) lookup(s S, ignoreRoot bool) (val S, keylen int, found bool) {
+// lookup returns the value and length of the highest-priority key that is a
+// prefix of s. It is used when the replacer has no options.
+func (r *genericReplacer[S]) lookup(s S, ignoreRoot bool) (val S, keylen, priority int) {
 	// Iterate down the trie to the end, and grab the value and keylen with
 	// the highest priority.
-	bestPriority := 0
 	node := &r.root
 	n := 0
 	for node != nil {
-		if node.priority > bestPriority && !(ignoreRoot && node == &r.root) {
-			bestPriority = node.priority
+		if node.priority > priority && !(ignoreRoot && node == &r.root) {
+			priority = node.priority
 			val = node.value
 			keylen = n
-			found = true
 		}
 
 		if IsEmpty(s) {
@@ -254,6 +359,58 @@ func (r *genericReplacer[S]) lookup(s S, ignoreRoot bool) (val S, keylen int, fo
 	return
 }
 
+// lookupOpts is like lookup, but honors r.opts and reports whether more text
+// could change the match. It returns the value, length and priority of the
+// highest-priority key that is a prefix of s, or of the longest one if
+// r.opts.LongestMatch is set.
+// priority is zero if no key matches. more is true if s is itself a proper
+// prefix of some key, so that a longer key might match if s were followed by
+// more text. afterWord reports whether s is preceded by a word character.
+func (r *genericReplacer[S]) lookupOpts(s S, ignoreRoot, afterWord bool) (val S, keylen, priority int, more bool) {
+	// Iterate down the trie to the end, and grab the value and keylen with
+	// the highest priority.
+	node := &r.root
+	n := 0
+	orig := s
+	for node != nil {
+		// Keys that are found later in the walk are longer.
+		better := node.priority > priority || r.opts.LongestMatch && node.priority > 0
+		if better && !(ignoreRoot && node == &r.root) &&
+			(!r.opts.WholeWord || isWholeWord(orig[:n], s, afterWord)) {
+			priority = node.priority
+			val = node.value
+			keylen = n
+		}
+		if node.priority > 0 && r.opts.WholeWord && !utf8.FullRune(s) {
+			// Whether the key ends a word depends on the text that follows
+			// s.
+			more = true
+		}
+
+		if IsEmpty(s) {
+			more = more || node.table != nil || node.next != nil
+			break
+		}
+		if node.table != nil {
+			index := r.mapping[s[0]]
+			if int(index) == r.tableSize {
+				break
+			}
+			node = node.table[index]
+			s = s[1:]
+			n++
+		} else if len(node.prefix) != 0 && r.hasPrefix(s, node.prefix) {
+			n += len(node.prefix)
+			s = s[len(node.prefix):]
+			node = node.next
+		} else {
+			more = more || len(s) < len(node.prefix) && r.hasPrefix(s, node.prefix[:len(s)])
+			break
+		}
+	}
+	return
+}
+
 // genericReplacer is the fully generic algorithm.
 // It's used as a fallback when nothing faster can be used.
 type genericReplacer[S String] struct {
@@ -263,10 +420,24 @@ type genericReplacer[S String] struct {
 	tableSize int
 	// mapping maps from key bytes to a dense index for trieNode[S].table.
 	mapping [256]byte
+	// fn computes the value for each match if it is non-nil.
+	fn   func(match S, offset int) S
+	opts ReplacerOptions
+	// size is the length of the list of old, new pairs. The priority of the
+	// key oldnew[i] is size-i.
+	size int
 }
 
-func makeGenericReplacer[S String](oldnew []S) *genericReplacer[S] {
-	r := new(genericReplacer[S])
+func makeGenericReplacer[S String](oldnew []S, fn func(match S, offset int) S, opts ReplacerOptions) *genericReplacer[S] {
+	r := &genericReplacer[S]{fn: fn, opts: opts, size: len(oldnew)}
+	if opts.IgnoreASCIICase {
+		// Build the trie from lower-case keys, and map the bytes of each
+		// upper-case letter to the same index as its lower-case form.
+		oldnew = append([]S(nil), oldnew...)
+		for i := 0; i < len(oldnew); i += 2 {
+			oldnew[i] = toLowerASCII(oldnew[i])
+		}
+	}
 	// Find each byte used, then assign them each an index.
 	for i := 0; i < len(oldnew); i += 2 {
 		key := oldnew[i]
@@ -288,6 +459,11 @@ func makeGenericReplacer[S String](oldnew []S) *genericReplacer[S] {
 			index++
 		}
 	}
+	if opts.IgnoreASCIICase {
+		for c := byte('A'); c <= 'Z'; c++ {
+			r.mapping[c] = r.mapping[c+'a'-'A']
+		}
+	}
 	// Ensure root node uses a lookup table (for performance).
 	r.root.table = make([]*trieNode[S], r.tableSize)
 
@@ -346,6 +522,11 @@ func (r *genericReplacer[S]) Replace(s S) S {
 }
 
 func (r *genericReplacer[S]) WriteString(w io.Writer, s S) (n int, err error) {
+	if r.opts.IgnoreASCIICase || r.opts.WholeWord || r.opts.LongestMatch {
+		_, n, err = r.writePrefix(w, s, 0, 0, true)
+		return
+	}
+
 	sw := getTextWriter[S](w)
 	var last, wn int
 	var prevMatchEmpty bool
@@ -360,9 +541,13 @@ func (r *genericReplacer[S]) WriteString(w io.Writer, s S) (n int, err error) {
 		}
 
 		// Ignore the empty match iff the previous loop found the empty match.
-		val, keylen, match := r.lookup(s[i:], prevMatchEmpty)
+		val, keylen, priority := r.lookup(s[i:], prevMatchEmpty)
+		match := priority > 0
 		prevMatchEmpty = match && keylen == 0
 		if match {
+			if r.fn != nil {
+				val = r.fn(s[i:i+keylen], i)
+			}
 			wn, err = sw.WriteText(s[last:i])
 			n += wn
 			if err != nil {
@@ -386,6 +571,87 @@ func (r *genericReplacer[S]) WriteString(w io.Writer, s S) (n int, err error) {
 	return
 }
 
+// hasPrefix reports whether s begins with the key fragment prefix.
+func (r *genericReplacer[S]) hasPrefix(s, prefix S) bool {
+	if r.opts.IgnoreASCIICase {
+		return hasPrefixLowerASCII(s, prefix)
+	}
+	return HasPrefix(s, prefix)
+}
+
+func (r *genericReplacer[S]) writePrefix(w io.Writer, s S, start, offset int, final bool) (consumed, n int, err error) {
+	return r.replace(w, s, start, offset, final, nil)
+}
+
+// replace implements writePrefix. If report is non-nil, replace appends a
+// record of each replacement to it.
+func (r *genericReplacer[S]) replace(w io.Writer, s S, start, offset int, final bool, report *[]Replacement) (consumed, n int, err error) {
+	sw := getTextWriter[S](w)
+	var wn int
+	var prevMatchEmpty bool
+	last := start
+	for i := start; i <= len(s); {
+		// Fast path: s[i] is not a prefix of any pattern.
+		if i != len(s) && r.root.priority == 0 {
+			index := int(r.mapping[s[i]])
+			if index == r.tableSize || r.root.table[index] == nil {
+				i++
+				continue
+			}
+		}
+
+		afterWord := false
+		if r.opts.WholeWord {
+			afterWord = endsWithWord(s[:i])
+		}
+
+		// Ignore the empty match iff the previous loop found the empty match.
+		val, keylen, priority, more := r.lookupOpts(s[i:], prevMatchEmpty, afterWord)
+		if !final && (more || i == len(s)) {
+			// The match at i depends on the text that follows s. A lookup
+			// that ignores the empty match walks the same path as the one
+			// before it, so prevMatchEmpty is always false here.
+			wn, err = sw.WriteText(s[last:i])
+			return i, n + wn, err
+		}
+		match := priority > 0
+		prevMatchEmpty = match && keylen == 0
+		if match {
+			if r.fn != nil {
+				val = r.fn(s[i:i+keylen], offset+i)
+			}
+			wn, err = sw.WriteText(s[last:i])
+			n += wn
+			if err != nil {
+				return
+			}
+			if report != nil {
+				*report = append(*report, Replacement{
+					Index:     (r.size - priority) / 2,
+					InOffset:  offset + i,
+					InLen:     keylen,
+					OutOffset: n,
+					OutLen:    len(val),
+				})
+			}
+			wn, err = sw.WriteText(val)
+			n += wn
+			if err != nil {
+				return
+			}
+			i += keylen
+			last = i
+			continue
+		}
+		i++
+	}
+	if last != len(s) {
+		wn, err = sw.WriteText(s[last:])
+		n += wn
+	}
+	return len(s), n, err
+}
+
 // singleStringReplacer is the implementation that's used when there is only
 // one string to replace (and that string has more than one byte).
 type singleStringReplacer[S String] struct {
@@ -420,8 +686,14 @@ func (r *singleStringReplacer[S]) Replace(s S) S {
 }
 
 func (r *singleStringReplacer[S]) WriteString(w io.Writer, s S) (n int, err error) {
+	_, n, err = r.writePrefix(w, s, 0, 0, true)
+	return
+}
+
+func (r *singleStringReplacer[S]) writePrefix(w io.Writer, s S, start, offset int, final bool) (consumed, n int, err error) {
 	sw := getTextWriter[S](w)
-	var i, wn int
+	var wn int
+	i := start
 	for {
 		match := r.finder.next(s[i:])
 		if match == -1 {
@@ -439,7 +711,18 @@ func (r *singleStringReplacer[S]) WriteString(w io.Writer, s S) (n int, err erro
 		}
 		i += match + len(r.finder.pattern)
 	}
-	wn, err = sw.WriteText(s[i:])
+	consumed = len(s)
+	if !final {
+		// Hold back the longest suffix of s that could begin a match that
+		// ends after s.
+		for k := min(len(r.finder.pattern)-1, len(s)-i); k > 0; k-- {
+			if HasPrefix(r.finder.pattern, s[len(s)-k:]) {
+				consumed -= k
+				break
+			}
+		}
+	}
+	wn, err = sw.WriteText(s[i:consumed])
 	n += wn
 	return
 }
@@ -500,6 +783,11 @@ func (r *byteReplacer[S]) WriteString(w io.Writer, s S) (n int, err error) {
 	return n, nil
 }
 
+func (r *byteReplacer[S]) writePrefix(w io.Writer, s S, start, offset int, final bool) (consumed, n int, err error) {
+	n, err = r.WriteString(w, s[start:])
+	return len(s), n, err
+}
+
 // byteStringReplacer is the implementation that's used when all the
 // "old" values are single ASCII bytes but the "new" values vary in size.
 type byteStringReplacer[S String] struct {
@@ -590,3 +878,8 @@ func (r *byteStringReplacer[S]) WriteString(w io.Writer, s S) (n int, err error)
 	}
 	return
 }
+
+func (r *byteStringReplacer[S]) writePrefix(w io.Writer, s S, start, offset int, final bool) (consumed, n int, err error) {
+	n, err = r.WriteString(w, s[start:])
+	return len(s), n, err
+}
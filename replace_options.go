@@ -0,0 +1,454 @@
+package text
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"unicode"
+
+	"github.com/pgavlin/text/utf8"
+)
+
+// ReplacerOptions controls how a Replacer matches its old strings.
+type ReplacerOptions struct {
+	// IgnoreASCIICase matches old strings without regard to the case of
+	// ASCII letters.
+	IgnoreASCIICase bool
+
+	// IgnoreCase matches old strings under simple Unicode case-folding, as
+	// for EqualFold. It implies IgnoreASCIICase.
+	IgnoreCase bool
+
+	// WholeWord replaces only those matches that do not extend a word, where
+	// a word is a run of letters, digits and underscores. If a match begins
+	// with a word character, it must not be preceded by one, and if it ends
+	// with a word character, it must not be followed by one.
+	WholeWord bool
+
+	// LongestMatch replaces the longest old string that matches at each
+	// position, rather than the first one in argument order. Matches of the
+	// same length are still chosen in argument order. This makes the result
+	// independent of the order of the old strings, for example when they are
+	// taken from a map.
+	LongestMatch bool
+
+	// AllowEmptyOld lets CompileReplacer accept an empty old string, which
+	// matches at every position where no other old string matches and
+	// between adjacent matches. It has no effect on NewReplacer and
+	// NewReplacerWithOptions, which always accept empty old strings.
+	AllowEmptyOld bool
+}
+
+// NewReplacerWithOptions returns a new Replacer from a list of old, new
+// string pairs that matches the old strings as described by opts.
+// Otherwise, it behaves like a Replacer returned by NewReplacer.
+//
+// NewReplacerWithOptions panics if given an odd number of arguments.
+func NewReplacerWithOptions[S String](opts ReplacerOptions, oldnew ...S) *Replacer[S] {
+	r := NewReplacer(oldnew...)
+	r.opts = opts
+	return r
+}
+
+// Errors returned by CompileReplacer, wrapped in a *ReplacerError.
+var (
+	ErrOddArgCount  = errors.New("odd argument count")
+	ErrEmptyOld     = errors.New("empty old string")
+	ErrDuplicateOld = errors.New("duplicate old string")
+	ErrShadowedOld  = errors.New("old string can never match")
+)
+
+// A ReplacerError describes a problem with an old string passed to
+// CompileReplacer.
+type ReplacerError struct {
+	// Index is the index of the old string in the list of old, new pairs,
+	// counting only the old strings, and Old is the old string itself.
+	Index int
+	Old   string
+
+	// Conflict is the index of an earlier old string that duplicates or
+	// shadows Old, or -1.
+	Conflict int
+
+	// Err is one of ErrOddArgCount, ErrEmptyOld, ErrDuplicateOld or
+	// ErrShadowedOld.
+	Err error
+}
+
+func (e *ReplacerError) Error() string {
+	switch e.Err {
+	case ErrOddArgCount:
+		return fmt.Sprintf("text.CompileReplacer: old string %d (%q) has no replacement", e.Index, e.Old)
+	case ErrDuplicateOld:
+		return fmt.Sprintf("text.CompileReplacer: old string %d (%q) duplicates old string %d", e.Index, e.Old, e.Conflict)
+	case ErrShadowedOld:
+		return fmt.Sprintf("text.CompileReplacer: old string %d (%q) is shadowed by old string %d", e.Index, e.Old, e.Conflict)
+	default:
+		return fmt.Sprintf("text.CompileReplacer: old string %d (%q): %v", e.Index, e.Old, e.Err)
+	}
+}
+
+func (e *ReplacerError) Unwrap() error {
+	return e.Err
+}
+
+// CompileReplacer returns a new Replacer from a list of old, new string pairs
+// that matches the old strings as described by opts, like
+// NewReplacerWithOptions. Unlike NewReplacerWithOptions, CompileReplacer
+// checks the list for mistakes, and returns an error instead of a Replacer if
+// it finds any:
+//
+//   - an odd number of arguments, so that the last old string has no
+//     replacement;
+//   - an empty old string, unless opts.AllowEmptyOld is set. An empty old
+//     string is usually an unfilled entry rather than a request to insert
+//     text between every pair of runes;
+//   - an old string that is equal to an earlier one, as compared under opts;
+//   - an old string that can never be replaced, because an earlier old
+//     string is a prefix of it and so always takes precedence. Old strings
+//     are only shadowed in this way if opts.LongestMatch is false.
+//
+// The error joins a *ReplacerError for each mistake.
+func CompileReplacer[S String](opts ReplacerOptions, oldnew ...S) (*Replacer[S], error) {
+	var errs []error
+	report := func(index int, old S, conflict int, err error) {
+		errs = append(errs, &ReplacerError{Index: index, Old: string(old), Conflict: conflict, Err: err})
+	}
+
+	if len(oldnew)%2 == 1 {
+		report(len(oldnew)/2, oldnew[len(oldnew)-1], -1, ErrOddArgCount)
+		oldnew = oldnew[:len(oldnew)-1]
+	}
+
+	// Check for duplicates by comparing canonical forms of the old strings.
+	canonical := make([]string, len(oldnew)/2)
+	seen := make(map[string]int, len(canonical))
+	for i := 0; i < len(oldnew); i += 2 {
+		index, old := i/2, oldnew[i]
+		if len(old) == 0 && !opts.AllowEmptyOld {
+			report(index, old, -1, ErrEmptyOld)
+			continue
+		}
+		c := canonicalKey(old, opts)
+		if prev, ok := seen[c]; ok {
+			report(index, old, prev, ErrDuplicateOld)
+			continue
+		}
+		seen[c], canonical[index] = index, c
+	}
+
+	// An old string is shadowed by an earlier old string that is one of its
+	// prefixes, unless the prefix can fail to match at a word boundary where
+	// the longer old string succeeds. An empty old string shadows nothing,
+	// since the Replacer tries the other old strings after an empty match.
+	if !opts.LongestMatch {
+		for index, c := range canonical {
+			for i := 0; i < len(c); {
+				_, size := utf8.DecodeRune(c[i:])
+				if i += size; i == len(c) {
+					break
+				}
+				prev, ok := seen[c[:i]]
+				if !ok || prev > index {
+					continue
+				}
+				if opts.WholeWord && endsWithWord(c[:i]) {
+					if r, _ := utf8.DecodeRune(c[i:]); isWordRune(r) {
+						continue
+					}
+				}
+				report(index, oldnew[2*index], prev, ErrShadowedOld)
+				break
+			}
+		}
+	}
+
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+	return NewReplacerWithOptions(opts, oldnew...), nil
+}
+
+// canonicalKey returns a string that is equal for old strings that match the
+// same text under opts.
+func canonicalKey[S String](old S, opts ReplacerOptions) string {
+	switch {
+	case opts.IgnoreCase:
+		b := make([]byte, 0, len(old))
+		for i := 0; i < len(old); {
+			r, size := decodeFoldRune(old[i:])
+			if r == utf8.RuneError && size == 1 {
+				b = append(b, old[i])
+			} else {
+				b = utf8.AppendRune(b, foldKey(r))
+			}
+			i += size
+		}
+		return string(b)
+	case opts.IgnoreASCIICase:
+		return string(toLowerASCII(old))
+	default:
+		return string(old)
+	}
+}
+
+func isASCIILetter(c byte) bool {
+	c |= 0x20
+	return 'a' <= c && c <= 'z'
+}
+
+// toLowerASCII returns s with all ASCII letters mapped to lower case.
+func toLowerASCII[S String](s S) S {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return S(b)
+}
+
+// hasPrefixLowerASCII reports whether s begins with prefix, which holds no
+// upper-case ASCII letters, ignoring the case of ASCII letters in s.
+func hasPrefixLowerASCII[S String](s, prefix S) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := 0; i < len(prefix); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if c != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isWordRune reports whether r is a letter, a digit or an underscore.
+func isWordRune(r rune) bool {
+	if r < utf8.RuneSelf {
+		c := byte(r)
+		return isASCIILetter(c) || '0' <= c && c <= '9' || c == '_'
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// endsWithWord reports whether the last rune in s is a word character.
+func endsWithWord[S String](s S) bool {
+	if len(s) == 0 {
+		return false
+	}
+	r, _ := utf8.DecodeLastRune(s)
+	return isWordRune(r)
+}
+
+// isWholeWord reports whether match, which is followed by rest and which is
+// preceded by a word character if afterWord is true, does not extend a word.
+func isWholeWord[S String](match, rest S, afterWord bool) bool {
+	if len(match) == 0 {
+		return true
+	}
+	if afterWord {
+		if r, _ := utf8.DecodeRune(match); isWordRune(r) {
+			return false
+		}
+	}
+	if len(rest) > 0 && endsWithWord(match) {
+		if r, _ := utf8.DecodeRune(rest); isWordRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// foldKey returns the smallest rune that is equivalent to r under simple
+// Unicode case-folding.
+func foldKey(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+	k := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		k = min(k, f)
+	}
+	return k
+}
+
+// foldReplacer is the algorithm that's used for case-insensitive matching
+// under simple Unicode case-folding. Because runes that fold to each other
+// may have encodings of different lengths, it cannot use the byte-oriented
+// trie of genericReplacer. Instead, it indexes the keys by their first rune
+// and tries the candidates for each position in the input in turn.
+type foldReplacer[S String] struct {
+	old, new []S
+	fn       func(match S, offset int) S
+	opts     ReplacerOptions
+
+	// ascii and other map the fold key of the first rune of each non-empty
+	// old string to the indices of the old strings that begin with it, in
+	// ascending order.
+	ascii [utf8.RuneSelf][]int
+	other map[rune][]int
+	// empty is the index of the first empty old string, or -1 if there is
+	// none.
+	empty int
+}
+
+func makeFoldReplacer[S String](oldnew []S, fn func(match S, offset int) S, opts ReplacerOptions) *foldReplacer[S] {
+	r := &foldReplacer[S]{fn: fn, opts: opts, other: map[rune][]int{}, empty: -1}
+	for i := 0; i < len(oldnew); i += 2 {
+		k := len(r.old)
+		r.old, r.new = append(r.old, oldnew[i]), append(r.new, oldnew[i+1])
+		if len(oldnew[i]) == 0 {
+			if r.empty < 0 {
+				r.empty = k
+			}
+			continue
+		}
+		first, _ := decodeFoldRune(oldnew[i])
+		if key := foldKey(first); key < utf8.RuneSelf {
+			r.ascii[key] = append(r.ascii[key], k)
+		} else {
+			r.other[key] = append(r.other[key], k)
+		}
+	}
+	return r
+}
+
+// prefixFold reports whether s begins with prefix under simple Unicode
+// case-folding, and if so, the length of the matching prefix of s. more is
+// true if s is a proper prefix of some text that begins with prefix.
+func (r *foldReplacer[S]) prefixFold(s, prefix S) (n int, ok, more bool) {
+	for len(prefix) > 0 {
+		if !utf8.FullRune(s[n:]) {
+			return 0, false, true
+		}
+		sr, ssize := decodeFoldRune(s[n:])
+		pr, psize := decodeFoldRune(prefix)
+		if !equalFoldRune(sr, pr) {
+			return 0, false, false
+		}
+		n, prefix = n+ssize, prefix[psize:]
+	}
+	return n, true, false
+}
+
+// lookup returns the index and length of the first old string that is a
+// prefix of s, or of the longest one if r.opts.LongestMatch is set. more is
+// true if a different old string might match if s were followed by more
+// text. afterWord reports whether s is preceded by a word character.
+func (r *foldReplacer[S]) lookup(s S, ignoreEmpty, afterWord bool) (index, keylen int, found, more bool) {
+	index = len(r.old)
+	if r.empty >= 0 && !ignoreEmpty {
+		index, found = r.empty, true
+	}
+	if !utf8.FullRune(s) {
+		return index, 0, found, len(r.old) > 0
+	}
+
+	first, _ := decodeFoldRune(s)
+	var candidates []int
+	if key := foldKey(first); key < utf8.RuneSelf {
+		candidates = r.ascii[key]
+	} else {
+		candidates = r.other[key]
+	}
+	for _, k := range candidates {
+		n, ok, m := r.prefixFold(s, r.old[k])
+		more = more || m
+		if !ok {
+			continue
+		}
+		if r.opts.WholeWord && !utf8.FullRune(s[n:]) {
+			// Whether the key ends a word depends on the text that follows
+			// s.
+			more = true
+		}
+		better := k < index
+		if r.opts.LongestMatch && found {
+			better = n > keylen || n == keylen && k < index
+		}
+		if better && (!r.opts.WholeWord || isWholeWord(s[:n], s[n:], afterWord)) {
+			index, keylen, found = k, n, true
+		}
+	}
+	return index, keylen, found, more
+}
+
+func (r *foldReplacer[S]) Replace(s S) S {
+	w := appendSliceWriter[S]{b: make([]byte, 0, len(s))}
+	r.WriteString(&w, s)
+	return S(w.b)
+}
+
+func (r *foldReplacer[S]) WriteString(w io.Writer, s S) (n int, err error) {
+	_, n, err = r.writePrefix(w, s, 0, 0, true)
+	return
+}
+
+func (r *foldReplacer[S]) writePrefix(w io.Writer, s S, start, offset int, final bool) (consumed, n int, err error) {
+	return r.replace(w, s, start, offset, final, nil)
+}
+
+// replace implements writePrefix. If report is non-nil, replace appends a
+// record of each replacement to it.
+func (r *foldReplacer[S]) replace(w io.Writer, s S, start, offset int, final bool, report *[]Replacement) (consumed, n int, err error) {
+	sw := getTextWriter[S](w)
+	var wn int
+	var prevMatchEmpty bool
+	last := start
+	for i := start; i <= len(s); {
+		afterWord := r.opts.WholeWord && endsWithWord(s[:i])
+
+		// Ignore the empty match iff the previous loop found the empty match.
+		k, keylen, match, more := r.lookup(s[i:], prevMatchEmpty, afterWord)
+		if !final && (more || i == len(s)) {
+			wn, err = sw.WriteText(s[last:i])
+			return i, n + wn, err
+		}
+		prevMatchEmpty = match && keylen == 0
+		if match {
+			val := r.new[k]
+			if r.fn != nil {
+				val = r.fn(s[i:i+keylen], offset+i)
+			}
+			wn, err = sw.WriteText(s[last:i])
+			n += wn
+			if err != nil {
+				return
+			}
+			if report != nil {
+				*report = append(*report, Replacement{
+					Index:     k,
+					InOffset:  offset + i,
+					InLen:     keylen,
+					OutOffset: n,
+					OutLen:    len(val),
+				})
+			}
+			wn, err = sw.WriteText(val)
+			n += wn
+			if err != nil {
+				return
+			}
+			i += keylen
+			last = i
+			continue
+		}
+		if i == len(s) {
+			break
+		}
+		_, size := decodeFoldRune(s[i:])
+		i += size
+	}
+	if last != len(s) {
+		wn, err = sw.WriteText(s[last:])
+		n += wn
+	}
+	return len(s), n, err
+}
@@ -0,0 +1,293 @@
+package text_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+var replacerOptionsTests = []struct {
+	opts    ReplacerOptions
+	oldnew  []string
+	in, out string
+}{
+	// Case-insensitive matching.
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"a", "1"}, "aAbB", "11bB"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"A", "1", "b", "2"}, "aAbB", "1122"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"a", "[a]", "B", "[b]"}, "aAbB", "[a][a][b][b]"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"a", "1", "A", "2"}, "aA", "11"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"hello", "bye"}, "Hello, HELLO, hElLo!", "bye, bye, bye!"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"foo", "x", "FOOBAR", "y"}, "FooBar foo", "xBar x"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"FOOBAR", "y", "foo", "x"}, "FooBar foo", "y x"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"é", "e"}, "éÉ", "eÉ"},
+	{ReplacerOptions{IgnoreASCIICase: true}, []string{"k", "_"}, "kKK", "__K"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"k", "_"}, "kKK", "___"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"é", "e"}, "éÉ", "ee"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"straße", "street"}, "STRAßE Straße", "street street"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"σ", "s"}, "ΣσςX", "sssX"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"a", "1", "AA", "2"}, "aaa", "111"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"AA", "2", "a", "1"}, "aaa", "21"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"", "-", "B", "b"}, "aB", "-a-b-"},
+	{ReplacerOptions{IgnoreCase: true}, []string{"x", "y"}, "", ""},
+	{ReplacerOptions{IgnoreCase: true}, []string{}, "abc", "abc"},
+
+	// Whole-word matching.
+	{ReplacerOptions{WholeWord: true}, []string{"cat", "dog"}, "cat concatenate cat.", "dog concatenate dog."},
+	{ReplacerOptions{WholeWord: true}, []string{"cat", "dog"}, "cats bobcat cat_ cat1 (cat)", "cats bobcat cat_ cat1 (dog)"},
+	{ReplacerOptions{WholeWord: true}, []string{"a", "b"}, "a a ab ba aa", "b b ab ba aa"},
+	{ReplacerOptions{WholeWord: true}, []string{"x", "y"}, "éx xé x", "éx xé y"},
+	{ReplacerOptions{WholeWord: true}, []string{"foo", "x", "foobar", "y"}, "foo foobar foobaz", "x y foobaz"},
+	{ReplacerOptions{WholeWord: true}, []string{"-", "+"}, "a-b - -", "a+b + +"},
+	{ReplacerOptions{WholeWord: true}, []string{"-x", "+y"}, "a-x -xa -x", "a+y -xa +y"},
+	{ReplacerOptions{WholeWord: true}, []string{"abc", "X"}, "abc", "X"},
+	{ReplacerOptions{WholeWord: true, IgnoreASCIICase: true}, []string{"Cat", "dog"}, "CAT concatenate Cat", "dog concatenate dog"},
+	{ReplacerOptions{WholeWord: true, IgnoreCase: true}, []string{"ß", "ss"}, "ß ẞ aß", "ss ss aß"},
+
+	// Longest matches.
+	{ReplacerOptions{LongestMatch: true}, []string{"a", "1", "aa", "2", "aaa", "3"}, "aaaa", "31"},
+	{ReplacerOptions{LongestMatch: true}, []string{"aaa", "3", "aa", "2", "a", "1"}, "aaaa", "31"},
+	{ReplacerOptions{LongestMatch: true}, []string{"a", "1", "aa", "2"}, "aaaaa", "221"},
+	{ReplacerOptions{LongestMatch: true}, []string{"<", "&lt;", "<<", "&laquo;"}, "<<<", "&laquo;&lt;"},
+	{ReplacerOptions{LongestMatch: true}, []string{"ab", "X", "abc", "Y"}, "abd abc", "Xd Y"},
+	{ReplacerOptions{LongestMatch: true}, []string{"a", "1", "a", "2", "ab", "3"}, "aab", "13"},
+	{ReplacerOptions{LongestMatch: true}, []string{"", "-", "a", "1"}, "ab", "1-b-"},
+	{ReplacerOptions{LongestMatch: true, WholeWord: true}, []string{"foo", "x", "foobar", "y"}, "foobar foobaz foo", "y foobaz x"},
+	{ReplacerOptions{LongestMatch: true, IgnoreASCIICase: true}, []string{"A", "1", "ab", "2"}, "aB", "2"},
+	{ReplacerOptions{LongestMatch: true, IgnoreCase: true}, []string{"s", "1", "SS", "2"}, "ſs", "2"},
+}
+
+func TestReplacerOptions(t *testing.T) {
+	for i, tt := range replacerOptionsTests {
+		r := NewReplacerWithOptions(tt.opts, tt.oldnew...)
+		if got := r.Replace(tt.in); got != tt.out {
+			t.Errorf("%d. %+v %q: Replace(%q) = %q, want %q", i, tt.opts, tt.oldnew, tt.in, got, tt.out)
+		}
+		var buf bytes.Buffer
+		if n, err := r.WriteString(&buf, tt.in); n != len(tt.out) || err != nil || buf.String() != tt.out {
+			t.Errorf("%d. %+v %q: WriteString(%q) = %d, %v and wrote %q, want %q", i, tt.opts, tt.oldnew, tt.in, n, err, buf.String(), tt.out)
+		}
+		for _, size := range []int{1, 2, 3} {
+			if got := replaceChunks(r, tt.in, size); got != tt.out {
+				t.Errorf("%d. %+v %q: NewWriter with %d-byte writes of %q wrote %q, want %q", i, tt.opts, tt.oldnew, size, tt.in, got, tt.out)
+			}
+		}
+
+		rb := NewReplacerWithOptions(tt.opts, bytesOf(tt.oldnew)...)
+		if got := string(rb.Replace([]byte(tt.in))); got != tt.out {
+			t.Errorf("%d. %+v %q: Replace([]byte(%q)) = %q, want %q", i, tt.opts, tt.oldnew, tt.in, got, tt.out)
+		}
+	}
+}
+
+func TestReplacerOptionsAlgorithm(t *testing.T) {
+	testCases := []struct {
+		opts   ReplacerOptions
+		oldnew []string
+		want   string
+	}{
+		{ReplacerOptions{IgnoreASCIICase: true}, []string{"a", "b"}, "*text.byteReplacer[string]"},
+		{ReplacerOptions{IgnoreASCIICase: true}, []string{"a", "bc"}, "*text.byteStringReplacer[string]"},
+		{ReplacerOptions{IgnoreASCIICase: true}, []string{"ab", "c"}, "*text.genericReplacer[string]"},
+		{ReplacerOptions{WholeWord: true}, []string{"a", "b"}, "*text.genericReplacer[string]"},
+		{ReplacerOptions{IgnoreCase: true}, []string{"a", "b"}, "*text.foldReplacer[string]"},
+	}
+	for i, tc := range testCases {
+		got := fmt.Sprintf("%T", NewReplacerWithOptions(tc.opts, tc.oldnew...).Replacer())
+		if got != tc.want {
+			t.Errorf("%d. algorithm = %s, want %s", i, got, tc.want)
+		}
+	}
+}
+
+// naiveReplace is a simple implementation of Replacer's options for
+// ASCII text and non-empty old strings.
+func naiveReplace(s string, oldnew []string, opts ReplacerOptions) string {
+	isWord := func(c byte) bool {
+		return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_'
+	}
+	equal := func(a, b string) bool { return a == b }
+	if opts.IgnoreASCIICase || opts.IgnoreCase {
+		equal = strings.EqualFold
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		best := -1
+		for k := 0; k < len(oldnew); k += 2 {
+			old := oldnew[k]
+			if len(s)-i < len(old) || !equal(s[i:i+len(old)], old) {
+				continue
+			}
+			if opts.WholeWord {
+				if i > 0 && isWord(s[i-1]) && isWord(old[0]) {
+					continue
+				}
+				if j := i + len(old); j < len(s) && isWord(s[j]) && isWord(old[len(old)-1]) {
+					continue
+				}
+			}
+			if best < 0 || opts.LongestMatch && len(old) > len(oldnew[best]) {
+				best = k
+			}
+		}
+		if best < 0 {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		b.WriteString(oldnew[best+1])
+		i += len(oldnew[best])
+	}
+	return b.String()
+}
+
+func TestReplacerOptionsRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	word := func(alphabet string, n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+	for range 500 {
+		var oldnew []string
+		for range 1 + rng.Intn(4) {
+			oldnew = append(oldnew, word("abAB-", 1+rng.Intn(3)), word("xyz", rng.Intn(3)))
+		}
+		in := word("abAB- ", rng.Intn(30))
+		for _, opts := range []ReplacerOptions{
+			{},
+			{LongestMatch: true},
+			{LongestMatch: true, WholeWord: true},
+			{LongestMatch: true, IgnoreASCIICase: true},
+			{LongestMatch: true, IgnoreCase: true, WholeWord: true},
+			{IgnoreASCIICase: true},
+			{IgnoreCase: true},
+			{IgnoreASCIICase: true, WholeWord: true},
+			{IgnoreCase: true, WholeWord: true},
+		} {
+			want := naiveReplace(in, oldnew, opts)
+			r := NewReplacerWithOptions(opts, oldnew...)
+			if got := r.Replace(in); got != want {
+				t.Fatalf("%+v %q: Replace(%q) = %q, want %q", opts, oldnew, in, got, want)
+			}
+			if got := replaceChunks(r, in, 1+rng.Intn(4)); got != want {
+				t.Fatalf("%+v %q: NewWriter(%q) wrote %q, want %q", opts, oldnew, in, got, want)
+			}
+		}
+	}
+}
+
+// TestReplacerLongestMatchOrder tests that a LongestMatch Replacer built from
+// a map does not depend on the map's iteration order.
+func TestReplacerLongestMatchOrder(t *testing.T) {
+	escapes := map[string]string{"<": "&lt;", "<<": "&laquo;", "<<<": "&lll;", ">": "&gt;", ">>": "&raquo;", "&": "&amp;"}
+	in := "<<<<<&>>>"
+	want := "&lll;&laquo;&amp;&raquo;&gt;"
+	for range 20 {
+		var oldnew []string
+		for old, new := range escapes {
+			oldnew = append(oldnew, old, new)
+		}
+		r := NewReplacerWithOptions(ReplacerOptions{LongestMatch: true}, oldnew...)
+		if got := r.Replace(in); got != want {
+			t.Fatalf("%q: Replace(%q) = %q, want %q", oldnew, in, got, want)
+		}
+	}
+}
+
+func BenchmarkReplacerIgnoreCase(b *testing.B) {
+	r := NewReplacerWithOptions(ReplacerOptions{IgnoreCase: true}, "quick", "slow", "brown", "red", "lazy", "energetic")
+	s := strings.Repeat("The QUICK Brown fox jumps over the Lazy dog. ", 100)
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		r.Replace(s)
+	}
+}
+
+func TestCompileReplacer(t *testing.T) {
+	type problem struct {
+		index, conflict int
+		err             error
+	}
+	testCases := []struct {
+		opts     ReplacerOptions
+		oldnew   []string
+		problems []problem
+	}{
+		{ReplacerOptions{}, nil, nil},
+		{ReplacerOptions{}, []string{"a", "1", "b", "2", "ab", "3"}, []problem{{2, 0, ErrShadowedOld}}},
+		{ReplacerOptions{}, []string{"ab", "3", "a", "1", "b", "2"}, nil},
+		{ReplacerOptions{}, []string{"a", "1", "A", "2"}, nil},
+		{ReplacerOptions{}, []string{"a", "1", "b"}, []problem{{1, -1, ErrOddArgCount}}},
+		{ReplacerOptions{}, []string{"a", "1", "", "2"}, []problem{{1, -1, ErrEmptyOld}}},
+		{ReplacerOptions{}, []string{"a", "1", "b", "2", "a", "3"}, []problem{{2, 0, ErrDuplicateOld}}},
+		{ReplacerOptions{}, []string{"foo", "1", "foobar", "2", "foobarbaz", "3"}, []problem{{1, 0, ErrShadowedOld}, {2, 0, ErrShadowedOld}}},
+		{ReplacerOptions{LongestMatch: true}, []string{"foo", "1", "foobar", "2", "foo", "3"}, []problem{{2, 0, ErrDuplicateOld}}},
+		{ReplacerOptions{IgnoreASCIICase: true}, []string{"a", "1", "A", "2"}, []problem{{1, 0, ErrDuplicateOld}}},
+		{ReplacerOptions{IgnoreASCIICase: true}, []string{"é", "1", "É", "2"}, nil},
+		{ReplacerOptions{IgnoreCase: true}, []string{"é", "1", "É", "2"}, []problem{{1, 0, ErrDuplicateOld}}},
+		{ReplacerOptions{IgnoreCase: true}, []string{"k", "1", "Key", "2"}, []problem{{1, 0, ErrShadowedOld}}},
+		{ReplacerOptions{WholeWord: true}, []string{"foo", "1", "foobar", "2"}, nil},
+		{ReplacerOptions{WholeWord: true}, []string{"foo", "1", "foo-bar", "2"}, []problem{{1, 0, ErrShadowedOld}}},
+		{ReplacerOptions{WholeWord: true}, []string{"foo-", "1", "foo-bar", "2"}, []problem{{1, 0, ErrShadowedOld}}},
+		{ReplacerOptions{}, []string{"", "1", "a", "2", "a", "3", "x"}, []problem{{3, -1, ErrOddArgCount}, {0, -1, ErrEmptyOld}, {2, 1, ErrDuplicateOld}}},
+		{ReplacerOptions{AllowEmptyOld: true}, []string{"", "1", "a", "2"}, nil},
+		{ReplacerOptions{AllowEmptyOld: true}, []string{"", "1", "a", "2", "", "3"}, []problem{{2, 0, ErrDuplicateOld}}},
+	}
+	for i, tc := range testCases {
+		r, err := CompileReplacer(tc.opts, tc.oldnew...)
+		if len(tc.problems) == 0 {
+			if r == nil || err != nil {
+				t.Errorf("%d. CompileReplacer(%+v, %q) = %v, %v; want a Replacer", i, tc.opts, tc.oldnew, r, err)
+			}
+			continue
+		}
+		if r != nil || err == nil {
+			t.Errorf("%d. CompileReplacer(%+v, %q) = %v, %v; want an error", i, tc.opts, tc.oldnew, r, err)
+			continue
+		}
+		errs := err.(interface{ Unwrap() []error }).Unwrap()
+		if len(errs) != len(tc.problems) {
+			t.Errorf("%d. CompileReplacer(%+v, %q): got errors %v; want %d", i, tc.opts, tc.oldnew, errs, len(tc.problems))
+			continue
+		}
+		for j, p := range tc.problems {
+			var re *ReplacerError
+			if !errors.As(errs[j], &re) || re.Index != p.index || re.Conflict != p.conflict || !errors.Is(re, p.err) {
+				t.Errorf("%d. CompileReplacer(%+v, %q): error %d is %v; want %+v", i, tc.opts, tc.oldnew, j, errs[j], p)
+			}
+		}
+	}
+}
+
+func TestCompileReplacerError(t *testing.T) {
+	_, err := CompileReplacer(ReplacerOptions{}, "fo", "1", "foo", "2")
+	if want := `text.CompileReplacer: old string 1 ("foo") is shadowed by old string 0`; err == nil || err.Error() != want {
+		t.Errorf("CompileReplacer: got error %v; want %v", err, want)
+	}
+	if !errors.Is(err, ErrShadowedOld) {
+		t.Errorf("CompileReplacer: error %v is not ErrShadowedOld", err)
+	}
+
+	r, err := CompileReplacer(ReplacerOptions{}, []byte("a"), []byte("1"))
+	if err != nil {
+		t.Fatalf("CompileReplacer: unexpected error %v", err)
+	}
+	if got := string(r.Replace([]byte("banana"))); got != "b1n1n1" {
+		t.Errorf("Replace = %q; want %q", got, "b1n1n1")
+	}
+
+	r2, err := CompileReplacer(ReplacerOptions{AllowEmptyOld: true}, "", "-", "a", "1")
+	if err != nil {
+		t.Fatalf("CompileReplacer: unexpected error %v", err)
+	}
+	if got := r2.Replace("ab"); got != "-1-b-" {
+		t.Errorf("Replace = %q; want %q", got, "-1-b-")
+	}
+}
@@ -0,0 +1,66 @@
+package text
+
+import "io"
+
+// A Replacement records a replacement performed by a Replacer.
+type Replacement struct {
+	// Index is the index of the old string that matched in the list of
+	// strings passed to the Replacer's constructor, counting only the old
+	// strings. For a Replacer returned by NewReplacer, the old string is
+	// thus oldnew[2*Index].
+	Index int
+
+	// InOffset and InLen are the byte offset and length of the match in the
+	// input.
+	InOffset, InLen int
+
+	// OutOffset and OutLen are the byte offset and length of the
+	// replacement in the output.
+	OutOffset, OutLen int
+}
+
+// reporter is implemented by replacement algorithms that can report the
+// replacements they perform.
+type reporter[S String] interface {
+	replace(w io.Writer, s S, start, offset int, final bool, report *[]Replacement) (consumed, n int, err error)
+}
+
+func (r *Replacer[S]) buildReporter() {
+	r.once.Do(r.buildOnce)
+	if rep, ok := r.r.(reporter[S]); ok {
+		r.reporter = rep
+		return
+	}
+	// The faster algorithms produce the same results as genericReplacer,
+	// but do not track which old string matched.
+	r.reporter = makeGenericReplacer(r.oldnew, r.fn, r.opts)
+}
+
+// ReplaceWithReport returns a copy of s with all replacements performed,
+// along with a record of each replacement in the order in which they were
+// performed.
+func (r *Replacer[S]) ReplaceWithReport(s S) (S, []Replacement) {
+	r.reportOnce.Do(r.buildReporter)
+	w := appendSliceWriter[S]{b: make([]byte, 0, len(s))}
+	var report []Replacement
+	r.reporter.replace(&w, s, 0, 0, true, &report)
+	return S(w.b), report
+}
+
+// ReplaceCounts returns a copy of s with all replacements performed, along
+// with the number of times each old string was replaced, indexed as for
+// Replacement.Index.
+func (r *Replacer[S]) ReplaceCounts(s S) (S, []int) {
+	out, report := r.ReplaceWithReport(s)
+	var counts []int
+	switch rep := r.reporter.(type) {
+	case *genericReplacer[S]:
+		counts = make([]int, rep.size/2)
+	case *foldReplacer[S]:
+		counts = make([]int, len(rep.old))
+	}
+	for _, x := range report {
+		counts[x.Index]++
+	}
+	return out, counts
+}
@@ -0,0 +1,65 @@
+package text_test
+
+import (
+	"slices"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+var replaceWithReportTests = []struct {
+	r      *Replacer[string]
+	in     string
+	out    string
+	report []Replacement
+}{
+	{NewReplacer[string](), "abc", "abc", nil},
+	{capitalLetters, "abca", "ABcA", []Replacement{{0, 0, 1, 0, 1}, {1, 1, 1, 1, 1}, {0, 3, 1, 3, 1}}},
+	{NewReplacer("a", "a"), "ba", "ba", []Replacement{{0, 1, 1, 1, 1}}},
+	{htmlEscaper, "<a&b>", "&lt;a&amp;b&gt;", []Replacement{{1, 0, 1, 0, 4}, {0, 2, 1, 5, 5}, {2, 4, 1, 11, 4}}},
+	{NewReplacer("secret", "******"), "a secret, a secret", "a ******, a ******", []Replacement{{0, 2, 6, 2, 6}, {0, 12, 6, 12, 6}}},
+	{NewReplacer("aaa", "3", "aa", "2", "a", "1"), "aaaa", "31", []Replacement{{0, 0, 3, 0, 1}, {2, 3, 1, 1, 1}}},
+	{NewReplacer("a", "1", "a", "2", "xxx", "xxx"), "brad", "br1d", []Replacement{{0, 2, 1, 2, 1}}},
+	{NewReplacer("", "X"), "ab", "XaXbX", []Replacement{{0, 0, 0, 0, 1}, {0, 1, 0, 2, 1}, {0, 2, 0, 4, 1}}},
+	{NewReplacerWithOptions(ReplacerOptions{LongestMatch: true}, "a", "1", "aa", "22"), "aaa", "221", []Replacement{{1, 0, 2, 0, 2}, {0, 2, 1, 2, 1}}},
+	{NewReplacerWithOptions(ReplacerOptions{IgnoreCase: true}, "k", "", "ß", "ss"), "\u212a-\u1e9e", "-ss", []Replacement{{0, 0, 3, 0, 0}, {1, 4, 3, 1, 2}}},
+	{NewReplacerFunc(Repeat[string], "x", "yz"), "xyzx", "yzxxx", []Replacement{{0, 0, 1, 0, 0}, {1, 1, 2, 0, 2}, {0, 3, 1, 2, 3}}},
+}
+
+func TestReplaceWithReport(t *testing.T) {
+	for i, tt := range replaceWithReportTests {
+		out, report := tt.r.ReplaceWithReport(tt.in)
+		if out != tt.out || !slices.Equal(report, tt.report) {
+			t.Errorf("%d. ReplaceWithReport(%q) = %q, %v; want %q, %v", i, tt.in, out, report, tt.out, tt.report)
+		}
+		if out := tt.r.Replace(tt.in); out != tt.out {
+			t.Errorf("%d. Replace(%q) = %q; want %q", i, tt.in, out, tt.out)
+		}
+
+		// Check that the report describes the output.
+		in, last := "", 0
+		for _, x := range report {
+			in += out[last:x.OutOffset] + tt.in[x.InOffset:x.InOffset+x.InLen]
+			last = x.OutOffset + x.OutLen
+		}
+		if in += out[last:]; in != tt.in {
+			t.Errorf("%d. ReplaceWithReport(%q): undoing the replacements gives %q", i, tt.in, in)
+		}
+	}
+}
+
+func TestReplaceCounts(t *testing.T) {
+	r := NewReplacer("password", "***", "token", "***", "key", "***")
+	out, counts := r.ReplaceCounts("password=1 token=2 password=3")
+	if want := "***=1 ***=2 ***=3"; out != want {
+		t.Errorf("ReplaceCounts: out = %q; want %q", out, want)
+	}
+	if want := []int{2, 1, 0}; !slices.Equal(counts, want) {
+		t.Errorf("ReplaceCounts: counts = %v; want %v", counts, want)
+	}
+
+	_, counts = capitalLetters.ReplaceCounts("banana")
+	if want := []int{3, 1}; !slices.Equal(counts, want) {
+		t.Errorf("ReplaceCounts: counts = %v; want %v", counts, want)
+	}
+}
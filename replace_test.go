@@ -7,6 +7,8 @@ package text_test
 import (
 	"bytes"
 	"fmt"
+	"math/rand"
+	"slices"
 	"testing"
 
 	. "github.com/pgavlin/text"
@@ -306,6 +308,157 @@ func TestReplacer(t *testing.T) {
 			t.Errorf("%d. WriteString(%q) wrote correct string but reported %d bytes; want %d (%q)",
 				i, tc.in, n, len(tc.out), tc.out)
 		}
+		for _, size := range []int{1, 2, 3, 7} {
+			if got := replaceChunks(tc.r, tc.in, size); got != tc.out {
+				t.Errorf("%d. NewWriter with %d-byte writes of %q wrote %q, want %q", i, size, tc.in, got, tc.out)
+			}
+		}
+	}
+}
+
+// replaceChunks writes s to r.NewWriter in chunks of the given size and
+// returns the output.
+func replaceChunks(r *Replacer[string], s string, size int) string {
+	var buf bytes.Buffer
+	w := r.NewWriter(&buf)
+	for len(s) > 0 {
+		n := min(size, len(s))
+		w.Write([]byte(s[:n]))
+		s = s[n:]
+	}
+	w.Close()
+	return buf.String()
+}
+
+// TestReplacerWriterRandom tests that the streaming writer agrees with
+// Replace for random inputs split at random points.
+func TestReplacerWriterRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	replacers := []*Replacer[string]{
+		NewReplacer("aab", "X"),
+		NewReplacer("abab", "[$0]"),
+		NewReplacer("a", "1", "aa", "2", "aaa", "3"),
+		NewReplacer("aaa", "3", "aa", "2", "a", "1"),
+		NewReplacer("ab", "X", "abcab", "Y", "b", "Z"),
+		NewReplacer("", "-", "ba", "!", "abba", "?"),
+		NewReplacer("a", "b", "b", "a"),
+		NewReplacer("a", "<a>", "c", ""),
+	}
+	for i, r := range replacers {
+		for range 200 {
+			in := make([]byte, rng.Intn(40))
+			for j := range in {
+				in[j] = "abc"[rng.Intn(3)]
+			}
+			want := r.Replace(string(in))
+
+			var buf bytes.Buffer
+			w := r.NewWriter(&buf)
+			for rest := in; len(rest) > 0; {
+				n := min(rng.Intn(6), len(rest))
+				if m, err := w.Write(rest[:n]); m != n || err != nil {
+					t.Fatalf("%d. Write = %d, %v; want %d, nil", i, m, err, n)
+				}
+				rest = rest[n:]
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("%d. Close: %v", i, err)
+			}
+			if got := buf.String(); got != want {
+				t.Errorf("%d. NewWriter(%q) wrote %q, want %q", i, in, got, want)
+			}
+		}
+	}
+}
+
+// TestReplacerFunc tests replacers whose values are computed by a callback.
+func TestReplacerFunc(t *testing.T) {
+	vars := map[string]string{"{name}": "<Bob>", "{greeting}": "Hello", "{n}": "3"}
+	var offsets []int
+	r := NewReplacerFunc(func(match string, offset int) string {
+		offsets = append(offsets, offset)
+		return htmlEscaper.Replace(vars[match])
+	}, "{name}", "{greeting}", "{n}")
+
+	in := "{greeting}, {name}! You have {n} new {messages}."
+	want := "Hello, &lt;Bob&gt;! You have 3 new {messages}."
+	wantOffsets := []int{0, 12, 29}
+	if got := r.Replace(in); got != want {
+		t.Errorf("Replace(%q) = %q, want %q", in, got, want)
+	}
+	if !slices.Equal(offsets, wantOffsets) {
+		t.Errorf("Replace(%q): offsets = %v, want %v", in, offsets, wantOffsets)
+	}
+
+	for _, size := range []int{1, 4, 100} {
+		offsets = nil
+		if got := replaceChunks(r, in, size); got != want {
+			t.Errorf("NewWriter with %d-byte writes of %q wrote %q, want %q", size, in, got, want)
+		}
+		if !slices.Equal(offsets, wantOffsets) {
+			t.Errorf("NewWriter with %d-byte writes: offsets = %v, want %v", size, offsets, wantOffsets)
+		}
+	}
+
+	// Keys are matched in argument order, as for NewReplacer.
+	testCases := []struct {
+		old     []string
+		in, out string
+	}{
+		{[]string{"a", "aa"}, "aaa", "[a@0][a@1][a@2]"},
+		{[]string{"aa", "a"}, "aaa", "[aa@0][a@2]"},
+		{[]string{"", "b"}, "ab", "[@0]a[@1][b@1][@2]"},
+		{[]string{"xyz"}, "wxyzxyz", "w[xyz@1][xyz@4]"},
+		{[]string{"x"}, "", ""},
+	}
+	for _, tc := range testCases {
+		r := NewReplacerFunc(func(match []byte, offset int) []byte {
+			return fmt.Appendf(nil, "[%s@%d]", match, offset)
+		}, bytesOf(tc.old)...)
+		if got := string(r.Replace([]byte(tc.in))); got != tc.out {
+			t.Errorf("NewReplacerFunc(%q).Replace(%q) = %q, want %q", tc.old, tc.in, got, tc.out)
+		}
+	}
+}
+
+func bytesOf(s []string) [][]byte {
+	b := make([][]byte, len(s))
+	for i := range s {
+		b[i] = []byte(s[i])
+	}
+	return b
+}
+
+// TestReplacerWriterBuffering tests that the streaming writer holds back no
+// more text than it needs to.
+func TestReplacerWriterBuffering(t *testing.T) {
+	testCases := []struct {
+		r       *Replacer[string]
+		in, out string
+	}{
+		{NewReplacer("hello", "bye"), "say hell", "say "},
+		{NewReplacer("hello", "bye"), "say hello", "say bye"},
+		{NewReplacer("hello", "bye"), "say hellx", "say hellx"},
+		{NewReplacer("a", "1", "abc", "2"), "xxab", "xx"},
+		{NewReplacer("abc", "2", "a", "1"), "xxab", "xx"},
+		{NewReplacer("a", "1", "abc", "2"), "xxabd", "xx1bd"},
+		{capitalLetters, "abc", "ABc"},
+		{htmlEscaper, "<a", "&lt;a"},
+	}
+	for i, tc := range testCases {
+		var buf bytes.Buffer
+		w := tc.r.NewWriter(&buf)
+		w.Write([]byte(tc.in))
+		if got := buf.String(); got != tc.out {
+			t.Errorf("%d. after Write(%q), wrote %q, want %q", i, tc.in, got, tc.out)
+		}
+		w.Close()
+		if got, want := buf.String(), tc.r.Replace(tc.in); got != want {
+			t.Errorf("%d. after Close, wrote %q, want %q", i, got, want)
+		}
+		if _, err := w.Write([]byte("a")); err == nil {
+			t.Errorf("%d. Write after Close succeeded", i)
+		}
 	}
 }
 
@@ -319,6 +472,7 @@ var algorithmTestCases = []struct {
 	{NewReplacer("1", "12"), "*text.byteStringReplacer[string]"},
 	{NewReplacer("", "X"), "*text.genericReplacer[string]"},
 	{NewReplacer("a", "1", "b", "12", "cde", "123"), "*text.genericReplacer[string]"},
+	{NewReplacerFunc(Repeat[string], "ab"), "*text.genericReplacer[string]"},
 }
 
 // TestPickAlgorithm tests that NewReplacer picks the correct algorithm.
@@ -348,6 +502,21 @@ func TestWriteStringError(t *testing.T) {
 	}
 }
 
+// TestReplacerWriterError tests that the streaming writer returns an error
+// received from the underlying io.Writer.
+func TestReplacerWriterError(t *testing.T) {
+	for i, tc := range algorithmTestCases {
+		w := tc.r.NewWriter(errWriter{})
+		_, err := w.Write([]byte("abcabc"))
+		if err == nil {
+			err = w.Close()
+		}
+		if err == nil || err.Error() != "unwritable" {
+			t.Errorf("%d. NewWriter error = %v, want unwritable", i, err)
+		}
+	}
+}
+
 // TestGenericTrieBuilding verifies the structure of the generated trie. There
 // is one node per line, and the key ending with the current line is in the
 // trie if it ends with a "+".
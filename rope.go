@@ -0,0 +1,460 @@
+package text
+
+import (
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/pgavlin/text/internal/bytealg"
+	"github.com/pgavlin/text/utf8"
+)
+
+// ropeChunkSize is the size of the chunks into which NewRope splits its
+// argument. Adjacent leaves whose total length is at most ropeChunkSize are
+// merged when they are joined, which keeps small edits from fragmenting the
+// rope.
+const ropeChunkSize = 1024
+
+// A Rope is an immutable sequence of bytes stored as a balanced tree of
+// chunks. Insert, Delete and Slice return new ropes that share structure with
+// the original in O(log n) time, which makes a Rope suitable for editing large
+// texts.
+//
+// If S is not a string type, a Rope aliases the texts it is built from, which
+// must not be modified afterwards.
+//
+// The zero value for Rope is an empty rope.
+type Rope[S String] struct {
+	root *ropeNode[S]
+}
+
+// A ropeNode is either a leaf holding a non-empty chunk of text or an
+// interior node with two non-nil children. The tree is kept height-balanced
+// as in an AVL tree.
+type ropeNode[S String] struct {
+	left, right *ropeNode[S]
+	leaf        S
+	length      int
+	height      int
+}
+
+// NewRope returns a new Rope holding s.
+func NewRope[S String](s S) Rope[S] {
+	return Rope[S]{root: buildRope(s)}
+}
+
+// buildRope returns a perfectly balanced tree of the chunks of s.
+func buildRope[S String](s S) *ropeNode[S] {
+	if len(s) <= ropeChunkSize {
+		return ropeLeaf(s)
+	}
+	mid := (len(s)/ropeChunkSize + 1) / 2 * ropeChunkSize
+	return ropeConcat(buildRope(s[:mid]), buildRope(s[mid:]))
+}
+
+func ropeLeaf[S String](s S) *ropeNode[S] {
+	if len(s) == 0 {
+		return nil
+	}
+	return &ropeNode[S]{leaf: s, length: len(s)}
+}
+
+func (n *ropeNode[S]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.length
+}
+
+// ropeConcat returns a new interior node with the given children, which must
+// be non-nil.
+func ropeConcat[S String](left, right *ropeNode[S]) *ropeNode[S] {
+	return &ropeNode[S]{
+		left:   left,
+		right:  right,
+		length: left.length + right.length,
+		height: max(left.height, right.height) + 1,
+	}
+}
+
+// ropeBalance returns the concatenation of left and right, whose heights
+// differ by at most two, rotating as necessary to restore balance.
+func ropeBalance[S String](left, right *ropeNode[S]) *ropeNode[S] {
+	switch {
+	case left.height > right.height+1:
+		if left.left.height >= left.right.height {
+			return ropeConcat(left.left, ropeConcat(left.right, right))
+		}
+		lr := left.right
+		return ropeConcat(ropeConcat(left.left, lr.left), ropeConcat(lr.right, right))
+	case right.height > left.height+1:
+		if right.right.height >= right.left.height {
+			return ropeConcat(ropeConcat(left, right.left), right.right)
+		}
+		rl := right.left
+		return ropeConcat(ropeConcat(left, rl.left), ropeConcat(rl.right, right.right))
+	default:
+		return ropeConcat(left, right)
+	}
+}
+
+// ropeJoin returns the concatenation of two balanced trees of arbitrary
+// heights in time proportional to the difference of their heights.
+func ropeJoin[S String](left, right *ropeNode[S]) *ropeNode[S] {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.height == 0 && right.height == 0 && left.length+right.length <= ropeChunkSize:
+		return ropeLeaf(Concat(left.leaf, right.leaf))
+	case left.height > right.height+1:
+		return ropeBalance(left.left, ropeJoin(left.right, right))
+	case right.height > left.height+1:
+		return ropeBalance(ropeJoin(left, right.left), right.right)
+	default:
+		return ropeConcat(left, right)
+	}
+}
+
+// ropeSplit splits n into trees holding its first i bytes and the rest.
+func ropeSplit[S String](n *ropeNode[S], i int) (left, right *ropeNode[S]) {
+	switch {
+	case i <= 0:
+		return nil, n
+	case i >= n.len():
+		return n, nil
+	case n.height == 0:
+		return ropeLeaf(n.leaf[:i]), ropeLeaf(n.leaf[i:])
+	case i < n.left.length:
+		ll, lr := ropeSplit(n.left, i)
+		return ll, ropeJoin(lr, n.right)
+	default:
+		rl, rr := ropeSplit(n.right, i-n.left.length)
+		return ropeJoin(n.left, rl), rr
+	}
+}
+
+// Len returns the length of the rope in bytes.
+func (r Rope[S]) Len() int {
+	return r.root.len()
+}
+
+// At returns the byte at index i. At panics if i is out of range.
+func (r Rope[S]) At(i int) byte {
+	if i < 0 || i >= r.Len() {
+		panic("text.Rope.At: index out of range")
+	}
+	n := r.root
+	for n.height > 0 {
+		if i < n.left.length {
+			n = n.left
+		} else {
+			i, n = i-n.left.length, n.right
+		}
+	}
+	return n.leaf[i]
+}
+
+// Concat returns the concatenation of r and other.
+func (r Rope[S]) Concat(other Rope[S]) Rope[S] {
+	return Rope[S]{root: ropeJoin(r.root, other.root)}
+}
+
+// Insert returns a rope with s inserted before the byte at index i.
+// Insert panics if i is out of range.
+func (r Rope[S]) Insert(i int, s S) Rope[S] {
+	if i < 0 || i > r.Len() {
+		panic("text.Rope.Insert: index out of range")
+	}
+	left, right := ropeSplit(r.root, i)
+	return Rope[S]{root: ropeJoin(ropeJoin(left, buildRope(s)), right)}
+}
+
+// Delete returns a rope with the bytes in [i, j) removed.
+// Delete panics if the range is out of bounds.
+func (r Rope[S]) Delete(i, j int) Rope[S] {
+	if i < 0 || j < i || j > r.Len() {
+		panic("text.Rope.Delete: range out of bounds")
+	}
+	left, rest := ropeSplit(r.root, i)
+	_, right := ropeSplit(rest, j-i)
+	return Rope[S]{root: ropeJoin(left, right)}
+}
+
+// Slice returns a rope holding the bytes in [i, j).
+// Slice panics if the range is out of bounds.
+func (r Rope[S]) Slice(i, j int) Rope[S] {
+	if i < 0 || j < i || j > r.Len() {
+		panic("text.Rope.Slice: range out of bounds")
+	}
+	_, rest := ropeSplit(r.root, i)
+	mid, _ := ropeSplit(rest, j-i)
+	return Rope[S]{root: mid}
+}
+
+// Chunks returns an iterator over the chunks of the rope, in order. The
+// chunks are non-empty and share memory with the rope.
+func (r Rope[S]) Chunks() iter.Seq[S] {
+	return func(yield func(S) bool) {
+		r.chunksFrom(0, func(_ int, c S) bool { return yield(c) })
+	}
+}
+
+// chunksFrom calls yield with each chunk of the rope that ends after offset
+// from, sliced to begin no earlier than from, along with the chunk's offset.
+// chunksFrom stops if yield returns false.
+func (r Rope[S]) chunksFrom(from int, yield func(offset int, c S) bool) {
+	var walk func(n *ropeNode[S], base int) bool
+	walk = func(n *ropeNode[S], base int) bool {
+		switch {
+		case n == nil || base+n.length <= from:
+			return true
+		case n.height == 0:
+			i := max(from-base, 0)
+			return yield(base+i, n.leaf[i:])
+		default:
+			return walk(n.left, base) && walk(n.right, base+n.left.length)
+		}
+	}
+	walk(r.root, 0)
+}
+
+// Text returns the contents of the rope as an S.
+func (r Rope[S]) Text() S {
+	var b Builder[S]
+	b.Grow(r.Len())
+	for c := range r.Chunks() {
+		b.WriteText(c)
+	}
+	return b.Text()
+}
+
+// String returns the contents of the rope as a string.
+func (r Rope[S]) String() string {
+	var b Builder[string]
+	b.Grow(r.Len())
+	for c := range r.Chunks() {
+		b.WriteString(bytealg.AsString(c))
+	}
+	return b.String()
+}
+
+// WriteTo implements the io.WriterTo interface.
+func (r Rope[S]) WriteTo(w io.Writer) (n int64, err error) {
+	r.chunksFrom(0, func(_ int, c S) bool {
+		var m int
+		m, err = io.WriteString(w, bytealg.AsString(c))
+		n += int64(m)
+		if m != len(c) && err == nil {
+			err = io.ErrShortWrite
+		}
+		return err == nil
+	})
+	return n, err
+}
+
+// Index returns the index of the first instance of substr in the rope, or -1
+// if substr is not present. Matches may span chunk boundaries.
+func (r Rope[S]) Index(substr S) int {
+	return r.indexFrom(substr, 0)
+}
+
+// indexFrom returns the index of the first instance of substr in the rope at
+// or after offset from, or -1.
+func (r Rope[S]) indexFrom(substr S, from int) int {
+	m := len(substr)
+	if m == 0 {
+		return from
+	}
+
+	// window holds the last m-1 bytes preceding the current chunk. A match
+	// that spans chunks begins in window and ends in the chunk.
+	var window, buf []byte
+	result := -1
+	r.chunksFrom(from, func(offset int, c S) bool {
+		if len(window) > 0 {
+			buf = append(append(buf[:0], window...), c[:min(len(c), m-1)]...)
+			if i := Index(buf, substr); i >= 0 && i < len(window) {
+				result = offset - len(window) + i
+				return false
+			}
+		}
+		if i := Index(c, substr); i >= 0 {
+			result = offset + i
+			return false
+		}
+		window = append(window, c[max(len(c)-(m-1), 0):]...)
+		if len(window) > m-1 {
+			window = append(window[:0], window[len(window)-(m-1):]...)
+		}
+		return true
+	})
+	return result
+}
+
+// Contains reports whether substr is within the rope.
+func (r Rope[S]) Contains(substr S) bool {
+	return r.Index(substr) >= 0
+}
+
+// Count counts the number of non-overlapping instances of substr in the rope.
+// If substr is empty, Count returns 1 + the number of Unicode code points in
+// the rope.
+func (r Rope[S]) Count(substr S) int {
+	if len(substr) == 0 {
+		n, rd := 1, r.NewReader()
+		for {
+			if _, _, err := rd.ReadRune(); err != nil {
+				return n
+			}
+			n++
+		}
+	}
+	n := 0
+	for i := 0; ; n++ {
+		j := r.indexFrom(substr, i)
+		if j < 0 {
+			return n
+		}
+		i = j + len(substr)
+	}
+}
+
+// NewReader returns a RopeReader reading from the rope.
+func (r Rope[S]) NewReader() *RopeReader[S] {
+	return &RopeReader[S]{rope: r}
+}
+
+// A RopeReader implements the io.Reader, io.ByteReader, io.RuneReader and
+// io.WriterTo interfaces by reading from a Rope.
+type RopeReader[S String] struct {
+	rope  Rope[S]
+	i     int // current reading index
+	chunk S   // the rest of the chunk containing i
+}
+
+// Len returns the number of bytes of the unread portion of the rope.
+func (r *RopeReader[S]) Len() int {
+	return max(r.rope.Len()-r.i, 0)
+}
+
+// next returns the rest of the chunk containing the reading index, which is
+// empty at the end of the rope.
+func (r *RopeReader[S]) next() S {
+	if len(r.chunk) == 0 && r.i < r.rope.Len() {
+		r.rope.chunksFrom(r.i, func(_ int, c S) bool {
+			r.chunk = c
+			return false
+		})
+	}
+	return r.chunk
+}
+
+// advance advances the reading index by n bytes, which must not exceed the
+// length of the current chunk.
+func (r *RopeReader[S]) advance(n int) {
+	r.chunk = r.chunk[n:]
+	r.i += n
+}
+
+// Read implements the io.Reader interface.
+func (r *RopeReader[S]) Read(b []byte) (n int, err error) {
+	if r.Len() == 0 {
+		return 0, io.EOF
+	}
+	for n < len(b) {
+		c := r.next()
+		if len(c) == 0 {
+			break
+		}
+		m := copy(b[n:], c)
+		r.advance(m)
+		n += m
+	}
+	return n, nil
+}
+
+// ReadByte implements the io.ByteReader interface.
+func (r *RopeReader[S]) ReadByte() (byte, error) {
+	c := r.next()
+	if len(c) == 0 {
+		return 0, io.EOF
+	}
+	r.advance(1)
+	return c[0], nil
+}
+
+// ReadRune implements the io.RuneReader interface. A rune may span chunks.
+func (r *RopeReader[S]) ReadRune() (ch rune, size int, err error) {
+	c := r.next()
+	if len(c) == 0 {
+		return 0, 0, io.EOF
+	}
+	if c[0] < utf8.RuneSelf {
+		r.advance(1)
+		return rune(c[0]), 1, nil
+	}
+	if utf8.FullRune(c) {
+		ch, size = utf8.DecodeRune(c)
+		r.advance(size)
+		return ch, size, nil
+	}
+
+	var buf [utf8.UTFMax]byte
+	n := 0
+	r.rope.chunksFrom(r.i, func(_ int, c S) bool {
+		n += copy(buf[n:], c)
+		return n < len(buf) && !utf8.FullRune(buf[:n])
+	})
+	ch, size = utf8.DecodeRune(buf[:n])
+	for i := size; i > 0; {
+		m := min(i, len(r.next()))
+		r.advance(m)
+		i -= m
+	}
+	return ch, size, nil
+}
+
+// WriteTo implements the io.WriterTo interface.
+func (r *RopeReader[S]) WriteTo(w io.Writer) (n int64, err error) {
+	for {
+		c := r.next()
+		if len(c) == 0 {
+			return n, nil
+		}
+		m, err := io.WriteString(w, bytealg.AsString(c))
+		if m > len(c) {
+			panic("text.RopeReader.WriteTo: invalid WriteString count")
+		}
+		r.advance(m)
+		n += int64(m)
+		if m != len(c) && err == nil {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+// Seek implements the io.Seeker interface. Seeking past the end of the rope
+// is allowed; subsequent reads return io.EOF.
+func (r *RopeReader[S]) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = int64(r.i) + offset
+	case io.SeekEnd:
+		abs = int64(r.rope.Len()) + offset
+	default:
+		return 0, errors.New("text.RopeReader.Seek: invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("text.RopeReader.Seek: negative position")
+	}
+	r.i, r.chunk = int(abs), Empty[S]()
+	return abs, nil
+}
@@ -0,0 +1,245 @@
+package text_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/pgavlin/text"
+)
+
+func checkRope(t *testing.T, what string, r Rope[string], want string) {
+	t.Helper()
+	if got := r.Text(); got != want {
+		t.Fatalf("%s: text = %q, want %q", what, got, want)
+	}
+	if r.Len() != len(want) {
+		t.Fatalf("%s: Len() = %d, want %d", what, r.Len(), len(want))
+	}
+	if !r.Balanced() {
+		t.Fatalf("%s: rope is not balanced", what)
+	}
+}
+
+func TestRope(t *testing.T) {
+	var r Rope[string]
+	checkRope(t, "zero", r, "")
+
+	r = r.Insert(0, "hello world")
+	checkRope(t, "Insert", r, "hello world")
+	r2 := r.Insert(5, ",").Insert(12, "!")
+	checkRope(t, "Insert middle and end", r2, "hello, world!")
+	checkRope(t, "original", r, "hello world")
+	checkRope(t, "Delete", r2.Delete(5, 6), "hello world!")
+	checkRope(t, "Delete all", r2.Delete(0, r2.Len()), "")
+	checkRope(t, "Slice", r2.Slice(7, 12), "world")
+	checkRope(t, "Slice empty", r2.Slice(3, 3), "")
+	checkRope(t, "Concat", r.Concat(NewRope("?")), "hello world?")
+
+	if got := r2.At(4); got != 'o' {
+		t.Errorf("At(4) = %q, want 'o'", got)
+	}
+	if got := r2.String(); got != "hello, world!" {
+		t.Errorf("String() = %q", got)
+	}
+
+	b := NewRope([]byte("abc")).Insert(1, []byte("xy"))
+	if got := string(b.Text()); got != "axybc" {
+		t.Errorf("[]byte rope = %q, want \"axybc\"", got)
+	}
+}
+
+func TestRopePanics(t *testing.T) {
+	r := NewRope("abc")
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"At", func() { r.At(3) }},
+		{"At negative", func() { r.At(-1) }},
+		{"Insert", func() { r.Insert(4, "x") }},
+		{"Delete", func() { r.Delete(2, 4) }},
+		{"Delete reversed", func() { r.Delete(2, 1) }},
+		{"Slice", func() { r.Slice(-1, 2) }},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.f()
+		}()
+	}
+}
+
+func randRopeText(rng *rand.Rand, n int) string {
+	const alphabet = "abcé\n"
+	var b strings.Builder
+	for b.Len() < n {
+		i := rng.Intn(len(alphabet) - 1)
+		if alphabet[i] >= utf8.RuneSelf {
+			b.WriteString("é")
+		} else {
+			b.WriteByte(alphabet[i])
+		}
+	}
+	return b.String()
+}
+
+func TestRopeRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	want := randRopeText(rng, 5000)
+	r := NewRope(want)
+	checkRope(t, "NewRope", r, want)
+
+	for i := 0; i < 500; i++ {
+		switch rng.Intn(3) {
+		case 0:
+			at := rng.Intn(len(want) + 1)
+			s := randRopeText(rng, rng.Intn(3000))
+			r, want = r.Insert(at, s), want[:at]+s+want[at:]
+			checkRope(t, "Insert", r, want)
+		case 1:
+			i := rng.Intn(len(want) + 1)
+			j := i + rng.Intn(min(len(want)-i, 2000)+1)
+			r, want = r.Delete(i, j), want[:i]+want[j:]
+			checkRope(t, "Delete", r, want)
+		case 2:
+			i := rng.Intn(len(want) + 1)
+			j := i + rng.Intn(len(want)-i+1)
+			checkRope(t, "Slice", r.Slice(i, j), want[i:j])
+		}
+		if len(want) > 0 {
+			k := rng.Intn(len(want))
+			if r.At(k) != want[k] {
+				t.Fatalf("At(%d) = %q, want %q", k, r.At(k), want[k])
+			}
+		}
+	}
+}
+
+// fragmentedRope returns a rope holding s whose chunks are short.
+func fragmentedRope(s string) Rope[string] {
+	var r Rope[string]
+	for len(s) > 0 {
+		n := min(len(s), 700)
+		r, s = r.Concat(NewRope(s[:n])), s[n:]
+	}
+	return r
+}
+
+func TestRopeSearch(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	s := randRopeText(rng, 20000)
+	r := fragmentedRope(s)
+	if n := len(collect(r.Chunks())); n < 10 {
+		t.Fatalf("rope has only %d chunks", n)
+	}
+
+	for i := 0; i < 500; i++ {
+		j := rng.Intn(len(s))
+		sub := s[j : j+min(rng.Intn(2000), len(s)-j)]
+		if got, want := r.Index(sub), strings.Index(s, sub); got != want {
+			t.Fatalf("Index(%q) = %d, want %d", sub, got, want)
+		}
+	}
+	for _, sub := range []string{"", "a", "é", "ab", "\n\n", "aaaa", "abcabc", "x"} {
+		if got, want := r.Index(sub), strings.Index(s, sub); got != want {
+			t.Errorf("Index(%q) = %d, want %d", sub, got, want)
+		}
+		if got, want := r.Count(sub), strings.Count(s, sub); got != want {
+			t.Errorf("Count(%q) = %d, want %d", sub, got, want)
+		}
+		if got, want := r.Contains(sub), strings.Contains(s, sub); got != want {
+			t.Errorf("Contains(%q) = %v, want %v", sub, got, want)
+		}
+	}
+
+	// Overlapping candidates at a chunk boundary.
+	r = NewRope(strings.Repeat("a", 1023) + "b").Concat(NewRope(strings.Repeat("a", 1023) + "b"))
+	if got := r.Index("aab"); got != 1021 {
+		t.Errorf("Index(\"aab\") = %d, want 1021", got)
+	}
+	if got := r.Count("ba"); got != 1 {
+		t.Errorf("Count(\"ba\") = %d, want 1", got)
+	}
+}
+
+func TestRopeReader(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	s := randRopeText(rng, 10000)
+	r := fragmentedRope(s)
+
+	got, err := io.ReadAll(struct{ io.Reader }{r.NewReader()})
+	if err != nil || string(got) != s {
+		t.Fatalf("ReadAll: got %d bytes, %v", len(got), err)
+	}
+
+	var buf bytes.Buffer
+	if n, err := r.WriteTo(&buf); n != int64(len(s)) || err != nil || buf.String() != s {
+		t.Fatalf("Rope.WriteTo: got %d, %v", n, err)
+	}
+
+	buf.Reset()
+	rd := r.NewReader()
+	rd.Seek(100, io.SeekStart)
+	if n, err := rd.WriteTo(&buf); n != int64(len(s)-100) || err != nil || buf.String() != s[100:] {
+		t.Fatalf("RopeReader.WriteTo: got %d, %v", n, err)
+	}
+
+	// Runes split across chunks decode correctly.
+	rd = r.NewReader()
+	var runes []rune
+	for {
+		c, size, err := rd.ReadRune()
+		if err != nil {
+			break
+		}
+		if size != utf8.RuneLen(c) {
+			t.Fatalf("ReadRune: size %d for %q", size, c)
+		}
+		runes = append(runes, c)
+	}
+	if string(runes) != s {
+		t.Fatalf("ReadRune: text mismatch")
+	}
+
+	rd = NewRope("ab").NewReader()
+	if c, _ := rd.ReadByte(); c != 'a' {
+		t.Errorf("ReadByte = %q", c)
+	}
+	if rd.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", rd.Len())
+	}
+	if _, err := rd.Seek(-1, io.SeekStart); err == nil {
+		t.Errorf("Seek(-1): got nil error")
+	}
+	if pos, _ := rd.Seek(5, io.SeekCurrent); pos != 6 {
+		t.Errorf("Seek(5, SeekCurrent) = %d, want 6", pos)
+	}
+	if _, err := rd.ReadByte(); err != io.EOF {
+		t.Errorf("ReadByte past end: %v", err)
+	}
+	if _, err := rd.Read(make([]byte, 1)); err != io.EOF {
+		t.Errorf("Read past end: %v", err)
+	}
+}
+
+func TestRopeWriteToError(t *testing.T) {
+	if n, err := fragmentedRope(strings.Repeat("x", 2000)).WriteTo(errWriter{}); n != 0 || err == nil {
+		t.Errorf("WriteTo: got %d, %v", n, err)
+	}
+}
+
+func BenchmarkRopeInsert(b *testing.B) {
+	r := NewRope(strings.Repeat("some text in a large document\n", 100000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r = r.Insert(i*7919%r.Len(), "x")
+	}
+}
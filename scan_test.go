@@ -0,0 +1,275 @@
+// Copyright 2013 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	. "github.com/pgavlin/text"
+)
+
+var scanTests = []string{
+	"",
+	"a",
+	"¼",
+	"☹",
+	"\x81", // UTF-8 error
+	"�",    // correctly encoded RuneError
+	"abcdefgh",
+	"abc def\n\t\tgh    ",
+	"abc¼☹\x81�日本語\x82abc",
+}
+
+func TestScanByte(t *testing.T) {
+	for n, test := range scanTests {
+		s := NewScanner[[]byte](strings.NewReader(test))
+		s.Split(ScanBytes)
+		var i int
+		for i = 0; s.Scan(); i++ {
+			if b := s.Text(); len(b) != 1 || b[0] != test[i] {
+				t.Errorf("#%d: %d: expected %q got %q", n, i, test[i], b)
+			}
+		}
+		if i != len(test) {
+			t.Errorf("#%d: termination expected at %d; got %d", n, len(test), i)
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("#%d: %v", n, err)
+		}
+	}
+}
+
+func TestScanRune(t *testing.T) {
+	for n, test := range scanTests {
+		s := NewScanner[string](strings.NewReader(test))
+		s.Split(ScanRunes)
+		var i, runeCount int
+		var expect rune
+		// Use a string range loop to validate the sequence of runes.
+		for i, expect = range test {
+			if !s.Scan() {
+				break
+			}
+			runeCount++
+			got, _ := utf8.DecodeRuneInString(s.Text())
+			if got != expect {
+				t.Errorf("#%d: %d: expected %q got %q", n, i, expect, got)
+			}
+		}
+		if s.Scan() {
+			t.Errorf("#%d: scan ran too long, got %q", n, s.Text())
+		}
+		testRuneCount := utf8.RuneCountInString(test)
+		if runeCount != testRuneCount {
+			t.Errorf("#%d: termination expected at %d; got %d", n, testRuneCount, runeCount)
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("#%d: %v", n, err)
+		}
+	}
+}
+
+var wordScanTests = []string{
+	"",
+	" ",
+	"\n",
+	"a",
+	" a ",
+	"abc def",
+	" abc def ",
+	" abc\tdef\nghi\rjkl\fmno\vpqr\u0085stu \n",
+	"               　",
+}
+
+func TestScanWords(t *testing.T) {
+	for n, test := range wordScanTests {
+		s := NewScanner[[]byte](strings.NewReader(test))
+		s.Split(ScanWords)
+		words := strings.Fields(test)
+		var wordCount int
+		for wordCount = 0; wordCount < len(words); wordCount++ {
+			if !s.Scan() {
+				break
+			}
+			got := s.Text()
+			if string(got) != words[wordCount] {
+				t.Errorf("#%d: %d: expected %q got %q", n, wordCount, words[wordCount], got)
+			}
+		}
+		if s.Scan() {
+			t.Errorf("#%d: scan ran too long, got %q", n, s.Text())
+		}
+		if wordCount != len(words) {
+			t.Errorf("#%d: termination expected at %d; got %d", n, len(words), wordCount)
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("#%d: %v", n, err)
+		}
+	}
+}
+
+// slowReader is a reader that returns only a few bytes at a time, to test the incremental
+// reads in Scanner.Scan.
+type slowReader struct {
+	max int
+	buf io.Reader
+}
+
+func (sr *slowReader) Read(p []byte) (n int, err error) {
+	if len(p) > sr.max {
+		p = p[0:sr.max]
+	}
+	return sr.buf.Read(p)
+}
+
+var lineScanTests = []struct {
+	in  string
+	out []string
+}{
+	{"", nil},
+	{"\n", []string{""}},
+	{"abc", []string{"abc"}},
+	{"abc\n", []string{"abc"}},
+	{"abc\r\ndef\r\n", []string{"abc", "def"}},
+	{"abc\n\ndef", []string{"abc", "", "def"}},
+	{"abc\r", []string{"abc"}},
+	{"a\rb\nc", []string{"a\rb", "c"}},
+}
+
+func TestScanLinesSlow(t *testing.T) {
+	for n, test := range lineScanTests {
+		for _, max := range []int{1, 2, 3, 100} {
+			s := NewScanner[string](&slowReader{max, strings.NewReader(test.in)})
+			s.Buffer(make([]byte, 2), 100)
+			var got []string
+			for s.Scan() {
+				got = append(got, s.Text())
+			}
+			if !eq(got, test.out) {
+				t.Errorf("#%d/%d: got %q, want %q", n, max, got, test.out)
+			}
+			if err := s.Err(); err != nil {
+				t.Errorf("#%d/%d: %v", n, max, err)
+			}
+		}
+	}
+}
+
+func TestScanWordsSlow(t *testing.T) {
+	// Force the multi-byte spaces to be split across reads.
+	for n, test := range wordScanTests {
+		s := NewScanner[string](&slowReader{1, strings.NewReader(test)})
+		s.Split(ScanWords)
+		got := []string{}
+		for s.Scan() {
+			got = append(got, s.Text())
+		}
+		if want := strings.FieldsFunc(test, unicode.IsSpace); !eq(got, want) {
+			t.Errorf("#%d: got %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestScanLineTooLong(t *testing.T) {
+	s := NewScanner[string](strings.NewReader("short\n" + strings.Repeat("x", 100) + "\n"))
+	s.Buffer(nil, 16)
+	if !s.Scan() || s.Text() != "short" {
+		t.Fatalf("first line: got %q, %v", s.Text(), s.Err())
+	}
+	if s.Scan() {
+		t.Fatalf("long line: unexpected token %q", s.Text())
+	}
+	if err := s.Err(); err != ErrTooLong {
+		t.Fatalf("long line: got error %v, want %v", err, ErrTooLong)
+	}
+}
+
+type lineBytes []byte
+
+func TestScanTextCopies(t *testing.T) {
+	s := NewScanner[lineBytes](strings.NewReader("abc\ndef\n"))
+	s.Buffer(make([]byte, 8), 8)
+	var lines []lineBytes
+	for s.Scan() {
+		lines = append(lines, s.Text())
+	}
+	if len(lines) != 2 || string(lines[0]) != "abc" || string(lines[1]) != "def" {
+		t.Errorf("got %q, want [abc def]", lines)
+	}
+}
+
+func TestScanZeroCopy(t *testing.T) {
+	buf := make([]byte, 32)
+	s := NewScanner[string](strings.NewReader("abc\ndef\n"))
+	s.Buffer(buf, len(buf))
+	s.ZeroCopy(true)
+	if !s.Scan() {
+		t.Fatalf("Scan: %v", s.Err())
+	}
+	text := s.Text()
+	if text != "abc" {
+		t.Fatalf("got %q, want %q", text, "abc")
+	}
+	if n := testing.AllocsPerRun(10, func() { _ = s.Text() }); n != 0 {
+		t.Errorf("Text in zero-copy mode allocated %v times", n)
+	}
+	buf[0] = 'x'
+	if text != "xbc" {
+		t.Errorf("zero-copy token does not alias the buffer: got %q", text)
+	}
+}
+
+func TestScanZeroCopyAfterScanPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("ZeroCopy after Scan did not panic")
+		}
+	}()
+	s := NewScanner[string](strings.NewReader("abc"))
+	s.Scan()
+	s.ZeroCopy(true)
+}
+
+var errTestFinal = errors.New("final")
+
+func TestScanFinalToken(t *testing.T) {
+	s := NewScanner[string](strings.NewReader("a,b,STOP,c"))
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		before, _, found := Cut(data, ",")
+		if !found && !atEOF {
+			return 0, nil, nil
+		}
+		if string(before) == "STOP" {
+			return 0, nil, ErrFinalToken
+		}
+		return len(before) + 1, before, nil
+	})
+	var got []string
+	for s.Scan() {
+		got = append(got, s.Text())
+	}
+	if want := []string{"a", "b"}; !eq(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestScanSplitError(t *testing.T) {
+	s := NewScanner[string](strings.NewReader("abc"))
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) { return 0, nil, errTestFinal })
+	if s.Scan() {
+		t.Error("Scan succeeded")
+	}
+	if err := s.Err(); err != errTestFinal {
+		t.Errorf("got error %v, want %v", err, errTestFinal)
+	}
+}
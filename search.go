@@ -4,6 +4,12 @@
 
 package text
 
+import (
+	"iter"
+
+	"github.com/pgavlin/text/utf8"
+)
+
 // stringFinder efficiently finds strings in a source text. It's implemented
 // using the Boyer-Moore string search algorithm:
 // https://en.wikipedia.org/wiki/Boyer-Moore_string_search_algorithm
@@ -116,6 +122,113 @@ func (f *stringFinder[S]) next(text S) int {
 	return -1
 }
 
+// last returns the index in text of the last occurrence of the pattern that
+// was reversed to build f. If the pattern is not found, it returns -1.
+//
+// last mirrors next: it walks text from the end as if it were reversed, which
+// lets the reversed pattern's skip tables drive a right-to-left search.
+func (f *stringFinder[S]) last(text S) int {
+	n := len(text)
+	i := len(f.pattern) - 1
+	for i < n {
+		// Compare backwards from the end until the first unmatching character.
+		j := len(f.pattern) - 1
+		for j >= 0 && text[n-1-i] == f.pattern[j] {
+			i--
+			j--
+		}
+		if j < 0 {
+			return n - i - 1 - len(f.pattern) // match
+		}
+		i += max(f.badCharSkip[text[n-1-i]], f.goodSuffixSkip[j])
+	}
+	return -1
+}
+
+// A Finder efficiently finds a fixed pattern in texts of type S. The
+// Boyer-Moore tables for the pattern are computed once by NewFinder, so a
+// Finder is well suited to searching for the same pattern in many texts.
+// It is safe for concurrent use by multiple goroutines.
+type Finder[S String] struct {
+	fwd *stringFinder[S]
+	rev *stringFinder[S]
+}
+
+// NewFinder returns a Finder that searches texts of type S for pattern.
+func NewFinder[S, P String](pattern P) *Finder[S] {
+	p := make([]byte, len(pattern))
+	copy(p, pattern)
+	r := make([]byte, len(p))
+	for i, c := range p {
+		r[len(r)-1-i] = c
+	}
+	return &Finder[S]{
+		fwd: makeStringFinder(S(p)),
+		rev: makeStringFinder(S(r)),
+	}
+}
+
+// Pattern returns the pattern that f searches for.
+func (f *Finder[S]) Pattern() S {
+	return f.fwd.pattern
+}
+
+// Index returns the index of the first instance of f's pattern in text, or
+// -1 if the pattern is not present in text.
+func (f *Finder[S]) Index(text S) int {
+	return f.fwd.next(text)
+}
+
+// LastIndex returns the index of the last instance of f's pattern in text,
+// or -1 if the pattern is not present in text.
+func (f *Finder[S]) LastIndex(text S) int {
+	return f.rev.last(text)
+}
+
+// IndexAll returns an iterator over the indices of the non-overlapping
+// instances of f's pattern in text, in increasing order. If the pattern is
+// empty, the iterator yields the index of each UTF-8 sequence in text
+// followed by len(text).
+func (f *Finder[S]) IndexAll(text S) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if len(f.fwd.pattern) == 0 {
+			for i := 0; i < len(text); {
+				if !yield(i) {
+					return
+				}
+				_, size := utf8.DecodeRune(text[i:])
+				i += size
+			}
+			yield(len(text))
+			return
+		}
+		for i := 0; i <= len(text)-len(f.fwd.pattern); {
+			m := f.fwd.next(text[i:])
+			if m < 0 {
+				return
+			}
+			if !yield(i + m) {
+				return
+			}
+			i += m + len(f.fwd.pattern)
+		}
+	}
+}
+
+// Count counts the number of non-overlapping instances of f's pattern in
+// text. If the pattern is empty, Count returns 1 + the number of Unicode code
+// points in text.
+func (f *Finder[S]) Count(text S) int {
+	if len(f.fwd.pattern) == 0 {
+		return utf8.RuneCount(text) + 1
+	}
+	n := 0
+	for range f.IndexAll(text) {
+		n++
+	}
+	return n
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
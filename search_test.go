@@ -5,7 +5,11 @@
 package text_test
 
 import (
+	"math/rand"
 	"reflect"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
 	. "github.com/pgavlin/text"
@@ -89,3 +93,94 @@ func TestFinderCreation(t *testing.T) {
 		}
 	}
 }
+
+func TestFinder(t *testing.T) {
+	for _, tc := range indexTests {
+		f := NewFinder[string](tc.sep)
+		if got, want := f.Index(tc.s), strings.Index(tc.s, tc.sep); got != want {
+			t.Errorf("NewFinder(%q).Index(%q) = %d; want %d", tc.sep, tc.s, got, want)
+		}
+		if got, want := f.LastIndex(tc.s), strings.LastIndex(tc.s, tc.sep); got != want {
+			t.Errorf("NewFinder(%q).LastIndex(%q) = %d; want %d", tc.sep, tc.s, got, want)
+		}
+		if got, want := f.Count(tc.s), strings.Count(tc.s, tc.sep); got != want {
+			t.Errorf("NewFinder(%q).Count(%q) = %d; want %d", tc.sep, tc.s, got, want)
+		}
+	}
+	for _, tc := range lastIndexTests {
+		f := NewFinder[[]byte](tc.sep)
+		if got := f.LastIndex([]byte(tc.s)); got != tc.out {
+			t.Errorf("NewFinder(%q).LastIndex([]byte(%q)) = %d; want %d", tc.sep, tc.s, got, tc.out)
+		}
+	}
+}
+
+func TestFinderRandom(t *testing.T) {
+	const chars = "abc"
+	random := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = chars[rand.Intn(len(chars))]
+		}
+		return string(b)
+	}
+	for i := 0; i < 1000; i++ {
+		s, sep := random(rand.Intn(64)), random(rand.Intn(5))
+		f := NewFinder[string](sep)
+		if got, want := f.Index(s), strings.Index(s, sep); got != want {
+			t.Fatalf("NewFinder(%q).Index(%q) = %d; want %d", sep, s, got, want)
+		}
+		if got, want := f.LastIndex(s), strings.LastIndex(s, sep); got != want {
+			t.Fatalf("NewFinder(%q).LastIndex(%q) = %d; want %d", sep, s, got, want)
+		}
+		if got, want := f.Count(s), strings.Count(s, sep); got != want {
+			t.Fatalf("NewFinder(%q).Count(%q) = %d; want %d", sep, s, got, want)
+		}
+	}
+}
+
+func TestFinderIndexAll(t *testing.T) {
+	testCases := []struct {
+		pat, text string
+		want      []int
+	}{
+		{"", "", []int{0}},
+		{"", "a☺b", []int{0, 1, 4, 5}},
+		{"abc", "", nil},
+		{"aa", "aaaaa", []int{0, 2}},
+		{"nan", "bananananana", []int{2, 6}},
+		{"x", "axbxcx", []int{1, 3, 5}},
+	}
+	for _, tc := range testCases {
+		got := slices.Collect(NewFinder[[]byte](tc.pat).IndexAll([]byte(tc.text)))
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("NewFinder(%q).IndexAll(%q) = %v; want %v", tc.pat, tc.text, got, tc.want)
+		}
+	}
+}
+
+func TestFinderConcurrent(t *testing.T) {
+	f := NewFinder[string]("needle")
+	text := strings.Repeat("hay", 100) + "needle" + strings.Repeat("hay", 100)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := f.Index(text); got != 300 {
+				t.Errorf("Index = %d; want 300", got)
+			}
+			if got := f.LastIndex(text); got != 300 {
+				t.Errorf("LastIndex = %d; want 300", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkFinderIndex(b *testing.B) {
+	f := NewFinder[string]("<b>hello world</b>")
+	for i := 0; i < b.N; i++ {
+		f.Index(benchInputHard)
+	}
+}
@@ -1100,6 +1100,45 @@ func ReplaceAll[S1, S2, S3 String](s S1, old S2, new S3) S1 {
 	return Replace(s, old, new, -1)
 }
 
+// ReplaceAllFunc returns a copy of the string s with each non-overlapping
+// instance of old replaced by the result of calling repl on that instance.
+// If old is empty, it matches at the beginning of the string and after each
+// UTF-8 sequence, as for ReplaceAll.
+func ReplaceAllFunc[S1, S2 String](s S1, old S2, repl func(match S1) S1) S1 {
+	var b Builder[S1]
+	start, matched := 0, false
+	for j := 0; ; {
+		if len(old) == 0 {
+			if matched {
+				if j == len(s) {
+					break
+				}
+				_, wid := utf8.DecodeRune(s[j:])
+				j += wid
+			}
+		} else {
+			i := Index(s[j:], old)
+			if i < 0 {
+				break
+			}
+			j += i
+		}
+		if !matched {
+			b.Grow(len(s))
+			matched = true
+		}
+		b.WriteText(s[start:j])
+		b.WriteText(repl(s[j : j+len(old)]))
+		j += len(old)
+		start = j
+	}
+	if !matched {
+		return s // avoid allocation
+	}
+	b.WriteText(s[start:])
+	return b.Text()
+}
+
 // EqualFold reports whether s and t, interpreted as UTF-8 strings,
 // are equal under simple Unicode case-folding, which is a more general
 // form of case-insensitivity.
@@ -1150,41 +1189,42 @@ hasUnicode:
 		}
 
 		// If they match, keep going; if not, return false.
-
-		// Easy case.
-		if tr == sr {
-			continue
-		}
-
-		// Make sr < tr to simplify what follows.
-		if tr < sr {
-			tr, sr = sr, tr
-		}
-		// Fast check for ASCII.
-		if tr < utf8.RuneSelf {
-			// ASCII only, sr/tr must be upper/lower case
-			if 'A' <= sr && sr <= 'Z' && tr == sr+'a'-'A' {
-				continue
-			}
+		if !equalFoldRune(sr, tr) {
 			return false
 		}
-
-		// General case. SimpleFold(x) returns the next equivalent rune > x
-		// or wraps around to smaller values.
-		r := unicode.SimpleFold(sr)
-		for r != sr && r < tr {
-			r = unicode.SimpleFold(r)
-		}
-		if r == tr {
-			continue
-		}
-		return false
 	}
 
 	// First string is empty, so check if the second one is also empty.
 	return len(t) == 0
 }
 
+// equalFoldRune reports whether sr and tr are equal under simple Unicode
+// case-folding.
+func equalFoldRune(sr, tr rune) bool {
+	// Easy case.
+	if tr == sr {
+		return true
+	}
+
+	// Make sr < tr to simplify what follows.
+	if tr < sr {
+		tr, sr = sr, tr
+	}
+	// Fast check for ASCII.
+	if tr < utf8.RuneSelf {
+		// ASCII only, sr/tr must be upper/lower case
+		return 'A' <= sr && sr <= 'Z' && tr == sr+'a'-'A'
+	}
+
+	// General case. SimpleFold(x) returns the next equivalent rune > x
+	// or wraps around to smaller values.
+	r := unicode.SimpleFold(sr)
+	for r != sr && r < tr {
+		r = unicode.SimpleFold(r)
+	}
+	return r == tr
+}
+
 // Index returns the index of the first instance of substr in s, or -1 if substr is not present in s.
 func Index[S1, S2 String](s S1, substr S2) int {
 	return strings.Index(bytealg.AsString(s), bytealg.AsString(substr))
@@ -1391,6 +1391,42 @@ func TestReplace(t *testing.T) {
 	}
 }
 
+func TestReplaceAllFunc(t *testing.T) {
+	for _, tt := range ReplaceTests {
+		if tt.n != -1 {
+			continue
+		}
+		s := ReplaceAllFunc(tt.in, tt.old, func(match string) string {
+			if match != tt.old {
+				t.Errorf("ReplaceAllFunc(%q, %q): repl called with %q", tt.in, tt.old, match)
+			}
+			return tt.new
+		})
+		if s != tt.out {
+			t.Errorf("ReplaceAllFunc(%q, %q, %q) = %q, want %q", tt.in, tt.old, tt.new, s, tt.out)
+		}
+	}
+
+	n := 0
+	count := func(match []byte) []byte {
+		n++
+		return fmt.Appendf(nil, "%s%d", bytes.ToUpper(match), n)
+	}
+	if got, want := string(ReplaceAllFunc([]byte("x{a}y{a}{a}"), "{a}", count)), "x{A}1y{A}2{A}3"; got != want {
+		t.Errorf("ReplaceAllFunc = %q, want %q", got, want)
+	}
+
+	s := "no placeholders here"
+	allocs := testing.AllocsPerRun(10, func() {
+		if ReplaceAllFunc(s, "{", ToUpper[string]) != s {
+			t.Error("ReplaceAllFunc changed its input")
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ReplaceAllFunc without matches allocated %v times", allocs)
+	}
+}
+
 var TitleTests = []struct {
 	in, out string
 }{
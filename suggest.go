@@ -0,0 +1,178 @@
+package text
+
+// SuggesterOptions configures a Suggester.
+type SuggesterOptions[S String] struct {
+	// Distance measures how far apart two texts are. It must be a metric:
+	// zero only for equal texts, symmetric, and satisfying the triangle
+	// inequality, or Suggest may miss candidates. If Distance is nil,
+	// Levenshtein is used.
+	//
+	// DamerauLevenshtein does not satisfy the triangle inequality, and should
+	// only be used if an occasional missed suggestion is acceptable.
+	Distance func(a, b S) int
+
+	// IgnoreCase compares texts under simple Unicode case-folding, the same
+	// rules used by EqualFold. Distance is called with folded texts.
+	IgnoreCase bool
+}
+
+// A Suggestion is a candidate returned by Suggester.Suggest.
+type Suggestion[S String] struct {
+	Text     S
+	Distance int
+}
+
+// A Suggester finds the candidates from a fixed dictionary that are closest to
+// a query, for example to suggest corrections for a misspelled flag or key.
+// The candidates are indexed in a BK-tree, so a query compares the query with
+// only a fraction of the dictionary when the maximum distance is small.
+//
+// A Suggester is safe for concurrent use by multiple goroutines.
+type Suggester[S String] struct {
+	root     *bkNode[S]
+	size     int
+	distance func(a, b S) int
+	fold     bool
+}
+
+// A bkNode is a node of a BK-tree. Every candidate in the subtree of the child
+// at distance d is at distance d from the node's key.
+type bkNode[S String] struct {
+	key      S
+	items    []bkItem[S] // candidates whose key is key, in dictionary order
+	children []bkEdge[S]
+}
+
+type bkItem[S String] struct {
+	text  S
+	index int // position in the dictionary
+}
+
+type bkEdge[S String] struct {
+	distance int
+	node     *bkNode[S]
+}
+
+// NewSuggester returns a new Suggester for the given candidates, which are
+// compared as described by opts.
+func NewSuggester[S String](opts SuggesterOptions[S], candidates ...S) *Suggester[S] {
+	s := &Suggester[S]{distance: opts.Distance, fold: opts.IgnoreCase}
+	if s.distance == nil {
+		s.distance = Levenshtein[S, S]
+	}
+	for i, c := range candidates {
+		s.insert(bkItem[S]{text: c, index: i})
+	}
+	return s
+}
+
+// Len returns the number of candidates in the dictionary.
+func (s *Suggester[S]) Len() int {
+	return s.size
+}
+
+// key returns the text that is compared in place of t.
+func (s *Suggester[S]) key(t S) S {
+	if s.fold {
+		return S(canonicalKey(t, ReplacerOptions{IgnoreCase: true}))
+	}
+	return t
+}
+
+func (s *Suggester[S]) insert(item bkItem[S]) {
+	s.size++
+	key := s.key(item.text)
+	if s.root == nil {
+		s.root = &bkNode[S]{key: key, items: []bkItem[S]{item}}
+		return
+	}
+
+	n := s.root
+	for {
+		d := s.distance(key, n.key)
+		if d == 0 {
+			n.items = append(n.items, item)
+			return
+		}
+		var next *bkNode[S]
+		for _, e := range n.children {
+			if e.distance == d {
+				next = e.node
+				break
+			}
+		}
+		if next == nil {
+			n.children = append(n.children, bkEdge[S]{d, &bkNode[S]{key: key, items: []bkItem[S]{item}}})
+			return
+		}
+		n = next
+	}
+}
+
+// Suggest returns up to k candidates at most maxDist from query, closest
+// first. Candidates at the same distance are returned in dictionary order. If
+// k is zero or negative, Suggest returns every candidate within maxDist; if
+// maxDist is negative, the distance is not limited.
+func (s *Suggester[S]) Suggest(query S, k, maxDist int) []Suggestion[S] {
+	if s.root == nil {
+		return nil
+	}
+	if k <= 0 {
+		k = s.size
+	}
+	radius := maxDist
+	if radius < 0 {
+		radius = int(^uint(0) >> 2)
+	}
+
+	// best holds the k closest candidates found so far, ordered by distance
+	// and then dictionary index. Once it is full, only candidates at most as
+	// far as its last element can enter it, so the search radius shrinks.
+	type found struct {
+		item     bkItem[S]
+		distance int
+	}
+	var best []found
+	add := func(f found) {
+		i := len(best)
+		for i > 0 && (best[i-1].distance > f.distance || best[i-1].distance == f.distance && best[i-1].item.index > f.item.index) {
+			i--
+		}
+		if i == k {
+			return
+		}
+		if len(best) < k {
+			best = append(best, found{})
+		}
+		copy(best[i+1:], best[i:])
+		best[i] = f
+		if len(best) == k {
+			radius = min(radius, best[k-1].distance)
+		}
+	}
+
+	q := s.key(query)
+	stack := []*bkNode[S]{s.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		d := s.distance(q, n.key)
+		if d <= radius {
+			for _, item := range n.items {
+				add(found{item, d})
+			}
+		}
+		for _, e := range n.children {
+			if e.distance >= d-radius && e.distance <= d+radius {
+				stack = append(stack, e.node)
+			}
+		}
+	}
+
+	suggestions := make([]Suggestion[S], len(best))
+	for i, f := range best {
+		suggestions[i] = Suggestion[S]{Text: f.item.text, Distance: f.distance}
+	}
+	return suggestions
+}
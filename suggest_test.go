@@ -0,0 +1,165 @@
+package text_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+func formatSuggestions[S String](s []Suggestion[S]) string {
+	out := ""
+	for i, x := range s {
+		if i > 0 {
+			out += " "
+		}
+		out += fmt.Sprintf("%s:%d", x.Text, x.Distance)
+	}
+	return out
+}
+
+func TestSuggester(t *testing.T) {
+	flags := []string{"verbose", "version", "verify", "output", "out", "input", "debug", "Verbose"}
+	s := NewSuggester(SuggesterOptions[string]{}, flags...)
+	if s.Len() != len(flags) {
+		t.Errorf("Len() = %d, want %d", s.Len(), len(flags))
+	}
+
+	tests := []struct {
+		query  string
+		k, max int
+		want   string
+	}{
+		{"verbse", 1, 2, "verbose:1"},
+		{"verbse", 3, 2, "verbose:1 Verbose:2"},
+		{"versoin", 2, -1, "version:2 verbose:3"},
+		{"outptu", 0, 2, "output:2"},
+		{"xyzzy", 3, 2, ""},
+		{"ot", 0, 2, "out:1"},
+		{"deb", 0, -1, "debug:2 out:3 verbose:5 verify:5 input:5 Verbose:5 version:6 output:6"},
+	}
+	for _, tt := range tests {
+		if got := formatSuggestions(s.Suggest(tt.query, tt.k, tt.max)); got != tt.want {
+			t.Errorf("Suggest(%q, %d, %d) = %q, want %q", tt.query, tt.k, tt.max, got, tt.want)
+		}
+	}
+
+	if got := NewSuggester(SuggesterOptions[string]{}).Suggest("x", 1, -1); got != nil {
+		t.Errorf("Suggest on an empty dictionary = %v", got)
+	}
+}
+
+func TestSuggesterOptions(t *testing.T) {
+	s := NewSuggester(SuggesterOptions[[]byte]{IgnoreCase: true},
+		[]byte("Verbose"), []byte("VERSION"), []byte("straße"), []byte("verbose"))
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"VERBOSE", "Verbose:0 verbose:0"},
+		{"version", "VERSION:0"},
+		{"STRASSE", "straße:2"},
+		{"STRAẞE", "straße:0"},
+	}
+	for _, tt := range tests {
+		if got := formatSuggestions(s.Suggest([]byte(tt.query), 2, 2)); got != tt.want {
+			t.Errorf("Suggest(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+
+	// A custom metric: the difference in length.
+	lengths := NewSuggester(SuggesterOptions[string]{
+		Distance: func(a, b string) int { return max(len(a)-len(b), len(b)-len(a)) },
+	}, "a", "bb", "ccc", "dddd")
+	if got, want := formatSuggestions(lengths.Suggest("xx", 2, -1)), "bb:0 a:1"; got != want {
+		t.Errorf("Suggest with a custom metric = %q, want %q", got, want)
+	}
+
+	bytewise := NewSuggester(SuggesterOptions[string]{Distance: LevenshteinBytes[string, string]}, "héllo", "hello")
+	if got, want := formatSuggestions(bytewise.Suggest("hèllo", 0, 1)), "héllo:1"; got != want {
+		t.Errorf("Suggest with LevenshteinBytes = %q, want %q", got, want)
+	}
+}
+
+func TestSuggesterRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	word := func() string {
+		b := make([]byte, 1+rng.Intn(6))
+		for i := range b {
+			b[i] = "abcd"[rng.Intn(4)]
+		}
+		return string(b)
+	}
+	dict := make([]string, 300)
+	for i := range dict {
+		dict[i] = word()
+	}
+	s := NewSuggester(SuggesterOptions[string]{}, dict...)
+
+	for i := 0; i < 200; i++ {
+		q, k, maxDist := word(), rng.Intn(6), rng.Intn(5)-1
+
+		type cand struct {
+			text  string
+			d, ix int
+		}
+		var all []cand
+		for ix, w := range dict {
+			if d := Levenshtein(q, w); maxDist < 0 || d <= maxDist {
+				all = append(all, cand{w, d, ix})
+			}
+		}
+		sort.Slice(all, func(i, j int) bool {
+			return all[i].d < all[j].d || all[i].d == all[j].d && all[i].ix < all[j].ix
+		})
+		if k > 0 && len(all) > k {
+			all = all[:k]
+		}
+		var want []Suggestion[string]
+		for _, c := range all {
+			want = append(want, Suggestion[string]{Text: c.text, Distance: c.d})
+		}
+
+		if got := s.Suggest(q, k, maxDist); formatSuggestions(got) != formatSuggestions(want) {
+			t.Fatalf("Suggest(%q, %d, %d) = %v, want %v", q, k, maxDist, got, want)
+		}
+	}
+}
+
+func TestSuggesterConcurrent(t *testing.T) {
+	s := NewSuggester(SuggesterOptions[string]{IgnoreCase: true}, "alpha", "beta", "gamma", "delta")
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if got := s.Suggest("DELTS", 1, 2); len(got) != 1 || got[0].Text != "delta" {
+					t.Errorf("Suggest = %v", got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkSuggest(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	dict := make([]string, 10000)
+	for i := range dict {
+		w := make([]byte, 4+rng.Intn(8))
+		for j := range w {
+			w[j] = byte('a' + rng.Intn(26))
+		}
+		dict[i] = string(w)
+	}
+	s := NewSuggester(SuggesterOptions[string]{}, dict...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Suggest(dict[i%len(dict)]+"x", 3, 2)
+	}
+}
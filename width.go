@@ -0,0 +1,160 @@
+package text
+
+import (
+	"unicode"
+
+	"github.com/pgavlin/text/grapheme"
+	"github.com/pgavlin/text/internal/bytealg"
+	"github.com/pgavlin/text/utf8"
+)
+
+//go:generate go run gen_width.go
+
+// runeRange is an inclusive range of runes.
+type runeRange struct {
+	lo, hi rune
+}
+
+// isWide reports whether r occupies two columns on its own.
+func isWide(r rune) bool {
+	lo, hi := 0, len(wideRanges)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch {
+		case wideRanges[m].hi < r:
+			lo = m + 1
+		case wideRanges[m].lo > r:
+			hi = m
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// runeWidth returns the number of columns occupied by r on its own.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || r >= 0x7F && r < 0xA0:
+		return 0
+	case r < 0x7F:
+		return 1
+	case r >= 0x1160 && r <= 0x11FF || r >= 0xD7B0 && r <= 0xD7FF:
+		// Hangul medial vowels and final consonants combine with a
+		// preceding leading consonant.
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Zl, unicode.Zp):
+		return 0
+	case isWide(r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// clusterWidth returns the number of columns occupied by the extended
+// grapheme cluster c.
+func clusterWidth[S String](c S) int {
+	width := 0
+	for i := 0; i < len(c); {
+		r, size := utf8.DecodeRune(c[i:])
+		if r == 0xFE0F && width > 0 {
+			// VARIATION SELECTOR-16 requests emoji presentation.
+			width = 2
+		}
+		width = max(width, runeWidth(r))
+		i += size
+	}
+	return width
+}
+
+// DisplayWidth returns the number of columns that s occupies when displayed
+// in a monospaced font, such as in a terminal.
+//
+// Each extended grapheme cluster in s is as wide as its widest rune, so that
+// combining marks add nothing to the width of their base, and emoji
+// sequences count as a single emoji. Runes whose East_Asian_Width property is
+// Wide or Fullwidth and runes with the Emoji_Presentation property are two
+// columns wide. Control characters, nonspacing and enclosing marks and format
+// characters are zero columns wide. All other runes, including those whose
+// East_Asian_Width is Ambiguous, are one column wide.
+func DisplayWidth[S String](s S) int {
+	width := 0
+	for len(s) > 0 {
+		if c := s[0]; c < utf8.RuneSelf && (len(s) == 1 || s[1] < utf8.RuneSelf) {
+			if c >= 0x20 && c < 0x7F {
+				width++
+			}
+			s = s[1:]
+			continue
+		}
+		var cluster S
+		cluster, s = grapheme.FirstGrapheme(s)
+		width += clusterWidth(cluster)
+	}
+	return width
+}
+
+// TruncateWidth returns s truncated to at most maxCols columns, as measured by
+// DisplayWidth. If s is wider than maxCols, TruncateWidth returns the longest
+// prefix of s that consists of whole grapheme clusters and that leaves room
+// for ellipsis, followed by ellipsis. If ellipsis is itself wider than
+// maxCols, it is omitted.
+func TruncateWidth[S1, S2 String](s S1, maxCols int, ellipsis S2) S1 {
+	ellipsisWidth := DisplayWidth(ellipsis)
+	if ellipsisWidth > maxCols {
+		ellipsis, ellipsisWidth = Empty[S2](), 0
+	}
+
+	width, cut := 0, 0
+	for i := 0; i < len(s); {
+		cluster, _ := grapheme.FirstGrapheme(s[i:])
+		width += clusterWidth(cluster)
+		if width > maxCols {
+			var b Builder[S1]
+			b.Grow(cut + len(ellipsis))
+			b.WriteText(s[:cut])
+			b.WriteString(bytealg.AsString(ellipsis))
+			return b.Text()
+		}
+		i += len(cluster)
+		if width <= maxCols-ellipsisWidth {
+			cut = i
+		}
+	}
+	return s
+}
+
+// PadToWidth returns s followed by enough spaces to make it cols columns wide,
+// as measured by DisplayWidth. If s is already at least cols columns wide,
+// PadToWidth returns s unchanged.
+func PadToWidth[S String](s S, cols int) S {
+	n := cols - DisplayWidth(s)
+	if n <= 0 {
+		return s
+	}
+	var b Builder[S]
+	b.Grow(len(s) + n)
+	b.WriteText(s)
+	for ; n > 0; n-- {
+		b.WriteByte(' ')
+	}
+	return b.Text()
+}
+
+// PadLeftToWidth returns s preceded by enough spaces to make it cols columns
+// wide, as measured by DisplayWidth. If s is already at least cols columns
+// wide, PadLeftToWidth returns s unchanged.
+func PadLeftToWidth[S String](s S, cols int) S {
+	n := cols - DisplayWidth(s)
+	if n <= 0 {
+		return s
+	}
+	var b Builder[S]
+	b.Grow(len(s) + n)
+	for ; n > 0; n-- {
+		b.WriteByte(' ')
+	}
+	b.WriteText(s)
+	return b.Text()
+}
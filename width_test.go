@@ -0,0 +1,119 @@
+package text_test
+
+import (
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+var displayWidthTests = []struct {
+	in    string
+	width int
+}{
+	{"", 0},
+	{"abc", 3},
+	{"a\tb\n", 2},
+	{"\x1b[0m", 3},
+	{"e\u0301", 1},
+	{"é", 1},
+	{"日本語", 6},
+	{"가\u11a8", 2},
+	{"\u1100\u1161\u11a8", 2},
+	{"ＡＢ", 4},
+	{"αβ", 2},
+	{"\u200b", 0},
+	{"\U0001f44d", 2},
+	{"\U0001f44d\U0001f3fd", 2},
+	{"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466", 2},
+	{"\U0001f1fa\U0001f1f8", 2},
+	{"\U0001f1fa", 2},
+	{"❤", 1},
+	{"❤\ufe0f", 2},
+	{"1\ufe0f\u20e3", 2},
+	{"a\U0001f44db", 4},
+	{"\xff", 1},
+}
+
+func TestDisplayWidth(t *testing.T) {
+	for _, tt := range displayWidthTests {
+		if got := DisplayWidth(tt.in); got != tt.width {
+			t.Errorf("DisplayWidth(%+q) = %d; want %d", tt.in, got, tt.width)
+		}
+		if got := DisplayWidth([]byte(tt.in)); got != tt.width {
+			t.Errorf("DisplayWidth([]byte(%+q)) = %d; want %d", tt.in, got, tt.width)
+		}
+	}
+}
+
+var truncateWidthTests = []struct {
+	in       string
+	maxCols  int
+	ellipsis string
+	out      string
+}{
+	{"", 0, "...", ""},
+	{"abc", 3, "...", "abc"},
+	{"abcd", 3, "...", "..."},
+	{"abcdef", 5, "...", "ab..."},
+	{"abcdef", 5, "…", "abcd…"},
+	{"abcdef", 2, "...", "ab"},
+	{"abcdef", 0, "", ""},
+	{"日本語", 5, "", "日本"},
+	{"日本語", 5, "…", "日本…"},
+	{"日本語", 4, "…", "日…"},
+	{"e\u0301e\u0301e\u0301", 2, "", "e\u0301e\u0301"},
+	{"a\U0001f469\u200d\U0001f469\u200d\U0001f467b", 2, "", "a"},
+	{"a\U0001f469\u200d\U0001f469\u200d\U0001f467b", 3, "", "a\U0001f469\u200d\U0001f469\u200d\U0001f467"},
+}
+
+func TestTruncateWidth(t *testing.T) {
+	for _, tt := range truncateWidthTests {
+		if got := TruncateWidth(tt.in, tt.maxCols, tt.ellipsis); got != tt.out {
+			t.Errorf("TruncateWidth(%+q, %d, %+q) = %+q; want %+q", tt.in, tt.maxCols, tt.ellipsis, got, tt.out)
+		}
+		if got := TruncateWidth([]byte(tt.in), tt.maxCols, tt.ellipsis); string(got) != tt.out {
+			t.Errorf("TruncateWidth([]byte(%+q), %d, %+q) = %+q; want %+q", tt.in, tt.maxCols, tt.ellipsis, got, tt.out)
+		}
+	}
+}
+
+func TestTruncateWidthDoesNotClobber(t *testing.T) {
+	b := []byte("abcdef")
+	TruncateWidth(b, 4, "!")
+	if string(b) != "abcdef" {
+		t.Errorf("TruncateWidth modified its input: %q", b)
+	}
+}
+
+var padToWidthTests = []struct {
+	in    string
+	cols  int
+	right string
+	left  string
+}{
+	{"", 2, "  ", "  "},
+	{"ab", 4, "ab  ", "  ab"},
+	{"ab", 2, "ab", "ab"},
+	{"abc", 2, "abc", "abc"},
+	{"日本", 5, "日本 ", " 日本"},
+	{"e\u0301", 3, "e\u0301  ", "  e\u0301"},
+}
+
+func TestPadToWidth(t *testing.T) {
+	for _, tt := range padToWidthTests {
+		if got := PadToWidth(tt.in, tt.cols); got != tt.right {
+			t.Errorf("PadToWidth(%+q, %d) = %+q; want %+q", tt.in, tt.cols, got, tt.right)
+		}
+		if got := PadLeftToWidth([]byte(tt.in), tt.cols); string(got) != tt.left {
+			t.Errorf("PadLeftToWidth(%+q, %d) = %+q; want %+q", tt.in, tt.cols, got, tt.left)
+		}
+	}
+}
+
+func BenchmarkDisplayWidth(b *testing.B) {
+	s := "The quick brown fox jumps over the lazy dog. 日本語 \U0001f44d\U0001f3fd"
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		DisplayWidth(s)
+	}
+}
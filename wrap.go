@@ -0,0 +1,212 @@
+package text
+
+import (
+	"github.com/pgavlin/text/grapheme"
+	"github.com/pgavlin/text/utf8"
+)
+
+// WrapOptions controls how Wrap and WriteWrapped reflow text.
+type WrapOptions struct {
+	// BreakLongWords breaks words that do not fit on a line of their own at
+	// grapheme cluster boundaries. If BreakLongWords is false, such words are
+	// placed on lines of their own that are wider than the requested width.
+	BreakLongWords bool
+
+	// PreserveNewlines keeps every newline in the input, so that each input
+	// line is wrapped on its own. If PreserveNewlines is false, single
+	// newlines are treated as spaces, and only paragraphs separated by blank
+	// lines are kept apart.
+	PreserveNewlines bool
+
+	// Indent is written at the start of the first line of each paragraph.
+	Indent string
+
+	// HangingIndent is written at the start of every line of a paragraph
+	// other than the first.
+	HangingIndent string
+
+	// DisplayWidth measures text with DisplayWidth, so that wide characters
+	// count as two columns and combining marks as none. If DisplayWidth is
+	// false, each rune counts as one column.
+	DisplayWidth bool
+}
+
+// wrapper holds the state of a call to WriteWrapped.
+type wrapper[S String] struct {
+	w   Writer[S]
+	n   int
+	err error
+
+	width                     int
+	opts                      *WrapOptions
+	indent, hanging           S
+	indentWidth, hangingWidth int
+
+	// open is true if a line has been started but not yet terminated, and
+	// empty is true if that line holds nothing but its prefix. col is the
+	// width of the open line, and first is true if the next line to be
+	// started is the first line of a paragraph.
+	open, empty, first bool
+	col                int
+}
+
+func (wr *wrapper[S]) write(s S) {
+	if wr.err == nil && len(s) > 0 {
+		n, err := wr.w.WriteText(s)
+		wr.n += n
+		wr.err = err
+	}
+}
+
+func (wr *wrapper[S]) writeString(s string) {
+	wr.write(S(s))
+}
+
+// measure returns the width of s.
+func (wr *wrapper[S]) measure(s S) int {
+	if wr.opts.DisplayWidth {
+		return DisplayWidth(s)
+	}
+	return utf8.RuneCount(s)
+}
+
+// fit returns the longest prefix of s that is at most width wide, and the
+// width of that prefix.
+func (wr *wrapper[S]) fit(s S, width int) (S, int) {
+	i, w := 0, 0
+	for i < len(s) {
+		cluster, _ := grapheme.FirstGrapheme(s[i:])
+		cw := utf8.RuneCount(cluster)
+		if wr.opts.DisplayWidth {
+			cw = clusterWidth(cluster)
+		}
+		if w+cw > width {
+			break
+		}
+		i, w = i+len(cluster), w+cw
+	}
+	return s[:i], w
+}
+
+func (wr *wrapper[S]) startLine() {
+	if wr.first {
+		wr.write(wr.indent)
+		wr.col = wr.indentWidth
+	} else {
+		wr.write(wr.hanging)
+		wr.col = wr.hangingWidth
+	}
+	wr.open, wr.empty, wr.first = true, true, false
+}
+
+func (wr *wrapper[S]) endLine() {
+	if wr.open {
+		wr.writeString("\n")
+		wr.open = false
+	}
+}
+
+// word adds a word to the current paragraph.
+func (wr *wrapper[S]) word(word S) {
+	for len(word) > 0 {
+		if !wr.open {
+			wr.startLine()
+		}
+		sep := 1
+		if wr.empty {
+			sep = 0
+		}
+
+		width := wr.measure(word)
+		if wr.col+sep+width <= wr.width || wr.empty && !wr.opts.BreakLongWords {
+			if sep != 0 {
+				wr.writeString(" ")
+			}
+			wr.write(word)
+			wr.col, wr.empty = wr.col+sep+width, false
+			return
+		}
+
+		// If the word does not fit on a line of its own, break it to fill
+		// the rest of this line.
+		if wr.opts.BreakLongWords && (wr.empty || width > wr.width-wr.hangingWidth) {
+			head, headWidth := wr.fit(word, wr.width-wr.col-sep)
+			if len(head) == 0 && wr.empty {
+				// Always make progress, even if the line is too narrow to
+				// hold a single character.
+				head, _ = grapheme.FirstGrapheme(word)
+				headWidth = wr.measure(head)
+			}
+			if len(head) > 0 {
+				if sep != 0 {
+					wr.writeString(" ")
+				}
+				wr.write(head)
+				wr.col, wr.empty = wr.col+sep+headWidth, false
+				word = word[len(head):]
+			}
+		}
+		if len(word) > 0 {
+			wr.endLine()
+		}
+	}
+}
+
+// WriteWrapped writes s to w, reflowed into lines that are at most width
+// columns wide, including any indentation. Lines are broken at whitespace,
+// and each run of whitespace between words on the same line is replaced by a
+// single space. Blank lines are written as empty lines. If s ends with a
+// newline, so does the output. A width less than one is treated as one.
+//
+// WriteWrapped returns the number of bytes written and any error returned by
+// w.
+func WriteWrapped[S String](w Writer[S], s S, width int, opts WrapOptions) (n int, err error) {
+	wr := wrapper[S]{
+		w:       w,
+		width:   max(width, 1),
+		opts:    &opts,
+		indent:  S(opts.Indent),
+		hanging: S(opts.HangingIndent),
+		first:   true,
+	}
+	wr.indentWidth, wr.hangingWidth = wr.measure(wr.indent), wr.measure(wr.hanging)
+
+	inParagraph, newline := false, false
+	for line := range Lines(s) {
+		if newline = line[len(line)-1] == '\n'; newline {
+			line = line[:len(line)-1]
+		}
+		if len(TrimSpace(line)) == 0 {
+			// A blank line ends the current paragraph.
+			wr.endLine()
+			if newline {
+				wr.writeString("\n")
+			}
+			inParagraph = false
+			continue
+		}
+		if !inParagraph || opts.PreserveNewlines {
+			wr.endLine()
+			wr.first, inParagraph = true, true
+		}
+		for word := range FieldsSeq(line) {
+			wr.word(word)
+		}
+		if wr.err != nil {
+			break
+		}
+	}
+	if newline {
+		wr.endLine()
+	}
+	return wr.n, wr.err
+}
+
+// Wrap returns s reflowed into lines that are at most width columns wide, as
+// described by WriteWrapped.
+func Wrap[S String](s S, width int, opts WrapOptions) S {
+	var b Builder[S]
+	b.Grow(len(s))
+	WriteWrapped[S](&b, s, width, opts)
+	return b.Text()
+}
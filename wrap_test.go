@@ -0,0 +1,132 @@
+package text_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/pgavlin/text"
+)
+
+var wrapTests = []struct {
+	in    string
+	width int
+	opts  WrapOptions
+	out   string
+}{
+	{"", 10, WrapOptions{}, ""},
+	{"\n", 10, WrapOptions{}, "\n"},
+	{"hello", 10, WrapOptions{}, "hello"},
+	{"hello\n", 10, WrapOptions{}, "hello\n"},
+	{"the quick brown fox", 10, WrapOptions{}, "the quick\nbrown fox"},
+	{"the quick brown fox", 9, WrapOptions{}, "the quick\nbrown fox"},
+	{"the quick brown fox", 8, WrapOptions{}, "the\nquick\nbrown\nfox"},
+	{"  the   quick\tbrown  ", 20, WrapOptions{}, "the quick brown"},
+	{"the quick\nbrown fox\n", 20, WrapOptions{}, "the quick brown fox\n"},
+	{"one\n\ntwo\n", 20, WrapOptions{}, "one\n\ntwo\n"},
+	{"one\n \n\t\ntwo", 20, WrapOptions{}, "one\n\n\ntwo"},
+	{"\n\none", 20, WrapOptions{}, "\n\none"},
+	{"the quick\nbrown fox\n", 20, WrapOptions{PreserveNewlines: true}, "the quick\nbrown fox\n"},
+	{"a b c d\ne f\n", 3, WrapOptions{PreserveNewlines: true}, "a b\nc d\ne f\n"},
+
+	// Long words.
+	{"a supercalifragilistic b", 8, WrapOptions{}, "a\nsupercalifragilistic\nb"},
+	{"a supercalifragilistic b", 8, WrapOptions{BreakLongWords: true}, "a superc\nalifragi\nlistic b"},
+	{"abc defghij", 5, WrapOptions{BreakLongWords: true}, "abc d\nefghi\nj"},
+	{"abc defg", 5, WrapOptions{BreakLongWords: true}, "abc\ndefg"},
+	{"abcdef", 1, WrapOptions{BreakLongWords: true}, "a\nb\nc\nd\ne\nf"},
+	{"abc", 0, WrapOptions{BreakLongWords: true}, "a\nb\nc"},
+	{"abcde\u0301f", 5, WrapOptions{BreakLongWords: true}, "abcd\ne\u0301f"},
+	{"e\u0301e\u0301", 1, WrapOptions{BreakLongWords: true}, "e\u0301\ne\u0301"},
+	{"日本語", 2, WrapOptions{BreakLongWords: true}, "日本\n語"},
+
+	// Indentation.
+	{"the quick brown fox", 12, WrapOptions{Indent: "* ", HangingIndent: "  "}, "* the quick\n  brown fox"},
+	{"one two\n\nthree four", 9, WrapOptions{Indent: "> ", HangingIndent: "> "}, "> one two\n\n> three\n> four"},
+	{"one two three", 7, WrapOptions{HangingIndent: "    "}, "one two\n    three"},
+	{"aaaaaaaa", 6, WrapOptions{Indent: "- ", HangingIndent: "  ", BreakLongWords: true}, "- aaaa\n  aaaa"},
+	{"a\nb\n", 10, WrapOptions{Indent: "- ", PreserveNewlines: true}, "- a\n- b\n"},
+
+	// Display width.
+	{"日本語 日本語", 7, WrapOptions{}, "日本語 日本語"},
+	{"日本語 日本語", 7, WrapOptions{DisplayWidth: true}, "日本語\n日本語"},
+	{"日本語", 3, WrapOptions{DisplayWidth: true, BreakLongWords: true}, "日\n本\n語"},
+	{"日本語", 1, WrapOptions{DisplayWidth: true, BreakLongWords: true}, "日\n本\n語"},
+	{"ééé é", 5, WrapOptions{DisplayWidth: true}, "ééé é"},
+	{"ééé", 2, WrapOptions{DisplayWidth: true, BreakLongWords: true}, "éé\né"},
+	{"ab\U0001f469\u200d\U0001f469\u200d\U0001f467", 3, WrapOptions{DisplayWidth: true, BreakLongWords: true}, "ab\n\U0001f469\u200d\U0001f469\u200d\U0001f467"},
+}
+
+func TestWrap(t *testing.T) {
+	for _, tt := range wrapTests {
+		if got := Wrap(tt.in, tt.width, tt.opts); got != tt.out {
+			t.Errorf("Wrap(%+q, %d, %+v) = %+q; want %+q", tt.in, tt.width, tt.opts, got, tt.out)
+		}
+		if got := Wrap([]byte(tt.in), tt.width, tt.opts); string(got) != tt.out {
+			t.Errorf("Wrap([]byte(%+q), %d, %+v) = %+q; want %+q", tt.in, tt.width, tt.opts, got, tt.out)
+		}
+	}
+}
+
+func TestWriteWrapped(t *testing.T) {
+	for _, tt := range wrapTests {
+		var b Builder[string]
+		n, err := WriteWrapped[string](&b, tt.in, tt.width, tt.opts)
+		if err != nil {
+			t.Errorf("WriteWrapped(%+q, %d, %+v): unexpected error %v", tt.in, tt.width, tt.opts, err)
+		}
+		if got := b.String(); got != tt.out || n != len(tt.out) {
+			t.Errorf("WriteWrapped(%+q, %d, %+v) = %+q, %d; want %+q, %d", tt.in, tt.width, tt.opts, got, n, tt.out, len(tt.out))
+		}
+	}
+}
+
+type limitedWriter struct {
+	strings.Builder
+	limit int
+}
+
+var errLimit = errors.New("limit reached")
+
+func (w *limitedWriter) WriteText(s string) (int, error) {
+	if w.Len()+len(s) > w.limit {
+		n, _ := w.WriteString(s[:w.limit-w.Len()])
+		return n, errLimit
+	}
+	return w.WriteString(s)
+}
+
+func TestWriteWrappedError(t *testing.T) {
+	w := &limitedWriter{limit: 10}
+	n, err := WriteWrapped[string](w, strings.Repeat("word ", 100), 12, WrapOptions{})
+	if err != errLimit {
+		t.Errorf("WriteWrapped: got error %v; want %v", err, errLimit)
+	}
+	if n != 10 || w.String() != "word word\n" {
+		t.Errorf("WriteWrapped: wrote %+q, %d", w.String(), n)
+	}
+}
+
+func TestWrapWidth(t *testing.T) {
+	in := strings.Repeat("lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 20)
+	for width := 1; width < 40; width++ {
+		opts := WrapOptions{BreakLongWords: true, HangingIndent: " "}
+		for line := range Lines(Wrap(in, width, opts)) {
+			line = strings.TrimSuffix(line, "\n")
+			if DisplayWidth(line) > max(width, 2) {
+				t.Errorf("Wrap(_, %d, %+v): line %+q is too wide", width, opts, line)
+			}
+		}
+		if got, want := strings.Join(strings.Fields(Wrap(in, width, WrapOptions{})), " "), strings.TrimSpace(in); got != want {
+			t.Errorf("Wrap(_, %d) changed the words of its input", width)
+		}
+	}
+}
+
+func BenchmarkWrap(b *testing.B) {
+	s := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 100)
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		Wrap(s, 72, WrapOptions{})
+	}
+}